@@ -0,0 +1,18 @@
+// Package openapi embeds the OpenAPI document of the REST gateway generated from the proto.
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+//go:embed gophkeeper.swagger.json
+var Document []byte
+
+// Handler serves the document.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(Document)
+	})
+}
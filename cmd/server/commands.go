@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itallix/gophkeeper/internal/server/config"
+)
+
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "server",
+		Short: "GophKeeper server",
+		Long: `GophKeeper server stores the encrypted secrets of the CLI users.
+
+Every setting can be given in a YAML or TOML file (--config), by an environment variable
+or by a flag. Flags take precedence over environment variables, which take precedence over
+the file. SIGHUP reloads the configuration, applying the log level and the rate limits.`,
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return run(cmd.Flags())
+		},
+	}
+	config.RegisterFlags(root.PersistentFlags())
+	root.AddCommand(newConfigCommand(), newMigrateCommand())
+	return root
+}
+
+func newConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the configuration",
+	}
+	var format string
+	printCmd := &cobra.Command{
+		Use:   "print",
+		Short: "Print the effective configuration with the secrets redacted",
+		Long: `Print the configuration resulting from the file, the environment and the flags
+with the secrets redacted. Problems found by the validation are reported after it.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.Read(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err = cfg.Write(cmd.OutOrStdout(), format); err != nil {
+				return err
+			}
+			if err = cfg.Validate(); err != nil {
+				return fmt.Errorf("invalid configuration:\n%w", err)
+			}
+			return nil
+		},
+	}
+	printCmd.Flags().StringVar(&format, "format", config.FormatYAML,
+		fmt.Sprintf("output format, %s or %s", config.FormatYAML, config.FormatTOML))
+	cmd.AddCommand(printCmd)
+	return cmd
+}
@@ -5,115 +5,379 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
-	"github.com/caarlos0/env"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
+	"github.com/itallix/gophkeeper/api/openapi"
 	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/common/password"
+	"github.com/itallix/gophkeeper/internal/common/tracing"
 	"github.com/itallix/gophkeeper/internal/server"
+	"github.com/itallix/gophkeeper/internal/server/config"
+	"github.com/itallix/gophkeeper/internal/server/gateway"
 	pgrpc "github.com/itallix/gophkeeper/internal/server/grpc"
 	"github.com/itallix/gophkeeper/internal/server/grpc/middleware"
+	"github.com/itallix/gophkeeper/internal/server/grpcweb"
+	"github.com/itallix/gophkeeper/internal/server/health"
+	"github.com/itallix/gophkeeper/internal/server/metrics"
+	"github.com/itallix/gophkeeper/internal/server/ratelimit"
 	"github.com/itallix/gophkeeper/internal/server/s3"
 	"github.com/itallix/gophkeeper/internal/server/service"
 	"github.com/itallix/gophkeeper/internal/server/storage"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
 )
 
-type config struct {
-	Address          string `env:"ADDRESS" envDefault:"localhost:8081"`
-	DSN              string `env:"DB_DSN" envDefault:"postgres://postgres:P@ssw0rd@localhost/gophkeeper?sslmode=disable"`
-	LogLevel         string `env:"LOG_LEVEL" envDefault:"DEBUG"`
-	AccessSecret     string `env:"ACCESS_SECRET" envDefault:"access_secret"`
-	RefreshSecret    string `env:"REFRESH_SECRET" envDefault:"refresh_secret"`
-	MasterKeyPath    string `env:"MASTER_KEY" envDefault:"testdata/private.pem"`
-	EncryptedKeyPath string `env:"ENCRYPTED_KEY" envDefault:"testdata/encrypted_key.bin"`
-}
-
 const (
 	AccessTokenTTLHours  = 1
 	RefreshTokenTTLHours = 24
 	ShutdownTimeoutSec   = 30
+	ReadHeaderTimeoutSec = 10
+	AuthRateBurst        = 5
 )
 
-func createServer(ctx context.Context, cfg config) (*grpc.Server, net.Listener, error) {
-	pool, err := pgxpool.New(ctx, cfg.DSN)
+// perMinute creates a limiter allowing rate calls per minute, nil if rate is not positive.
+func perMinute(rate int) *ratelimit.Limiter {
+	if rate <= 0 {
+		return nil
+	}
+	return ratelimit.NewLimiter(float64(rate)/time.Minute.Seconds(), AuthRateBurst)
+}
+
+// perSecond creates a limiter allowing rate calls per second with bursts of rate calls, nil if rate is not positive.
+func perSecond(rate int) *ratelimit.Limiter {
+	if rate <= 0 {
+		return nil
+	}
+	return ratelimit.NewLimiter(float64(rate), rate)
+}
+
+func argon2Params(cfg config.Argon2) service.Argon2Params {
+	// the ranges are checked by config.Validate
+	return service.Argon2Params{
+		Memory:      uint32(cfg.Memory),     //nolint:gosec // validated
+		Iterations:  uint32(cfg.Iterations), //nolint:gosec // validated
+		Parallelism: uint8(cfg.Parallelism), //nolint:gosec // validated
+	}
+}
+
+// newSSO creates the single sign-on manager, nil if no issuer is configured.
+func newSSO(ctx context.Context, cfg config.OIDC, pool *pgxpool.Pool) (*server.SSOManager, error) {
+	if cfg.Issuer == "" {
+		return nil, nil //nolint:nilnil // single sign-on is optional
+	}
+	mappings, err := server.ParseGroupRoles(cfg.GroupRoles)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to initialize connection pool: %w", err)
+		return nil, fmt.Errorf("oidc.group_roles: %w", err)
 	}
+	verifier := service.NewOIDCVerifier(service.OIDCConfig{
+		Issuer:        cfg.Issuer,
+		ClientID:      cfg.ClientID,
+		Scopes:        cfg.Scopes,
+		UsernameClaim: cfg.UsernameClaim,
+		GroupsClaim:   cfg.GroupsClaim,
+	}, nil)
+	return server.NewSSOManager(ctx, pool, verifier, mappings), nil
+}
 
-	objectStorage, err := s3.NewObjectStorage()
+// servers are the listeners of the application, http and metrics are nil when their address is empty.
+type servers struct {
+	grpc    *grpc.Server
+	lis     net.Listener
+	http    *http.Server
+	metrics *http.Server
+	health  *health.Checker
+	// gateway is the connection of the REST gateway to the gRPC server
+	gateway *grpc.ClientConn
+	// limits are updated when the configuration is reloaded
+	limits middleware.RateLimits
+}
+
+func createServer(ctx context.Context, cfg *config.Config) (*servers, error) {
+	if err := checkSchema(cfg.Database); err != nil {
+		return nil, err
+	}
+	poolConfig, err := pgxpool.ParseConfig(cfg.Database.DSN)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to initialize object storage: %w", err)
+		return nil, fmt.Errorf("failed to parse the database DSN: %w", err)
+	}
+	poolConfig.ConnConfig.Tracer = storage.NewQueryTracer()
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize connection pool: %w", err)
+	}
+	m := metrics.New()
+	if err = m.RegisterPool(pool); err != nil {
+		return nil, fmt.Errorf("failed to register pool metrics: %w", err)
+	}
+
+	objectStorage, err := s3.NewObjectStorage(s3.Config{
+		Endpoint:  cfg.ObjectStorage.Endpoint,
+		AccessKey: cfg.ObjectStorage.AccessKey,
+		SecretKey: cfg.ObjectStorage.SecretKey,
+		Secure:    cfg.ObjectStorage.Secure,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize object storage: %w", err)
 	}
 
-	kms, err := service.NewRSAKMS(cfg.MasterKeyPath, cfg.EncryptedKeyPath)
+	kms, err := service.NewRSAKMS(cfg.KMS.MasterKey, cfg.KMS.EncryptedKey)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to initialize kms: %w", err)
+		return nil, fmt.Errorf("failed to initialize kms: %w", err)
 	}
-	encryptionService := service.NewStandardEncryptionService(kms)
-	vault := server.NewVaultImpl(ctx, pool, objectStorage, encryptionService)
+	encryptionService := service.NewStandardEncryptionService(metrics.NewKMS(kms, m))
+	vault := server.NewVaultImpl(ctx, pool, objectStorage, encryptionService,
+		server.WithPasswordPolicy(password.Policy{
+			MinLength:     cfg.Password.MinLength,
+			RequireLower:  cfg.Password.RequireLower,
+			RequireUpper:  cfg.Password.RequireUpper,
+			RequireDigit:  cfg.Password.RequireDigit,
+			RequireSymbol: cfg.Password.RequireSymbol,
+			MinScore:      cfg.Password.MinScore,
+		}))
 	lis, err := net.Listen("tcp", cfg.Address)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed liseting address: %w", err)
+		return nil, fmt.Errorf("failed liseting address: %w", err)
+	}
+	authOptions := []service.AuthOption{
+		service.WithPasswordHasher(service.NewArgon2Hasher(argon2Params(cfg.Argon2))),
+		service.WithServiceTokenTTL(cfg.JWT.ServiceTokenTTL),
+	}
+	serverOptions := []pgrpc.ServerOption{}
+	mux := http.NewServeMux()
+	if cfg.JWT.Algorithm != config.JWTAlgorithmHMAC {
+		keyset, ksErr := service.NewKeyset(ctx, storage.NewSigningKeyRepo(pool), encryptionService, cfg.JWT.Algorithm)
+		if ksErr != nil {
+			return nil, fmt.Errorf("failed to initialize signing keys: %w", ksErr)
+		}
+		authOptions = append(authOptions, service.WithKeyset(keyset))
+		serverOptions = append(serverOptions, pgrpc.WithSigningKeys(keyset))
+		mux.Handle("GET /.well-known/jwks.json", service.NewJWKSHandler(keyset))
 	}
 	userRepo := storage.NewUserRepo(pool)
-	authService := service.NewJWTAuthService(userRepo, []byte(cfg.AccessSecret),
-		[]byte(cfg.RefreshSecret), AccessTokenTTLHours*time.Hour, RefreshTokenTTLHours*time.Hour)
+	authService := service.NewJWTAuthService(userRepo, []byte(cfg.JWT.AccessSecret),
+		[]byte(cfg.JWT.RefreshSecret), AccessTokenTTLHours*time.Hour, RefreshTokenTTLHours*time.Hour,
+		authOptions...)
 	authInterceptor := middleware.NewAuthInterceptor(authService)
+	auditInterceptor := middleware.NewAuditInterceptor(vault)
+	limits := middleware.RateLimits{
+		PerIP:    perMinute(cfg.RateLimit.IP),
+		PerLogin: perMinute(cfg.RateLimit.Login),
+		PerUser:  perSecond(cfg.RateLimit.User),
+	}
+	lockout := ratelimit.NewLockout(storage.NewLockoutRepo(pool), ratelimit.LockoutPolicy{
+		Threshold: cfg.RateLimit.LockoutThreshold,
+		Duration:  cfg.RateLimit.LockoutDuration,
+		BaseDelay: cfg.RateLimit.LoginDelayBase,
+		MaxDelay:  cfg.RateLimit.LoginDelayMax,
+	})
+	rateLimitInterceptor := middleware.NewRateLimitInterceptor(limits, lockout)
+	metricsInterceptor := middleware.NewMetricsInterceptor(m)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authInterceptor.Unary()),
-		grpc.StreamInterceptor(authInterceptor.Stream()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		// Throttled calls are rejected before they reach the audit log. They are still counted by the metrics.
+		grpc.ChainUnaryInterceptor(metricsInterceptor.Unary(), authInterceptor.Unary(), rateLimitInterceptor.Unary(),
+			auditInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(metricsInterceptor.Stream(), authInterceptor.Stream(), rateLimitInterceptor.Stream(),
+			auditInterceptor.Stream()),
+	)
+	authorizer := server.NewAuthorizer(ctx, pool, metrics.NewVault(vault, m))
+	sso, err := newSSO(ctx, cfg.OIDC, pool)
+	if err != nil {
+		return nil, err
+	}
+	if sso != nil {
+		serverOptions = append(serverOptions, pgrpc.WithSSO(sso))
+	}
+	serverOptions = append(serverOptions,
+		pgrpc.WithOrganizations(authorizer),
+		pgrpc.WithAuditLog(vault),
+		pgrpc.WithAdmins(cfg.AdminUsers...),
+		pgrpc.WithSRP(service.NewSRPAuthService(userRepo, authService)),
+		pgrpc.WithServiceAccounts(server.NewServiceAccountManager(ctx, pool)),
+		pgrpc.WithSecretTypes(server.NewSecretTypeRegistry(pool)),
 	)
-	pb.RegisterGophkeeperServiceServer(grpcServer, pgrpc.NewGophkeeperServer(vault, authService, userRepo))
+	pb.RegisterGophkeeperServiceServer(grpcServer, pgrpc.NewGophkeeperServer(authorizer, authService, userRepo,
+		serverOptions...))
+
+	checker := health.NewChecker(map[string]health.Probe{
+		"postgres": pool.Ping,
+		"object_storage": func(ctx context.Context) error {
+			return objectStorage.Ping(ctx, storage.BucketBinaries)
+		},
+	}, health.WithInterval(cfg.HealthCheckInterval), health.WithServices(pb.GophkeeperService_ServiceDesc.ServiceName))
+	healthpb.RegisterHealthServer(grpcServer, checker.Server())
+	mux.Handle("GET /healthz", checker.LiveHandler())
+	mux.Handle("GET /readyz", checker.ReadyHandler())
+	if cfg.Reflection {
+		reflection.Register(grpcServer)
+	}
 
-	return grpcServer, lis, nil
+	srv := &servers{grpc: grpcServer, lis: lis, health: checker, limits: limits}
+	if cfg.HTTPAddress != "" {
+		srv.gateway, err = grpc.NewClient(lis.Addr().String(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+			middleware.WithGatewayCredentials(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect the REST gateway: %w", err)
+		}
+		gw, gwErr := gateway.New(ctx, srv.gateway)
+		if gwErr != nil {
+			return nil, gwErr
+		}
+		mux.Handle("/v1/", gw)
+		mux.Handle("GET /openapi.json", openapi.Handler())
+		if cfg.GRPCWeb {
+			grpcweb.New(grpcServer).Register(mux)
+		}
+		var handler http.Handler = mux
+		if len(cfg.CORSOrigins) > 0 {
+			handler = grpcweb.CORS(cfg.CORSOrigins, mux)
+		}
+		srv.http = &http.Server{
+			Addr:              cfg.HTTPAddress,
+			Handler:           handler,
+			ReadHeaderTimeout: ReadHeaderTimeoutSec * time.Second,
+		}
+	}
+	if cfg.MetricsAddress != "" {
+		srv.metrics = &http.Server{
+			Addr:              cfg.MetricsAddress,
+			Handler:           m.Handler(),
+			ReadHeaderTimeout: ReadHeaderTimeoutSec * time.Second,
+		}
+	}
+	return srv, nil
+}
+
+// setRate updates a limiter created by create, enabling or disabling a limit needs a restart.
+func setRate(limiter *ratelimit.Limiter, rate int, create func(int) *ratelimit.Limiter, key string) {
+	updated := create(rate)
+	if (limiter == nil) != (updated == nil) {
+		logger.Log().Warnf("enabling or disabling %s requires a restart", key)
+		return
+	}
+	if limiter != nil {
+		limiter.SetLimits(updated.Limits())
+	}
+}
+
+// reload applies the log level and the rate limits of the reloaded configuration,
+// the other changes are only reported. An invalid configuration is ignored.
+func (srv *servers) reload(current *config.Config, flags *pflag.FlagSet) *config.Config {
+	cfg, err := config.Load(flags)
+	if err != nil {
+		logger.Log().Errorf("configuration not reloaded: %v", err)
+		return current
+	}
+	for _, key := range config.Changes(current, cfg) {
+		if !config.Reloadable(key) {
+			logger.Log().Warnf("%s has changed, restart the server to apply it", key)
+		}
+	}
+	if err = logger.SetLevel(cfg.LogLevel); err != nil {
+		logger.Log().Errorf("failed to set the log level: %v", err)
+	}
+	setRate(srv.limits.PerIP, cfg.RateLimit.IP, perMinute, "rate_limit.ip")
+	setRate(srv.limits.PerLogin, cfg.RateLimit.Login, perMinute, "rate_limit.login")
+	setRate(srv.limits.PerUser, cfg.RateLimit.User, perSecond, "rate_limit.user")
+	logger.Log().Info("Configuration reloaded")
+	return cfg
 }
 
-func run() error {
+func run(flags *pflag.FlagSet) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGHUP)
 
-	var cfg config
-	if err := env.Parse(&cfg); err != nil {
-		return fmt.Errorf("cannot parse config: %w", err)
+	cfg, err := config.Load(flags)
+	if err != nil {
+		return fmt.Errorf("invalid configuration:\n%w", err)
 	}
 
-	if err := logger.Initialize(cfg.LogLevel); err != nil {
+	if err = logger.Initialize(cfg.LogLevel); err != nil {
 		return fmt.Errorf("cannot instantiate zap logger: %w", err)
 	}
-	grpcServer, lis, err := createServer(ctx, cfg)
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		ServiceName: "gophkeeper-server",
+		Exporter:    cfg.Tracing.Exporter,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot set up tracing: %w", err)
+	}
+	srv, err := createServer(ctx, cfg)
 	if err != nil {
 		return err
 	}
+	grpcServer := srv.grpc
+	go srv.health.Run(ctx)
 
 	go func() {
 		logger.Log().Infof("Starting gRPC server %s...", cfg.Address)
-		if err = grpcServer.Serve(lis); err != nil && !errors.Is(err, net.ErrClosed) {
-			logger.Log().Errorf("failed to serve gRPC server: %v", err)
+		if serveErr := grpcServer.Serve(srv.lis); serveErr != nil && !errors.Is(serveErr, net.ErrClosed) {
+			logger.Log().Errorf("failed to serve gRPC server: %v", serveErr)
 			cancel()
 		}
 	}()
+	for name, httpServer := range map[string]*http.Server{"HTTP": srv.http, "metrics": srv.metrics} {
+		if httpServer == nil {
+			continue
+		}
+		go func() {
+			logger.Log().Infof("Starting %s server %s...", name, httpServer.Addr)
+			if serveErr := httpServer.ListenAndServe(); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+				logger.Log().Errorf("failed to serve %s server: %v", name, serveErr)
+				cancel()
+			}
+		}()
+	}
 
-	select {
-	case sig := <-sigChan:
-		logger.Log().Infof("Received signal: %v", sig)
-	case <-ctx.Done():
-		logger.Log().Info("Shutting down due to context cancellation")
+wait:
+	for {
+		select {
+		case sig := <-sigChan:
+			if sig == syscall.SIGHUP {
+				cfg = srv.reload(cfg, flags)
+				continue
+			}
+			logger.Log().Infof("Received signal: %v", sig)
+			break wait
+		case <-ctx.Done():
+			logger.Log().Info("Shutting down due to context cancellation")
+			break wait
+		}
 	}
 
 	logger.Log().Info("Initiating graceful shutdown...")
+	srv.health.Shutdown()
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), ShutdownTimeoutSec*time.Second)
 	defer shutdownCancel()
 
+	for name, httpServer := range map[string]*http.Server{"HTTP": srv.http, "metrics": srv.metrics} {
+		if httpServer == nil {
+			continue
+		}
+		if err = httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Log().Errorf("failed to shut down %s server: %v", name, err)
+		}
+	}
+
 	done := make(chan bool)
 	go func() {
 		// Gracefully stop the gRPC server
@@ -130,11 +394,18 @@ func run() error {
 		logger.Log().Info("Graceful shutdown completed")
 	}
 
+	if srv.gateway != nil {
+		_ = srv.gateway.Close()
+	}
+	if err = shutdownTracing(shutdownCtx); err != nil {
+		logger.Log().Errorf("failed to flush traces: %v", err)
+	}
+
 	return nil
 }
 
 func main() {
-	if err := run(); err != nil {
-		logger.Log().Fatal(err)
+	if err := newRootCommand().Execute(); err != nil {
+		os.Exit(1)
 	}
 }
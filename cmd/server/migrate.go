@@ -0,0 +1,136 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itallix/gophkeeper/db/migrations"
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server"
+	"github.com/itallix/gophkeeper/internal/server/config"
+)
+
+// checkSchema applies the pending migrations when enabled and refuses to serve a database at another version.
+func checkSchema(cfg config.Database) error {
+	migrator, err := server.NewMigrator(cfg.DSN, migrations.FS)
+	if err != nil {
+		return fmt.Errorf("failed to initialize migrations: %w", err)
+	}
+	defer migrator.Close()
+	if cfg.AutoMigrate {
+		logger.Log().Info("Applying database migrations...")
+		if err = migrator.Up(); err != nil {
+			return fmt.Errorf("failed to apply migrations: %w", err)
+		}
+	}
+	return migrator.Check()
+}
+
+// withMigrator runs fn with a migrator of the database configured by the flags, the other settings are not needed.
+func withMigrator(cmd *cobra.Command, fn func(*server.Migrator) error) error {
+	cfg, err := config.Read(cmd.Flags())
+	if err != nil {
+		return err
+	}
+	if cfg.Database.DSN == "" {
+		return errors.New("database.dsn (DB_DSN) is required")
+	}
+	migrator, err := server.NewMigrator(cfg.Database.DSN, migrations.FS)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+	if err = fn(migrator); err != nil {
+		return err
+	}
+	return printStatus(cmd, migrator)
+}
+
+func printStatus(cmd *cobra.Command, migrator *server.Migrator) error {
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) //nolint:mnd // column padding
+	for _, migration := range status.Migrations {
+		state := "pending"
+		switch {
+		case migration.Version == status.Version && status.Dirty:
+			state = "dirty"
+		case migration.Version <= status.Version:
+			state = "applied"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", migration.Version, migration.Name, state)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "schema version %d, the server expects %d\n", status.Version, status.Latest())
+	return nil
+}
+
+func parseVersion(arg string) (uint, error) {
+	version, err := strconv.ParseUint(arg, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q", arg)
+	}
+	return uint(version), nil
+}
+
+func newMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage the database schema",
+		Long: `Apply or revert the schema migrations embedded into the server. Only the database
+settings are used. Concurrent runs are serialized by a Postgres advisory lock.`,
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "up",
+		Short: "Apply all the pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withMigrator(cmd, (*server.Migrator).Up)
+		},
+	}, &cobra.Command{
+		Use:   "down",
+		Short: "Revert the last applied migration",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withMigrator(cmd, (*server.Migrator).Down)
+		},
+	}, &cobra.Command{
+		Use:   "status",
+		Short: "Show the applied and pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withMigrator(cmd, func(*server.Migrator) error { return nil })
+		},
+	}, &cobra.Command{
+		Use:   "to VERSION",
+		Short: "Apply or revert migrations up to VERSION, 0 reverts them all",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := parseVersion(args[0])
+			if err != nil {
+				return err
+			}
+			return withMigrator(cmd, func(migrator *server.Migrator) error { return migrator.To(version) })
+		},
+	}, &cobra.Command{
+		Use:   "force VERSION",
+		Short: "Set the schema version after fixing a failed migration by hand",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := parseVersion(args[0])
+			if err != nil {
+				return err
+			}
+			return withMigrator(cmd, func(migrator *server.Migrator) error { return migrator.Force(version) })
+		},
+	})
+	return cmd
+}
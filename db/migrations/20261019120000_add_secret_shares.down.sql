@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS secrets_created_by_idx;
+DROP TABLE IF EXISTS secret_shares;
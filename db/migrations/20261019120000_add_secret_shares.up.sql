@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "secret_shares" (
+	"secret_id" INTEGER NOT NULL,
+	"grantee" VARCHAR(255) NOT NULL,
+	"permission" VARCHAR(16) NOT NULL CHECK ("permission" IN ('read', 'read-write')),
+	"created_at" TIMESTAMP NOT NULL DEFAULT(now()),
+	PRIMARY KEY("secret_id", "grantee")
+);
+
+CREATE INDEX IF NOT EXISTS "secret_shares_grantee_idx" ON "secret_shares"("grantee");
+CREATE INDEX IF NOT EXISTS "secrets_created_by_idx" ON "secrets"("created_by");
+
+ALTER TABLE "secret_shares"
+ADD FOREIGN KEY("secret_id") REFERENCES "secrets"("secret_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "secret_shares"
+ADD FOREIGN KEY("grantee") REFERENCES "users"("login")
+ON UPDATE NO ACTION ON DELETE CASCADE;
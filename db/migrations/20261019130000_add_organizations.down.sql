@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS collection_teams;
+DROP TABLE IF EXISTS collection_secrets;
+DROP TABLE IF EXISTS collections;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
+DROP TABLE IF EXISTS org_members;
+DROP TABLE IF EXISTS organizations;
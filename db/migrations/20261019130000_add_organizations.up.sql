@@ -0,0 +1,90 @@
+CREATE TABLE IF NOT EXISTS "organizations" (
+	"org_id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"name" VARCHAR(255) NOT NULL UNIQUE,
+	"created_at" TIMESTAMP NOT NULL DEFAULT(now()),
+	"created_by" VARCHAR(255),
+	PRIMARY KEY("org_id")
+);
+
+
+CREATE TABLE IF NOT EXISTS "org_members" (
+	"org_id" INTEGER NOT NULL,
+	"login" VARCHAR(255) NOT NULL,
+	"role" VARCHAR(16) NOT NULL CHECK ("role" IN ('owner', 'admin', 'editor', 'viewer')),
+	PRIMARY KEY("org_id", "login")
+);
+
+
+CREATE TABLE IF NOT EXISTS "teams" (
+	"team_id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"org_id" INTEGER NOT NULL,
+	"name" VARCHAR(255) NOT NULL,
+	PRIMARY KEY("team_id"),
+	UNIQUE("org_id", "name")
+);
+
+
+CREATE TABLE IF NOT EXISTS "team_members" (
+	"team_id" INTEGER NOT NULL,
+	"login" VARCHAR(255) NOT NULL,
+	PRIMARY KEY("team_id", "login")
+);
+
+
+CREATE TABLE IF NOT EXISTS "collections" (
+	"collection_id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"org_id" INTEGER NOT NULL,
+	"name" VARCHAR(255) NOT NULL,
+	PRIMARY KEY("collection_id"),
+	UNIQUE("org_id", "name")
+);
+
+
+CREATE TABLE IF NOT EXISTS "collection_secrets" (
+	"collection_id" INTEGER NOT NULL,
+	"secret_id" INTEGER NOT NULL,
+	PRIMARY KEY("secret_id")
+);
+
+
+CREATE TABLE IF NOT EXISTS "collection_teams" (
+	"collection_id" INTEGER NOT NULL,
+	"team_id" INTEGER NOT NULL,
+	"role" VARCHAR(16) NOT NULL CHECK ("role" IN ('editor', 'viewer')),
+	PRIMARY KEY("collection_id", "team_id")
+);
+
+
+ALTER TABLE "organizations"
+ADD FOREIGN KEY("created_by") REFERENCES "users"("login")
+ON UPDATE NO ACTION ON DELETE SET NULL;
+ALTER TABLE "org_members"
+ADD FOREIGN KEY("org_id") REFERENCES "organizations"("org_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "org_members"
+ADD FOREIGN KEY("login") REFERENCES "users"("login")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "teams"
+ADD FOREIGN KEY("org_id") REFERENCES "organizations"("org_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "team_members"
+ADD FOREIGN KEY("team_id") REFERENCES "teams"("team_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "team_members"
+ADD FOREIGN KEY("login") REFERENCES "users"("login")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "collections"
+ADD FOREIGN KEY("org_id") REFERENCES "organizations"("org_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "collection_secrets"
+ADD FOREIGN KEY("collection_id") REFERENCES "collections"("collection_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "collection_secrets"
+ADD FOREIGN KEY("secret_id") REFERENCES "secrets"("secret_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "collection_teams"
+ADD FOREIGN KEY("collection_id") REFERENCES "collections"("collection_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "collection_teams"
+ADD FOREIGN KEY("team_id") REFERENCES "teams"("team_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS "audit_events" (
+	"event_id" BIGINT NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"actor" VARCHAR(255) NOT NULL,
+	"action" VARCHAR(64) NOT NULL,
+	"path" VARCHAR(255) NOT NULL,
+	"secret_type" VARCHAR(16) NOT NULL,
+	"owner" VARCHAR(255) NOT NULL,
+	"result" VARCHAR(32) NOT NULL,
+	"client_ip" VARCHAR(64) NOT NULL,
+	"user_agent" VARCHAR(512) NOT NULL,
+	"created_at" TIMESTAMPTZ NOT NULL,
+	"prev_hash" BYTEA NOT NULL,
+	"hash" BYTEA NOT NULL,
+	PRIMARY KEY("event_id")
+);
+
+CREATE INDEX IF NOT EXISTS "audit_events_actor_idx" ON "audit_events"("actor");
+CREATE INDEX IF NOT EXISTS "audit_events_owner_idx" ON "audit_events"("owner");
+CREATE INDEX IF NOT EXISTS "audit_events_path_idx" ON "audit_events"("path");
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_events_no_change"
+BEFORE UPDATE OR DELETE ON "audit_events"
+FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+CREATE TRIGGER "audit_events_no_truncate"
+BEFORE TRUNCATE ON "audit_events"
+FOR EACH STATEMENT EXECUTE FUNCTION audit_events_append_only();
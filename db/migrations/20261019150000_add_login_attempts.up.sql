@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "login_attempts" (
+	"login" VARCHAR(255) NOT NULL,
+	"failures" INTEGER NOT NULL DEFAULT 0,
+	"last_failure" TIMESTAMPTZ,
+	"locked_until" TIMESTAMPTZ,
+	PRIMARY KEY("login")
+);
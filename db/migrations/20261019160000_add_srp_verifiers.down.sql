@@ -0,0 +1,5 @@
+-- Users registered with SRP have no password hash and can't log in until they reset it.
+UPDATE "users" SET "password_hash" = '' WHERE "password_hash" IS NULL;
+ALTER TABLE "users" ALTER COLUMN "password_hash" SET NOT NULL;
+ALTER TABLE "users" DROP COLUMN IF EXISTS "srp_verifier";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "srp_salt";
@@ -0,0 +1,3 @@
+ALTER TABLE "users" ALTER COLUMN "password_hash" DROP NOT NULL;
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "srp_salt" BYTEA;
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "srp_verifier" BYTEA;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS api_keys;
+DELETE FROM users WHERE login IN (SELECT name FROM service_accounts);
+DROP TABLE IF EXISTS service_accounts;
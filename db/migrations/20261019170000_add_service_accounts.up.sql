@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS "service_accounts" (
+	"name" VARCHAR(255) NOT NULL,
+	"owner" VARCHAR(255) NOT NULL,
+	"created_at" TIMESTAMPTZ NOT NULL DEFAULT(now()),
+	PRIMARY KEY("name")
+);
+
+
+CREATE TABLE IF NOT EXISTS "api_keys" (
+	"key_id" VARCHAR(32) NOT NULL,
+	"account" VARCHAR(255) NOT NULL,
+	"key_hash" BYTEA NOT NULL,
+	"read_only" BOOLEAN NOT NULL DEFAULT false,
+	"path_prefixes" TEXT[] NOT NULL DEFAULT '{}',
+	"created_at" TIMESTAMPTZ NOT NULL DEFAULT(now()),
+	"expires_at" TIMESTAMPTZ,
+	"last_used_at" TIMESTAMPTZ,
+	PRIMARY KEY("key_id")
+);
+
+CREATE INDEX IF NOT EXISTS "service_accounts_owner_idx" ON "service_accounts"("owner");
+CREATE INDEX IF NOT EXISTS "api_keys_account_idx" ON "api_keys"("account");
+
+
+-- The account is a user as well, so secrets can be shared with it and it shows up in the audit log.
+ALTER TABLE "service_accounts"
+ADD FOREIGN KEY("name") REFERENCES "users"("login")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "service_accounts"
+ADD FOREIGN KEY("owner") REFERENCES "users"("login")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "api_keys"
+ADD FOREIGN KEY("account") REFERENCES "service_accounts"("name")
+ON UPDATE NO ACTION ON DELETE CASCADE;
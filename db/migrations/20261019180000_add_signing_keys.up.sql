@@ -0,0 +1,11 @@
+-- Private keys are encrypted with a data key of the KMS, like the secrets themselves.
+CREATE TABLE IF NOT EXISTS "signing_keys" (
+	"kid" VARCHAR(64) NOT NULL,
+	"algorithm" VARCHAR(16) NOT NULL,
+	"private_key" BYTEA NOT NULL,
+	"data_key" BYTEA NOT NULL,
+	"created_at" TIMESTAMPTZ NOT NULL DEFAULT(now()),
+	PRIMARY KEY("kid")
+);
+
+CREATE INDEX IF NOT EXISTS "signing_keys_created_at_idx" ON "signing_keys"("created_at");
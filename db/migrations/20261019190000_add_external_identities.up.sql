@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "external_identities" (
+	"issuer" VARCHAR(255) NOT NULL,
+	"subject" VARCHAR(255) NOT NULL,
+	"login" VARCHAR(255) NOT NULL,
+	"groups" TEXT[] NOT NULL DEFAULT '{}',
+	"admin" BOOLEAN NOT NULL DEFAULT false,
+	"created_at" TIMESTAMPTZ NOT NULL DEFAULT(now()),
+	"last_login_at" TIMESTAMPTZ NOT NULL DEFAULT(now()),
+	PRIMARY KEY("issuer", "subject")
+);
+
+CREATE INDEX IF NOT EXISTS "external_identities_login_idx" ON "external_identities"("login");
+
+
+ALTER TABLE "external_identities"
+ADD FOREIGN KEY("login") REFERENCES "users"("login")
+ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "ssh_keys" (
+	"ssh_key_id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"secret_id" INTEGER NOT NULL,
+	"private_key" BYTEA NOT NULL,
+	"public_key" TEXT NOT NULL,
+	"passphrase" BYTEA NOT NULL,
+	"comment" VARCHAR(255) NOT NULL DEFAULT '',
+	"fingerprint" VARCHAR(255) NOT NULL,
+	PRIMARY KEY("ssh_key_id", "secret_id")
+);
+
+ALTER TABLE "ssh_keys"
+ADD FOREIGN KEY("secret_id") REFERENCES "secrets"("secret_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+ALTER TABLE "logins" DROP COLUMN IF EXISTS "totp";
+DROP TABLE IF EXISTS totps;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "totps" (
+	"totp_id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"secret_id" INTEGER NOT NULL,
+	"uri" BYTEA NOT NULL,
+	PRIMARY KEY("totp_id", "secret_id")
+);
+
+ALTER TABLE "totps"
+ADD FOREIGN KEY("secret_id") REFERENCES "secrets"("secret_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+
+-- encrypted otpauth URI of the second factor of a login, empty when none is attached
+ALTER TABLE "logins" ADD COLUMN IF NOT EXISTS "totp" BYTEA NOT NULL DEFAULT '';
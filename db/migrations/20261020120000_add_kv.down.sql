@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS kv_fields;
+DROP TABLE IF EXISTS kvs;
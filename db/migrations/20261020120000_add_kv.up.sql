@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS "kvs" (
+	"kv_id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"secret_id" INTEGER NOT NULL,
+	PRIMARY KEY("kv_id", "secret_id")
+);
+
+ALTER TABLE "kvs"
+ADD FOREIGN KEY("secret_id") REFERENCES "secrets"("secret_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+
+-- every field value is encrypted with the data key of the secret, the position keeps the order they were given in
+CREATE TABLE IF NOT EXISTS "kv_fields" (
+	"kv_id" INTEGER NOT NULL,
+	"position" INTEGER NOT NULL,
+	"name" VARCHAR(255) NOT NULL,
+	"value" BYTEA NOT NULL,
+	"sensitive" BOOLEAN NOT NULL DEFAULT FALSE,
+	PRIMARY KEY("kv_id", "name")
+);
+
+ALTER TABLE "kv_fields"
+ADD FOREIGN KEY("kv_id") REFERENCES "kvs"("kv_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
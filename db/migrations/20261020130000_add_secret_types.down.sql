@@ -0,0 +1,2 @@
+ALTER TABLE "kvs" DROP COLUMN IF EXISTS "type_name";
+DROP TABLE IF EXISTS secret_types;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "secret_types" (
+	"name" VARCHAR(255) NOT NULL,
+	"description" TEXT NOT NULL DEFAULT '',
+	-- field definitions: name, type, description, required, sensitive and pattern
+	"fields" JSONB NOT NULL,
+	"created_by" VARCHAR(255) NOT NULL,
+	"created_at" TIMESTAMP NOT NULL DEFAULT(now()),
+	PRIMARY KEY("name")
+);
+
+-- user-defined secret type of a key-value secret, NULL for free-form fields
+ALTER TABLE "kvs" ADD COLUMN IF NOT EXISTS "type_name" VARCHAR(255);
+
+ALTER TABLE "kvs"
+ADD FOREIGN KEY("type_name") REFERENCES "secret_types"("name")
+ON UPDATE NO ACTION ON DELETE RESTRICT;
@@ -0,0 +1,3 @@
+ALTER TABLE "cards" DROP COLUMN IF EXISTS "pin";
+ALTER TABLE "cards" DROP COLUMN IF EXISTS "billing_address";
+ALTER TABLE "cards" DROP COLUMN IF EXISTS "brand";
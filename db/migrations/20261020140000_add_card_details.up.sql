@@ -0,0 +1,4 @@
+-- brand detected from the card number, kept unencrypted so that cards can be told apart without decryption
+ALTER TABLE "cards" ADD COLUMN IF NOT EXISTS "brand" VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE "cards" ADD COLUMN IF NOT EXISTS "billing_address" BYTEA NOT NULL DEFAULT '';
+ALTER TABLE "cards" ADD COLUMN IF NOT EXISTS "pin" BYTEA NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS login_fields;
+DROP TABLE IF EXISTS login_uris;
+ALTER TABLE "logins" DROP COLUMN IF EXISTS "notes";
@@ -0,0 +1,29 @@
+ALTER TABLE "logins" ADD COLUMN IF NOT EXISTS "notes" BYTEA NOT NULL DEFAULT '';
+
+-- websites a login is used for, unencrypted so that logins can be found by URL
+CREATE TABLE IF NOT EXISTS "login_uris" (
+	"login_id" INTEGER NOT NULL,
+	"position" INTEGER NOT NULL,
+	"uri" TEXT NOT NULL,
+	-- base_domain, host, exact or regex
+	"match" VARCHAR(16) NOT NULL,
+	PRIMARY KEY("login_id", "position")
+);
+
+ALTER TABLE "login_uris"
+ADD FOREIGN KEY("login_id") REFERENCES "logins"("login_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
+
+-- custom fields, every value is encrypted with the data key of the login
+CREATE TABLE IF NOT EXISTS "login_fields" (
+	"login_id" INTEGER NOT NULL,
+	"position" INTEGER NOT NULL,
+	"name" VARCHAR(255) NOT NULL,
+	"value" BYTEA NOT NULL,
+	"sensitive" BOOLEAN NOT NULL DEFAULT FALSE,
+	PRIMARY KEY("login_id", "name")
+);
+
+ALTER TABLE "login_fields"
+ADD FOREIGN KEY("login_id") REFERENCES "logins"("login_id")
+ON UPDATE NO ACTION ON DELETE CASCADE;
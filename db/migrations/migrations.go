@@ -0,0 +1,9 @@
+// Package migrations embeds the versioned schema migrations into the server binary.
+package migrations
+
+import "embed"
+
+// FS holds the <version>_<name>.up.sql and .down.sql scripts.
+//
+//go:embed *.sql
+var FS embed.FS
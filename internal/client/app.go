@@ -22,8 +22,24 @@ func Execute(version, date, commit string) error {
 		cmd.NewCardCmd(),
 		cmd.NewNoteCmd(),
 		cmd.NewBinaryCmd(),
+		cmd.NewSSHKeyCmd(),
+		cmd.NewSSHAgentCmd(),
+		cmd.NewTOTPCmd(),
+		cmd.NewKVCmd(),
+		cmd.NewSecretTypeCmd(),
+		cmd.NewImportCmd(),
+		cmd.NewExportCmd(),
+		cmd.NewRestoreCmd(),
+		cmd.NewGenerateCmd(),
+		cmd.NewShareCmd(),
+		cmd.NewOrgCmd(),
+		cmd.NewTeamCmd(),
+		cmd.NewAuditCmd(),
+		cmd.NewServiceAccountCmd(),
+		cmd.NewKeysCmd(),
 		cmd.NewBuildCmd(version, date, commit),
 	)
 
+	defer cmd.Shutdown()
 	return rootCmd.Execute()
 }
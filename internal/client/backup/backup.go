@@ -0,0 +1,261 @@
+// Package backup implements the encrypted archive format used by the export and
+// restore commands.
+//
+// An archive starts with a plain header holding the key derivation parameters,
+// followed by a stream of ChaCha20-Poly1305 sealed segments. The key is derived
+// from the passphrase with Argon2id. Every segment is authenticated together
+// with the header and its position in the stream, and the final segment is
+// marked, so reordering, truncation or tampering with the parameters is
+// detected. The plaintext is a sequence of length-delimited ExportItem messages.
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"google.golang.org/protobuf/encoding/protodelim"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+const (
+	magic   = "GKBACKUP"
+	version = 1
+
+	saltSize    = 16
+	headerSize  = len(magic) + 1 + 4 + 4 + 1 + saltSize
+	segmentSize = 64 * 1024
+	lengthSize  = 4
+	lastSegment = 1
+
+	// Argon2id parameters recommended by RFC 9106 for memory constrained environments.
+	argonTime    = 3
+	argonMemory  = 64 * 1024
+	argonThreads = 4
+	maxMemory    = 4 * 1024 * 1024
+)
+
+var (
+	ErrNotBackup          = errors.New("file is not a gophkeeper backup")
+	ErrUnsupportedVersion = errors.New("unsupported backup version")
+	ErrWrongPassphrase    = errors.New("wrong passphrase")
+	ErrCorrupted          = errors.New("backup is corrupted")
+	ErrTruncated          = errors.New("backup is truncated")
+)
+
+type params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	salt    []byte
+}
+
+func (p params) marshal() []byte {
+	header := make([]byte, 0, headerSize)
+	header = append(header, magic...)
+	header = append(header, version)
+	header = binary.BigEndian.AppendUint32(header, p.time)
+	header = binary.BigEndian.AppendUint32(header, p.memory)
+	header = append(header, p.threads)
+	return append(header, p.salt...)
+}
+
+func unmarshalParams(header []byte) (params, error) {
+	if !bytes.HasPrefix(header, []byte(magic)) {
+		return params{}, ErrNotBackup
+	}
+	pos := len(magic)
+	if header[pos] != version {
+		return params{}, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header[pos])
+	}
+	pos++
+	p := params{
+		time:    binary.BigEndian.Uint32(header[pos:]),
+		memory:  binary.BigEndian.Uint32(header[pos+4:]),
+		threads: header[pos+8],
+		salt:    header[pos+9:],
+	}
+	if p.time == 0 || p.threads == 0 || p.memory > maxMemory {
+		return params{}, ErrCorrupted
+	}
+	return p, nil
+}
+
+func (p params) aead(passphrase string) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(passphrase), p.salt, p.time, p.memory, p.threads, chacha20poly1305.KeySize)
+	return chacha20poly1305.New(key)
+}
+
+func nonce(counter uint64, last bool) []byte {
+	n := make([]byte, chacha20poly1305.NonceSize)
+	binary.BigEndian.PutUint64(n[3:11], counter)
+	if last {
+		n[len(n)-1] = lastSegment
+	}
+	return n
+}
+
+// Writer encrypts items into an archive. Close must be called to write the final segment.
+type Writer struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	header  []byte
+	buf     []byte
+	counter uint64
+}
+
+// NewWriter writes the archive header and returns a writer encrypting with the passphrase.
+func NewWriter(w io.Writer, passphrase string) (*Writer, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	p := params{time: argonTime, memory: argonMemory, threads: argonThreads, salt: salt}
+	aead, err := p.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	header := p.marshal()
+	if _, err = w.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write backup header: %w", err)
+	}
+	return &Writer{w: w, aead: aead, header: header, buf: make([]byte, 0, segmentSize)}, nil
+}
+
+// WriteItem appends a single item to the archive.
+func (w *Writer) WriteItem(item *pb.ExportItem) error {
+	_, err := protodelim.MarshalTo(w, item)
+	return err
+}
+
+// Write buffers plaintext and seals it segment by segment.
+func (w *Writer) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		p, written = p[n:], written+n
+		if len(w.buf) == cap(w.buf) && len(p) > 0 {
+			if err := w.flush(false); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (w *Writer) flush(last bool) error {
+	sealed := w.aead.Seal(nil, nonce(w.counter, last), w.buf, w.header)
+	if _, err := w.w.Write(binary.BigEndian.AppendUint32(nil, uint32(len(sealed)))); err != nil { // #nosec G115
+		return fmt.Errorf("failed to write segment: %w", err)
+	}
+	if _, err := w.w.Write(sealed); err != nil {
+		return fmt.Errorf("failed to write segment: %w", err)
+	}
+	w.counter++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// Close seals the remaining plaintext as the final segment.
+func (w *Writer) Close() error {
+	return w.flush(true)
+}
+
+// Reader decrypts items from an archive.
+type Reader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	header  []byte
+	plain   []byte
+	counter uint64
+	last    bool
+	items   *bufio.Reader
+}
+
+// NewReader reads the archive header and opens the first segment, so a wrong passphrase
+// is reported right away.
+func NewReader(r io.Reader, passphrase string) (*Reader, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, ErrNotBackup
+	}
+	p, err := unmarshalParams(header)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := p.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	reader := &Reader{r: r, aead: aead, header: header}
+	if err = reader.next(); err != nil {
+		if errors.Is(err, ErrCorrupted) {
+			return nil, ErrWrongPassphrase
+		}
+		return nil, err
+	}
+	reader.items = bufio.NewReader(reader)
+	return reader, nil
+}
+
+func (r *Reader) next() error {
+	var length [lengthSize]byte
+	if _, err := io.ReadFull(r.r, length[:]); err != nil {
+		return ErrTruncated
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > segmentSize+chacha20poly1305.Overhead {
+		return ErrCorrupted
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(r.r, sealed); err != nil {
+		return ErrTruncated
+	}
+
+	plain, err := r.aead.Open(nil, nonce(r.counter, false), sealed, r.header)
+	if err != nil {
+		if plain, err = r.aead.Open(nil, nonce(r.counter, true), sealed, r.header); err != nil {
+			return ErrCorrupted
+		}
+		r.last = true
+	}
+	r.counter++
+	r.plain = plain
+	return nil
+}
+
+// Read returns decrypted plaintext.
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.last {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+// ReadItem returns the next item of the archive or io.EOF when all items have been read.
+func (r *Reader) ReadItem() (*pb.ExportItem, error) {
+	item := &pb.ExportItem{}
+	if err := protodelim.UnmarshalFrom(r.items, item); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("failed to read backup item: %w", err)
+	}
+	return item, nil
+}
@@ -0,0 +1,108 @@
+package backup_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/client/backup"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func writeArchive(t *testing.T, passphrase string, items []*pb.ExportItem) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w, err := backup.NewWriter(buf, passphrase)
+	require.NoError(t, err)
+	for _, item := range items {
+		require.NoError(t, w.WriteItem(item))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func testItems() []*pb.ExportItem {
+	return []*pb.ExportItem{
+		{Item: &pb.ExportItem_Secret{Secret: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_LOGIN,
+			Base: &pb.Metadata{Path: "mail"},
+			Data: &pb.TypedData_Login{Login: &pb.LoginData{Login: "user", Password: "pass"}},
+		}}},
+		{Item: &pb.ExportItem_Secret{Secret: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_BINARY,
+			Base: &pb.Metadata{Path: "photo.png"},
+		}}},
+		{Item: &pb.ExportItem_Chunk{Chunk: &pb.Chunk{
+			Filename: "photo.png",
+			Data:     bytes.Repeat([]byte{0xAB}, 150*1024),
+		}}},
+		{Item: &pb.ExportItem_Chunk{Chunk: &pb.Chunk{Filename: "photo.png", Hash: "hash", ChunkId: 1}}},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []*pb.ExportItem
+	}{
+		{name: "empty vault", items: nil},
+		{name: "items spanning several segments", items: testItems()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive := writeArchive(t, "correct horse", tt.items)
+
+			r, err := backup.NewReader(bytes.NewReader(archive), "correct horse")
+			require.NoError(t, err)
+			for _, expected := range tt.items {
+				item, readErr := r.ReadItem()
+				require.NoError(t, readErr)
+				assert.Equal(t, expected.String(), item.String())
+			}
+			_, err = r.ReadItem()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	archive := writeArchive(t, "correct horse", testItems())
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		_, err := backup.NewReader(bytes.NewReader(archive), "battery staple")
+		require.ErrorIs(t, err, backup.ErrWrongPassphrase)
+	})
+
+	t.Run("not a backup", func(t *testing.T) {
+		_, err := backup.NewReader(bytes.NewReader([]byte("plain text file, definitely not an archive")), "x")
+		require.ErrorIs(t, err, backup.ErrNotBackup)
+	})
+
+	t.Run("truncated archive", func(t *testing.T) {
+		r, err := backup.NewReader(bytes.NewReader(archive[:len(archive)-100]), "correct horse")
+		require.NoError(t, err)
+		for {
+			if _, err = r.ReadItem(); err != nil {
+				break
+			}
+		}
+		require.NotErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("tampered segment", func(t *testing.T) {
+		tampered := bytes.Clone(archive)
+		tampered[len(tampered)-1] ^= 0xFF
+		r, err := backup.NewReader(bytes.NewReader(tampered), "correct horse")
+		require.NoError(t, err)
+		for {
+			if _, err = r.ReadItem(); err != nil {
+				break
+			}
+		}
+		require.ErrorIs(t, err, backup.ErrCorrupted)
+	})
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// parseSince accepts an RFC 3339 timestamp, a date or a duration counted back from now.
+func parseSince(value string, now time.Time) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d).UTC().Format(time.RFC3339), nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("invalid time %q, expected a duration (24h), a date (2006-01-02) or RFC 3339", value)
+}
+
+func NewAuditCmd() *cobra.Command {
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Review activity on your secrets",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit events performed by you or concerning your secrets",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			user, _ := cmd.Flags().GetString("user")
+			action, _ := cmd.Flags().GetString("action")
+			path, _ := cmd.Flags().GetString("path")
+			limit, _ := cmd.Flags().GetInt32("limit")
+			now := time.Now()
+			sinceFlag, _ := cmd.Flags().GetString("since")
+			since, err := parseSince(sinceFlag, now)
+			if err != nil {
+				return err
+			}
+			untilFlag, _ := cmd.Flags().GetString("until")
+			until, err := parseSince(untilFlag, now)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListAuditEvents(context.Background(), &pb.ListAuditEventsRequest{
+				Actor:  user,
+				Action: action,
+				Path:   path,
+				Since:  since,
+				Until:  until,
+				Limit:  limit,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list audit events: %w", err)
+			}
+			for _, event := range resp.GetEvents() {
+				target := event.GetPath()
+				if target == "" {
+					target = "-"
+				}
+				cmd.Printf("%s\t%s\t%s\t%s\t%s\t%s\n", event.GetCreatedAt(), event.GetActor(), event.GetAction(),
+					target, event.GetResult(), event.GetClientIp())
+			}
+			return nil
+		},
+	}
+	listCmd.Flags().StringP("user", "u", "", "Only events performed by the user")
+	listCmd.Flags().String("action", "", "Only events of the action, e.g. Get, Create, Delete")
+	listCmd.Flags().StringP("path", "p", "", "Only events on secrets whose path starts with the prefix")
+	listCmd.Flags().String("since", "", "Only events after the time: a duration (24h), a date or RFC 3339")
+	listCmd.Flags().String("until", "", "Only events before the time: a duration (24h), a date or RFC 3339")
+	listCmd.Flags().Int32("limit", 0, "Maximum number of events, the server defaults to 100")
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check the audit log has not been tampered with (administrators only)",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resp, err := client.VerifyAuditLog(context.Background(), &pb.VerifyAuditLogRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to verify audit log: %w", err)
+			}
+			if !resp.GetValid() {
+				return fmt.Errorf("audit log is broken at event %d: %s", resp.GetBrokenAt(), resp.GetReason())
+			}
+			cmd.Printf("Audit log is intact (%d events)\n", resp.GetEvents())
+			return nil
+		},
+	}
+
+	auditCmd.AddCommand(listCmd, verifyCmd)
+
+	return auditCmd
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	since, err := parseSince("24h", now)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-18T12:00:00Z", since)
+
+	since, err = parseSince("2026-10-01T08:30:00+02:00", now)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-01T06:30:00Z", since)
+
+	since, err = parseSince("", now)
+	require.NoError(t, err)
+	assert.Empty(t, since)
+
+	_, err = parseSince("last week", now)
+	require.Error(t, err)
+}
+
+func TestAuditCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	t.Run("list events", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewAuditCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().ListAuditEvents(mock.Anything, &pb.ListAuditEventsRequest{
+			Action: "Get",
+			Path:   "team/",
+			Limit:  5,
+		}).Return(&pb.ListAuditEventsResponse{Events: []*pb.AuditEvent{
+			{
+				Actor:     "lucius",
+				Action:    "Get",
+				Path:      "team/db",
+				Result:    "PermissionDenied",
+				ClientIp:  "10.0.0.2",
+				CreatedAt: "2026-10-19T12:00:00Z",
+			},
+		}}, nil)
+
+		cmd.SetArgs([]string{"list", "--action", "Get", "-p", "team/", "--limit", "5"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "2026-10-19T12:00:00Z\tlucius\tGet\tteam/db\tPermissionDenied\t10.0.0.2\n", buf.String())
+	})
+
+	t.Run("verify broken log", func(t *testing.T) {
+		cmd := NewAuditCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		mockClient.EXPECT().VerifyAuditLog(mock.Anything, &pb.VerifyAuditLogRequest{}).
+			Return(&pb.VerifyAuditLogResponse{Events: 10, BrokenAt: 7, Reason: "event has been modified"}, nil)
+
+		cmd.SetArgs([]string{"verify"})
+		require.ErrorContains(t, cmd.Execute(), "audit log is broken at event 7: event has been modified")
+	})
+}
@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itallix/gophkeeper/internal/client/backup"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+var ErrUnexpectedItem = errors.New("unexpected item in backup")
+
+func promptNewPassphrase(cmd *cobra.Command) (string, error) {
+	reader := bufio.NewReader(cmd.InOrStdin())
+	passphrase, err := promptPassword(cmd, reader, "Enter backup passphrase: ")
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase: %w", err)
+	}
+	confirm, err := promptPassword(cmd, reader, "Confirm backup passphrase: ")
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase confirmation: %w", err)
+	}
+	if passphrase == "" {
+		return "", errors.New("passphrase must not be empty")
+	}
+	if passphrase != confirm {
+		return "", errors.New("passphrases don't match")
+	}
+	return passphrase, nil
+}
+
+func exportVault(ctx context.Context, output, passphrase string) (int, error) {
+	stream, err := client.Export(ctx, &pb.ExportRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create export stream: %w", err)
+	}
+
+	file, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create a new file: %w", err)
+	}
+	defer file.Close()
+
+	w, err := backup.NewWriter(file, passphrase)
+	if err != nil {
+		return 0, err
+	}
+	secrets := 0
+	for {
+		item, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			break
+		}
+		if recvErr != nil {
+			return 0, fmt.Errorf("failed to receive secret: %w", recvErr)
+		}
+		if err = w.WriteItem(item); err != nil {
+			return 0, fmt.Errorf("failed to write backup: %w", err)
+		}
+		if item.GetSecret() != nil {
+			secrets++
+		}
+	}
+	if err = w.Close(); err != nil {
+		return 0, fmt.Errorf("failed to write backup: %w", err)
+	}
+	return secrets, nil
+}
+
+func NewExportCmd() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export all secrets into an encrypted backup",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, _ := cmd.Flags().GetString("output")
+
+			passphrase, err := promptNewPassphrase(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.Println()
+
+			secrets, err := exportVault(context.Background(), output, passphrase)
+			if err != nil {
+				_ = os.Remove(output)
+				return err
+			}
+			cmd.Printf("%d secrets have been exported to %s\n", secrets, output)
+			return nil
+		},
+	}
+	exportCmd.Flags().StringP("output", "o", "", "Backup filepath")
+	_ = exportCmd.MarkFlagRequired("output")
+
+	return exportCmd
+}
+
+// archiveChunkReader reads the content of a binary from the chunks following it in the backup
+// and verifies it against the hash carried by the final chunk.
+type archiveChunkReader struct {
+	archive *backup.Reader
+	hash    hash.Hash
+	data    []byte
+	done    bool
+}
+
+func newArchiveChunkReader(archive *backup.Reader) *archiveChunkReader {
+	return &archiveChunkReader{archive: archive, hash: sha256.New()}
+}
+
+func (r *archiveChunkReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		item, err := r.archive.ReadItem()
+		if errors.Is(err, io.EOF) {
+			return 0, backup.ErrTruncated
+		}
+		if err != nil {
+			return 0, err
+		}
+		chunk := item.GetChunk()
+		if chunk == nil {
+			return 0, ErrUnexpectedItem
+		}
+		if chunk.GetData() == nil {
+			if hex.EncodeToString(r.hash.Sum(nil)) != chunk.GetHash() {
+				return 0, ErrFileHash
+			}
+			r.done = true
+			continue
+		}
+		r.hash.Write(chunk.GetData())
+		r.data = chunk.GetData()
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+type restorer struct {
+	cmd      *cobra.Command
+	archive  *backup.Reader
+	resolver *conflictResolver
+	report   *importReport
+}
+
+func (r *restorer) restore(ctx context.Context, secret *pb.TypedData) error {
+	path := secret.GetBase().GetPath()
+	res := r.resolver.resolve(path)
+	chunks := newArchiveChunkReader(r.archive)
+	if res.skip {
+		r.report.conflicts = append(r.report.conflicts, path)
+		if secret.GetType() == pb.DataType_DATA_TYPE_BINARY {
+			_, err := io.Copy(io.Discard, chunks)
+			return err
+		}
+		return nil
+	}
+	if err := r.resolver.apply(ctx, res, secret.GetType()); err != nil {
+		return err
+	}
+	r.report.record(path, res)
+
+	if secret.GetType() == pb.DataType_DATA_TYPE_BINARY {
+		if _, err := uploadBinary(ctx, r.cmd, res.path, chunks); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", res.path, err)
+		}
+		return nil
+	}
+	if _, err := client.Create(ctx, &pb.CreateRequest{Data: &pb.TypedData{
+		Type: secret.GetType(),
+		Base: &pb.Metadata{Path: res.path},
+		Data: secret.GetData(),
+	}}); err != nil {
+		return fmt.Errorf("failed to restore %s: %w", res.path, err)
+	}
+	return nil
+}
+
+func NewRestoreCmd() *cobra.Command {
+	restoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore secrets from an encrypted backup",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fpath, _ := cmd.Flags().GetString("file")
+			onConflict, _ := cmd.Flags().GetString("on-conflict")
+
+			policy, err := parseConflictPolicy(onConflict)
+			if err != nil {
+				return err
+			}
+			file, err := os.Open(fpath)
+			if err != nil {
+				return fmt.Errorf("failed to read a file: %w", err)
+			}
+			defer file.Close()
+
+			passphrase, err := promptPassword(cmd, bufio.NewReader(cmd.InOrStdin()), "Enter backup passphrase: ")
+			if err != nil {
+				return fmt.Errorf("failed to read passphrase: %w", err)
+			}
+			archive, err := backup.NewReader(bufio.NewReader(file), passphrase)
+			if err != nil {
+				return fmt.Errorf("failed to open backup: %w", err)
+			}
+
+			ctx := context.Background()
+			resolver, err := newConflictResolver(ctx, policy)
+			if err != nil {
+				return err
+			}
+			r := &restorer{cmd: cmd, archive: archive, resolver: resolver, report: &importReport{}}
+			for {
+				item, readErr := archive.ReadItem()
+				if errors.Is(readErr, io.EOF) {
+					break
+				}
+				if readErr != nil {
+					return readErr
+				}
+				if item.GetSecret() == nil {
+					return ErrUnexpectedItem
+				}
+				if err = r.restore(ctx, item.GetSecret()); err != nil {
+					return err
+				}
+			}
+			r.report.print(cmd, false)
+			return nil
+		},
+	}
+	restoreCmd.Flags().StringP("file", "f", "", "Backup filepath")
+	restoreCmd.Flags().String("on-conflict", string(ConflictSkip), "How to handle existing paths: skip, overwrite, rename")
+	_ = restoreCmd.MarkFlagRequired("file")
+
+	return restoreCmd
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+type exportClientStream struct {
+	grpc.ClientStream
+
+	items []*pb.ExportItem
+}
+
+func (s *exportClientStream) Recv() (*pb.ExportItem, error) {
+	if len(s.items) == 0 {
+		return nil, io.EOF
+	}
+	item := s.items[0]
+	s.items = s.items[1:]
+	return item, nil
+}
+
+type uploadClientStream struct {
+	grpc.ClientStream
+
+	chunks []*pb.Chunk
+}
+
+func (s *uploadClientStream) Send(chunk *pb.Chunk) error {
+	s.chunks = append(s.chunks, &pb.Chunk{
+		Filename: chunk.GetFilename(),
+		Data:     bytes.Clone(chunk.GetData()),
+		ChunkId:  chunk.GetChunkId(),
+		Hash:     chunk.GetHash(),
+	})
+	return nil
+}
+
+func (s *uploadClientStream) CloseAndRecv() (*pb.UploadResponse, error) {
+	return &pb.UploadResponse{Message: "uploaded"}, nil
+}
+
+func TestExportRestoreCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	content := []byte("binary content")
+	fileHash := NewFileHash()
+	chunkHash := fileHash.AddChunk(0, content)
+	exported := []*pb.ExportItem{
+		{Item: &pb.ExportItem_Secret{Secret: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_LOGIN,
+			Base: &pb.Metadata{Path: "mail", CreatedBy: "user"},
+			Data: &pb.TypedData_Login{Login: &pb.LoginData{Login: "user", Password: "pass"}},
+		}}},
+		{Item: &pb.ExportItem_Secret{Secret: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_BINARY,
+			Base: &pb.Metadata{Path: "photo.png"},
+		}}},
+		{Item: &pb.ExportItem_Chunk{Chunk: &pb.Chunk{Filename: "photo.png", Data: content, Hash: chunkHash}}},
+		{Item: &pb.ExportItem_Chunk{Chunk: &pb.Chunk{Filename: "photo.png", Hash: fileHash.Complete(), ChunkId: 1}}},
+	}
+	archive := filepath.Join(t.TempDir(), "vault.gkb")
+
+	t.Run("export", func(t *testing.T) {
+		mockClient := mocks.NewGophkeeperServiceClient(t)
+		client = mockClient
+		mockClient.EXPECT().Export(mock.Anything, &pb.ExportRequest{}).
+			Return(&exportClientStream{items: exported}, nil)
+
+		buf := new(bytes.Buffer)
+		cmd := NewExportCmd()
+		cmd.SetOut(buf)
+		cmd.SetIn(strings.NewReader("passphrase\npassphrase\n"))
+		cmd.SetArgs([]string{"-o", archive})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "2 secrets have been exported")
+	})
+
+	t.Run("passphrases don't match", func(t *testing.T) {
+		cmd := NewExportCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetIn(strings.NewReader("passphrase\nother\n"))
+		cmd.SetArgs([]string{"-o", filepath.Join(t.TempDir(), "other.gkb")})
+		require.Error(t, cmd.Execute())
+	})
+
+	t.Run("restore renaming existing paths", func(t *testing.T) {
+		mockClient := mocks.NewGophkeeperServiceClient(t)
+		client = mockClient
+		expectExistingSecrets(mockClient, map[pb.DataType][]string{pb.DataType_DATA_TYPE_LOGIN: {"mail"}})
+		mockClient.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req *pb.CreateRequest) bool {
+			return req.GetData().GetBase().GetPath() == "mail-1" &&
+				req.GetData().GetLogin().GetPassword() == "pass"
+		})).Return(&pb.CreateResponse{}, nil).Once()
+		upload := &uploadClientStream{}
+		mockClient.EXPECT().Upload(mock.Anything).RunAndReturn(
+			func(context.Context, ...grpc.CallOption) (grpc.ClientStreamingClient[pb.Chunk, pb.UploadResponse], error) {
+				return upload, nil
+			}).Once()
+
+		buf := new(bytes.Buffer)
+		cmd := NewRestoreCmd()
+		cmd.SetOut(buf)
+		cmd.SetIn(strings.NewReader("passphrase\n"))
+		cmd.SetArgs([]string{"-f", archive, "--on-conflict", "rename"})
+		require.NoError(t, cmd.Execute())
+
+		assert.Contains(t, buf.String(), "Created: 1, overwritten: 0, renamed: 1")
+		require.Len(t, upload.chunks, 2)
+		assert.Equal(t, "photo.png", upload.chunks[0].GetFilename())
+		assert.Equal(t, content, upload.chunks[0].GetData())
+		assert.Equal(t, fileHash.Complete(), upload.chunks[1].GetHash())
+	})
+
+	t.Run("restore with wrong passphrase", func(t *testing.T) {
+		cmd := NewRestoreCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetIn(strings.NewReader("wrong\n"))
+		cmd.SetArgs([]string{"-f", archive})
+		require.ErrorContains(t, cmd.Execute(), "wrong passphrase")
+	})
+}
@@ -58,6 +58,61 @@ func (fh *FileHash) Complete() string {
 	return hex.EncodeToString(fh.hash.Sum(nil))
 }
 
+// uploadBinary streams the content of the reader to the server in chunks and stores it
+// as a binary secret under the given path. Progress is indicated by printing dots.
+func uploadBinary(ctx context.Context, cmd *cobra.Command, path string, reader io.Reader) (*pb.UploadResponse, error) {
+	stream, err := client.Upload(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload stream: %w", err)
+	}
+
+	fileHash := NewFileHash()
+	buffer := make([]byte, chunkSize)
+	chunkID := int64(0)
+	for {
+		n, readErr := reader.Read(buffer)
+		if n > 0 {
+			chunkData := buffer[:n]
+			chunkHash := fileHash.AddChunk(chunkID, chunkData)
+
+			chunk := &pb.Chunk{
+				Data:     chunkData,
+				Filename: path,
+				Hash:     chunkHash,
+				ChunkId:  chunkID,
+			}
+
+			if err = stream.Send(chunk); err != nil {
+				return nil, fmt.Errorf("failed to send chunk: %w", err)
+			}
+			cmd.Print(".")
+			chunkID++
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read file: %w", readErr)
+		}
+	}
+	cmd.Println()
+
+	if err = stream.Send(&pb.Chunk{
+		Data:     nil,
+		Filename: path,
+		Hash:     fileHash.Complete(),
+		ChunkId:  chunkID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send chunk: %w", err)
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive upload status: %w", err)
+	}
+	return resp, nil
+}
+
 func newCreateBinaryCmd() *cobra.Command {
 	createCmd := &cobra.Command{
 		Use:   "create",
@@ -71,53 +126,9 @@ func newCreateBinaryCmd() *cobra.Command {
 			}
 			defer file.Close()
 
-			stream, err := client.Upload(context.Background())
-			if err != nil {
-				return fmt.Errorf("failed to create upload stream: %w", err)
-			}
-
-			fileHash := NewFileHash()
-			buffer := make([]byte, chunkSize)
-			chunkID := int64(0)
-			for {
-				n, readErr := file.Read(buffer)
-				if errors.Is(readErr, io.EOF) {
-					break
-				}
-				if err != nil {
-					return fmt.Errorf("failed to read file: %w", err)
-				}
-
-				chunkData := buffer[:n]
-				chunkHash := fileHash.AddChunk(chunkID, chunkData)
-
-				chunk := &pb.Chunk{
-					Data:     chunkData,
-					Filename: filepath.Base(fpath),
-					Hash:     chunkHash,
-					ChunkId:  chunkID,
-				}
-
-				if err = stream.Send(chunk); err != nil {
-					return fmt.Errorf("failed to send chunk: %w", err)
-				}
-				cmd.Print(".")
-				chunkID++
-			}
-			cmd.Println()
-
-			if err = stream.Send(&pb.Chunk{
-				Data:     nil,
-				Filename: filepath.Base(fpath),
-				Hash:     fileHash.Complete(),
-				ChunkId:  chunkID,
-			}); err != nil {
-				return fmt.Errorf("failed to send chunk: %w", err)
-			}
-
-			resp, err := stream.CloseAndRecv()
+			resp, err := uploadBinary(context.Background(), cmd, filepath.Base(fpath), file)
 			if err != nil {
-				return fmt.Errorf("failed to receive upload status: %w", err)
+				return err
 			}
 
 			cmd.Println(resp.GetMessage())
@@ -4,9 +4,11 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/itallix/gophkeeper/internal/common/card"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
 )
 
@@ -19,8 +21,11 @@ func NewCardCmd() *cobra.Command {
 	getCmd := &cobra.Command{
 		Use:   "get",
 		Short: "Retrieve card data by path",
+		Long: `Print the card, the number is masked to its last four digits and the CVC and PIN are hidden
+unless --reveal is given.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			path, _ := cmd.Flags().GetString("path")
+			reveal, _ := cmd.Flags().GetBool("reveal")
 
 			resp, err := client.Get(context.Background(), &pb.GetRequest{
 				Type: pb.DataType_DATA_TYPE_CARD,
@@ -31,10 +36,26 @@ func NewCardCmd() *cobra.Command {
 			}
 			baseData := resp.GetData().GetBase()
 			cardData := resp.GetData().GetCard()
+			number, cvc, pin := cardData.GetNumber(), cardData.GetCvv(), cardData.GetPin()
+			if !reveal {
+				number, cvc = card.Mask(number), maskedValue
+				if pin != "" {
+					pin = maskedValue
+				}
+			}
 			cmd.Printf("Card holder: %s\n", cardData.GetCardHolder())
-			cmd.Printf("Card number: %s\n", cardData.GetNumber())
+			if cardData.GetBrand() != "" {
+				cmd.Printf("Brand: %s\n", cardData.GetBrand())
+			}
+			cmd.Printf("Card number: %s\n", number)
 			cmd.Printf("Expiry month/year: %d/%d\n", cardData.GetExpiryMonth(), cardData.GetExpiryYear())
-			cmd.Printf("CVC: %s\n", cardData.GetCvv())
+			cmd.Printf("CVC: %s\n", cvc)
+			if pin != "" {
+				cmd.Printf("PIN: %s\n", pin)
+			}
+			if cardData.GetBillingAddress() != "" {
+				cmd.Printf("Billing address: %s\n", cardData.GetBillingAddress())
+			}
 			cmd.Printf("Created at: %s\n", baseData.GetCreatedAt())
 			cmd.Printf("Created by: %s\n", baseData.GetCreatedBy())
 			cmd.Printf("Metadata: %s\n", baseData.GetMetadata())
@@ -42,11 +63,14 @@ func NewCardCmd() *cobra.Command {
 		},
 	}
 	getCmd.Flags().StringP("path", "p", "", "Card path")
+	getCmd.Flags().Bool("reveal", false, "Print the full card number, CVC and PIN")
 	_ = getCmd.MarkFlagRequired("path")
 
 	createCmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new card",
+		Long: `Prompt for the card, spaces and dashes in the number are ignored. The server checks the number
+against its checksum and the length and CVC rules of its brand.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			path, _ := cmd.Flags().GetString("path")
 			reader := bufio.NewReader(cmd.InOrStdin())
@@ -73,6 +97,20 @@ func NewCardCmd() *cobra.Command {
 			}
 			cmd.Println()
 
+			var address, pin string
+			if withAddress, _ := cmd.Flags().GetBool("address"); withAddress {
+				address, err = promptString(cmd, reader, "Enter billing address: ")
+				if err != nil {
+					return fmt.Errorf("failed to read billing address: %w", err)
+				}
+			}
+			if withPIN, _ := cmd.Flags().GetBool("pin"); withPIN {
+				pin, err = promptPassword(cmd, reader, "Enter PIN: ")
+				if err != nil {
+					return fmt.Errorf("failed to read PIN: %w", err)
+				}
+			}
+
 			resp, err := client.Create(context.Background(), &pb.CreateRequest{
 				Data: &pb.TypedData{
 					Type: pb.DataType_DATA_TYPE_CARD,
@@ -81,23 +119,27 @@ func NewCardCmd() *cobra.Command {
 					},
 					Data: &pb.TypedData_Card{
 						Card: &pb.CardData{
-							CardHolder:  holderName,
-							Number:      number,
-							ExpiryMonth: int64(expiryMonth),
-							ExpiryYear:  int64(expiryYear),
-							Cvv:         cvc,
+							CardHolder:     holderName,
+							Number:         strings.NewReplacer(" ", "", "-", "").Replace(number),
+							ExpiryMonth:    int64(expiryMonth),
+							ExpiryYear:     int64(expiryYear),
+							Cvv:            cvc,
+							BillingAddress: address,
+							Pin:            pin,
 						},
 					},
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("failed to create a new card: %w", err)
+				return fmt.Errorf("failed to create a new card: %w", withFieldViolations(err))
 			}
 			cmd.Println(resp.GetMessage())
 			return nil
 		},
 	}
 	createCmd.Flags().StringP("path", "p", "", "Card path")
+	createCmd.Flags().Bool("address", false, "Prompt for the billing address")
+	createCmd.Flags().Bool("pin", false, "Prompt for the PIN")
 	_ = createCmd.MarkFlagRequired("path")
 
 	listCmd := NewListCmd("card", "List available cards", pb.DataType_DATA_TYPE_CARD)
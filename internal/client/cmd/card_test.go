@@ -73,10 +73,47 @@ func TestCardCommands(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Contains(t, buf.String(), "John Doe")
-		assert.Contains(t, buf.String(), "4111111111111111")
+		assert.Contains(t, buf.String(), "Card number: ************1111")
+		assert.Contains(t, buf.String(), "CVC: ********")
+		assert.NotContains(t, buf.String(), "4111111111111111")
 		assert.Contains(t, buf.String(), "12/25")
 	})
 
+	t.Run("get card with reveal", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewCardCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_CARD,
+			Path: "amex",
+		}).Return(&pb.GetResponse{
+			Data: &pb.TypedData{
+				Base: &pb.Metadata{Path: "amex"},
+				Data: &pb.TypedData_Card{
+					Card: &pb.CardData{
+						CardHolder:     "John Doe",
+						Number:         "378282246310005",
+						ExpiryMonth:    12,
+						ExpiryYear:     2030,
+						Cvv:            "1234",
+						Brand:          "Amex",
+						BillingAddress: "1 Main St, New York",
+						Pin:            "4321",
+					},
+				},
+			},
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"get", "-p", "amex", "--reveal"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Brand: Amex")
+		assert.Contains(t, buf.String(), "Card number: 378282246310005")
+		assert.Contains(t, buf.String(), "CVC: 1234")
+		assert.Contains(t, buf.String(), "PIN: 4321")
+		assert.Contains(t, buf.String(), "Billing address: 1 Main St, New York")
+	})
+
 	t.Run("create card", func(t *testing.T) {
 		input := "John Doe\n4111111111111111\n12\n25\n123\n"
 		cmd := NewCardCmd()
@@ -113,6 +150,37 @@ func TestCardCommands(t *testing.T) {
 		assert.Contains(t, buf.String(), "Card created successfully")
 	})
 
+	t.Run("create card with billing address and pin", func(t *testing.T) {
+		input := "John Doe\n3782 822463 10005\n12\n2030\n1234\n1 Main St, New York\n4321\n"
+		cmd := NewCardCmd()
+		cmd.SetIn(strings.NewReader(input))
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Create(mock.Anything, &pb.CreateRequest{
+			Data: &pb.TypedData{
+				Type: pb.DataType_DATA_TYPE_CARD,
+				Base: &pb.Metadata{Path: "amex"},
+				Data: &pb.TypedData_Card{
+					Card: &pb.CardData{
+						CardHolder:     "John Doe",
+						Number:         "378282246310005",
+						ExpiryMonth:    12,
+						ExpiryYear:     2030,
+						Cvv:            "1234",
+						BillingAddress: "1 Main St, New York",
+						Pin:            "4321",
+					},
+				},
+			},
+		}).Return(&pb.CreateResponse{Message: "Card created successfully"}, nil).Once()
+
+		cmd.SetArgs([]string{"create", "-p", "amex", "--address", "--pin"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Enter billing address: ")
+		assert.Contains(t, buf.String(), "Enter PIN: ")
+	})
+
 	t.Run("delete card", func(t *testing.T) {
 		buf := new(bytes.Buffer)
 		cmd := NewCardCmd()
@@ -3,12 +3,36 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
 )
 
+// withFieldViolations appends the invalid fields reported by the server to an InvalidArgument error.
+func withFieldViolations(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return err
+	}
+	var violations []string
+	for _, detail := range st.Details() {
+		if badRequest, isBadRequest := detail.(*errdetails.BadRequest); isBadRequest {
+			for _, v := range badRequest.GetFieldViolations() {
+				violations = append(violations, fmt.Sprintf("  %s: %s", v.GetField(), v.GetDescription()))
+			}
+		}
+	}
+	if len(violations) == 0 {
+		return err
+	}
+	return fmt.Errorf("%w\n%s", err, strings.Join(violations, "\n"))
+}
+
 func NewListCmd(secretName, desc string, dataType pb.DataType) *cobra.Command {
 	return &cobra.Command{
 		Use:   "list",
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"log"
 	"os"
 	"path/filepath"
@@ -10,19 +11,24 @@ import (
 
 	"github.com/itallix/gophkeeper/internal/client/grpc"
 	"github.com/itallix/gophkeeper/internal/client/jwt"
+	"github.com/itallix/gophkeeper/internal/common/tracing"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
 )
 
 type Config struct {
 	ServerURL string `mapstructure:"server_url"`
 	TokenFile string `mapstructure:"token_file"`
+	APIKey    string `mapstructure:"api_key"`
+	// TracesExporter is none, otlp or stdout, the spans of the latter are printed to stderr.
+	TracesExporter string `mapstructure:"traces_exporter"`
 }
 
 var (
-	cfgFile       string
-	config        Config
-	client        pb.GophkeeperServiceClient
-	tokenProvider *jwt.TokenProvider
+	cfgFile         string
+	config          Config
+	client          pb.GophkeeperServiceClient
+	tokenProvider   *jwt.TokenProvider
+	shutdownTracing tracing.Shutdown = func(context.Context) error { return nil }
 )
 
 func InitConfig() {
@@ -41,8 +47,11 @@ func InitConfig() {
 	// Set defaults
 	viper.SetDefault("server_url", "localhost:8081")
 	viper.SetDefault("token_file", filepath.Join(os.TempDir(), ".gophkeeper_token"))
+	viper.SetDefault("traces_exporter", tracing.ExporterNone)
 
 	viper.AutomaticEnv()
+	_ = viper.BindEnv("api_key", "GOPHKEEPER_API_KEY")
+	_ = viper.BindEnv("traces_exporter", "GOPHKEEPER_TRACES_EXPORTER")
 
 	if err := viper.ReadInConfig(); err == nil {
 		log.Printf("Using config file: %s\n", viper.ConfigFileUsed())
@@ -53,13 +62,32 @@ func InitConfig() {
 	}
 
 	var err error
+	shutdownTracing, err = tracing.Setup(context.Background(), tracing.Config{
+		ServiceName: "gophkeeper-cli",
+		Exporter:    config.TracesExporter,
+		SampleRatio: 1,
+		Output:      os.Stderr,
+	})
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v\n", err)
+	}
 	tokenProvider = jwt.NewTokenProvider(config.TokenFile)
+	if config.APIKey != "" {
+		tokenProvider = jwt.NewAPIKeyTokenProvider(exchangeAPIKey)
+	}
 	client, err = grpc.NewGophkeeperClient(config.ServerURL, tokenProvider)
 	if err != nil {
 		log.Fatalf("Failed to create gRPC client: %v\n", err)
 	}
 }
 
+// Shutdown flushes the spans of the calls made by the command.
+func Shutdown() {
+	if err := shutdownTracing(context.Background()); err != nil {
+		log.Printf("Failed to flush traces: %v\n", err)
+	}
+}
+
 func GetConfig() *Config {
 	return &config
 }
@@ -67,3 +95,12 @@ func GetConfig() *Config {
 func GetTokenProvider() *jwt.TokenProvider {
 	return tokenProvider
 }
+
+// exchangeAPIKey obtains an access token for the configured API key.
+func exchangeAPIKey() (*jwt.TokenData, error) {
+	resp, err := client.ExchangeAPIKey(context.Background(), &pb.ExchangeAPIKeyRequest{ApiKey: config.APIKey})
+	if err != nil {
+		return nil, err
+	}
+	return jwt.NewToken(resp.GetAccessToken(), ""), nil
+}
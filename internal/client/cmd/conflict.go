@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// ConflictPolicy defines what happens when a secret being written already exists.
+type ConflictPolicy string
+
+const (
+	ConflictSkip      ConflictPolicy = "skip"
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	ConflictRename    ConflictPolicy = "rename"
+)
+
+var secretTypes = []pb.DataType{
+	pb.DataType_DATA_TYPE_LOGIN,
+	pb.DataType_DATA_TYPE_CARD,
+	pb.DataType_DATA_TYPE_NOTE,
+	pb.DataType_DATA_TYPE_BINARY,
+	pb.DataType_DATA_TYPE_SSH_KEY,
+	pb.DataType_DATA_TYPE_TOTP,
+	pb.DataType_DATA_TYPE_KV,
+}
+
+func parseConflictPolicy(value string) (ConflictPolicy, error) {
+	switch policy := ConflictPolicy(strings.ToLower(value)); policy {
+	case ConflictSkip, ConflictOverwrite, ConflictRename:
+		return policy, nil
+	}
+	return "", fmt.Errorf("unknown conflict policy %q, expected one of: skip, overwrite, rename", value)
+}
+
+// conflictResolver tracks the paths taken on the server, which are unique across all secret types.
+type conflictResolver struct {
+	policy   ConflictPolicy
+	existing map[string]pb.DataType
+}
+
+func newConflictResolver(ctx context.Context, policy ConflictPolicy) (*conflictResolver, error) {
+	existing := make(map[string]pb.DataType)
+	for _, dataType := range secretTypes {
+		resp, err := client.List(ctx, &pb.ListRequest{Type: dataType})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list existing secrets: %w", err)
+		}
+		for _, path := range resp.GetSecrets() {
+			existing[path] = dataType
+		}
+	}
+	return &conflictResolver{policy: policy, existing: existing}, nil
+}
+
+// resolution describes how a single secret is going to be written.
+type resolution struct {
+	path     string
+	skip     bool
+	replaces pb.DataType
+}
+
+func (r *conflictResolver) resolve(path string) resolution {
+	dataType, taken := r.existing[path]
+	if !taken {
+		return resolution{path: path}
+	}
+	switch r.policy {
+	case ConflictOverwrite:
+		return resolution{path: path, replaces: dataType}
+	case ConflictRename:
+		for i := 1; ; i++ {
+			candidate := fmt.Sprintf("%s-%d", path, i)
+			if _, ok := r.existing[candidate]; !ok {
+				return resolution{path: candidate}
+			}
+		}
+	default:
+		return resolution{path: path, skip: true}
+	}
+}
+
+// apply removes the secret being overwritten and reserves the target path.
+func (r *conflictResolver) apply(ctx context.Context, res resolution, dataType pb.DataType) error {
+	if res.replaces != pb.DataType_DATA_TYPE_UNSPECIFIED {
+		if _, err := client.Delete(ctx, &pb.DeleteRequest{Type: res.replaces, Path: res.path}); err != nil {
+			return fmt.Errorf("failed to delete existing secret %s: %w", res.path, err)
+		}
+	}
+	r.reserve(res.path, dataType)
+	return nil
+}
+
+// reserve marks the path as taken, so that later secrets of the same run are resolved against it.
+func (r *conflictResolver) reserve(path string, dataType pb.DataType) {
+	r.existing[path] = dataType
+}
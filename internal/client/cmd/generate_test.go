@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCmd(t *testing.T) {
+	t.Run("password", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewGenerateCmd()
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{"-n", "16", "--no-symbols", "--exclude-ambiguous"})
+
+		require.NoError(t, cmd.Execute())
+		lines := strings.Split(buf.String(), "\n")
+		assert.Regexp(t, `^[a-km-zA-HJ-NP-Z2-9]{16}$`, lines[0])
+		assert.Contains(t, buf.String(), "Entropy: ")
+		assert.Contains(t, buf.String(), "Strength: ")
+	})
+
+	t.Run("passphrase", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewGenerateCmd()
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{"--words", "5", "--separator", "."})
+
+		require.NoError(t, cmd.Execute())
+		lines := strings.Split(buf.String(), "\n")
+		assert.Len(t, strings.Split(lines[0], "."), 5)
+		assert.Contains(t, buf.String(), "Entropy: 64.6 bits")
+	})
+
+	t.Run("no character classes", func(t *testing.T) {
+		cmd := NewGenerateCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetArgs([]string{"--no-lower", "--no-upper", "--no-digits", "--no-symbols"})
+
+		require.Error(t, cmd.Execute())
+	})
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itallix/gophkeeper/internal/client/importer"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// importReport accumulates the outcome of an import or restore run.
+type importReport struct {
+	created     int
+	overwritten int
+	renamed     int
+	conflicts   []string
+	skipped     []importer.Skipped
+}
+
+func (r *importReport) record(path string, res resolution) {
+	switch {
+	case res.replaces != pb.DataType_DATA_TYPE_UNSPECIFIED:
+		r.overwritten++
+	case res.path != path:
+		r.renamed++
+	default:
+		r.created++
+	}
+}
+
+func (r *importReport) print(cmd *cobra.Command, dryRun bool) {
+	if dryRun {
+		cmd.Println("Dry run, nothing has been written.")
+	}
+	cmd.Printf("Created: %d, overwritten: %d, renamed: %d, skipped due to conflicts: %d, skipped as unsupported: %d\n",
+		r.created, r.overwritten, r.renamed, len(r.conflicts), len(r.skipped))
+	for _, path := range r.conflicts {
+		cmd.Printf("  conflict: %s already exists\n", path)
+	}
+	for _, s := range r.skipped {
+		cmd.Printf("  skipped: %s (%s)\n", s.Title, s.Reason)
+	}
+}
+
+// itemImporter writes parsed items to the server applying the conflict policy.
+type itemImporter struct {
+	cmd      *cobra.Command
+	resolver *conflictResolver
+	report   *importReport
+	dryRun   bool
+}
+
+func (i *itemImporter) write(ctx context.Context, item importer.Item) error {
+	res := i.resolver.resolve(item.Path())
+	if res.skip {
+		i.report.conflicts = append(i.report.conflicts, res.path)
+		return nil
+	}
+	i.report.record(item.Path(), res)
+
+	if i.dryRun {
+		i.cmd.Printf("%s %s\n", describe(item.Path(), res), typeName(item.Data.GetType()))
+		i.resolver.reserve(res.path, item.Data.GetType())
+	} else {
+		if err := i.resolver.apply(ctx, res, item.Data.GetType()); err != nil {
+			return err
+		}
+		item.Data.Base.Path = res.path
+		if _, err := client.Create(ctx, &pb.CreateRequest{Data: item.Data}); err != nil {
+			return fmt.Errorf("failed to create %s: %w", res.path, withFieldViolations(err))
+		}
+	}
+
+	for _, attachment := range item.Attachments {
+		if err := i.writeAttachment(ctx, res.path+"/"+attachment.Name, attachment.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *itemImporter) writeAttachment(ctx context.Context, path string, data []byte) error {
+	res := i.resolver.resolve(path)
+	if res.skip {
+		i.report.conflicts = append(i.report.conflicts, res.path)
+		return nil
+	}
+	i.report.record(path, res)
+
+	if i.dryRun {
+		i.cmd.Printf("%s binary\n", describe(path, res))
+		i.resolver.reserve(res.path, pb.DataType_DATA_TYPE_BINARY)
+		return nil
+	}
+	if err := i.resolver.apply(ctx, res, pb.DataType_DATA_TYPE_BINARY); err != nil {
+		return err
+	}
+	if _, err := uploadBinary(ctx, i.cmd, res.path, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to upload attachment %s: %w", res.path, err)
+	}
+	return nil
+}
+
+func describe(path string, res resolution) string {
+	switch {
+	case res.replaces != pb.DataType_DATA_TYPE_UNSPECIFIED:
+		return "overwrite " + path
+	case res.path != path:
+		return fmt.Sprintf("create %s (renamed from %s)", res.path, path)
+	default:
+		return "create " + path
+	}
+}
+
+func typeName(dataType pb.DataType) string {
+	return strings.ToLower(strings.TrimPrefix(dataType.String(), "DATA_TYPE_"))
+}
+
+func formatNames() string {
+	formats := importer.Formats()
+	names := make([]string, 0, len(formats))
+	for _, f := range formats {
+		names = append(names, string(f))
+	}
+	return strings.Join(names, ", ")
+}
+
+func NewImportCmd() *cobra.Command {
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import secrets from other password managers",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fpath, _ := cmd.Flags().GetString("file")
+			format, _ := cmd.Flags().GetString("format")
+			onConflict, _ := cmd.Flags().GetString("on-conflict")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+			policy, err := parseConflictPolicy(onConflict)
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(fpath)
+			if err != nil {
+				return fmt.Errorf("failed to read a file: %w", err)
+			}
+
+			var opts importer.Options
+			if importer.Format(format) == importer.FormatKeePass {
+				reader := bufio.NewReader(cmd.InOrStdin())
+				opts.MasterPassword, err = promptPassword(cmd, reader, "Enter master password: ")
+				if err != nil {
+					return fmt.Errorf("failed to read master password: %w", err)
+				}
+			}
+
+			result, err := importer.Parse(importer.Format(format), data, opts)
+			if err != nil {
+				return fmt.Errorf("failed to parse %s export: %w", format, err)
+			}
+
+			ctx := context.Background()
+			resolver, err := newConflictResolver(ctx, policy)
+			if err != nil {
+				return err
+			}
+			report := &importReport{skipped: result.Skipped}
+			imp := &itemImporter{cmd: cmd, resolver: resolver, report: report, dryRun: dryRun}
+			for _, item := range result.Items {
+				if err = imp.write(ctx, item); err != nil {
+					return err
+				}
+			}
+			report.print(cmd, dryRun)
+			return nil
+		},
+	}
+	importCmd.Flags().StringP("file", "f", "", "Export filepath")
+	importCmd.Flags().StringP("format", "t", "", "Export format: "+formatNames())
+	importCmd.Flags().String("on-conflict", string(ConflictSkip), "How to handle existing paths: skip, overwrite, rename")
+	importCmd.Flags().Bool("dry-run", false, "Show what would be imported without writing anything")
+	_ = importCmd.MarkFlagRequired("file")
+	_ = importCmd.MarkFlagRequired("format")
+
+	return importCmd
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+const bitwardenExport = `{"items": [
+	{"type": 1, "name": "GitHub", "login": {"username": "octocat", "password": "s3cr3t"}},
+	{"type": 2, "name": "Recovery codes", "notes": "one two three"},
+	{"type": 4, "name": "Passport"}
+]}`
+
+func expectExistingSecrets(mockClient *mocks.GophkeeperServiceClient, existing map[pb.DataType][]string) {
+	for _, dataType := range secretTypes {
+		mockClient.EXPECT().List(mock.Anything, &pb.ListRequest{Type: dataType}).
+			Return(&pb.ListResponse{Secrets: existing[dataType]}, nil).Once()
+	}
+}
+
+func isCreateOf(path string) func(req *pb.CreateRequest) bool {
+	return func(req *pb.CreateRequest) bool {
+		return req.GetData().GetBase().GetPath() == path
+	}
+}
+
+func TestImportCommand(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	file := filepath.Join(t.TempDir(), "bitwarden.json")
+	require.NoError(t, os.WriteFile(file, []byte(bitwardenExport), 0o600))
+	existing := map[pb.DataType][]string{pb.DataType_DATA_TYPE_NOTE: {"GitHub"}}
+
+	run := func(t *testing.T, args ...string) string {
+		buf := new(bytes.Buffer)
+		cmd := NewImportCmd()
+		cmd.SetOut(buf)
+		cmd.SetArgs(append([]string{"-f", file, "-t", "bitwarden"}, args...))
+		require.NoError(t, cmd.Execute())
+		return buf.String()
+	}
+
+	t.Run("dry run does not write", func(t *testing.T) {
+		mockClient := mocks.NewGophkeeperServiceClient(t)
+		client = mockClient
+		expectExistingSecrets(mockClient, existing)
+
+		out := run(t, "--dry-run", "--on-conflict", "overwrite")
+
+		assert.Contains(t, out, "overwrite GitHub login")
+		assert.Contains(t, out, "create Recovery codes note")
+		assert.Contains(t, out, "Dry run, nothing has been written.")
+		assert.Contains(t, out, "skipped: Passport (identity items are not supported)")
+	})
+
+	t.Run("skip existing paths", func(t *testing.T) {
+		mockClient := mocks.NewGophkeeperServiceClient(t)
+		client = mockClient
+		expectExistingSecrets(mockClient, existing)
+		mockClient.EXPECT().Create(mock.Anything, mock.MatchedBy(isCreateOf("Recovery codes"))).
+			Return(&pb.CreateResponse{}, nil).Once()
+
+		out := run(t)
+
+		assert.Contains(t, out, "Created: 1, overwritten: 0, renamed: 0, skipped due to conflicts: 1")
+		assert.Contains(t, out, "conflict: GitHub already exists")
+	})
+
+	t.Run("overwrite existing paths", func(t *testing.T) {
+		mockClient := mocks.NewGophkeeperServiceClient(t)
+		client = mockClient
+		expectExistingSecrets(mockClient, existing)
+		mockClient.EXPECT().Delete(mock.Anything, &pb.DeleteRequest{
+			Type: pb.DataType_DATA_TYPE_NOTE,
+			Path: "GitHub",
+		}).Return(&pb.DeleteResponse{}, nil).Once()
+		mockClient.EXPECT().Create(mock.Anything, mock.MatchedBy(isCreateOf("GitHub"))).
+			Return(&pb.CreateResponse{}, nil).Once()
+		mockClient.EXPECT().Create(mock.Anything, mock.MatchedBy(isCreateOf("Recovery codes"))).
+			Return(&pb.CreateResponse{}, nil).Once()
+
+		out := run(t, "--on-conflict", "overwrite")
+
+		assert.Contains(t, out, "Created: 1, overwritten: 1, renamed: 0")
+	})
+
+	t.Run("rename conflicting paths", func(t *testing.T) {
+		mockClient := mocks.NewGophkeeperServiceClient(t)
+		client = mockClient
+		expectExistingSecrets(mockClient, map[pb.DataType][]string{
+			pb.DataType_DATA_TYPE_NOTE:  {"GitHub"},
+			pb.DataType_DATA_TYPE_LOGIN: {"GitHub-1"},
+		})
+		mockClient.EXPECT().Create(mock.Anything, mock.MatchedBy(isCreateOf("GitHub-2"))).
+			Return(&pb.CreateResponse{}, nil).Once()
+		mockClient.EXPECT().Create(mock.Anything, mock.MatchedBy(isCreateOf("Recovery codes"))).
+			Return(&pb.CreateResponse{}, nil).Once()
+
+		out := run(t, "--on-conflict", "rename")
+
+		assert.Contains(t, out, "Created: 1, overwritten: 0, renamed: 1")
+	})
+
+	t.Run("unknown conflict policy", func(t *testing.T) {
+		cmd := NewImportCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetArgs([]string{"-f", file, "-t", "bitwarden", "--on-conflict", "merge"})
+		require.Error(t, cmd.Execute())
+	})
+}
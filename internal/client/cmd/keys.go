@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func NewKeysCmd() *cobra.Command {
+	keysCmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage the keys the server signs tokens with",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the keys accepted for token verification, the current one first",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resp, err := client.GetJWKS(context.Background(), &pb.GetJWKSRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to list signing keys: %w", err)
+			}
+			for _, key := range resp.GetKeys() {
+				cmd.Printf("%s\t%s\t%s\n", key.GetKid(), key.GetAlg(), key.GetCrv())
+			}
+			return nil
+		},
+	}
+
+	rotateCmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Sign new tokens with a new key, the previous key stays valid (administrators only)",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resp, err := client.RotateSigningKey(context.Background(), &pb.RotateSigningKeyRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to rotate signing key: %w", err)
+			}
+			cmd.Printf("Tokens are now signed with %s key %s\n", resp.GetAlgorithm(), resp.GetKid())
+			return nil
+		},
+	}
+
+	keysCmd.AddCommand(listCmd, rotateCmd)
+
+	return keysCmd
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestKeysCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	t.Run("list keys", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewKeysCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().GetJWKS(mock.Anything, &pb.GetJWKSRequest{}).Return(&pb.GetJWKSResponse{Keys: []*pb.JWK{
+			{Kid: "5f2b", Alg: "EdDSA", Crv: "Ed25519"},
+			{Kid: "91ac", Alg: "EdDSA", Crv: "Ed25519"},
+		}}, nil).Once()
+
+		cmd.SetArgs([]string{"list"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "5f2b\tEdDSA\tEd25519\n91ac\tEdDSA\tEd25519\n", buf.String())
+	})
+
+	t.Run("rotate key", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewKeysCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().RotateSigningKey(mock.Anything, &pb.RotateSigningKeyRequest{}).
+			Return(&pb.RotateSigningKeyResponse{Kid: "c0de", Algorithm: "ES256"}, nil).Once()
+
+		cmd.SetArgs([]string{"rotate"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "Tokens are now signed with ES256 key c0de\n", buf.String())
+	})
+
+	t.Run("rotate key denied", func(t *testing.T) {
+		cmd := NewKeysCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		mockClient.EXPECT().RotateSigningKey(mock.Anything, &pb.RotateSigningKeyRequest{}).
+			Return(nil, errors.New("mark is not an administrator")).Once()
+
+		cmd.SetArgs([]string{"rotate"})
+		require.ErrorContains(t, cmd.Execute(), "failed to rotate signing key")
+	})
+}
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+const maskedValue = "********"
+
+// parseKVFields builds the fields from the name=value pairs of --field. The names given by --sensitive are
+// marked sensitive, their values are prompted for unless they are already given by --field.
+func parseKVFields(
+	cmd *cobra.Command, reader *bufio.Reader, pairs, sensitive []string,
+) ([]*pb.KVField, error) {
+	fields := make([]*pb.KVField, 0, len(pairs)+len(sensitive))
+	for _, pair := range pairs {
+		name, value, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("field %q must be given as name=value", pair)
+		}
+		fields = append(fields, &pb.KVField{
+			Name:      name,
+			Value:     value,
+			Sensitive: slices.Contains(sensitive, name),
+		})
+	}
+
+	for _, name := range sensitive {
+		if slices.ContainsFunc(fields, func(field *pb.KVField) bool { return field.GetName() == name }) {
+			continue
+		}
+		value, err := promptPassword(cmd, reader, fmt.Sprintf("Enter %s: ", name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		fields = append(fields, &pb.KVField{Name: name, Value: value, Sensitive: true})
+	}
+	return fields, nil
+}
+
+// promptTypeFields prompts for the fields of the secret type that are not given yet, empty values of
+// optional fields are skipped.
+func promptTypeFields(
+	cmd *cobra.Command, reader *bufio.Reader, secretType *pb.SecretType, fields []*pb.KVField,
+) ([]*pb.KVField, error) {
+	for _, field := range secretType.GetFields() {
+		if slices.ContainsFunc(fields, func(f *pb.KVField) bool { return f.GetName() == field.GetName() }) {
+			continue
+		}
+		hint := field.GetDescription()
+		if !field.GetRequired() {
+			hint = strings.TrimPrefix(hint+", optional", ", ")
+		}
+		prompt := fmt.Sprintf("Enter %s: ", field.GetName())
+		if hint != "" {
+			prompt = fmt.Sprintf("Enter %s (%s): ", field.GetName(), hint)
+		}
+
+		var value string
+		var err error
+		if field.GetSensitive() {
+			value, err = promptPassword(cmd, reader, prompt)
+		} else {
+			value, err = promptString(cmd, reader, prompt)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", field.GetName(), err)
+		}
+		if value == "" && !field.GetRequired() {
+			continue
+		}
+		fields = append(fields, &pb.KVField{Name: field.GetName(), Value: value, Sensitive: field.GetSensitive()})
+	}
+	return fields, nil
+}
+
+func newListKVCmd() *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List available key-value secrets",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			secretType, _ := cmd.Flags().GetString("type")
+			resp, err := client.List(context.Background(), &pb.ListRequest{
+				Type:       pb.DataType_DATA_TYPE_KV,
+				SecretType: secretType,
+			})
+			if err != nil {
+				return fmt.Errorf("error listing key-value secret: %w", err)
+			}
+			for _, name := range resp.GetSecrets() {
+				cmd.Println(name)
+			}
+			return nil
+		},
+	}
+	listCmd.Flags().StringP("type", "t", "", "List only the secrets of this secret type")
+	return listCmd
+}
+
+func newGetKVCmd() *cobra.Command {
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Retrieve key-value secret by path",
+		Long: `Print the fields of the secret, sensitive values are masked unless --reveal is given.
+With --field only the value of that field is printed, unmasked, e.g. to be used in scripts.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			name, _ := cmd.Flags().GetString("field")
+			reveal, _ := cmd.Flags().GetBool("reveal")
+
+			resp, err := client.Get(context.Background(), &pb.GetRequest{
+				Type: pb.DataType_DATA_TYPE_KV,
+				Path: path,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to retrieve key-value secret: %w", err)
+			}
+			fields := resp.GetData().GetKv().GetFields()
+			if name != "" {
+				i := slices.IndexFunc(fields, func(field *pb.KVField) bool { return field.GetName() == name })
+				if i < 0 {
+					return fmt.Errorf("field %s not found in %s", name, path)
+				}
+				cmd.Println(fields[i].GetValue())
+				return nil
+			}
+
+			if kvType := resp.GetData().GetKv().GetType(); kvType != "" {
+				cmd.Printf("Type: %s\n", kvType)
+			}
+			for _, field := range fields {
+				value := field.GetValue()
+				if field.GetSensitive() && !reveal {
+					value = maskedValue
+				}
+				cmd.Printf("%s: %s\n", field.GetName(), value)
+			}
+			cmd.Printf("Created at: %s\n", resp.GetData().GetBase().GetCreatedAt())
+			cmd.Printf("Created by: %s\n", resp.GetData().GetBase().GetCreatedBy())
+			cmd.Printf("Metadata: %s\n", resp.GetData().GetBase().GetMetadata())
+			return nil
+		},
+	}
+	getCmd.Flags().StringP("path", "p", "", "Key-value secret path")
+	getCmd.Flags().StringP("field", "f", "", "Print only the value of this field")
+	getCmd.Flags().Bool("reveal", false, "Print the values of sensitive fields")
+	_ = getCmd.MarkFlagRequired("path")
+	return getCmd
+}
+
+func newCreateKVCmd() *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new key-value secret",
+		Long: `Store the fields given by --field name=value. Fields named by --sensitive are masked when printed,
+their values are prompted for when not given by --field so that they don't end up in the shell history.
+With --type the secret is validated against the schema of that secret type and its fields are prompted for.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			pairs, _ := cmd.Flags().GetStringArray("field")
+			sensitive, _ := cmd.Flags().GetStringArray("sensitive")
+			typeName, _ := cmd.Flags().GetString("type")
+
+			reader := bufio.NewReader(cmd.InOrStdin())
+			fields, err := parseKVFields(cmd, reader, pairs, sensitive)
+			if err != nil {
+				return err
+			}
+			if typeName != "" {
+				secretType, getErr := client.GetSecretType(context.Background(),
+					&pb.GetSecretTypeRequest{Name: typeName})
+				if getErr != nil {
+					return fmt.Errorf("failed to retrieve secret type: %w", getErr)
+				}
+				if fields, err = promptTypeFields(cmd, reader, secretType.GetType(), fields); err != nil {
+					return err
+				}
+			}
+
+			resp, err := client.Create(context.Background(), &pb.CreateRequest{
+				Data: &pb.TypedData{
+					Type: pb.DataType_DATA_TYPE_KV,
+					Base: &pb.Metadata{
+						Path: path,
+					},
+					Data: &pb.TypedData_Kv{
+						Kv: &pb.KVData{Fields: fields, Type: typeName},
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create a new key-value secret: %w", withFieldViolations(err))
+			}
+			cmd.Println(resp.GetMessage())
+			return nil
+		},
+	}
+	createCmd.Flags().StringP("path", "p", "", "Key-value secret path")
+	createCmd.Flags().StringArrayP("field", "f", nil, "Field as name=value, can be repeated")
+	createCmd.Flags().StringArrayP("sensitive", "s", nil, "Name of a sensitive field, can be repeated")
+	createCmd.Flags().StringP("type", "t", "", "Secret type the secret is validated against")
+	_ = createCmd.MarkFlagRequired("path")
+	return createCmd
+}
+
+func NewKVCmd() *cobra.Command {
+	kvCmd := &cobra.Command{
+		Use:   "kv",
+		Short: "Key-value secret management commands",
+		Long:  `Key-value secrets keep named fields such as API tokens, webhook secrets and connection strings.`,
+	}
+
+	kvCmd.AddCommand(
+		newListKVCmd(), newGetKVCmd(), newCreateKVCmd(),
+		NewDeleteCmd("key-value secret", "Delete existing key-value secret", pb.DataType_DATA_TYPE_KV),
+	)
+
+	return kvCmd
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestKVCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	webhook := &pb.GetResponse{
+		Data: &pb.TypedData{
+			Base: &pb.Metadata{Path: "webhook", CreatedBy: "user"},
+			Data: &pb.TypedData_Kv{Kv: &pb.KVData{Fields: []*pb.KVField{
+				{Name: "url", Value: "https://hooks.example.com"},
+				{Name: "signing_secret", Value: "whsec_123", Sensitive: true},
+			}}},
+		},
+	}
+
+	t.Run("create kv", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewKVCmd()
+		cmd.SetIn(strings.NewReader("whsec_123\n"))
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Create(mock.Anything, &pb.CreateRequest{
+			Data: &pb.TypedData{
+				Type: pb.DataType_DATA_TYPE_KV,
+				Base: &pb.Metadata{Path: "webhook"},
+				Data: &pb.TypedData_Kv{Kv: &pb.KVData{Fields: []*pb.KVField{
+					{Name: "url", Value: "https://hooks.example.com"},
+					{Name: "region", Value: "eu=west", Sensitive: true},
+					{Name: "signing_secret", Value: "whsec_123", Sensitive: true},
+				}}},
+			},
+		}).Return(&pb.CreateResponse{Message: "Key-value secret created successfully"}, nil).Once()
+
+		cmd.SetArgs([]string{"create", "-p", "webhook", "-f", "url=https://hooks.example.com",
+			"-f", "region=eu=west", "-s", "region", "-s", "signing_secret"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Enter signing_secret: ")
+		assert.Contains(t, buf.String(), "Key-value secret created successfully")
+	})
+
+	t.Run("create kv of a secret type", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewKVCmd()
+		cmd.SetIn(strings.NewReader("5432\n\ns3cr3tp4ssw0rd\n"))
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().GetSecretType(mock.Anything, &pb.GetSecretTypeRequest{Name: "database"}).
+			Return(&pb.GetSecretTypeResponse{Type: &pb.SecretType{
+				Name: "database",
+				Fields: []*pb.SecretTypeField{
+					{Name: "host", Type: "string", Required: true},
+					{Name: "port", Type: "integer", Description: "TCP port", Required: true},
+					{Name: "database", Type: "string"},
+					{Name: "password", Type: "string", Required: true, Sensitive: true},
+				},
+			}}, nil).Once()
+		mockClient.EXPECT().Create(mock.Anything, &pb.CreateRequest{
+			Data: &pb.TypedData{
+				Type: pb.DataType_DATA_TYPE_KV,
+				Base: &pb.Metadata{Path: "db/orders"},
+				Data: &pb.TypedData_Kv{Kv: &pb.KVData{Type: "database", Fields: []*pb.KVField{
+					{Name: "host", Value: "db.example.com"},
+					{Name: "port", Value: "5432"},
+					{Name: "password", Value: "s3cr3tp4ssw0rd", Sensitive: true},
+				}}},
+			},
+		}).Return(&pb.CreateResponse{Message: "Key-value secret created successfully"}, nil).Once()
+
+		cmd.SetArgs([]string{"create", "-p", "db/orders", "-t", "database", "-f", "host=db.example.com"})
+		require.NoError(t, cmd.Execute())
+		assert.NotContains(t, buf.String(), "Enter host")
+		assert.Contains(t, buf.String(), "Enter port (TCP port): ")
+		assert.Contains(t, buf.String(), "Enter database (optional): ")
+		assert.Contains(t, buf.String(), "Enter password: ")
+	})
+
+	t.Run("list kv of a secret type", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewKVCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().List(mock.Anything, &pb.ListRequest{
+			Type:       pb.DataType_DATA_TYPE_KV,
+			SecretType: "database",
+		}).Return(&pb.ListResponse{Secrets: []string{"db/orders"}}, nil).Once()
+
+		cmd.SetArgs([]string{"list", "-t", "database"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "db/orders\n", buf.String())
+	})
+
+	t.Run("create kv with invalid field", func(t *testing.T) {
+		cmd := NewKVCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		cmd.SetArgs([]string{"create", "-p", "webhook", "-f", "url"})
+		require.ErrorContains(t, cmd.Execute(), `field "url" must be given as name=value`)
+	})
+
+	t.Run("get kv masks sensitive fields", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewKVCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_KV,
+			Path: "webhook",
+		}).Return(webhook, nil).Once()
+
+		cmd.SetArgs([]string{"get", "-p", "webhook"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "url: https://hooks.example.com")
+		assert.Contains(t, buf.String(), "signing_secret: ********")
+		assert.NotContains(t, buf.String(), "whsec_123")
+	})
+
+	t.Run("get kv with reveal", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewKVCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, mock.Anything).Return(webhook, nil).Once()
+
+		cmd.SetArgs([]string{"get", "-p", "webhook", "--reveal"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "signing_secret: whsec_123")
+	})
+
+	t.Run("get kv field", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewKVCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, mock.Anything).Return(webhook, nil).Once()
+
+		cmd.SetArgs([]string{"get", "-p", "webhook", "-f", "signing_secret"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "whsec_123\n", buf.String())
+	})
+
+	t.Run("get unknown kv field", func(t *testing.T) {
+		cmd := NewKVCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		mockClient.EXPECT().Get(mock.Anything, mock.Anything).Return(webhook, nil).Once()
+
+		cmd.SetArgs([]string{"get", "-p", "webhook", "-f", "token"})
+		require.ErrorContains(t, cmd.Execute(), "field token not found in webhook")
+	})
+}
@@ -5,12 +5,79 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/itallix/gophkeeper/internal/common/password"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
 )
 
+func promptNewPassword(cmd *cobra.Command, reader *bufio.Reader) (string, error) {
+	secret, err := promptPassword(cmd, reader, "Enter password: ")
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	confirm, err := promptPassword(cmd, reader, "Confirm password: ")
+	if err != nil {
+		return "", fmt.Errorf("failed to read password confirmation: %w", err)
+	}
+	cmd.Println()
+
+	if secret != confirm {
+		return "", errors.New("passwords don't match")
+	}
+	return secret, nil
+}
+
+func matchName(match pb.URIMatch) string {
+	if match == pb.URIMatch_URI_MATCH_UNSPECIFIED {
+		match = pb.URIMatch_URI_MATCH_BASE_DOMAIN
+	}
+	return strings.ToLower(strings.TrimPrefix(match.String(), "URI_MATCH_"))
+}
+
+// parseLoginURI parses a --uri value, the URI can be prefixed by its match rule as in host=https://example.com.
+// Without a known rule the server matches the URI by its base domain.
+func parseLoginURI(value string) *pb.LoginURI {
+	if rule, uri, found := strings.Cut(value, "="); found {
+		match := pb.URIMatch(pb.URIMatch_value["URI_MATCH_"+strings.ToUpper(rule)])
+		if match != pb.URIMatch_URI_MATCH_UNSPECIFIED {
+			return &pb.LoginURI{Uri: uri, Match: match}
+		}
+	}
+	return &pb.LoginURI{Uri: value}
+}
+
+func newFindLoginCmd() *cobra.Command {
+	findCmd := &cobra.Command{
+		Use:   "find",
+		Short: "Find the logins for a website",
+		Long: `Print the path, login and matching URI of every login whose URIs match the URL according to their
+match rules, e.g. to fill in a sign-in form.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			url, _ := cmd.Flags().GetString("url")
+
+			resp, err := client.FindByURL(context.Background(), &pb.FindByURLRequest{Url: url})
+			if err != nil {
+				return fmt.Errorf("failed to find logins: %w", withFieldViolations(err))
+			}
+			if len(resp.GetLogins()) == 0 {
+				cmd.Printf("No logins found for %s\n", url)
+				return nil
+			}
+			for _, login := range resp.GetLogins() {
+				cmd.Printf("%s\t%s\t%s (%s)\n", login.GetPath(), login.GetLogin(), login.GetUri().GetUri(),
+					matchName(login.GetUri().GetMatch()))
+			}
+			return nil
+		},
+	}
+	findCmd.Flags().StringP("url", "u", "", "URL of the website")
+	_ = findCmd.MarkFlagRequired("url")
+	return findCmd
+}
+
 func NewLoginCmd() *cobra.Command {
 	loginCmd := &cobra.Command{
 		Use:   "login",
@@ -22,6 +89,7 @@ func NewLoginCmd() *cobra.Command {
 		Short: "Retrieve login data by path",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			path, _ := cmd.Flags().GetString("path")
+			reveal, _ := cmd.Flags().GetBool("reveal")
 
 			resp, err := client.Get(context.Background(), &pb.GetRequest{
 				Type: pb.DataType_DATA_TYPE_LOGIN,
@@ -32,6 +100,25 @@ func NewLoginCmd() *cobra.Command {
 			}
 			cmd.Printf("Login: %s\n", resp.GetData().GetLogin().GetLogin())
 			cmd.Printf("Password: %s\n", resp.GetData().GetLogin().GetPassword())
+			if uri := resp.GetData().GetLogin().GetTotp(); uri != "" {
+				cmd.Print("TOTP: ")
+				if err = printCode(cmd, uri); err != nil {
+					return err
+				}
+			}
+			for _, uri := range resp.GetData().GetLogin().GetUris() {
+				cmd.Printf("URI: %s (%s)\n", uri.GetUri(), matchName(uri.GetMatch()))
+			}
+			for _, field := range resp.GetData().GetLogin().GetFields() {
+				value := field.GetValue()
+				if field.GetSensitive() && !reveal {
+					value = maskedValue
+				}
+				cmd.Printf("%s: %s\n", field.GetName(), value)
+			}
+			if notes := resp.GetData().GetLogin().GetNotes(); notes != "" {
+				cmd.Printf("Notes: %s\n", notes)
+			}
 			cmd.Printf("Created at: %s\n", resp.GetData().GetBase().GetCreatedAt())
 			cmd.Printf("Created by: %s\n", resp.GetData().GetBase().GetCreatedBy())
 			cmd.Printf("Metadata: %s\n", resp.GetData().GetBase().GetMetadata())
@@ -39,13 +126,21 @@ func NewLoginCmd() *cobra.Command {
 		},
 	}
 	getCmd.Flags().StringP("path", "p", "", "Login path")
+	getCmd.Flags().Bool("reveal", false, "Print the values of sensitive custom fields")
 	_ = getCmd.MarkFlagRequired("path")
 
 	createCmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new login secret",
+		Long: `Store a login with the websites it is used for. Each --uri is matched by its base domain unless it is
+prefixed by a match rule: base_domain, host, exact or regex, e.g. host=https://gitlab.example.com.
+Custom fields are given by --field name=value, fields named by --sensitive are prompted for unless given.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			path, _ := cmd.Flags().GetString("path")
+			uriValues, _ := cmd.Flags().GetStringArray("uri")
+			notes, _ := cmd.Flags().GetString("notes")
+			pairs, _ := cmd.Flags().GetStringArray("field")
+			sensitive, _ := cmd.Flags().GetStringArray("sensitive")
 			reader := bufio.NewReader(cmd.InOrStdin())
 
 			login, err := promptString(cmd, reader, "Enter login: ")
@@ -53,18 +148,38 @@ func NewLoginCmd() *cobra.Command {
 				return fmt.Errorf("failed to read login: %w", err)
 			}
 
-			password, err := promptPassword(cmd, reader, "Enter password: ")
-			if err != nil {
-				return fmt.Errorf("failed to read password: %w", err)
+			generate, _ := cmd.Flags().GetBool("generate")
+			var secret string
+			if generate {
+				secret, _, err = generateFromFlags(cmd)
+				if err != nil {
+					return fmt.Errorf("failed to generate password: %w", err)
+				}
+			} else {
+				secret, err = promptNewPassword(cmd, reader)
+				if err != nil {
+					return err
+				}
+				printStrength(cmd, password.Estimate(secret))
 			}
-			confirm, err := promptPassword(cmd, reader, "Confirm password: ")
-			if err != nil {
-				return fmt.Errorf("failed to read password confirmation: %w", err)
+
+			var otpauth string
+			if withTOTP, _ := cmd.Flags().GetBool("totp"); withTOTP {
+				otpauth, err = promptPassword(cmd, reader, "Enter otpauth URI: ")
+				if err != nil {
+					return fmt.Errorf("failed to read otpauth URI: %w", err)
+				}
 			}
-			cmd.Println()
 
-			if password != confirm {
-				return errors.New("passwords don't match")
+			var uris []*pb.LoginURI
+			for _, value := range uriValues {
+				uris = append(uris, parseLoginURI(value))
+			}
+			var fields []*pb.KVField
+			if len(pairs) > 0 || len(sensitive) > 0 {
+				if fields, err = parseKVFields(cmd, reader, pairs, sensitive); err != nil {
+					return err
+				}
 			}
 
 			resp, err := client.Create(context.Background(), &pb.CreateRequest{
@@ -76,25 +191,39 @@ func NewLoginCmd() *cobra.Command {
 					Data: &pb.TypedData_Login{
 						Login: &pb.LoginData{
 							Login:    login,
-							Password: password,
+							Password: secret,
+							Totp:     otpauth,
+							Uris:     uris,
+							Notes:    notes,
+							Fields:   fields,
 						},
 					},
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("failed to create a new login entry: %w", err)
+				return fmt.Errorf("failed to create a new login entry: %w", withFieldViolations(err))
 			}
 			cmd.Println(resp.GetMessage())
+			if generate {
+				cmd.Printf("Generated password: %s\n", secret)
+			}
 			return nil
 		},
 	}
 	createCmd.Flags().StringP("path", "p", "", "Login path")
+	createCmd.Flags().Bool("generate", false, "Generate a random password instead of prompting for one")
+	createCmd.Flags().Bool("totp", false, "Prompt for the otpauth URI of a totp to attach")
+	createCmd.Flags().StringArray("uri", nil, "Website the login is used for as [rule=]uri, can be repeated")
+	createCmd.Flags().String("notes", "", "Notes on the login")
+	createCmd.Flags().StringArrayP("field", "f", nil, "Custom field as name=value, can be repeated")
+	createCmd.Flags().StringArrayP("sensitive", "s", nil, "Name of a sensitive custom field, can be repeated")
+	addGeneratorFlags(createCmd)
 	_ = createCmd.MarkFlagRequired("path")
 
 	listCmd := NewListCmd("login", "List available logins", pb.DataType_DATA_TYPE_LOGIN)
 	deleteCmd := NewDeleteCmd("login", "Delete existing login entry", pb.DataType_DATA_TYPE_LOGIN)
 
-	loginCmd.AddCommand(listCmd, getCmd, createCmd, deleteCmd)
+	loginCmd.AddCommand(listCmd, getCmd, createCmd, deleteCmd, newFindLoginCmd())
 
 	return loginCmd
 }
@@ -8,6 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
@@ -106,6 +109,167 @@ func TestLoginCommands(t *testing.T) {
 		assert.Contains(t, buf.String(), "Login created successfully")
 	})
 
+	t.Run("create login shows password strength", func(t *testing.T) {
+		cmd := NewLoginCmd()
+		cmd.SetIn(strings.NewReader("mark\nqwerty\nqwerty\n"))
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Create(mock.Anything, mock.Anything).Return(&pb.CreateResponse{
+			Message: "Login created successfully",
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"create", "-p", "weak-login"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Strength: very weak")
+		assert.Contains(t, buf.String(), "this is one of the most commonly used passwords")
+	})
+
+	t.Run("create login with generated password", func(t *testing.T) {
+		cmd := NewLoginCmd()
+		cmd.SetIn(strings.NewReader("mark\n"))
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+
+		var generated string
+		mockClient.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req *pb.CreateRequest) bool {
+			generated = req.GetData().GetLogin().GetPassword()
+			return req.GetData().GetLogin().GetLogin() == "mark"
+		})).Return(&pb.CreateResponse{
+			Message: "Login created successfully",
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"create", "-p", "generated-login", "--generate", "--length", "32", "--no-symbols"})
+		require.NoError(t, cmd.Execute())
+		assert.Regexp(t, `^[a-zA-Z0-9]{32}$`, generated)
+		assert.Contains(t, buf.String(), "Generated password: "+generated)
+		assert.NotContains(t, buf.String(), "Enter password")
+	})
+
+	t.Run("create login rejected by password policy", func(t *testing.T) {
+		cmd := NewLoginCmd()
+		cmd.SetIn(strings.NewReader("mark\nsecret\nsecret\n"))
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		st, err := status.New(codes.InvalidArgument, "invalid secret").WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "password", Description: "password should contain a digit"},
+			},
+		})
+		require.NoError(t, err)
+		mockClient.EXPECT().Create(mock.Anything, mock.Anything).Return(nil, st.Err()).Once()
+
+		cmd.SetArgs([]string{"create", "-p", "rejected-login"})
+		err = cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "password: password should contain a digit")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("get login with uris, fields and notes", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewLoginCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_LOGIN,
+			Path: "github",
+		}).Return(&pb.GetResponse{
+			Data: &pb.TypedData{
+				Base: &pb.Metadata{Path: "github"},
+				Data: &pb.TypedData_Login{
+					Login: &pb.LoginData{
+						Login:    "mark",
+						Password: "secret",
+						Uris: []*pb.LoginURI{
+							{Uri: "github.com", Match: pb.URIMatch_URI_MATCH_BASE_DOMAIN},
+							{Uri: "https://github.com/login", Match: pb.URIMatch_URI_MATCH_EXACT},
+						},
+						Fields: []*pb.KVField{
+							{Name: "account", Value: "42"},
+							{Name: "recovery", Value: "abcd-efgh", Sensitive: true},
+						},
+						Notes: "2FA enforced by the org",
+					},
+				},
+			},
+		}, nil).Twice()
+
+		cmd.SetArgs([]string{"get", "-p", "github"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "URI: github.com (base_domain)\n")
+		assert.Contains(t, buf.String(), "URI: https://github.com/login (exact)\n")
+		assert.Contains(t, buf.String(), "account: 42\n")
+		assert.Contains(t, buf.String(), "recovery: ********\n")
+		assert.Contains(t, buf.String(), "Notes: 2FA enforced by the org\n")
+
+		buf.Reset()
+		cmd.SetArgs([]string{"get", "-p", "github", "--reveal"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "recovery: abcd-efgh\n")
+	})
+
+	t.Run("create login with uris, fields and notes", func(t *testing.T) {
+		cmd := NewLoginCmd()
+		cmd.SetIn(strings.NewReader("mark\nsecret\nsecret\n1234\n"))
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Create(mock.Anything, &pb.CreateRequest{
+			Data: &pb.TypedData{
+				Type: pb.DataType_DATA_TYPE_LOGIN,
+				Base: &pb.Metadata{Path: "gitlab"},
+				Data: &pb.TypedData_Login{
+					Login: &pb.LoginData{
+						Login:    "mark",
+						Password: "secret",
+						Uris: []*pb.LoginURI{
+							{Uri: "gitlab.com"},
+							{Uri: "https://gitlab.example.com", Match: pb.URIMatch_URI_MATCH_HOST},
+							{Uri: "https://example.com/?next=/", Match: pb.URIMatch_URI_MATCH_EXACT},
+						},
+						Notes: "work account",
+						Fields: []*pb.KVField{
+							{Name: "team", Value: "infra"},
+							{Name: "pin", Value: "1234", Sensitive: true},
+						},
+					},
+				},
+			},
+		}).Return(&pb.CreateResponse{Message: "Login created successfully"}, nil).Once()
+
+		cmd.SetArgs([]string{
+			"create", "-p", "gitlab", "--uri", "gitlab.com", "--uri", "host=https://gitlab.example.com",
+			"--uri", "exact=https://example.com/?next=/", "--notes", "work account", "-f", "team=infra", "-s", "pin",
+		})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Login created successfully")
+	})
+
+	t.Run("find logins by url", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewLoginCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().FindByURL(mock.Anything, &pb.FindByURLRequest{Url: "https://mail.google.com"}).
+			Return(&pb.FindByURLResponse{Logins: []*pb.LoginMatch{
+				{Path: "google", Login: "mark", Uri: &pb.LoginURI{
+					Uri: "google.com", Match: pb.URIMatch_URI_MATCH_BASE_DOMAIN,
+				}},
+			}}, nil).Once()
+		cmd.SetArgs([]string{"find", "--url", "https://mail.google.com"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "google\tmark\tgoogle.com (base_domain)\n", buf.String())
+
+		buf.Reset()
+		mockClient.EXPECT().FindByURL(mock.Anything, &pb.FindByURLRequest{Url: "https://gitlab.com"}).
+			Return(&pb.FindByURLResponse{}, nil).Once()
+		cmd.SetArgs([]string{"find", "-u", "https://gitlab.com"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "No logins found for https://gitlab.com\n", buf.String())
+	})
+
 	t.Run("delete login", func(t *testing.T) {
 		buf := new(bytes.Buffer)
 		cmd := NewLoginCmd()
@@ -0,0 +1,288 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+var roleNames = map[pb.Role]string{
+	pb.Role_ROLE_OWNER:  "owner",
+	pb.Role_ROLE_ADMIN:  "admin",
+	pb.Role_ROLE_EDITOR: "editor",
+	pb.Role_ROLE_VIEWER: "viewer",
+}
+
+func roleName(role pb.Role) string {
+	if name, ok := roleNames[role]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+func parseRole(name string) (pb.Role, error) {
+	for role, n := range roleNames {
+		if strings.EqualFold(n, name) {
+			return role, nil
+		}
+	}
+	return pb.Role_ROLE_UNSPECIFIED, fmt.Errorf("unknown role %q, expected owner, admin, editor or viewer", name)
+}
+
+// printOrgResponse prints the result of an organization change or wraps the error with the failed action.
+func printOrgResponse(cmd *cobra.Command, resp *pb.OrganizationResponse, err error, action string) error {
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+	cmd.Println(resp.GetMessage())
+	return nil
+}
+
+func newOrgMemberCmd() *cobra.Command {
+	memberCmd := &cobra.Command{
+		Use:   "member",
+		Short: "Manage members of an organization",
+	}
+
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Short: "Add a user to an organization or change their role",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			user, _ := cmd.Flags().GetString("user")
+			name, _ := cmd.Flags().GetString("role")
+			role, err := parseRole(name)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SetMember(context.Background(), &pb.SetMemberRequest{Org: org, Login: user, Role: role})
+			return printOrgResponse(cmd, resp, err, "set member")
+		},
+	}
+	setCmd.Flags().StringP("user", "u", "", "User to add")
+	setCmd.Flags().StringP("role", "r", "viewer", "Role of the user: owner, admin, editor or viewer")
+	_ = setCmd.MarkFlagRequired("user")
+
+	removeCmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a user from an organization and its teams",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			user, _ := cmd.Flags().GetString("user")
+
+			resp, err := client.RemoveMember(context.Background(), &pb.RemoveMemberRequest{Org: org, Login: user})
+			return printOrgResponse(cmd, resp, err, "remove member")
+		},
+	}
+	removeCmd.Flags().StringP("user", "u", "", "User to remove")
+	_ = removeCmd.MarkFlagRequired("user")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List members of an organization",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+
+			resp, err := client.ListMembers(context.Background(), &pb.ListMembersRequest{Org: org})
+			if err != nil {
+				return fmt.Errorf("failed to list members: %w", err)
+			}
+			for _, member := range resp.GetMembers() {
+				cmd.Printf("%s\t%s\t%s\n", member.GetLogin(), roleName(member.GetRole()),
+					strings.Join(member.GetTeams(), ","))
+			}
+			return nil
+		},
+	}
+
+	memberCmd.AddCommand(setCmd, removeCmd, listCmd)
+
+	return memberCmd
+}
+
+func newOrgCollectionCmd() *cobra.Command {
+	collectionCmd := &cobra.Command{
+		Use:   "collection",
+		Short: "Manage collections of secrets owned by an organization",
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a collection",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			name, _ := cmd.Flags().GetString("name")
+
+			resp, err := client.CreateCollection(context.Background(), &pb.CreateCollectionRequest{Org: org, Name: name})
+			return printOrgResponse(cmd, resp, err, "create collection")
+		},
+	}
+	createCmd.Flags().StringP("name", "n", "", "Collection name")
+	_ = createCmd.MarkFlagRequired("name")
+
+	addCmd := &cobra.Command{
+		Use:   "add",
+		Short: "Move a secret into a collection",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			collection, _ := cmd.Flags().GetString("collection")
+			path, _ := cmd.Flags().GetString("path")
+
+			resp, err := client.AddToCollection(context.Background(), &pb.AddToCollectionRequest{
+				Org:        org,
+				Collection: collection,
+				Path:       path,
+			})
+			return printOrgResponse(cmd, resp, err, "add secret to collection")
+		},
+	}
+
+	removeCmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Take a secret out of a collection",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			collection, _ := cmd.Flags().GetString("collection")
+			path, _ := cmd.Flags().GetString("path")
+
+			resp, err := client.RemoveFromCollection(context.Background(), &pb.RemoveFromCollectionRequest{
+				Org:        org,
+				Collection: collection,
+				Path:       path,
+			})
+			return printOrgResponse(cmd, resp, err, "remove secret from collection")
+		},
+	}
+	for _, c := range []*cobra.Command{addCmd, removeCmd} {
+		c.Flags().StringP("collection", "c", "", "Collection name")
+		c.Flags().StringP("path", "p", "", "Secret path")
+		_ = c.MarkFlagRequired("collection")
+		_ = c.MarkFlagRequired("path")
+	}
+
+	assignCmd := &cobra.Command{
+		Use:   "assign",
+		Short: "Give a team access to a collection",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			collection, _ := cmd.Flags().GetString("collection")
+			team, _ := cmd.Flags().GetString("team")
+			name, _ := cmd.Flags().GetString("role")
+			role, err := parseRole(name)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.AssignCollection(context.Background(), &pb.AssignCollectionRequest{
+				Org:        org,
+				Collection: collection,
+				Team:       team,
+				Role:       role,
+			})
+			return printOrgResponse(cmd, resp, err, "assign collection")
+		},
+	}
+	assignCmd.Flags().StringP("role", "r", "viewer", "Role of the team: editor or viewer")
+
+	unassignCmd := &cobra.Command{
+		Use:   "unassign",
+		Short: "Revoke access of a team to a collection",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			collection, _ := cmd.Flags().GetString("collection")
+			team, _ := cmd.Flags().GetString("team")
+
+			resp, err := client.UnassignCollection(context.Background(), &pb.UnassignCollectionRequest{
+				Org:        org,
+				Collection: collection,
+				Team:       team,
+			})
+			return printOrgResponse(cmd, resp, err, "unassign collection")
+		},
+	}
+	for _, c := range []*cobra.Command{assignCmd, unassignCmd} {
+		c.Flags().StringP("collection", "c", "", "Collection name")
+		c.Flags().StringP("team", "t", "", "Team name")
+		_ = c.MarkFlagRequired("collection")
+		_ = c.MarkFlagRequired("team")
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List collections with their secrets and teams",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+
+			resp, err := client.ListCollections(context.Background(), &pb.ListCollectionsRequest{Org: org})
+			if err != nil {
+				return fmt.Errorf("failed to list collections: %w", err)
+			}
+			for _, collection := range resp.GetCollections() {
+				teams := make([]string, 0, len(collection.GetTeams()))
+				for _, team := range collection.GetTeams() {
+					teams = append(teams, fmt.Sprintf("%s(%s)", team.GetTeam(), roleName(team.GetRole())))
+				}
+				cmd.Printf("%s\tteams: %s\n", collection.GetName(), strings.Join(teams, ","))
+				for _, path := range collection.GetSecrets() {
+					cmd.Printf("  %s\n", path)
+				}
+			}
+			return nil
+		},
+	}
+
+	collectionCmd.AddCommand(createCmd, addCmd, removeCmd, assignCmd, unassignCmd, listCmd)
+
+	return collectionCmd
+}
+
+func NewOrgCmd() *cobra.Command {
+	orgCmd := &cobra.Command{
+		Use:   "org",
+		Short: "Manage organizations, their members and collections",
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an organization, you become its owner",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			name, _ := cmd.Flags().GetString("name")
+
+			resp, err := client.CreateOrganization(context.Background(), &pb.CreateOrganizationRequest{Name: name})
+			return printOrgResponse(cmd, resp, err, "create organization")
+		},
+	}
+	createCmd.Flags().StringP("name", "n", "", "Organization name")
+	_ = createCmd.MarkFlagRequired("name")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List organizations you are a member of",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resp, err := client.ListOrganizations(context.Background(), &pb.ListOrganizationsRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to list organizations: %w", err)
+			}
+			for _, org := range resp.GetOrganizations() {
+				cmd.Printf("%s\t%s\n", org.GetName(), roleName(org.GetRole()))
+			}
+			return nil
+		},
+	}
+
+	memberCmd := newOrgMemberCmd()
+	collectionCmd := newOrgCollectionCmd()
+	for _, c := range []*cobra.Command{memberCmd, collectionCmd} {
+		c.PersistentFlags().StringP("org", "o", "", "Organization name")
+		_ = c.MarkPersistentFlagRequired("org")
+	}
+
+	orgCmd.AddCommand(createCmd, listCmd, memberCmd, collectionCmd)
+
+	return orgCmd
+}
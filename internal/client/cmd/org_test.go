@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestOrgCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	t.Run("create organization", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewOrgCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().CreateOrganization(mock.Anything, &pb.CreateOrganizationRequest{Name: "rome"}).
+			Return(&pb.OrganizationResponse{Message: "organization rome has been created"}, nil)
+
+		cmd.SetArgs([]string{"create", "-n", "rome"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "organization rome has been created")
+	})
+
+	t.Run("set member role", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewOrgCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().SetMember(mock.Anything, &pb.SetMemberRequest{
+			Org:   "rome",
+			Login: "lucius",
+			Role:  pb.Role_ROLE_ADMIN,
+		}).Return(&pb.OrganizationResponse{Message: "lucius is now admin of organization rome"}, nil)
+
+		cmd.SetArgs([]string{"member", "set", "-o", "rome", "-u", "lucius", "-r", "Admin"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "lucius is now admin")
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		cmd := NewOrgCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		cmd.SetArgs([]string{"member", "set", "-o", "rome", "-u", "lucius", "-r", "emperor"})
+		require.ErrorContains(t, cmd.Execute(), "unknown role")
+	})
+
+	t.Run("list members", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewOrgCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().ListMembers(mock.Anything, &pb.ListMembersRequest{Org: "rome"}).
+			Return(&pb.ListMembersResponse{Members: []*pb.Member{
+				{Login: "lucius", Role: pb.Role_ROLE_EDITOR, Teams: []string{"senate", "legion"}},
+			}}, nil)
+
+		cmd.SetArgs([]string{"member", "list", "-o", "rome"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "lucius\teditor\tsenate,legion\n", buf.String())
+	})
+
+	t.Run("assign collection", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewOrgCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().AssignCollection(mock.Anything, &pb.AssignCollectionRequest{
+			Org:        "rome",
+			Collection: "treasury",
+			Team:       "senate",
+			Role:       pb.Role_ROLE_EDITOR,
+		}).Return(&pb.OrganizationResponse{Message: "team senate is now editor of collection treasury"}, nil)
+
+		cmd.SetArgs([]string{"collection", "assign", "-o", "rome", "-c", "treasury", "-t", "senate", "-r", "editor"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "team senate is now editor")
+	})
+
+	t.Run("list collections", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewOrgCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().ListCollections(mock.Anything, &pb.ListCollectionsRequest{Org: "rome"}).
+			Return(&pb.ListCollectionsResponse{Collections: []*pb.Collection{
+				{
+					Name:    "treasury",
+					Secrets: []string{"bank/login"},
+					Teams:   []*pb.CollectionTeam{{Team: "senate", Role: pb.Role_ROLE_VIEWER}},
+				},
+			}}, nil)
+
+		cmd.SetArgs([]string{"collection", "list", "-o", "rome"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "treasury\tteams: senate(viewer)\n  bank/login\n", buf.String())
+	})
+
+	t.Run("collection requires org", func(t *testing.T) {
+		cmd := NewOrgCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		cmd.SetArgs([]string{"collection", "list"})
+		require.Error(t, cmd.Execute())
+	})
+}
+
+func TestTeamCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	t.Run("add team member", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewTeamCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().AddTeamMember(mock.Anything, &pb.AddTeamMemberRequest{
+			Org:   "rome",
+			Team:  "senate",
+			Login: "lucius",
+		}).Return(&pb.OrganizationResponse{Message: "lucius has been added to team senate"}, nil)
+
+		cmd.SetArgs([]string{"add", "-o", "rome", "-t", "senate", "-u", "lucius"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "lucius has been added to team senate")
+	})
+
+	t.Run("list teams", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewTeamCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().ListTeams(mock.Anything, &pb.ListTeamsRequest{Org: "rome"}).
+			Return(&pb.ListTeamsResponse{Teams: []*pb.Team{{Name: "senate", Members: []string{"lucius", "mark"}}}}, nil)
+
+		cmd.SetArgs([]string{"list", "-o", "rome"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "senate\tlucius,mark\n", buf.String())
+	})
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// schemaDocument is the JSON-Schema-like definition of a secret type given to secret-type register.
+type schemaDocument struct {
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Properties  map[string]schemaProperty `json:"properties"`
+	Required    []string                  `json:"required"`
+}
+
+type schemaProperty struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Sensitive   bool   `json:"sensitive"`
+	Pattern     string `json:"pattern"`
+}
+
+// propertyNames returns the names of the properties in the order of the document, it is the order the fields
+// are prompted for.
+func propertyNames(data []byte) ([]string, error) {
+	var document struct {
+		Properties json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	if len(document.Properties) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(document.Properties))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	var names []string
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected token %v", token)
+		}
+		names = append(names, name)
+		var skip json.RawMessage
+		if err = decoder.Decode(&skip); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+// parseSchema converts the schema document to the secret type sent to the server.
+func parseSchema(data []byte) (*pb.SecretType, error) {
+	var document schemaDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+	names, err := propertyNames(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema properties: %w", err)
+	}
+	for _, name := range document.Required {
+		if _, ok := document.Properties[name]; !ok {
+			return nil, fmt.Errorf("required field %s is not a property of the schema", name)
+		}
+	}
+
+	secretType := &pb.SecretType{Name: document.Name, Description: document.Description}
+	for _, name := range names {
+		property := document.Properties[name]
+		secretType.Fields = append(secretType.Fields, &pb.SecretTypeField{
+			Name:        name,
+			Type:        property.Type,
+			Description: property.Description,
+			Required:    slices.Contains(document.Required, name),
+			Sensitive:   property.Sensitive,
+			Pattern:     property.Pattern,
+		})
+	}
+	return secretType, nil
+}
+
+func printSecretType(cmd *cobra.Command, secretType *pb.SecretType) {
+	cmd.Printf("Name: %s\n", secretType.GetName())
+	if secretType.GetDescription() != "" {
+		cmd.Printf("Description: %s\n", secretType.GetDescription())
+	}
+	cmd.Println("Fields:")
+	for _, field := range secretType.GetFields() {
+		var flags []string
+		if field.GetRequired() {
+			flags = append(flags, "required")
+		}
+		if field.GetSensitive() {
+			flags = append(flags, "sensitive")
+		}
+		if field.GetPattern() != "" {
+			flags = append(flags, "pattern "+field.GetPattern())
+		}
+		cmd.Printf("  %s\t%s\t%s\t%s\n", field.GetName(), field.GetType(), strings.Join(flags, ", "),
+			field.GetDescription())
+	}
+	cmd.Printf("Created at: %s\n", secretType.GetCreatedAt())
+	cmd.Printf("Created by: %s\n", secretType.GetCreatedBy())
+}
+
+func NewSecretTypeCmd() *cobra.Command {
+	secretTypeCmd := &cobra.Command{
+		Use:   "secret-type",
+		Short: "Manage user-defined secret types",
+		Long: `Secret types define the fields of key-value secrets, e.g. a database type with host, port and password.
+Secrets of a type are validated against its schema and kv create -t prompts for its fields.`,
+	}
+
+	registerCmd := &cobra.Command{
+		Use:   "register",
+		Short: "Register a secret type from a schema file, replacing its definition (administrators only)",
+		Long: `The schema is a JSON document with the name and the properties of the type, e.g.
+{"name": "database", "properties": {"port": {"type": "integer"}, "password": {"type": "string",
+"sensitive": true, "pattern": ".{12,}"}}, "required": ["password"]}
+Property types are string, integer, number and boolean.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			file, _ := cmd.Flags().GetString("file")
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read schema: %w", err)
+			}
+			secretType, err := parseSchema(data)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.RegisterSecretType(context.Background(),
+				&pb.RegisterSecretTypeRequest{Type: secretType})
+			if err != nil {
+				return fmt.Errorf("failed to register secret type: %w", withFieldViolations(err))
+			}
+			cmd.Println(resp.GetMessage())
+			return nil
+		},
+	}
+	registerCmd.Flags().StringP("file", "f", "", "Schema file")
+	_ = registerCmd.MarkFlagRequired("file")
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Print the fields of a secret type",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			name, _ := cmd.Flags().GetString("name")
+			resp, err := client.GetSecretType(context.Background(), &pb.GetSecretTypeRequest{Name: name})
+			if err != nil {
+				return fmt.Errorf("failed to retrieve secret type: %w", err)
+			}
+			printSecretType(cmd, resp.GetType())
+			return nil
+		},
+	}
+	getCmd.Flags().StringP("name", "n", "", "Secret type name")
+	_ = getCmd.MarkFlagRequired("name")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List registered secret types",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resp, err := client.ListSecretTypes(context.Background(), &pb.ListSecretTypesRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to list secret types: %w", err)
+			}
+			for _, secretType := range resp.GetTypes() {
+				cmd.Printf("%s\t%s\n", secretType.GetName(), secretType.GetDescription())
+			}
+			return nil
+		},
+	}
+
+	secretTypeCmd.AddCommand(registerCmd, getCmd, listCmd)
+
+	return secretTypeCmd
+}
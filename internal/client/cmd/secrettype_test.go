@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestParseSchema(t *testing.T) {
+	secretType, err := parseSchema([]byte(`{
+		"name": "database",
+		"description": "Database credentials",
+		"properties": {
+			"host": {"type": "string", "description": "Host name"},
+			"port": {"type": "integer"},
+			"password": {"type": "string", "sensitive": true, "pattern": ".{12,}"}
+		},
+		"required": ["host", "password"]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, &pb.SecretType{
+		Name:        "database",
+		Description: "Database credentials",
+		Fields: []*pb.SecretTypeField{
+			{Name: "host", Type: "string", Description: "Host name", Required: true},
+			{Name: "port", Type: "integer"},
+			{Name: "password", Type: "string", Required: true, Sensitive: true, Pattern: ".{12,}"},
+		},
+	}, secretType)
+
+	_, err = parseSchema([]byte(`{"name": "database", "properties": {}, "required": ["host"]}`))
+	require.ErrorContains(t, err, "required field host is not a property of the schema")
+
+	_, err = parseSchema([]byte(`{"name": "database", "properties": []}`))
+	require.ErrorContains(t, err, "failed to parse schema")
+}
+
+func TestSecretTypeCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	t.Run("register secret type", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "database.json")
+		require.NoError(t, os.WriteFile(file,
+			[]byte(`{"name": "database", "properties": {"host": {"type": "string"}}, "required": ["host"]}`), 0o600))
+
+		buf := new(bytes.Buffer)
+		cmd := NewSecretTypeCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().RegisterSecretType(mock.Anything, &pb.RegisterSecretTypeRequest{Type: &pb.SecretType{
+			Name:   "database",
+			Fields: []*pb.SecretTypeField{{Name: "host", Type: "string", Required: true}},
+		}}).Return(&pb.RegisterSecretTypeResponse{Message: "secret type database has been registered"}, nil).Once()
+
+		cmd.SetArgs([]string{"register", "-f", file})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "secret type database has been registered")
+	})
+
+	t.Run("get secret type", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewSecretTypeCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().GetSecretType(mock.Anything, &pb.GetSecretTypeRequest{Name: "database"}).
+			Return(&pb.GetSecretTypeResponse{Type: &pb.SecretType{
+				Name: "database",
+				Fields: []*pb.SecretTypeField{
+					{Name: "password", Type: "string", Required: true, Sensitive: true, Description: "Password"},
+				},
+				CreatedBy: "root",
+			}}, nil).Once()
+
+		cmd.SetArgs([]string{"get", "-n", "database"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Name: database")
+		assert.Contains(t, buf.String(), "password\tstring\trequired, sensitive\tPassword")
+		assert.Contains(t, buf.String(), "Created by: root")
+	})
+
+	t.Run("list secret types", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewSecretTypeCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().ListSecretTypes(mock.Anything, &pb.ListSecretTypesRequest{}).
+			Return(&pb.ListSecretTypesResponse{Types: []*pb.SecretType{
+				{Name: "database", Description: "Database credentials"},
+			}}, nil).Once()
+
+		cmd.SetArgs([]string{"list"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "database\tDatabase credentials\n", buf.String())
+	})
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// printAccountResponse prints the result of a service account change or wraps the error with the failed action.
+func printAccountResponse(cmd *cobra.Command, resp *pb.ServiceAccountResponse, err error, action string) error {
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+	cmd.Println(resp.GetMessage())
+	return nil
+}
+
+// keyScope describes the scope of an API key in a single line.
+func keyScope(key *pb.APIKey) string {
+	access := "read-write"
+	if key.GetReadOnly() {
+		access = "read-only"
+	}
+	paths := "all paths"
+	if len(key.GetPathPrefixes()) > 0 {
+		paths = strings.Join(key.GetPathPrefixes(), ",")
+	}
+	return access + "\t" + paths
+}
+
+func newAPIKeyCmd() *cobra.Command {
+	keyCmd := &cobra.Command{
+		Use:   "key",
+		Short: "Manage API keys of a service account",
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an API key, it is shown only once",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			account, _ := cmd.Flags().GetString("name")
+			readOnly, _ := cmd.Flags().GetBool("read-only")
+			prefixes, _ := cmd.Flags().GetStringSlice("path-prefix")
+			ttl, _ := cmd.Flags().GetDuration("ttl")
+
+			resp, err := client.CreateAPIKey(context.Background(), &pb.CreateAPIKeyRequest{
+				Account:      account,
+				ReadOnly:     readOnly,
+				PathPrefixes: prefixes,
+				TtlSeconds:   int64(ttl / time.Second),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create api key: %w", err)
+			}
+			cmd.Printf("API key %s has been created, store it now, it can't be shown again:\n%s\n",
+				resp.GetKey().GetKeyId(), resp.GetApiKey())
+			return nil
+		},
+	}
+	createCmd.Flags().Bool("read-only", false, "Only allow reading secrets")
+	createCmd.Flags().StringSlice("path-prefix", nil, "Only allow secrets with the path prefix, can be repeated")
+	createCmd.Flags().Duration("ttl", 0, "Lifetime of the key, e.g. 720h (default never expires)")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List API keys of a service account",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			account, _ := cmd.Flags().GetString("name")
+
+			resp, err := client.ListAPIKeys(context.Background(), &pb.ListAPIKeysRequest{Account: account})
+			if err != nil {
+				return fmt.Errorf("failed to list api keys: %w", err)
+			}
+			for _, key := range resp.GetKeys() {
+				expires, used := key.GetExpiresAt(), key.GetLastUsedAt()
+				if expires == "" {
+					expires = "never"
+				}
+				if used == "" {
+					used = "never"
+				}
+				cmd.Printf("%s\t%s\texpires: %s\tlast used: %s\n", key.GetKeyId(), keyScope(key), expires, used)
+			}
+			return nil
+		},
+	}
+
+	revokeCmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke an API key",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			account, _ := cmd.Flags().GetString("name")
+			keyID, _ := cmd.Flags().GetString("id")
+
+			resp, err := client.RevokeAPIKey(context.Background(), &pb.RevokeAPIKeyRequest{
+				Account: account,
+				KeyId:   keyID,
+			})
+			return printAccountResponse(cmd, resp, err, "revoke api key")
+		},
+	}
+	revokeCmd.Flags().String("id", "", "Key id")
+	_ = revokeCmd.MarkFlagRequired("id")
+
+	keyCmd.PersistentFlags().StringP("name", "n", "", "Service account name")
+	_ = keyCmd.MarkPersistentFlagRequired("name")
+	keyCmd.AddCommand(createCmd, listCmd, revokeCmd)
+
+	return keyCmd
+}
+
+func NewServiceAccountCmd() *cobra.Command {
+	accountCmd := &cobra.Command{
+		Use:     "service-account",
+		Aliases: []string{"sa"},
+		Short:   "Manage service accounts used by automation",
+		Long: "Service accounts authenticate with API keys instead of passwords. Share secrets with an account\n" +
+			"like with any other user, then set GOPHKEEPER_API_KEY to use one of its keys.",
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a service account you own",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			name, _ := cmd.Flags().GetString("name")
+
+			resp, err := client.CreateServiceAccount(context.Background(), &pb.CreateServiceAccountRequest{Name: name})
+			return printAccountResponse(cmd, resp, err, "create service account")
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a service account with its keys",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			name, _ := cmd.Flags().GetString("name")
+
+			resp, err := client.DeleteServiceAccount(context.Background(), &pb.DeleteServiceAccountRequest{Name: name})
+			return printAccountResponse(cmd, resp, err, "delete service account")
+		},
+	}
+	for _, c := range []*cobra.Command{createCmd, deleteCmd} {
+		c.Flags().StringP("name", "n", "", "Service account name")
+		_ = c.MarkFlagRequired("name")
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List your service accounts",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resp, err := client.ListServiceAccounts(context.Background(), &pb.ListServiceAccountsRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to list service accounts: %w", err)
+			}
+			for _, account := range resp.GetAccounts() {
+				cmd.Printf("%s\t%s\n", account.GetName(), account.GetCreatedAt())
+			}
+			return nil
+		},
+	}
+
+	accountCmd.AddCommand(createCmd, deleteCmd, listCmd, newAPIKeyCmd())
+
+	return accountCmd
+}
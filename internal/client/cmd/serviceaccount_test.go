@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestServiceAccountCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	t.Run("create service account", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewServiceAccountCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().CreateServiceAccount(mock.Anything, &pb.CreateServiceAccountRequest{Name: "deployer"}).
+			Return(&pb.ServiceAccountResponse{Message: "service account deployer has been created"}, nil)
+
+		cmd.SetArgs([]string{"create", "-n", "deployer"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "service account deployer has been created")
+	})
+
+	t.Run("create scoped api key", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewServiceAccountCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().CreateAPIKey(mock.Anything, &pb.CreateAPIKeyRequest{
+			Account:      "deployer",
+			ReadOnly:     true,
+			PathPrefixes: []string{"deploy/", "ci/"},
+			TtlSeconds:   int64((720 * time.Hour).Seconds()),
+		}).Return(&pb.CreateAPIKeyResponse{ApiKey: "gk_0a1b_secret", Key: &pb.APIKey{KeyId: "0a1b"}}, nil)
+
+		cmd.SetArgs([]string{"key", "create", "-n", "deployer", "--read-only",
+			"--path-prefix", "deploy/", "--path-prefix", "ci/", "--ttl", "720h"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "API key 0a1b has been created")
+		assert.Contains(t, buf.String(), "gk_0a1b_secret")
+	})
+
+	t.Run("list api keys", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewServiceAccountCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().ListAPIKeys(mock.Anything, &pb.ListAPIKeysRequest{Account: "deployer"}).
+			Return(&pb.ListAPIKeysResponse{Keys: []*pb.APIKey{
+				{KeyId: "0a1b", ReadOnly: true, PathPrefixes: []string{"deploy/"}, LastUsedAt: "2026-10-19T12:00:00Z"},
+				{KeyId: "2c3d"},
+			}}, nil)
+
+		cmd.SetArgs([]string{"key", "list", "-n", "deployer"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "0a1b\tread-only\tdeploy/\texpires: never\tlast used: 2026-10-19T12:00:00Z")
+		assert.Contains(t, buf.String(), "2c3d\tread-write\tall paths\texpires: never\tlast used: never")
+	})
+
+	t.Run("revoke api key", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewServiceAccountCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().RevokeAPIKey(mock.Anything, &pb.RevokeAPIKeyRequest{Account: "deployer", KeyId: "0a1b"}).
+			Return(&pb.ServiceAccountResponse{Message: "api key 0a1b has been revoked"}, nil)
+
+		cmd.SetArgs([]string{"key", "revoke", "-n", "deployer", "--id", "0a1b"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "api key 0a1b has been revoked")
+	})
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func permissionName(permission pb.Permission) string {
+	if permission == pb.Permission_PERMISSION_READ_WRITE {
+		return "read-write"
+	}
+	return "read"
+}
+
+func NewShareCmd() *cobra.Command {
+	shareCmd := &cobra.Command{
+		Use:   "share",
+		Short: "Share secrets with other users",
+	}
+
+	addCmd := &cobra.Command{
+		Use:   "add",
+		Short: "Grant another user access to a secret",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			user, _ := cmd.Flags().GetString("user")
+			write, _ := cmd.Flags().GetBool("write")
+
+			permission := pb.Permission_PERMISSION_READ
+			if write {
+				permission = pb.Permission_PERMISSION_READ_WRITE
+			}
+			resp, err := client.Share(context.Background(), &pb.ShareRequest{
+				Path:       path,
+				Grantee:    user,
+				Permission: permission,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to share secret: %w", err)
+			}
+			cmd.Println(resp.GetMessage())
+			return nil
+		},
+	}
+	addCmd.Flags().StringP("path", "p", "", "Secret path")
+	addCmd.Flags().StringP("user", "u", "", "User to share the secret with")
+	addCmd.Flags().Bool("write", false, "Allow the user to modify and delete the secret")
+	_ = addCmd.MarkFlagRequired("path")
+	_ = addCmd.MarkFlagRequired("user")
+
+	removeCmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Revoke access of a user to a secret",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			user, _ := cmd.Flags().GetString("user")
+
+			resp, err := client.Unshare(context.Background(), &pb.UnshareRequest{
+				Path:    path,
+				Grantee: user,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to revoke access: %w", err)
+			}
+			cmd.Println(resp.GetMessage())
+			return nil
+		},
+	}
+	removeCmd.Flags().StringP("path", "p", "", "Secret path")
+	removeCmd.Flags().StringP("user", "u", "", "User to revoke access from")
+	_ = removeCmd.MarkFlagRequired("path")
+	_ = removeCmd.MarkFlagRequired("user")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users a secret is shared with, or secrets shared with you",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			withMe, _ := cmd.Flags().GetBool("with-me")
+			if path == "" && !withMe {
+				return errors.New("either --path or --with-me is required")
+			}
+
+			resp, err := client.ListShares(context.Background(), &pb.ListSharesRequest{
+				Path:         path,
+				SharedWithMe: withMe,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list shares: %w", err)
+			}
+			for _, share := range resp.GetShares() {
+				if withMe {
+					cmd.Printf("%s\t%s\tby %s\t%s\n", share.GetPath(), typeName(share.GetType()),
+						share.GetOwner(), permissionName(share.GetPermission()))
+				} else {
+					cmd.Printf("%s\t%s\tsince %s\n", share.GetGrantee(), permissionName(share.GetPermission()),
+						share.GetCreatedAt())
+				}
+			}
+			return nil
+		},
+	}
+	listCmd.Flags().StringP("path", "p", "", "Secret path")
+	listCmd.Flags().Bool("with-me", false, "List secrets other users have shared with you")
+
+	shareCmd.AddCommand(addCmd, removeCmd, listCmd)
+
+	return shareCmd
+}
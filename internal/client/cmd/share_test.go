@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestShareCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	t.Run("share with write access", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewShareCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Share(mock.Anything, &pb.ShareRequest{
+			Path:       "team/db",
+			Grantee:    "lucius",
+			Permission: pb.Permission_PERMISSION_READ_WRITE,
+		}).Return(&pb.ShareResponse{Message: "secret has been shared"}, nil)
+
+		cmd.SetArgs([]string{"add", "-p", "team/db", "-u", "lucius", "--write"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "secret has been shared")
+	})
+
+	t.Run("revoke access", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewShareCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Unshare(mock.Anything, &pb.UnshareRequest{
+			Path:    "team/db",
+			Grantee: "lucius",
+		}).Return(&pb.UnshareResponse{Message: "access has been revoked"}, nil)
+
+		cmd.SetArgs([]string{"remove", "-p", "team/db", "-u", "lucius"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "access has been revoked")
+	})
+
+	t.Run("list shared with me", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewShareCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().ListShares(mock.Anything, &pb.ListSharesRequest{SharedWithMe: true}).
+			Return(&pb.ListSharesResponse{Shares: []*pb.Share{
+				{
+					Path:       "ops/cert.pem",
+					Type:       pb.DataType_DATA_TYPE_BINARY,
+					Owner:      "mark",
+					Grantee:    "lucius",
+					Permission: pb.Permission_PERMISSION_READ,
+				},
+			}}, nil)
+
+		cmd.SetArgs([]string{"list", "--with-me"})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "ops/cert.pem\tbinary\tby mark\tread\n", buf.String())
+	})
+
+	t.Run("list requires path or with-me", func(t *testing.T) {
+		cmd := NewShareCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		cmd.SetArgs([]string{"list"})
+		require.Error(t, cmd.Execute())
+	})
+}
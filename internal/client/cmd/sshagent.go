@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+
+	"github.com/itallix/gophkeeper/internal/client/sshagent"
+	"github.com/itallix/gophkeeper/internal/common/sshkey"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// loadSSHKeys loads the keys stored at paths into the agent, all the ssh keys when paths is empty.
+func loadSSHKeys(ctx context.Context, a *sshagent.Agent, paths []string) (int, error) {
+	if len(paths) == 0 {
+		resp, err := client.List(ctx, &pb.ListRequest{Type: pb.DataType_DATA_TYPE_SSH_KEY})
+		if err != nil {
+			return 0, fmt.Errorf("error listing ssh keys: %w", err)
+		}
+		paths = resp.GetSecrets()
+	}
+	for _, path := range paths {
+		resp, err := client.Get(ctx, &pb.GetRequest{Type: pb.DataType_DATA_TYPE_SSH_KEY, Path: path})
+		if err != nil {
+			return 0, fmt.Errorf("failed to retrieve ssh key %s: %w", path, err)
+		}
+		data := resp.GetData().GetSshKey()
+		key, err := sshkey.Parse([]byte(data.GetPrivateKey()), []byte(data.GetPassphrase()))
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse ssh key %s: %w", path, err)
+		}
+		comment := data.GetComment()
+		if comment == "" {
+			comment = path
+		}
+		if err = a.Load(key, comment); err != nil {
+			return 0, fmt.Errorf("failed to load ssh key %s: %w", path, err)
+		}
+	}
+	return len(paths), nil
+}
+
+// confirmOnStdin asks on the terminal before every use of a key.
+func confirmOnStdin(cmd *cobra.Command) sshagent.ConfirmFunc {
+	reader := bufio.NewReader(cmd.InOrStdin())
+	return func(key *agent.Key) bool {
+		answer, err := promptString(cmd, reader,
+			fmt.Sprintf("Allow use of %s (%s)? [y/N]: ", key.Comment, ssh.FingerprintSHA256(key)))
+		return err == nil && strings.EqualFold(answer, "y")
+	}
+}
+
+func NewSSHAgentCmd() *cobra.Command {
+	agentCmd := &cobra.Command{
+		Use:   "ssh-agent",
+		Short: "Serve the stored ssh keys to ssh clients",
+		Long: `Start an ssh-agent holding the stored ssh keys in memory until it is stopped.
+Point ssh at it with the printed SSH_AUTH_SOCK variable.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			paths, _ := cmd.Flags().GetStringSlice("path")
+			socket, _ := cmd.Flags().GetString("socket")
+			confirm, _ := cmd.Flags().GetBool("confirm")
+			lifetime, _ := cmd.Flags().GetDuration("lifetime")
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			opts := []sshagent.Option{sshagent.WithLifetime(lifetime)}
+			if confirm {
+				opts = append(opts, sshagent.WithConfirm(confirmOnStdin(cmd)))
+			}
+			keyAgent := sshagent.New(opts...)
+			loaded, err := loadSSHKeys(ctx, keyAgent, paths)
+			if err != nil {
+				return err
+			}
+
+			if socket == "" {
+				dir, dirErr := os.MkdirTemp("", "gophkeeper-agent-")
+				if dirErr != nil {
+					return fmt.Errorf("failed to create the socket directory: %w", dirErr)
+				}
+				defer os.RemoveAll(dir)
+				socket = filepath.Join(dir, "agent.sock")
+			}
+			listener, err := (&net.ListenConfig{}).Listen(ctx, "unix", socket)
+			if err != nil {
+				return fmt.Errorf("failed to listen on %s: %w", socket, err)
+			}
+			go func() {
+				<-ctx.Done()
+				_ = listener.Close()
+			}()
+
+			cmd.Printf("SSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", socket)
+			cmd.PrintErrf("Serving %d ssh keys, press Ctrl+C to stop\n", loaded)
+			for {
+				conn, acceptErr := listener.Accept()
+				if errors.Is(acceptErr, net.ErrClosed) {
+					return nil
+				}
+				if acceptErr != nil {
+					return fmt.Errorf("failed to accept a connection: %w", acceptErr)
+				}
+				go func() {
+					defer conn.Close()
+					_ = agent.ServeAgent(keyAgent, conn)
+				}()
+			}
+		},
+	}
+	agentCmd.Flags().StringSliceP("path", "p", nil, "SSH key paths to load (default all ssh keys)")
+	agentCmd.Flags().String("socket", "", "Unix socket to listen on (default a temporary directory)")
+	agentCmd.Flags().Bool("confirm", false, "Ask for confirmation before every use of a key")
+	agentCmd.Flags().Duration("lifetime", 0, "Forget the keys after this duration, 0 keeps them until stopped")
+
+	return agentCmd
+}
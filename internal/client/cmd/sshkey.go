@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itallix/gophkeeper/internal/common/sshkey"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func createSSHKey(cmd *cobra.Command, path string, key *pb.SSHKeyData) error {
+	resp, err := client.Create(context.Background(), &pb.CreateRequest{
+		Data: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_SSH_KEY,
+			Base: &pb.Metadata{
+				Path: path,
+			},
+			Data: &pb.TypedData_SshKey{
+				SshKey: key,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create a new ssh key: %w", withFieldViolations(err))
+	}
+	cmd.Println(resp.GetMessage())
+	return nil
+}
+
+func newGetSSHKeyCmd() *cobra.Command {
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Retrieve ssh key by path",
+		Long: `Print the public key, its fingerprint and comment. The private key is only
+written to the file given by --output.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			output, _ := cmd.Flags().GetString("output")
+
+			resp, err := client.Get(context.Background(), &pb.GetRequest{
+				Type: pb.DataType_DATA_TYPE_SSH_KEY,
+				Path: path,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to retrieve ssh key: %w", err)
+			}
+			key := resp.GetData().GetSshKey()
+			cmd.Printf("Public key: %s\n", key.GetPublicKey())
+			cmd.Printf("Fingerprint: %s\n", key.GetFingerprint())
+			cmd.Printf("Comment: %s\n", key.GetComment())
+			cmd.Printf("Created at: %s\n", resp.GetData().GetBase().GetCreatedAt())
+			cmd.Printf("Created by: %s\n", resp.GetData().GetBase().GetCreatedBy())
+			cmd.Printf("Metadata: %s\n", resp.GetData().GetBase().GetMetadata())
+			if output == "" {
+				return nil
+			}
+			if err = os.WriteFile(output, []byte(key.GetPrivateKey()), 0o600); err != nil {
+				return fmt.Errorf("failed to write the private key: %w", err)
+			}
+			cmd.Printf("Private key written to %s\n", output)
+			if key.GetPassphrase() != "" {
+				cmd.Printf("Passphrase: %s\n", key.GetPassphrase())
+			}
+			return nil
+		},
+	}
+	getCmd.Flags().StringP("path", "p", "", "SSH key path")
+	getCmd.Flags().StringP("output", "o", "", "File to write the private key to")
+	_ = getCmd.MarkFlagRequired("path")
+	return getCmd
+}
+
+func newCreateSSHKeyCmd() *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Store an existing ssh key",
+		Long: `Store the private key of an OpenSSH or PEM file. The passphrase is prompted for
+encrypted keys. The comment is taken from the .pub file next to the key unless given.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			file, _ := cmd.Flags().GetString("file")
+			comment, _ := cmd.Flags().GetString("comment")
+
+			privateKey, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read the private key: %w", err)
+			}
+			var passphrase string
+			if _, err = sshkey.Parse(privateKey, nil); errors.Is(err, sshkey.ErrPassphraseRequired) {
+				reader := bufio.NewReader(cmd.InOrStdin())
+				if passphrase, err = promptPassword(cmd, reader, "Enter passphrase: "); err != nil {
+					return fmt.Errorf("failed to read passphrase: %w", err)
+				}
+			}
+			var publicKey string
+			if data, readErr := os.ReadFile(file + ".pub"); readErr == nil {
+				publicKey = strings.TrimSpace(string(data))
+			}
+
+			return createSSHKey(cmd, path, &pb.SSHKeyData{
+				PrivateKey: string(privateKey),
+				PublicKey:  publicKey,
+				Passphrase: passphrase,
+				Comment:    comment,
+			})
+		},
+	}
+	createCmd.Flags().StringP("path", "p", "", "SSH key path")
+	createCmd.Flags().StringP("file", "f", "", "Private key file")
+	createCmd.Flags().String("comment", "", "Key comment, e.g. user@host")
+	_ = createCmd.MarkFlagRequired("path")
+	_ = createCmd.MarkFlagRequired("file")
+	return createCmd
+}
+
+func newGenerateSSHKeyCmd() *cobra.Command {
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate and store a new ssh key",
+		Long:  `Generate an ed25519 or RSA key, store it and print its public key for authorized_keys.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			keyType, _ := cmd.Flags().GetString("type")
+			bits, _ := cmd.Flags().GetInt("bits")
+			comment, _ := cmd.Flags().GetString("comment")
+
+			privateKey, publicKey, err := sshkey.Generate(keyType, bits, comment)
+			if err != nil {
+				return err
+			}
+			if err = createSSHKey(cmd, path, &pb.SSHKeyData{
+				PrivateKey: string(privateKey),
+				PublicKey:  publicKey,
+				Comment:    comment,
+			}); err != nil {
+				return err
+			}
+			cmd.Println(publicKey)
+			return nil
+		},
+	}
+	generateCmd.Flags().StringP("path", "p", "", "SSH key path")
+	generateCmd.Flags().StringP("type", "t", sshkey.TypeEd25519,
+		fmt.Sprintf("Key type, %s or %s", sshkey.TypeEd25519, sshkey.TypeRSA))
+	generateCmd.Flags().Int("bits", sshkey.DefaultRSABits, "Size of RSA keys")
+	generateCmd.Flags().String("comment", "", "Key comment, e.g. user@host")
+	_ = generateCmd.MarkFlagRequired("path")
+	return generateCmd
+}
+
+func NewSSHKeyCmd() *cobra.Command {
+	sshKeyCmd := &cobra.Command{
+		Use:   "ssh-key",
+		Short: "SSH key management commands",
+	}
+
+	sshKeyCmd.AddCommand(
+		NewListCmd("ssh key", "List available ssh keys", pb.DataType_DATA_TYPE_SSH_KEY),
+		newGetSSHKeyCmd(), newCreateSSHKeyCmd(), newGenerateSSHKeyCmd(),
+		NewDeleteCmd("ssh key", "Delete existing ssh key", pb.DataType_DATA_TYPE_SSH_KEY),
+	)
+
+	return sshKeyCmd
+}
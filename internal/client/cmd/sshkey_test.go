@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc"
+
+	"github.com/itallix/gophkeeper/internal/client/sshagent"
+	"github.com/itallix/gophkeeper/internal/common/sshkey"
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestSSHKeyCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	privateKey, publicKey, err := sshkey.Generate(sshkey.TypeEd25519, 0, "alice@laptop")
+	require.NoError(t, err)
+
+	t.Run("generate ssh key", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewSSHKeyCmd()
+		cmd.SetOut(buf)
+
+		var created *pb.SSHKeyData
+		mockClient.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, req *pb.CreateRequest, _ ...grpc.CallOption) (*pb.CreateResponse, error) {
+				created = req.GetData().GetSshKey()
+				return &pb.CreateResponse{Message: "SSH key created successfully"}, nil
+			}).Once()
+
+		cmd.SetArgs([]string{"generate", "-p", "deploy", "--comment", "ci@build"})
+		require.NoError(t, cmd.Execute())
+
+		require.NotNil(t, created)
+		assert.True(t, strings.HasPrefix(created.GetPublicKey(), "ssh-ed25519 "))
+		assert.Equal(t, "ci@build", created.GetComment())
+		_, err = sshkey.Parse([]byte(created.GetPrivateKey()), nil)
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "SSH key created successfully")
+		assert.Contains(t, buf.String(), created.GetPublicKey())
+	})
+
+	t.Run("create encrypted ssh key", func(t *testing.T) {
+		key, parseErr := sshkey.Parse(privateKey, nil)
+		require.NoError(t, parseErr)
+		block, marshalErr := ssh.MarshalPrivateKeyWithPassphrase(key, "", []byte("secret"))
+		require.NoError(t, marshalErr)
+		file := filepath.Join(t.TempDir(), "id_ed25519")
+		encrypted := pem.EncodeToMemory(block)
+		require.NoError(t, os.WriteFile(file, encrypted, 0o600))
+		require.NoError(t, os.WriteFile(file+".pub", []byte(publicKey+"\n"), 0o600))
+
+		buf := new(bytes.Buffer)
+		cmd := NewSSHKeyCmd()
+		cmd.SetIn(strings.NewReader("secret\n"))
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Create(mock.Anything, &pb.CreateRequest{
+			Data: &pb.TypedData{
+				Type: pb.DataType_DATA_TYPE_SSH_KEY,
+				Base: &pb.Metadata{Path: "laptop"},
+				Data: &pb.TypedData_SshKey{
+					SshKey: &pb.SSHKeyData{
+						PrivateKey: string(encrypted),
+						PublicKey:  publicKey,
+						Passphrase: "secret",
+					},
+				},
+			},
+		}).Return(&pb.CreateResponse{Message: "SSH key created successfully"}, nil).Once()
+
+		cmd.SetArgs([]string{"create", "-p", "laptop", "-f", file})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Enter passphrase")
+	})
+
+	t.Run("get ssh key", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "id_ed25519")
+		buf := new(bytes.Buffer)
+		cmd := NewSSHKeyCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_SSH_KEY,
+			Path: "laptop",
+		}).Return(&pb.GetResponse{
+			Data: &pb.TypedData{
+				Base: &pb.Metadata{Path: "laptop", CreatedBy: "alice"},
+				Data: &pb.TypedData_SshKey{
+					SshKey: &pb.SSHKeyData{
+						PrivateKey:  string(privateKey),
+						PublicKey:   publicKey,
+						Comment:     "alice@laptop",
+						Fingerprint: "SHA256:abc",
+					},
+				},
+			},
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"get", "-p", "laptop", "-o", output})
+		require.NoError(t, cmd.Execute())
+
+		assert.Contains(t, buf.String(), publicKey)
+		assert.Contains(t, buf.String(), "SHA256:abc")
+		assert.NotContains(t, buf.String(), string(privateKey))
+		written, readErr := os.ReadFile(output)
+		require.NoError(t, readErr)
+		assert.Equal(t, privateKey, written)
+		info, statErr := os.Stat(output)
+		require.NoError(t, statErr)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("load all ssh keys into the agent", func(t *testing.T) {
+		mockClient.EXPECT().List(mock.Anything, &pb.ListRequest{
+			Type: pb.DataType_DATA_TYPE_SSH_KEY,
+		}).Return(&pb.ListResponse{Secrets: []string{"laptop"}}, nil).Once()
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_SSH_KEY,
+			Path: "laptop",
+		}).Return(&pb.GetResponse{
+			Data: &pb.TypedData{
+				Data: &pb.TypedData_SshKey{
+					SshKey: &pb.SSHKeyData{PrivateKey: string(privateKey)},
+				},
+			},
+		}, nil).Once()
+
+		keyAgent := sshagent.New()
+		loaded, loadErr := loadSSHKeys(context.Background(), keyAgent, nil)
+		require.NoError(t, loadErr)
+		assert.Equal(t, 1, loaded)
+		keys, listErr := keyAgent.List()
+		require.NoError(t, listErr)
+		require.Len(t, keys, 1)
+		assert.Equal(t, "laptop", keys[0].Comment, "the path is used when the key has no comment")
+	})
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itallix/gophkeeper/internal/client/jwt"
+	"github.com/itallix/gophkeeper/internal/common/oidc"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// SSOTimeout bounds the time the user has to sign in at the identity provider.
+const SSOTimeout = 5 * time.Minute
+
+var (
+	// oidcHTTPClient talks to the identity provider.
+	oidcHTTPClient = &http.Client{Timeout: 30 * time.Second}
+	// openBrowser opens the URL in the default browser.
+	openBrowser = func(url string) error {
+		name, args := "xdg-open", []string{url}
+		switch runtime.GOOS {
+		case "darwin":
+			name = "open"
+		case "windows":
+			name, args = "rundll32", []string{"url.dll,FileProtocolHandler", url}
+		}
+		return exec.Command(name, args...).Start() //nolint:gosec // the url is passed as an argument
+	}
+)
+
+func newSSOCmd() *cobra.Command {
+	ssoCmd := &cobra.Command{
+		Use:   "sso",
+		Short: "Login with the single sign-on of your organization",
+		Long: "Login through the OpenID Connect identity provider configured on the server. By default the " +
+			"browser is opened and redirects back to the CLI, --device prints a code to enter on another device.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			device, _ := cmd.Flags().GetBool("device")
+			ctx, cancel := context.WithTimeout(context.Background(), SSOTimeout)
+			defer cancel()
+
+			cfg, err := client.GetOIDCConfig(ctx, &pb.GetOIDCConfigRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to get single sign-on configuration: %w", err)
+			}
+			provider, err := oidc.Discover(ctx, oidcHTTPClient, cfg.GetIssuer())
+			if err != nil {
+				return err
+			}
+			oidcClient := &oidc.Client{
+				HTTP:     oidcHTTPClient,
+				Provider: provider,
+				ClientID: cfg.GetClientId(),
+				Scopes:   cfg.GetScopes(),
+			}
+
+			var (
+				token oidc.Token
+				nonce string
+			)
+			if device {
+				token, err = deviceLogin(ctx, cmd, oidcClient)
+			} else {
+				token, nonce, err = oidcClient.LoopbackLogin(ctx, func(authURL string) error {
+					cmd.Printf("Opening the browser to sign in, if it doesn't open visit:\n%s\n", authURL)
+					_ = openBrowser(authURL)
+					return nil
+				})
+			}
+			if err != nil {
+				return fmt.Errorf("failed to sign in: %w", err)
+			}
+
+			resp, err := client.OIDCLogin(ctx, &pb.OIDCLoginRequest{IdToken: token.IDToken, Nonce: nonce})
+			if err != nil {
+				return fmt.Errorf("failed to login: %w", err)
+			}
+			if err = tokenProvider.SaveToken(jwt.NewToken(resp.GetAccessToken(), resp.GetRefreshToken())); err != nil {
+				return fmt.Errorf("failed to save token: %w", err)
+			}
+			cmd.Printf("Signed in as %s\n", resp.GetUserId())
+			return nil
+		},
+	}
+	ssoCmd.Flags().Bool("device", false, "Sign in on another device, e.g. when the browser can't be opened")
+
+	return ssoCmd
+}
+
+func deviceLogin(ctx context.Context, cmd *cobra.Command, oidcClient *oidc.Client) (oidc.Token, error) {
+	auth, err := oidcClient.AuthorizeDevice(ctx)
+	if err != nil {
+		return oidc.Token{}, err
+	}
+	cmd.Printf("Visit %s and enter the code %s\n", auth.VerificationURI, auth.UserCode)
+	if auth.VerificationURIComplete != "" {
+		cmd.Printf("or open %s\n", auth.VerificationURIComplete)
+	}
+	cmd.Println("Waiting for approval...")
+	return oidcClient.PollDeviceToken(ctx, auth)
+}
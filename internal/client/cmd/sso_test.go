@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cjwt "github.com/itallix/gophkeeper/internal/client/jwt"
+	"github.com/itallix/gophkeeper/internal/common/oidc/oidctest"
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestSSOCommand(t *testing.T) {
+	originalClient, originalTokenProvider := client, tokenProvider
+	originalHTTP, originalBrowser := oidcHTTPClient, openBrowser
+	defer func() {
+		client, tokenProvider = originalClient, originalTokenProvider
+		oidcHTTPClient, openBrowser = originalHTTP, originalBrowser
+	}()
+
+	issuer := oidctest.NewIssuer("gophkeeper-cli")
+	defer issuer.Close()
+	issuer.AutoApprove = true
+	issuer.SetClaims(map[string]any{"sub": "00u1", "preferred_username": "portia"})
+	oidcHTTPClient = issuer.Client()
+	openBrowser = func(authURL string) error {
+		resp, err := http.Get(authURL) //nolint:noctx // follows the redirect like a browser would
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+	tmp, err := os.CreateTemp("", "token")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	tokenProvider = cjwt.NewTokenProvider(tmp.Name())
+
+	mockClient.EXPECT().GetOIDCConfig(mock.Anything, &pb.GetOIDCConfigRequest{}).Return(&pb.GetOIDCConfigResponse{
+		Issuer:   issuer.URL,
+		ClientId: "gophkeeper-cli",
+		Scopes:   []string{"openid", "groups"},
+	}, nil)
+
+	// idTokenFor checks the ID token the CLI passes to the server was issued by the provider.
+	idTokenFor := func(nonce bool) any {
+		return mock.MatchedBy(func(req *pb.OIDCLoginRequest) bool {
+			claims := jwt.MapClaims{}
+			if _, _, parseErr := jwt.NewParser().ParseUnverified(req.GetIdToken(), claims); parseErr != nil {
+				return false
+			}
+			return claims["sub"] == "00u1" && (req.GetNonce() != "") == nonce &&
+				(!nonce || claims["nonce"] == req.GetNonce())
+		})
+	}
+
+	t.Run("loopback redirect", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewUserCmd()
+		cmd.SetOut(buf)
+		mockClient.EXPECT().OIDCLogin(mock.Anything, idTokenFor(true)).Return(&pb.AuthResponse{
+			AccessToken: "access_token", RefreshToken: "refresh_token", UserId: "portia",
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"sso"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), issuer.URL+"/authorize?")
+		assert.Contains(t, buf.String(), "Signed in as portia")
+
+		token, loadErr := tokenProvider.LoadToken()
+		require.NoError(t, loadErr)
+		assert.Equal(t, "access_token", token.AccessToken)
+	})
+
+	t.Run("device authorization", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewUserCmd()
+		cmd.SetOut(buf)
+		mockClient.EXPECT().OIDCLogin(mock.Anything, idTokenFor(false)).Return(&pb.AuthResponse{
+			AccessToken: "access_token", RefreshToken: "refresh_token", UserId: "portia",
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"sso", "--device"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Visit "+issuer.URL+"/activate and enter the code")
+		assert.Contains(t, buf.String(), "Signed in as portia")
+	})
+
+	t.Run("server rejects the token", func(t *testing.T) {
+		cmd := NewUserCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		mockClient.EXPECT().OIDCLogin(mock.Anything, mock.Anything).
+			Return(nil, errors.New("invalid id token")).Once()
+
+		cmd.SetArgs([]string{"sso"})
+		require.ErrorContains(t, cmd.Execute(), "failed to login")
+	})
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func NewTeamCmd() *cobra.Command {
+	teamCmd := &cobra.Command{
+		Use:   "team",
+		Short: "Manage teams of an organization",
+	}
+	teamCmd.PersistentFlags().StringP("org", "o", "", "Organization name")
+	_ = teamCmd.MarkPersistentFlagRequired("org")
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a team",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			name, _ := cmd.Flags().GetString("name")
+
+			resp, err := client.CreateTeam(context.Background(), &pb.CreateTeamRequest{Org: org, Name: name})
+			return printOrgResponse(cmd, resp, err, "create team")
+		},
+	}
+	createCmd.Flags().StringP("name", "n", "", "Team name")
+	_ = createCmd.MarkFlagRequired("name")
+
+	addCmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a member of the organization to a team",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			team, _ := cmd.Flags().GetString("team")
+			user, _ := cmd.Flags().GetString("user")
+
+			resp, err := client.AddTeamMember(context.Background(), &pb.AddTeamMemberRequest{
+				Org:   org,
+				Team:  team,
+				Login: user,
+			})
+			return printOrgResponse(cmd, resp, err, "add team member")
+		},
+	}
+
+	removeCmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a user from a team",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+			team, _ := cmd.Flags().GetString("team")
+			user, _ := cmd.Flags().GetString("user")
+
+			resp, err := client.RemoveTeamMember(context.Background(), &pb.RemoveTeamMemberRequest{
+				Org:   org,
+				Team:  team,
+				Login: user,
+			})
+			return printOrgResponse(cmd, resp, err, "remove team member")
+		},
+	}
+	for _, c := range []*cobra.Command{addCmd, removeCmd} {
+		c.Flags().StringP("team", "t", "", "Team name")
+		c.Flags().StringP("user", "u", "", "Team member")
+		_ = c.MarkFlagRequired("team")
+		_ = c.MarkFlagRequired("user")
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List teams with their members",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			org, _ := cmd.Flags().GetString("org")
+
+			resp, err := client.ListTeams(context.Background(), &pb.ListTeamsRequest{Org: org})
+			if err != nil {
+				return fmt.Errorf("failed to list teams: %w", err)
+			}
+			for _, team := range resp.GetTeams() {
+				cmd.Printf("%s\t%s\n", team.GetName(), strings.Join(team.GetMembers(), ","))
+			}
+			return nil
+		},
+	}
+
+	teamCmd.AddCommand(createCmd, addCmd, removeCmd, listCmd)
+
+	return teamCmd
+}
@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg" // decoders of QR code screenshots
+	_ "image/png"
+	"os"
+	"time"
+
+	"github.com/makiuchi-d/gozxing"
+	"github.com/makiuchi-d/gozxing/qrcode"
+	"github.com/spf13/cobra"
+
+	"github.com/itallix/gophkeeper/internal/common/totp"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// decodeQR reads the otpauth URI from a PNG or JPEG image of a QR code.
+func decodeQR(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to open the QR code image: %w", err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the QR code image: %w", err)
+	}
+	bitmap, err := gozxing.NewBinaryBitmapFromImage(img)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the QR code image: %w", err)
+	}
+	result, err := qrcode.NewQRCodeReader().Decode(bitmap, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the QR code: %w", err)
+	}
+	return result.GetText(), nil
+}
+
+// readOTPAuthURI takes the URI from --qr or --uri and prompts for it when neither is given.
+func readOTPAuthURI(cmd *cobra.Command) (string, error) {
+	if file, _ := cmd.Flags().GetString("qr"); file != "" {
+		return decodeQR(file)
+	}
+	if uri, _ := cmd.Flags().GetString("uri"); uri != "" {
+		return uri, nil
+	}
+	uri, err := promptPassword(cmd, bufio.NewReader(cmd.InOrStdin()), "Enter otpauth URI: ")
+	if err != nil {
+		return "", fmt.Errorf("failed to read otpauth URI: %w", err)
+	}
+	return uri, nil
+}
+
+func addOTPAuthFlags(cmd *cobra.Command) {
+	cmd.Flags().String("uri", "", "otpauth://totp URI, prompted for if neither --uri nor --qr is given")
+	cmd.Flags().String("qr", "", "PNG or JPEG image of the QR code to read the URI from")
+	cmd.MarkFlagsMutuallyExclusive("uri", "qr")
+}
+
+// printCode prints the code of the otpauth URI valid now and how long it stays valid.
+func printCode(cmd *cobra.Command, uri string) error {
+	key, err := totp.Parse(uri)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	code, err := key.Code(now)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("%s (%ds remaining)\n", code, int(key.Remaining(now).Round(time.Second)/time.Second))
+	return nil
+}
+
+func newGetTOTPCmd() *cobra.Command {
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Retrieve totp by path",
+		Long:  `Print the parameters of the totp. The otpauth URI with the seed is only shown with --reveal.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			reveal, _ := cmd.Flags().GetBool("reveal")
+
+			resp, err := client.Get(context.Background(), &pb.GetRequest{
+				Type: pb.DataType_DATA_TYPE_TOTP,
+				Path: path,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to retrieve totp: %w", err)
+			}
+			uri := resp.GetData().GetTotp().GetUri()
+			key, err := totp.Parse(uri)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Issuer: %s\n", key.Issuer)
+			cmd.Printf("Account: %s\n", key.Account)
+			cmd.Printf("Algorithm: %s\n", key.Algorithm)
+			cmd.Printf("Digits: %d\n", key.Digits)
+			cmd.Printf("Period: %s\n", key.Period)
+			if reveal {
+				cmd.Printf("URI: %s\n", uri)
+			}
+			cmd.Printf("Created at: %s\n", resp.GetData().GetBase().GetCreatedAt())
+			cmd.Printf("Created by: %s\n", resp.GetData().GetBase().GetCreatedBy())
+			cmd.Printf("Metadata: %s\n", resp.GetData().GetBase().GetMetadata())
+			return nil
+		},
+	}
+	getCmd.Flags().StringP("path", "p", "", "TOTP path")
+	getCmd.Flags().Bool("reveal", false, "Print the otpauth URI with the seed")
+	_ = getCmd.MarkFlagRequired("path")
+	return getCmd
+}
+
+func newCreateTOTPCmd() *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Store a totp seed",
+		Long:  `Store the otpauth URI given by --uri, read from the QR code image given by --qr or prompted for.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			uri, err := readOTPAuthURI(cmd)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Create(context.Background(), &pb.CreateRequest{
+				Data: &pb.TypedData{
+					Type: pb.DataType_DATA_TYPE_TOTP,
+					Base: &pb.Metadata{
+						Path: path,
+					},
+					Data: &pb.TypedData_Totp{
+						Totp: &pb.TOTPData{Uri: uri},
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create a new totp: %w", withFieldViolations(err))
+			}
+			cmd.Println(resp.GetMessage())
+			return nil
+		},
+	}
+	createCmd.Flags().StringP("path", "p", "", "TOTP path")
+	addOTPAuthFlags(createCmd)
+	_ = createCmd.MarkFlagRequired("path")
+	return createCmd
+}
+
+func newCodeTOTPCmd() *cobra.Command {
+	codeCmd := &cobra.Command{
+		Use:   "code",
+		Short: "Print the current totp code",
+		Long: `Print the current code and the seconds it stays valid. With --login the path is a login
+with a totp attached.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			login, _ := cmd.Flags().GetBool("login")
+
+			dataType := pb.DataType_DATA_TYPE_TOTP
+			if login {
+				dataType = pb.DataType_DATA_TYPE_LOGIN
+			}
+			resp, err := client.Get(context.Background(), &pb.GetRequest{
+				Type: dataType,
+				Path: path,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to retrieve totp: %w", err)
+			}
+			uri := resp.GetData().GetTotp().GetUri()
+			if login {
+				uri = resp.GetData().GetLogin().GetTotp()
+				if uri == "" {
+					return fmt.Errorf("login %s has no totp attached", path)
+				}
+			}
+			return printCode(cmd, uri)
+		},
+	}
+	codeCmd.Flags().StringP("path", "p", "", "TOTP path")
+	codeCmd.Flags().Bool("login", false, "Read the totp attached to the login at path")
+	_ = codeCmd.MarkFlagRequired("path")
+	return codeCmd
+}
+
+func newAttachTOTPCmd() *cobra.Command {
+	attachCmd := &cobra.Command{
+		Use:   "attach",
+		Short: "Attach a totp to an existing login",
+		Long:  `Attach the otpauth URI to the login at path, an attached totp is replaced.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			uri, err := readOTPAuthURI(cmd)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.AttachTOTP(context.Background(), &pb.AttachTOTPRequest{
+				Path: path,
+				Uri:  uri,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to attach totp: %w", withFieldViolations(err))
+			}
+			cmd.Println(resp.GetMessage())
+			return nil
+		},
+	}
+	attachCmd.Flags().StringP("path", "p", "", "Login path")
+	addOTPAuthFlags(attachCmd)
+	_ = attachCmd.MarkFlagRequired("path")
+	return attachCmd
+}
+
+func NewTOTPCmd() *cobra.Command {
+	totpCmd := &cobra.Command{
+		Use:   "totp",
+		Short: "TOTP management commands",
+	}
+
+	totpCmd.AddCommand(
+		NewListCmd("totp", "List available totps", pb.DataType_DATA_TYPE_TOTP),
+		newGetTOTPCmd(), newCreateTOTPCmd(), newCodeTOTPCmd(), newAttachTOTPCmd(),
+		NewDeleteCmd("totp", "Delete existing totp", pb.DataType_DATA_TYPE_TOTP),
+	)
+
+	return totpCmd
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bytes"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/makiuchi-d/gozxing"
+	"github.com/makiuchi-d/gozxing/qrcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestTOTPCommands(t *testing.T) {
+	originalClient := client
+	defer func() { client = originalClient }()
+
+	mockClient := mocks.NewGophkeeperServiceClient(t)
+	client = mockClient
+
+	const uri = "otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP&issuer=GitHub"
+	code := regexp.MustCompile(`\d{6} \(\d+s remaining\)`)
+
+	t.Run("create totp from qr code", func(t *testing.T) {
+		matrix, err := qrcode.NewQRCodeWriter().Encode(uri, gozxing.BarcodeFormat_QR_CODE, 256, 256, nil)
+		require.NoError(t, err)
+		file := filepath.Join(t.TempDir(), "qr.png")
+		f, err := os.Create(file)
+		require.NoError(t, err)
+		require.NoError(t, png.Encode(f, matrix))
+		require.NoError(t, f.Close())
+
+		buf := new(bytes.Buffer)
+		cmd := NewTOTPCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Create(mock.Anything, &pb.CreateRequest{
+			Data: &pb.TypedData{
+				Type: pb.DataType_DATA_TYPE_TOTP,
+				Base: &pb.Metadata{Path: "github-2fa"},
+				Data: &pb.TypedData_Totp{Totp: &pb.TOTPData{Uri: uri}},
+			},
+		}).Return(&pb.CreateResponse{Message: "TOTP created successfully"}, nil).Once()
+
+		cmd.SetArgs([]string{"create", "-p", "github-2fa", "--qr", file})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "TOTP created successfully")
+	})
+
+	t.Run("get totp", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewTOTPCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_TOTP,
+			Path: "github-2fa",
+		}).Return(&pb.GetResponse{
+			Data: &pb.TypedData{
+				Base: &pb.Metadata{Path: "github-2fa"},
+				Data: &pb.TypedData_Totp{Totp: &pb.TOTPData{Uri: uri}},
+			},
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"get", "-p", "github-2fa"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Issuer: GitHub")
+		assert.Contains(t, buf.String(), "Account: octocat")
+		assert.Contains(t, buf.String(), "Period: 30s")
+		assert.NotContains(t, buf.String(), "JBSWY3DPEHPK3PXP")
+	})
+
+	t.Run("totp code", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewTOTPCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_TOTP,
+			Path: "github-2fa",
+		}).Return(&pb.GetResponse{
+			Data: &pb.TypedData{
+				Data: &pb.TypedData_Totp{Totp: &pb.TOTPData{Uri: uri}},
+			},
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"code", "-p", "github-2fa"})
+		require.NoError(t, cmd.Execute())
+		assert.Regexp(t, code, buf.String())
+	})
+
+	t.Run("totp code of login", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewTOTPCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_LOGIN,
+			Path: "github",
+		}).Return(&pb.GetResponse{
+			Data: &pb.TypedData{
+				Data: &pb.TypedData_Login{Login: &pb.LoginData{Login: "octocat", Totp: uri}},
+			},
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"code", "-p", "github", "--login"})
+		require.NoError(t, cmd.Execute())
+		assert.Regexp(t, code, buf.String())
+	})
+
+	t.Run("totp code of login without totp", func(t *testing.T) {
+		cmd := NewTOTPCmd()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+
+		mockClient.EXPECT().Get(mock.Anything, &pb.GetRequest{
+			Type: pb.DataType_DATA_TYPE_LOGIN,
+			Path: "gitlab",
+		}).Return(&pb.GetResponse{
+			Data: &pb.TypedData{
+				Data: &pb.TypedData_Login{Login: &pb.LoginData{Login: "octocat"}},
+			},
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"code", "-p", "gitlab", "--login"})
+		require.ErrorContains(t, cmd.Execute(), "login gitlab has no totp attached")
+	})
+
+	t.Run("attach totp", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewTOTPCmd()
+		cmd.SetOut(buf)
+
+		mockClient.EXPECT().AttachTOTP(mock.Anything, &pb.AttachTOTPRequest{
+			Path: "github",
+			Uri:  uri,
+		}).Return(&pb.AttachTOTPResponse{Message: "totp has been attached to login with path=github"}, nil).Once()
+
+		cmd.SetArgs([]string{"attach", "-p", "github", "--uri", uri})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "totp has been attached to login with path=github")
+	})
+}
@@ -8,8 +8,11 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/itallix/gophkeeper/internal/client/jwt"
+	"github.com/itallix/gophkeeper/internal/common/srp"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
 )
 
@@ -40,10 +43,13 @@ func NewUserCmd() *cobra.Command {
 				return errors.New("passwords don't match")
 			}
 
-			resp, err := client.Register(context.Background(), &pb.RegisterRequest{
-				Login:    login,
-				Password: password,
-			})
+			resp, err := srpRegister(context.Background(), login, password)
+			if status.Code(err) == codes.Unimplemented {
+				resp, err = client.Register(context.Background(), &pb.RegisterRequest{
+					Login:    login,
+					Password: password,
+				})
+			}
 			if err != nil {
 				return fmt.Errorf("dailed to register: %w", err)
 			}
@@ -71,10 +77,17 @@ func NewUserCmd() *cobra.Command {
 				return fmt.Errorf("failed to read password: %w", err)
 			}
 
-			resp, err := client.Login(context.Background(), &pb.LoginRequest{
-				Login:    login,
-				Password: password,
-			})
+			resp, err := srpLogin(context.Background(), login, password)
+			switch status.Code(err) {
+			case codes.FailedPrecondition:
+				// the account predates SRP, log in with the password once and enroll the verifier
+				resp, err = passwordLogin(context.Background(), login, password, true)
+				if err == nil {
+					cmd.Println("Your account now uses zero-knowledge login")
+				}
+			case codes.Unimplemented:
+				resp, err = passwordLogin(context.Background(), login, password, false)
+			}
 			if err != nil {
 				return fmt.Errorf("failed to login: %w", err)
 			}
@@ -102,7 +115,73 @@ func NewUserCmd() *cobra.Command {
 			return nil
 		},
 	}
-	userCmd.AddCommand(registerCmd, authCmd, logoutCmd)
+	userCmd.AddCommand(registerCmd, authCmd, newSSOCmd(), logoutCmd)
 
 	return userCmd
 }
+
+// srpRegister registers the user with an SRP verifier, the password itself is never sent.
+func srpRegister(ctx context.Context, login, password string) (*pb.AuthResponse, error) {
+	salt, err := srp.NewSalt()
+	if err != nil {
+		return nil, err
+	}
+	return client.SRPRegister(ctx, &pb.SRPRegisterRequest{
+		Login:    login,
+		Salt:     salt,
+		Verifier: srp.Verifier(login, password, salt),
+	})
+}
+
+// srpLogin proves the knowledge of the password to the server and checks that the server
+// knows the verifier before accepting the tokens.
+func srpLogin(ctx context.Context, login, password string) (*pb.AuthResponse, error) {
+	exchange, err := srp.NewClient(login, password)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := client.SRPBegin(ctx, &pb.SRPBeginRequest{
+		Login:        login,
+		ClientPublic: exchange.Public(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	proof, err := exchange.Proof(challenge.GetSalt(), challenge.GetServerPublic())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.SRPFinish(ctx, &pb.SRPFinishRequest{
+		SessionId:   challenge.GetSessionId(),
+		Login:       login,
+		ClientProof: proof,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = exchange.VerifyServer(resp.GetServerProof()); err != nil {
+		return nil, fmt.Errorf("server failed to prove its identity: %w", err)
+	}
+	return &pb.AuthResponse{
+		AccessToken:  resp.GetAccessToken(),
+		RefreshToken: resp.GetRefreshToken(),
+		UserId:       resp.GetUserId(),
+	}, nil
+}
+
+// passwordLogin logs in by sending the password, optionally enrolling an SRP verifier.
+func passwordLogin(ctx context.Context, login, password string, enroll bool) (*pb.AuthResponse, error) {
+	req := &pb.LoginRequest{
+		Login:    login,
+		Password: password,
+	}
+	if enroll {
+		salt, err := srp.NewSalt()
+		if err != nil {
+			return nil, err
+		}
+		req.SrpSalt = salt
+		req.SrpVerifier = srp.Verifier(login, password, salt)
+	}
+	return client.Login(ctx, req)
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io"
 	"os"
 	"strings"
@@ -10,8 +12,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/itallix/gophkeeper/internal/client/jwt"
+	"github.com/itallix/gophkeeper/internal/common/srp"
 	mocks "github.com/itallix/gophkeeper/mocks/pkg/generated/api/proto/v1"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
 )
@@ -34,6 +40,18 @@ func TestUserCommands(t *testing.T) {
 	}()
 	tokenProvider = jwt.NewTokenProvider(tmp.Name())
 
+	readTokens := func(t *testing.T) string {
+		t.Helper()
+		_, err = tmp.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		tokens, readErr := io.ReadAll(tmp)
+		require.NoError(t, readErr)
+		return string(tokens)
+	}
+	want := "{\"access_token\":\"access_token\",\"refresh_token\":\"refresh_token\"}"
+
+	var salt, verifier []byte
+
 	t.Run("register a new user", func(t *testing.T) {
 		input := "secret\nsecret\n"
 		buf := new(bytes.Buffer)
@@ -41,53 +59,136 @@ func TestUserCommands(t *testing.T) {
 		cmd.SetIn(strings.NewReader(input))
 		cmd.SetOut(buf)
 
-		mockClient.EXPECT().Register(mock.Anything, &pb.RegisterRequest{
-			Login:    "mark",
-			Password: "secret",
-		}).Return(&pb.AuthResponse{
+		mockClient.EXPECT().SRPRegister(mock.Anything, mock.MatchedBy(func(req *pb.SRPRegisterRequest) bool {
+			salt, verifier = req.GetSalt(), req.GetVerifier()
+			return req.GetLogin() == "mark"
+		})).Return(&pb.AuthResponse{
 			AccessToken:  "access_token",
 			RefreshToken: "refresh_token",
-		}, nil)
+		}, nil).Once()
 
 		cmd.SetArgs([]string{"register", "-l", "mark"})
 		err = cmd.Execute()
 
 		require.NoError(t, err)
 		assert.Contains(t, buf.String(), "User with login=mark successfully registered")
-		_, err = tmp.Seek(0, io.SeekStart)
-		require.NoError(t, err)
-		tokens, readErr := io.ReadAll(tmp)
-		require.NoError(t, readErr)
-		want := "{\"access_token\":\"access_token\",\"refresh_token\":\"refresh_token\"}"
-		assert.Equal(t, want, string(tokens))
+		assert.Equal(t, srp.Verifier("mark", "secret", salt), verifier)
+		assert.Equal(t, want, readTokens(t))
 	})
 
+	t.Run("register on a server without srp", func(t *testing.T) {
+		cmd := NewUserCmd()
+		cmd.SetIn(strings.NewReader("secret\nsecret\n"))
+		cmd.SetOut(new(bytes.Buffer))
+
+		mockClient.EXPECT().SRPRegister(mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.Unimplemented, "unknown method")).Once()
+		mockClient.EXPECT().Register(mock.Anything, &pb.RegisterRequest{
+			Login:    "mark",
+			Password: "secret",
+		}).Return(&pb.AuthResponse{
+			AccessToken:  "access_token",
+			RefreshToken: "refresh_token",
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"register", "-l", "mark"})
+		require.NoError(t, cmd.Execute())
+	})
+
+	// expectExchange answers the SRP login like the server would for the registered verifier.
+	expectExchange := func(t *testing.T) {
+		t.Helper()
+		var server *srp.Server
+		mockClient.EXPECT().SRPBegin(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, req *pb.SRPBeginRequest, _ ...grpc.CallOption) (*pb.SRPBeginResponse, error) {
+				var beginErr error
+				server, beginErr = srp.NewServer(req.GetLogin(), salt, verifier, req.GetClientPublic())
+				require.NoError(t, beginErr)
+				return &pb.SRPBeginResponse{SessionId: "s1", Salt: salt, ServerPublic: server.Public()}, nil
+			}).Once()
+		mockClient.EXPECT().SRPFinish(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, req *pb.SRPFinishRequest, _ ...grpc.CallOption) (*pb.SRPFinishResponse, error) {
+				assert.Equal(t, "s1", req.GetSessionId())
+				proof, _, verifyErr := server.Verify(req.GetClientProof())
+				if verifyErr != nil {
+					return nil, status.Error(codes.Unauthenticated, verifyErr.Error())
+				}
+				return &pb.SRPFinishResponse{
+					ServerProof:  proof,
+					AccessToken:  "access_token",
+					RefreshToken: "refresh_token",
+				}, nil
+			}).Once()
+	}
+
 	t.Run("login as a user", func(t *testing.T) {
 		input := "secret\n"
 		buf := new(bytes.Buffer)
 		cmd := NewUserCmd()
 		cmd.SetIn(strings.NewReader(input))
 		cmd.SetOut(buf)
+		expectExchange(t)
+
+		cmd.SetArgs([]string{"auth", "-l", "mark"})
+		err = cmd.Execute()
+
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "Successfully logged in as mark")
+		assert.Equal(t, want, readTokens(t))
+	})
+
+	t.Run("login with a wrong password", func(t *testing.T) {
+		cmd := NewUserCmd()
+		cmd.SetIn(strings.NewReader("geheim\n"))
+		cmd.SetOut(new(bytes.Buffer))
+		expectExchange(t)
+
+		cmd.SetArgs([]string{"auth", "-l", "mark"})
+		err = cmd.Execute()
+
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(errors.Unwrap(err)))
+	})
+
+	t.Run("login to a legacy account enrolls a verifier", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		cmd := NewUserCmd()
+		cmd.SetIn(strings.NewReader("secret\n"))
+		cmd.SetOut(buf)
 
+		mockClient.EXPECT().SRPBegin(mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.FailedPrecondition, "user has no srp verifier")).Once()
+		mockClient.EXPECT().Login(mock.Anything, mock.MatchedBy(func(req *pb.LoginRequest) bool {
+			return req.GetPassword() == "secret" &&
+				bytes.Equal(req.GetSrpVerifier(), srp.Verifier("mark", "secret", req.GetSrpSalt()))
+		})).Return(&pb.AuthResponse{
+			AccessToken:  "access_token",
+			RefreshToken: "refresh_token",
+		}, nil).Once()
+
+		cmd.SetArgs([]string{"auth", "-l", "mark"})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "Your account now uses zero-knowledge login")
+		assert.Contains(t, buf.String(), "Successfully logged in as mark")
+	})
+
+	t.Run("login to a server without srp", func(t *testing.T) {
+		cmd := NewUserCmd()
+		cmd.SetIn(strings.NewReader("secret\n"))
+		cmd.SetOut(new(bytes.Buffer))
+
+		mockClient.EXPECT().SRPBegin(mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.Unimplemented, "unknown method")).Once()
 		mockClient.EXPECT().Login(mock.Anything, &pb.LoginRequest{
 			Login:    "mark",
 			Password: "secret",
 		}).Return(&pb.AuthResponse{
 			AccessToken:  "access_token",
 			RefreshToken: "refresh_token",
-		}, nil)
+		}, nil).Once()
 
 		cmd.SetArgs([]string{"auth", "-l", "mark"})
-		err = cmd.Execute()
-
-		require.NoError(t, err)
-		assert.Contains(t, buf.String(), "Successfully logged in as mark")
-		_, err = tmp.Seek(0, io.SeekStart)
-		require.NoError(t, err)
-		tokens, readErr := io.ReadAll(tmp)
-		require.NoError(t, readErr)
-		want := "{\"access_token\":\"access_token\",\"refresh_token\":\"refresh_token\"}"
-		assert.Equal(t, want, string(tokens))
+		require.NoError(t, cmd.Execute())
 	})
 
 	t.Run("logout", func(t *testing.T) {
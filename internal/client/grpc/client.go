@@ -3,6 +3,7 @@ package grpc
 import (
 	"fmt"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -25,6 +26,7 @@ func NewGophkeeperClient(targetURL string, tokenProvider *jwt.TokenProvider) (*G
 	// TODO: implement tokenProvider with refresh tokens
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithUnaryInterceptor(middleware.AuthInterceptor(tokenProvider)),
 		grpc.WithStreamInterceptor(middleware.StreamAuthInterceptor(tokenProvider)),
 	}
@@ -8,14 +8,9 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/itallix/gophkeeper/internal/client/jwt"
+	"github.com/itallix/gophkeeper/internal/common/rpc"
 )
 
-var publicMethods = map[string]bool{
-	"/api.v1.GophkeeperService/Login":        true,
-	"/api.v1.GophkeeperService/Register":     true,
-	"/api.v1.GophkeeperService/RefreshToken": true,
-}
-
 func AuthInterceptor(tokenProvider *jwt.TokenProvider) grpc.UnaryClientInterceptor {
 	return func(
 		ctx context.Context,
@@ -25,7 +20,7 @@ func AuthInterceptor(tokenProvider *jwt.TokenProvider) grpc.UnaryClientIntercept
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption) error {
 		// Skip auth for login/register
-		if publicMethods[method] {
+		if rpc.IsPublic(method) {
 			return invoker(ctx, method, req, reply, cc, opts...)
 		}
 
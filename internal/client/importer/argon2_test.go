@@ -0,0 +1,40 @@
+package importer
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/argon2"
+)
+
+func TestArgon2Key(t *testing.T) {
+	password, salt := []byte("password"), []byte("somesaltsomesalt")
+
+	t.Run("argon2id matches x/crypto", func(t *testing.T) {
+		expected := argon2.IDKey(password, salt, 3, 64, 4, 32)
+		assert.Equal(t, expected, argon2Key(argon2id, password, salt, nil, nil, 3, 64, 4, 32))
+	})
+
+	t.Run("argon2i matches x/crypto", func(t *testing.T) {
+		expected := argon2.Key(password, salt, 2, 256, 2, 64)
+		assert.Equal(t, expected, argon2Key(argon2i, password, salt, nil, nil, 2, 256, 2, 64))
+	})
+
+	t.Run("argon2d RFC 9106 test vector", func(t *testing.T) {
+		pwd := repeat(0x01, 32)
+		s := repeat(0x02, 16)
+		secret := repeat(0x03, 8)
+		ad := repeat(0x04, 12)
+		tag := argon2Key(argon2d, pwd, s, secret, ad, 3, 32, 4, 32)
+		assert.Equal(t, "512b391b6f1162975371d30919734294f868e3be3984f3c1a13a4db9fabe4acb", hex.EncodeToString(tag))
+	})
+}
+
+func repeat(b byte, n int) []byte {
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = b
+	}
+	return out
+}
@@ -0,0 +1,170 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// Bitwarden item types as defined in the JSON export.
+const (
+	bitwardenLogin      = 1
+	bitwardenSecureNote = 2
+	bitwardenCard       = 3
+	bitwardenIdentity   = 4
+)
+
+// Bitwarden URI match detections and custom field types.
+const (
+	bitwardenMatchDomain     = 0
+	bitwardenMatchHost       = 1
+	bitwardenMatchStartsWith = 2
+	bitwardenMatchExact      = 3
+	bitwardenMatchRegex      = 4
+
+	bitwardenHiddenField = 1
+)
+
+var ErrEncryptedExport = errors.New("encrypted exports are not supported, export the vault as plain JSON")
+
+type bitwardenExport struct {
+	Encrypted bool `json:"encrypted"`
+	Folders   []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"folders"`
+	Items []struct {
+		Type     int     `json:"type"`
+		Name     string  `json:"name"`
+		Notes    *string `json:"notes"`
+		FolderID *string `json:"folderId"`
+		Fields   []struct {
+			Name  string  `json:"name"`
+			Value *string `json:"value"`
+			Type  int     `json:"type"`
+		} `json:"fields"`
+		Login *struct {
+			Username *string `json:"username"`
+			Password *string `json:"password"`
+			TOTP     *string `json:"totp"`
+			URIs     []struct {
+				URI   string `json:"uri"`
+				Match *int   `json:"match"`
+			} `json:"uris"`
+		} `json:"login"`
+		Card *struct {
+			CardholderName *string `json:"cardholderName"`
+			Number         *string `json:"number"`
+			ExpMonth       *string `json:"expMonth"`
+			ExpYear        *string `json:"expYear"`
+			Code           *string `json:"code"`
+		} `json:"card"`
+	} `json:"items"`
+}
+
+// bitwardenURI maps a login URI with its Bitwarden match detection, a missing one means the default of the
+// user which is the base domain. Starts with is expressed as a regular expression and never matching URIs are
+// dropped.
+func bitwardenURI(uri string, match *int) *pb.LoginURI {
+	if match == nil {
+		return &pb.LoginURI{Uri: uri}
+	}
+	switch *match {
+	case bitwardenMatchDomain:
+		return &pb.LoginURI{Uri: uri, Match: pb.URIMatch_URI_MATCH_BASE_DOMAIN}
+	case bitwardenMatchHost:
+		return &pb.LoginURI{Uri: uri, Match: pb.URIMatch_URI_MATCH_HOST}
+	case bitwardenMatchStartsWith:
+		return &pb.LoginURI{Uri: "^" + regexp.QuoteMeta(uri), Match: pb.URIMatch_URI_MATCH_REGEX}
+	case bitwardenMatchExact:
+		return &pb.LoginURI{Uri: uri, Match: pb.URIMatch_URI_MATCH_EXACT}
+	case bitwardenMatchRegex:
+		return &pb.LoginURI{Uri: uri, Match: pb.URIMatch_URI_MATCH_REGEX}
+	}
+	return nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// parseBitwarden maps an unencrypted Bitwarden JSON export. Bitwarden does not
+// include attachments in JSON exports, so none are produced.
+func parseBitwarden(data []byte) (*Result, error) {
+	var export bitwardenExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("failed to parse bitwarden export: %w", err)
+	}
+	if export.Encrypted {
+		return nil, ErrEncryptedExport
+	}
+
+	folders := make(map[string]string, len(export.Folders))
+	for _, f := range export.Folders {
+		folders[f.ID] = f.Name
+	}
+
+	result := &Result{}
+	for _, item := range export.Items {
+		var parents []string
+		if folder, ok := folders[deref(item.FolderID)]; ok {
+			parents = strings.Split(folder, pathSeparator)
+		}
+		p := buildPath(parents, item.Name)
+
+		switch item.Type {
+		case bitwardenLogin:
+			if item.Login == nil {
+				result.skip(item.Name, "login item without credentials")
+				continue
+			}
+			login := newLogin(p, deref(item.Login.Username), deref(item.Login.Password))
+			data := login.Data.GetLogin()
+			data.Totp = otpauthURI(deref(item.Login.TOTP), item.Name)
+			for _, uri := range item.Login.URIs {
+				if u := bitwardenURI(uri.URI, uri.Match); u != nil {
+					data.Uris = append(data.Uris, u)
+				}
+			}
+			data.Notes = deref(item.Notes)
+			for _, f := range item.Fields {
+				data.Fields = append(data.Fields, &pb.KVField{
+					Name:      f.Name,
+					Value:     deref(f.Value),
+					Sensitive: f.Type == bitwardenHiddenField,
+				})
+			}
+			result.Items = append(result.Items, login)
+		case bitwardenSecureNote:
+			result.Items = append(result.Items, newNote(p, deref(item.Notes)))
+		case bitwardenCard:
+			if item.Card == nil {
+				result.skip(item.Name, "card item without card data")
+				continue
+			}
+			month, _ := strconv.ParseInt(deref(item.Card.ExpMonth), 10, 64)
+			year, _ := strconv.ParseInt(deref(item.Card.ExpYear), 10, 64)
+			result.Items = append(result.Items, newCard(p, &pb.CardData{
+				CardHolder:  deref(item.Card.CardholderName),
+				Number:      strings.ReplaceAll(deref(item.Card.Number), " ", ""),
+				ExpiryMonth: month,
+				ExpiryYear:  year,
+				Cvv:         deref(item.Card.Code),
+			}))
+		case bitwardenIdentity:
+			result.skip(item.Name, "identity items are not supported")
+		default:
+			result.skip(item.Name, fmt.Sprintf("unknown item type %d", item.Type))
+		}
+	}
+
+	return result, nil
+}
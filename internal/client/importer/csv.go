@@ -0,0 +1,122 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// csvColumns maps the header names used by a CSV export to login fields.
+type csvColumns struct {
+	title    string
+	url      string
+	username string
+	password string
+	notes    string
+	archived string
+}
+
+var (
+	chromeColumns = csvColumns{
+		title:    "name",
+		url:      "url",
+		username: "username",
+		password: "password",
+		notes:    "note",
+	}
+	firefoxColumns = csvColumns{
+		url:      "url",
+		username: "username",
+		password: "password",
+	}
+	onePasswordColumns = csvColumns{
+		title:    "title",
+		url:      "url",
+		username: "username",
+		password: "password",
+		notes:    "notes",
+		archived: "archived",
+	}
+)
+
+var ErrMissingColumn = errors.New("required column is missing")
+
+// parseCSV maps browser and 1Password CSV exports to logins. Rows carrying only
+// notes become notes. Entries without a title (Firefox) are named after the host
+// of their URL.
+func parseCSV(data []byte, columns csvColumns) (*Result, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{columns.username, columns.password} {
+		if _, ok := index[required]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, required)
+		}
+	}
+	field := func(record []string, column string) string {
+		i, ok := index[column]
+		if column == "" || !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	result := &Result{}
+	for {
+		record, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read csv record: %w", readErr)
+		}
+
+		title := field(record, columns.title)
+		if title == "" {
+			title = hostOf(field(record, columns.url))
+		}
+		if strings.EqualFold(field(record, columns.archived), "true") {
+			result.skip(title, "archived item")
+			continue
+		}
+		username, password := field(record, columns.username), field(record, columns.password)
+		if username == "" && password == "" {
+			if notes := field(record, columns.notes); notes != "" {
+				result.Items = append(result.Items, newNote(buildPath(nil, title), notes))
+				continue
+			}
+			result.skip(title, "entry has neither username nor password")
+			continue
+		}
+		login := newLogin(buildPath(nil, title), username, password)
+		if u := field(record, columns.url); u != "" {
+			login.Data.GetLogin().Uris = []*pb.LoginURI{{Uri: u}}
+		}
+		login.Data.GetLogin().Notes = field(record, columns.notes)
+		result.Items = append(result.Items, login)
+	}
+
+	return result, nil
+}
+
+func hostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	return u.Hostname()
+}
@@ -0,0 +1,163 @@
+// Package importer converts exports of third-party password managers into
+// gophkeeper secrets that can be sent to the server as is.
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// Format identifies the source of an export file.
+type Format string
+
+const (
+	FormatBitwarden    Format = "bitwarden"
+	Format1PUX         Format = "1pux"
+	Format1PasswordCSV Format = "1password-csv"
+	FormatKeePass      Format = "keepass"
+	FormatChromeCSV    Format = "chrome"
+	FormatFirefoxCSV   Format = "firefox"
+	FormatOTPAuth      Format = "otpauth"
+)
+
+const (
+	maxPathLen    = 255 // matches secrets.path column size
+	untitled      = "untitled"
+	pathSeparator = "/"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported import format")
+
+// Formats lists all supported formats in the order they are shown to the user.
+func Formats() []Format {
+	return []Format{
+		FormatBitwarden, Format1PUX, Format1PasswordCSV, FormatKeePass, FormatChromeCSV, FormatFirefoxCSV,
+		FormatOTPAuth,
+	}
+}
+
+// Attachment is a file attached to an imported entry, stored as a binary secret.
+type Attachment struct {
+	Name string
+	Data []byte
+}
+
+// Item is a single entry ready to be created on the server.
+type Item struct {
+	Data        *pb.TypedData
+	Attachments []Attachment
+}
+
+// Path returns the secret path of the item.
+func (i Item) Path() string {
+	return i.Data.GetBase().GetPath()
+}
+
+// AttachmentPath returns the binary path the attachment is stored under.
+func (i Item) AttachmentPath(a Attachment) string {
+	return i.Path() + pathSeparator + a.Name
+}
+
+// Skipped describes an entry of the export which could not be mapped to any secret type.
+type Skipped struct {
+	Title  string
+	Reason string
+}
+
+// Result holds everything extracted from an export file.
+type Result struct {
+	Items   []Item
+	Skipped []Skipped
+}
+
+func (r *Result) skip(title, reason string) {
+	if title == "" {
+		title = untitled
+	}
+	r.Skipped = append(r.Skipped, Skipped{Title: title, Reason: reason})
+}
+
+// Options holds format specific parameters.
+type Options struct {
+	// MasterPassword unlocks encrypted databases (KeePass).
+	MasterPassword string
+}
+
+// Parse reads the export in the given format and maps its entries to secrets.
+func Parse(format Format, data []byte, opts Options) (*Result, error) {
+	switch format {
+	case FormatBitwarden:
+		return parseBitwarden(data)
+	case Format1PUX:
+		return parse1PUX(data)
+	case Format1PasswordCSV:
+		return parseCSV(data, onePasswordColumns)
+	case FormatKeePass:
+		return parseKeePass(data, opts.MasterPassword)
+	case FormatChromeCSV:
+		return parseCSV(data, chromeColumns)
+	case FormatFirefoxCSV:
+		return parseCSV(data, firefoxColumns)
+	case FormatOTPAuth:
+		return parseOTPAuth(data)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+}
+
+// buildPath joins folder and title into a secret path, dropping empty segments
+// and separators inside the segments themselves.
+func buildPath(folders []string, title string) string {
+	segments := make([]string, 0, len(folders)+1)
+	for _, s := range append(folders, title) {
+		s = strings.TrimSpace(strings.ReplaceAll(s, pathSeparator, "-"))
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	if len(segments) == 0 || strings.TrimSpace(title) == "" {
+		segments = append(segments, untitled)
+	}
+	p := path.Join(segments...)
+	if len(p) > maxPathLen {
+		p = p[:maxPathLen]
+	}
+	return p
+}
+
+func newLogin(p, login, password string) Item {
+	return Item{
+		Data: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_LOGIN,
+			Base: &pb.Metadata{Path: p},
+			Data: &pb.TypedData_Login{
+				Login: &pb.LoginData{Login: login, Password: password},
+			},
+		},
+	}
+}
+
+func newCard(p string, card *pb.CardData) Item {
+	return Item{
+		Data: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_CARD,
+			Base: &pb.Metadata{Path: p},
+			Data: &pb.TypedData_Card{Card: card},
+		},
+	}
+}
+
+func newNote(p, text string) Item {
+	return Item{
+		Data: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_NOTE,
+			Base: &pb.Metadata{Path: p},
+			Data: &pb.TypedData_Note{
+				Note: &pb.NoteData{Text: text},
+			},
+		},
+	}
+}
@@ -0,0 +1,214 @@
+package importer_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/client/importer"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func paths(result *importer.Result) []string {
+	var out []string
+	for _, item := range result.Items {
+		out = append(out, item.Path())
+	}
+	return out
+}
+
+func TestParseBitwarden(t *testing.T) {
+	data := `{
+		"encrypted": false,
+		"folders": [{"id": "f1", "name": "Personal/Banking"}],
+		"items": [
+			{"type": 1, "name": "GitHub", "folderId": null, "notes": "org owner",
+				"fields": [{"name": "recovery", "value": "abcd", "type": 1}],
+				"login": {"username": "octocat", "password": "s3cr3t", "totp": "JBSWY3DPEHPK3PXP", "uris": [
+					{"uri": "https://github.com", "match": null},
+					{"uri": "https://github.com/login", "match": 2},
+					{"uri": "https://gist.github.com", "match": 1},
+					{"uri": "https://example.com", "match": 5}
+				]}},
+			{"type": 3, "name": "Visa", "folderId": "f1",
+				"card": {"cardholderName": "John Doe", "number": "4111 1111 1111 1111",
+					"expMonth": "7", "expYear": "2030", "code": "123"}},
+			{"type": 2, "name": "Recovery codes", "notes": "one two three"},
+			{"type": 4, "name": "Passport"}
+		]
+	}`
+
+	result, err := importer.Parse(importer.FormatBitwarden, []byte(data), importer.Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GitHub", "Personal/Banking/Visa", "Recovery codes"}, paths(result))
+	assert.Equal(t, "s3cr3t", result.Items[0].Data.GetLogin().GetPassword())
+	assert.Equal(t, "otpauth://totp/GitHub?secret=JBSWY3DPEHPK3PXP", result.Items[0].Data.GetLogin().GetTotp())
+	assert.Equal(t, "org owner", result.Items[0].Data.GetLogin().GetNotes())
+	assert.Equal(t, []*pb.KVField{{Name: "recovery", Value: "abcd", Sensitive: true}},
+		result.Items[0].Data.GetLogin().GetFields())
+	assert.Equal(t, []*pb.LoginURI{
+		{Uri: "https://github.com"},
+		{Uri: `^https://github\.com/login`, Match: pb.URIMatch_URI_MATCH_REGEX},
+		{Uri: "https://gist.github.com", Match: pb.URIMatch_URI_MATCH_HOST},
+	}, result.Items[0].Data.GetLogin().GetUris())
+	card := result.Items[1].Data.GetCard()
+	assert.Equal(t, "4111111111111111", card.GetNumber())
+	assert.Equal(t, int64(7), card.GetExpiryMonth())
+	assert.Equal(t, int64(2030), card.GetExpiryYear())
+	assert.Equal(t, "one two three", result.Items[2].Data.GetNote().GetText())
+	assert.Equal(t, []importer.Skipped{{Title: "Passport", Reason: "identity items are not supported"}}, result.Skipped)
+
+	t.Run("encrypted export", func(t *testing.T) {
+		_, err = importer.Parse(importer.FormatBitwarden, []byte(`{"encrypted": true}`), importer.Options{})
+		require.ErrorIs(t, err, importer.ErrEncryptedExport)
+	})
+}
+
+func TestParse1PUX(t *testing.T) {
+	exportData := `{"accounts": [{"vaults": [{"attrs": {"name": "Private"}, "items": [
+		{"categoryUuid": "001", "overview": {"title": "Mail"}, "details": {"loginFields": [
+			{"value": "me@example.com", "designation": "username"},
+			{"value": "hunter2", "designation": "password"}]}},
+		{"categoryUuid": "002", "overview": {"title": "Amex"}, "details": {"sections": [{"fields": [
+			{"id": "cardholder", "value": {"string": "Jane Doe"}},
+			{"id": "ccnum", "value": {"creditCardNumber": "3782 822463 10005"}},
+			{"id": "cvv", "value": {"concealed": "1234"}},
+			{"id": "pin", "value": {"concealed": "4321"}},
+			{"id": "expiry", "value": {"monthYear": 202712}}]}]}},
+		{"categoryUuid": "006", "overview": {"title": "Contract"}, "details": {
+			"documentAttributes": {"fileName": "contract.pdf", "documentId": "doc1"}}},
+		{"categoryUuid": "001", "state": "archived", "overview": {"title": "Old"}},
+		{"categoryUuid": "004", "overview": {"title": "Me"}}
+	]}]}]}`
+
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	for name, content := range map[string]string{
+		"export.data":              exportData,
+		"files/doc1__contract.pdf": "%PDF-1.4",
+	} {
+		w, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+
+	result, err := importer.Parse(importer.Format1PUX, buf.Bytes(), importer.Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Private/Mail", "Private/Amex", "Private/Contract"}, paths(result))
+	assert.Equal(t, "hunter2", result.Items[0].Data.GetLogin().GetPassword())
+	card := result.Items[1].Data.GetCard()
+	assert.Equal(t, "378282246310005", card.GetNumber())
+	assert.Equal(t, "4321", card.GetPin())
+	assert.Equal(t, int64(12), card.GetExpiryMonth())
+	assert.Equal(t, int64(2027), card.GetExpiryYear())
+	require.Len(t, result.Items[2].Attachments, 1)
+	assert.Equal(t, "Private/Contract/contract.pdf", result.Items[2].AttachmentPath(result.Items[2].Attachments[0]))
+	assert.Equal(t, []byte("%PDF-1.4"), result.Items[2].Attachments[0].Data)
+	assert.Len(t, result.Skipped, 2)
+}
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   importer.Format
+		data     string
+		paths    []string
+		skipped  int
+		password string
+		uri      string
+	}{
+		{
+			name:   "chrome",
+			format: importer.FormatChromeCSV,
+			data: "\ufeffname,url,username,password,note\n" +
+				"example.com,https://example.com/login,bob,pw1,\n" +
+				"wifi,,,,\"ssid: home\"\n" +
+				"empty,,,,\n",
+			paths:    []string{"example.com", "wifi"},
+			skipped:  1,
+			password: "pw1",
+			uri:      "https://example.com/login",
+		},
+		{
+			name:   "firefox",
+			format: importer.FormatFirefoxCSV,
+			data: "\"url\",\"username\",\"password\",\"httpRealm\"\n" +
+				"\"https://accounts.example.org/\",\"alice\",\"pw2\",\"\"\n",
+			paths:    []string{"accounts.example.org"},
+			password: "pw2",
+			uri:      "https://accounts.example.org/",
+		},
+		{
+			name:   "1password",
+			format: importer.Format1PasswordCSV,
+			data: "Title,Url,Username,Password,Notes,Archived\n" +
+				"Bank,https://bank.example,carol,pw3,,false\n" +
+				"Gone,https://old.example,dave,pw4,,true\n",
+			paths:    []string{"Bank"},
+			skipped:  1,
+			password: "pw3",
+			uri:      "https://bank.example",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := importer.Parse(tt.format, []byte(tt.data), importer.Options{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.paths, paths(result))
+			assert.Len(t, result.Skipped, tt.skipped)
+			assert.Equal(t, tt.password, result.Items[0].Data.GetLogin().GetPassword())
+			assert.Equal(t, tt.uri, result.Items[0].Data.GetLogin().GetUris()[0].GetUri())
+		})
+	}
+
+	t.Run("missing column", func(t *testing.T) {
+		_, err := importer.Parse(importer.FormatChromeCSV, []byte("name,url\n"), importer.Options{})
+		require.ErrorIs(t, err, importer.ErrMissingColumn)
+	})
+}
+
+func TestParseOTPAuth(t *testing.T) {
+	data := `# exported from an authenticator
+otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP&issuer=GitHub
+otpauth://totp/backup-server?secret=GEZDGNBVGY3TQOJQ&digits=8
+
+otpauth://hotp/Legacy:bob?secret=JBSWY3DPEHPK3PXP&counter=1
+`
+	result, err := importer.Parse(importer.FormatOTPAuth, []byte(data), importer.Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GitHub/octocat", "backup-server"}, paths(result))
+	assert.Equal(t, pb.DataType_DATA_TYPE_TOTP, result.Items[0].Data.GetType())
+	assert.Equal(t, "otpauth://totp/backup-server?secret=GEZDGNBVGY3TQOJQ&digits=8",
+		result.Items[1].Data.GetTotp().GetUri())
+	require.Len(t, result.Skipped, 1)
+	assert.Equal(t, "line 5", result.Skipped[0].Title, "the seed is not shown")
+}
+
+func TestParse(t *testing.T) {
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := importer.Parse("lastpass", nil, importer.Options{})
+		require.ErrorIs(t, err, importer.ErrUnsupportedFormat)
+	})
+
+	t.Run("long and nested titles", func(t *testing.T) {
+		data := `{"items": [{"type": 2, "name": "a/b", "notes": "x"}, {"type": 2, "name": "` +
+			strings.Repeat("n", 300) + `", "notes": "y"}, {"type": 2, "name": "", "notes": "z"}]}`
+		result, err := importer.Parse(importer.FormatBitwarden, []byte(data), importer.Options{})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 3)
+		assert.Equal(t, "a-b", result.Items[0].Path())
+		assert.Len(t, result.Items[1].Path(), 255)
+		assert.Equal(t, "untitled", result.Items[2].Path())
+		assert.Equal(t, pb.DataType_DATA_TYPE_NOTE, result.Items[2].Data.GetType())
+	})
+}
@@ -0,0 +1,645 @@
+package importer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/salsa20/salsa"
+)
+
+// KDBX 4 file layout constants, see https://keepass.info/help/kb/kdbx_4.html.
+const (
+	kdbxSignature1   = 0x9AA2D903
+	kdbxSignature2   = 0xB54BFB67
+	kdbxMajorVersion = 4
+
+	kdbxHeaderEnd        = 0
+	kdbxHeaderCipherID   = 2
+	kdbxHeaderCompressed = 3
+	kdbxHeaderMasterSeed = 4
+	kdbxHeaderIV         = 7
+	kdbxHeaderKDF        = 11
+
+	kdbxInnerEnd          = 0
+	kdbxInnerStreamID     = 1
+	kdbxInnerStreamKey    = 2
+	kdbxInnerBinary       = 3
+	kdbxInnerStreamSalsa  = 2
+	kdbxInnerStreamChaCha = 3
+
+	kdbxVariantEnd       = 0x00
+	kdbxVariantVersion   = 0x0100
+	kdbxVariantMajorMask = 0xFF00
+
+	kdbxHashLen = 32
+	kdbxKeyLen  = 32
+	kdbxKiB     = 1024
+	// The KDF parameters are bounded, so that a crafted database can't exhaust the memory or the CPU.
+	kdbxMaxArgon2Memory     = 1 << 30
+	kdbxMaxArgon2Iterations = 1000
+	kdbxMaxAESRounds        = 100_000_000
+	kdbxRecycleBin          = "RecycleBinUUID"
+	kdbxProtected           = "Protected"
+	kdbxTrue                = "True"
+	kdbxBinaryRef           = "Ref"
+	kdbxAttrHistory         = "History"
+)
+
+var (
+	kdbxCipherAES    = []byte{0x31, 0xc1, 0xf2, 0xe6, 0xbf, 0x71, 0x43, 0x50, 0xbe, 0x58, 0x05, 0x21, 0x6a, 0xfc, 0x5a, 0xff}
+	kdbxCipherChaCha = []byte{0xd6, 0x03, 0x8a, 0x2b, 0x8b, 0x6f, 0x4c, 0xb5, 0xa5, 0x24, 0x33, 0x9a, 0x31, 0xdb, 0xb5, 0x9a}
+	kdbxKDFAES       = []byte{0xc9, 0xd9, 0xf3, 0x9a, 0x62, 0x8a, 0x44, 0x60, 0xbf, 0x74, 0x0d, 0x08, 0xc1, 0x8a, 0x4f, 0xea}
+	kdbxKDFArgon2d   = []byte{0xef, 0x63, 0x6d, 0xdf, 0x8c, 0x29, 0x44, 0x4b, 0x91, 0xf7, 0xa9, 0xa4, 0x03, 0xe3, 0x0a, 0x0c}
+	kdbxKDFArgon2id  = []byte{0x9e, 0x29, 0x8b, 0x19, 0x56, 0xdb, 0x47, 0x73, 0xb2, 0x3d, 0xfc, 0x3e, 0xc6, 0xf0, 0xa1, 0xe6}
+	kdbxSalsaNonce   = []byte{0xE8, 0x30, 0x09, 0x4B, 0x97, 0x20, 0x5D, 0x2A}
+)
+
+var (
+	ErrNotKDBX            = errors.New("file is not a KeePass database")
+	ErrUnsupportedKDBX    = errors.New("only KDBX 4 databases are supported")
+	ErrCorruptedKDBX      = errors.New("KeePass database is corrupted")
+	ErrInvalidMasterPass  = errors.New("invalid master password")
+	ErrUnsupportedCipher  = errors.New("unsupported KeePass cipher")
+	ErrUnsupportedKDF     = errors.New("unsupported KeePass key derivation function")
+	ErrUnsupportedRStream = errors.New("unsupported KeePass inner random stream")
+)
+
+type kdbxHeader struct {
+	cipherID   []byte
+	compressed bool
+	masterSeed []byte
+	iv         []byte
+	kdf        map[string][]byte
+}
+
+// parseKeePass decrypts a KDBX 4 database with the master password and maps
+// its entries. The root group is omitted from paths and entries in the
+// recycle bin are skipped.
+func parseKeePass(data []byte, password string) (*Result, error) {
+	header, headerLen, err := readKDBXHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	rest := data[headerLen:]
+	if len(rest) < 2*kdbxHashLen {
+		return nil, ErrCorruptedKDBX
+	}
+	headerHash := sha256.Sum256(data[:headerLen])
+	if !bytes.Equal(headerHash[:], rest[:kdbxHashLen]) {
+		return nil, ErrCorruptedKDBX
+	}
+
+	transformed, err := header.transformKey(password)
+	if err != nil {
+		return nil, err
+	}
+	hmacBase := sha512.Sum512(append(append(append([]byte(nil), header.masterSeed...), transformed...), 0x01))
+	if !hmac.Equal(kdbxBlockHMAC(hmacBase[:], math.MaxUint64, data[:headerLen]), rest[kdbxHashLen:2*kdbxHashLen]) {
+		return nil, ErrInvalidMasterPass
+	}
+
+	encrypted, err := readKDBXBlocks(rest[2*kdbxHashLen:], hmacBase[:])
+	if err != nil {
+		return nil, err
+	}
+	encryptionKey := sha256.Sum256(append(append([]byte(nil), header.masterSeed...), transformed...))
+	payload, err := header.decrypt(encryptionKey[:], encrypted)
+	if err != nil {
+		return nil, err
+	}
+	if header.compressed {
+		if payload, err = gunzip(payload); err != nil {
+			return nil, err
+		}
+	}
+
+	stream, binaries, xmlData, err := readKDBXInnerHeader(payload)
+	if err != nil {
+		return nil, err
+	}
+	return readKDBXEntries(xmlData, stream, binaries)
+}
+
+func readKDBXHeader(data []byte) (*kdbxHeader, int, error) {
+	const preambleLen = 12
+	if len(data) < preambleLen ||
+		binary.LittleEndian.Uint32(data[0:4]) != kdbxSignature1 ||
+		binary.LittleEndian.Uint32(data[4:8]) != kdbxSignature2 {
+		return nil, 0, ErrNotKDBX
+	}
+	if binary.LittleEndian.Uint16(data[10:12]) != kdbxMajorVersion {
+		return nil, 0, ErrUnsupportedKDBX
+	}
+
+	header := &kdbxHeader{}
+	pos := preambleLen
+	for {
+		if pos+5 > len(data) {
+			return nil, 0, ErrCorruptedKDBX
+		}
+		id := data[pos]
+		size := int(binary.LittleEndian.Uint32(data[pos+1 : pos+5]))
+		pos += 5
+		if size < 0 || pos+size > len(data) {
+			return nil, 0, ErrCorruptedKDBX
+		}
+		value := data[pos : pos+size]
+		pos += size
+
+		switch id {
+		case kdbxHeaderEnd:
+			return header, pos, nil
+		case kdbxHeaderCipherID:
+			header.cipherID = value
+		case kdbxHeaderCompressed:
+			header.compressed = len(value) == 4 && binary.LittleEndian.Uint32(value) == 1
+		case kdbxHeaderMasterSeed:
+			header.masterSeed = value
+		case kdbxHeaderIV:
+			header.iv = value
+		case kdbxHeaderKDF:
+			kdf, err := readVariantDictionary(value)
+			if err != nil {
+				return nil, 0, err
+			}
+			header.kdf = kdf
+		}
+	}
+}
+
+// readVariantDictionary returns raw values of a KDBX variant dictionary by key.
+func readVariantDictionary(data []byte) (map[string][]byte, error) {
+	if len(data) < 2 || binary.LittleEndian.Uint16(data)&kdbxVariantMajorMask != kdbxVariantVersion {
+		return nil, ErrCorruptedKDBX
+	}
+	values := make(map[string][]byte)
+	pos := 2
+	for pos < len(data) {
+		if data[pos] == kdbxVariantEnd {
+			return values, nil
+		}
+		pos++
+		key, next, err := readSized(data, pos)
+		if err != nil {
+			return nil, err
+		}
+		value, next, err := readSized(data, next)
+		if err != nil {
+			return nil, err
+		}
+		values[string(key)] = value
+		pos = next
+	}
+	return nil, ErrCorruptedKDBX
+}
+
+func readSized(data []byte, pos int) ([]byte, int, error) {
+	if pos+4 > len(data) {
+		return nil, 0, ErrCorruptedKDBX
+	}
+	size := int(binary.LittleEndian.Uint32(data[pos:]))
+	pos += 4
+	if size < 0 || pos+size > len(data) {
+		return nil, 0, ErrCorruptedKDBX
+	}
+	return data[pos : pos+size], pos + size, nil
+}
+
+func (h *kdbxHeader) kdfUint64(key string) (uint64, error) {
+	switch v := h.kdf[key]; len(v) {
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(v)), nil
+	case 8:
+		return binary.LittleEndian.Uint64(v), nil
+	}
+	return 0, fmt.Errorf("%w: missing %s parameter", ErrCorruptedKDBX, key)
+}
+
+// argon2Params returns the iterations, the memory in KiB and the parallelism of the Argon2 KDF. They
+// come from the unauthenticated header, so they are bounded before the key is derived with them.
+func (h *kdbxHeader) argon2Params() (uint32, uint32, uint8, error) {
+	iterations, err := h.kdfUint64("I")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	memory, err := h.kdfUint64("M")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	parallelism, err := h.kdfUint64("P")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	switch {
+	case parallelism == 0 || parallelism > math.MaxUint8:
+		return 0, 0, 0, fmt.Errorf("%w: Argon2 parallelism %d", ErrCorruptedKDBX, parallelism)
+	case memory%kdbxKiB != 0 || memory > kdbxMaxArgon2Memory || memory/kdbxKiB < 8*parallelism:
+		return 0, 0, 0, fmt.Errorf("%w: Argon2 memory of %d bytes", ErrCorruptedKDBX, memory)
+	case iterations == 0 || iterations > kdbxMaxArgon2Iterations:
+		return 0, 0, 0, fmt.Errorf("%w: %d Argon2 iterations", ErrCorruptedKDBX, iterations)
+	}
+	return uint32(iterations), uint32(memory / kdbxKiB), uint8(parallelism), nil // #nosec G115
+}
+
+// transformKey derives the transformed master key from the password.
+func (h *kdbxHeader) transformKey(password string) ([]byte, error) {
+	passwordHash := sha256.Sum256([]byte(password))
+	composite := sha256.Sum256(passwordHash[:])
+	salt := h.kdf["S"]
+
+	switch uuid := h.kdf["$UUID"]; {
+	case bytes.Equal(uuid, kdbxKDFAES):
+		rounds, err := h.kdfUint64("R")
+		if err != nil {
+			return nil, err
+		}
+		if rounds == 0 || rounds > kdbxMaxAESRounds {
+			return nil, fmt.Errorf("%w: %d AES-KDF rounds", ErrCorruptedKDBX, rounds)
+		}
+		block, err := aes.NewCipher(salt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCorruptedKDBX, err)
+		}
+		key := composite
+		for range rounds {
+			block.Encrypt(key[:aes.BlockSize], key[:aes.BlockSize])
+			block.Encrypt(key[aes.BlockSize:], key[aes.BlockSize:])
+		}
+		transformed := sha256.Sum256(key[:])
+		return transformed[:], nil
+	case bytes.Equal(uuid, kdbxKDFArgon2d), bytes.Equal(uuid, kdbxKDFArgon2id):
+		iterations, memory, threads, err := h.argon2Params()
+		if err != nil {
+			return nil, err
+		}
+		mode := argon2d
+		if bytes.Equal(uuid, kdbxKDFArgon2id) {
+			mode = argon2id
+		}
+		return argon2Key(mode, composite[:], salt, nil, nil, iterations, memory, threads, kdbxKeyLen), nil
+	}
+	return nil, ErrUnsupportedKDF
+}
+
+func (h *kdbxHeader) decrypt(key, data []byte) ([]byte, error) {
+	switch {
+	case bytes.Equal(h.cipherID, kdbxCipherAES):
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(h.iv) != aes.BlockSize || len(data) == 0 || len(data)%aes.BlockSize != 0 {
+			return nil, ErrCorruptedKDBX
+		}
+		plain := make([]byte, len(data))
+		cipher.NewCBCDecrypter(block, h.iv).CryptBlocks(plain, data)
+		padding := int(plain[len(plain)-1])
+		if padding == 0 || padding > aes.BlockSize {
+			return nil, ErrCorruptedKDBX
+		}
+		return plain[:len(plain)-padding], nil
+	case bytes.Equal(h.cipherID, kdbxCipherChaCha):
+		stream, err := chacha20.NewUnauthenticatedCipher(key, h.iv)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCorruptedKDBX, err)
+		}
+		plain := make([]byte, len(data))
+		stream.XORKeyStream(plain, data)
+		return plain, nil
+	}
+	return nil, ErrUnsupportedCipher
+}
+
+func kdbxBlockHMAC(base []byte, index uint64, data []byte) []byte {
+	var idx [8]byte
+	binary.LittleEndian.PutUint64(idx[:], index)
+	key := sha512.Sum512(append(idx[:], base...))
+	mac := hmac.New(sha256.New, key[:])
+	mac.Write(idx[:])
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// readKDBXBlocks verifies and concatenates the HMAC protected payload blocks.
+func readKDBXBlocks(data, hmacBase []byte) ([]byte, error) {
+	var payload []byte
+	pos := 0
+	for index := uint64(0); ; index++ {
+		if pos+kdbxHashLen+4 > len(data) {
+			return nil, ErrCorruptedKDBX
+		}
+		mac := data[pos : pos+kdbxHashLen]
+		block, next, err := readSized(data, pos+kdbxHashLen)
+		if err != nil {
+			return nil, err
+		}
+		if !hmac.Equal(mac, kdbxBlockHMAC(hmacBase, index, data[pos+kdbxHashLen:next])) {
+			return nil, ErrCorruptedKDBX
+		}
+		if len(block) == 0 {
+			return payload, nil
+		}
+		payload = append(payload, block...)
+		pos = next
+	}
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptedKDBX, err)
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// readKDBXInnerHeader returns the protected value stream, binary attachments
+// and the XML document stored in the decrypted payload.
+func readKDBXInnerHeader(payload []byte) (cipher.Stream, [][]byte, []byte, error) {
+	var (
+		streamID  uint32
+		streamKey []byte
+		binaries  [][]byte
+	)
+	pos := 0
+	for {
+		if pos >= len(payload) {
+			return nil, nil, nil, ErrCorruptedKDBX
+		}
+		id := payload[pos]
+		value, next, err := readSized(payload, pos+1)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		pos = next
+
+		switch id {
+		case kdbxInnerStreamID:
+			if len(value) != 4 {
+				return nil, nil, nil, ErrCorruptedKDBX
+			}
+			streamID = binary.LittleEndian.Uint32(value)
+		case kdbxInnerStreamKey:
+			streamKey = value
+		case kdbxInnerBinary:
+			if len(value) == 0 {
+				return nil, nil, nil, ErrCorruptedKDBX
+			}
+			binaries = append(binaries, value[1:]) // first byte holds protection flags
+		case kdbxInnerEnd:
+			stream, streamErr := newProtectedStream(streamID, streamKey)
+			if streamErr != nil {
+				return nil, nil, nil, streamErr
+			}
+			return stream, binaries, payload[pos:], nil
+		}
+	}
+}
+
+func newProtectedStream(id uint32, key []byte) (cipher.Stream, error) {
+	switch id {
+	case kdbxInnerStreamChaCha:
+		h := sha512.Sum512(key)
+		return chacha20.NewUnauthenticatedCipher(h[:chacha20.KeySize], h[chacha20.KeySize:chacha20.KeySize+chacha20.NonceSize])
+	case kdbxInnerStreamSalsa:
+		return &salsaStream{key: sha256.Sum256(key)}, nil
+	}
+	return nil, ErrUnsupportedRStream
+}
+
+// salsaStream is a Salsa20 key stream keeping its position across calls.
+type salsaStream struct {
+	key     [32]byte
+	counter uint64
+	buf     [64]byte
+	used    int
+}
+
+func (s *salsaStream) XORKeyStream(dst, src []byte) {
+	for i := range src {
+		if s.used == 0 || s.used == len(s.buf) {
+			var in [16]byte
+			copy(in[:8], kdbxSalsaNonce)
+			binary.LittleEndian.PutUint64(in[8:], s.counter)
+			var zero [64]byte
+			salsa.XORKeyStream(s.buf[:], zero[:], &in, &s.key)
+			s.counter++
+			s.used = 0
+		}
+		dst[i] = src[i] ^ s.buf[s.used]
+		s.used++
+	}
+}
+
+type kdbxEntry struct {
+	fields      map[string]string
+	attachments []Attachment
+	history     bool
+	recycled    bool
+	groups      []string
+}
+
+// kdbxReader walks the XML document in order, which is required because
+// protected values share a single key stream.
+type kdbxReader struct {
+	decoder    *xml.Decoder
+	stream     cipher.Stream
+	binaries   [][]byte
+	elements   []string
+	groups     []string
+	groupUUIDs []string
+	recycleBin string
+	entries    []*kdbxEntry
+	key        string
+	result     *Result
+}
+
+func readKDBXEntries(xmlData []byte, stream cipher.Stream, binaries [][]byte) (*Result, error) {
+	r := &kdbxReader{
+		decoder:  xml.NewDecoder(bytes.NewReader(xmlData)),
+		stream:   stream,
+		binaries: binaries,
+		result:   &Result{},
+	}
+	for {
+		token, err := r.decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return r.result, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCorruptedKDBX, err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if err = r.start(t); err != nil {
+				return nil, err
+			}
+		case xml.EndElement:
+			r.end(t)
+		}
+	}
+}
+
+func (r *kdbxReader) parent() string {
+	if len(r.elements) < 2 {
+		return ""
+	}
+	return r.elements[len(r.elements)-2]
+}
+
+// entry returns the innermost entry being read, which is a history item when inside History.
+func (r *kdbxReader) entry() *kdbxEntry {
+	if len(r.entries) == 0 {
+		return nil
+	}
+	return r.entries[len(r.entries)-1]
+}
+
+func (r *kdbxReader) text(t xml.StartElement) (string, error) {
+	var value string
+	if err := r.decoder.DecodeElement(&value, &t); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCorruptedKDBX, err)
+	}
+	r.elements = r.elements[:len(r.elements)-1]
+	return value, nil
+}
+
+func (r *kdbxReader) start(t xml.StartElement) error {
+	r.elements = append(r.elements, t.Name.Local)
+	parent := r.parent()
+
+	switch {
+	case t.Name.Local == kdbxRecycleBin && parent == "Meta":
+		value, err := r.text(t)
+		r.recycleBin = value
+		return err
+	case t.Name.Local == "Group":
+		r.groups = append(r.groups, "")
+		r.groupUUIDs = append(r.groupUUIDs, "")
+	case t.Name.Local == "UUID" && parent == "Group":
+		value, err := r.text(t)
+		r.groupUUIDs[len(r.groupUUIDs)-1] = value
+		return err
+	case t.Name.Local == "Name" && parent == "Group":
+		value, err := r.text(t)
+		r.groups[len(r.groups)-1] = value
+		return err
+	case t.Name.Local == "Entry":
+		entry := &kdbxEntry{
+			fields:  map[string]string{},
+			history: parent == kdbxAttrHistory,
+			groups:  append([]string(nil), r.groups...),
+		}
+		for _, uuid := range r.groupUUIDs {
+			if uuid != "" && uuid == r.recycleBin {
+				entry.recycled = true
+			}
+		}
+		r.entries = append(r.entries, entry)
+	case t.Name.Local == "Key" && (parent == "String" || parent == "Binary"):
+		value, err := r.text(t)
+		r.key = value
+		return err
+	case t.Name.Local == "Value" && parent == "String":
+		return r.readValue(t)
+	case t.Name.Local == "Value" && parent == "Binary":
+		return r.readBinaryRef(t)
+	}
+	return nil
+}
+
+func (r *kdbxReader) readValue(t xml.StartElement) error {
+	value, err := r.text(t)
+	if err != nil {
+		return err
+	}
+	for _, attr := range t.Attr {
+		if attr.Name.Local == kdbxProtected && attr.Value == kdbxTrue {
+			raw, decodeErr := base64.StdEncoding.DecodeString(value)
+			if decodeErr != nil {
+				return fmt.Errorf("%w: %w", ErrCorruptedKDBX, decodeErr)
+			}
+			r.stream.XORKeyStream(raw, raw)
+			value = string(raw)
+		}
+	}
+	if entry := r.entry(); entry != nil {
+		entry.fields[r.key] = value
+	}
+	return nil
+}
+
+func (r *kdbxReader) readBinaryRef(t xml.StartElement) error {
+	if _, err := r.text(t); err != nil {
+		return err
+	}
+	for _, attr := range t.Attr {
+		if attr.Name.Local != kdbxBinaryRef {
+			continue
+		}
+		ref, err := strconv.Atoi(attr.Value)
+		if err != nil || ref < 0 || ref >= len(r.binaries) {
+			return ErrCorruptedKDBX
+		}
+		if entry := r.entry(); entry != nil {
+			entry.attachments = append(entry.attachments, Attachment{Name: r.key, Data: r.binaries[ref]})
+		}
+	}
+	return nil
+}
+
+func (r *kdbxReader) end(t xml.EndElement) {
+	r.elements = r.elements[:len(r.elements)-1]
+	switch t.Name.Local {
+	case "Group":
+		r.groups = r.groups[:len(r.groups)-1]
+		r.groupUUIDs = r.groupUUIDs[:len(r.groupUUIDs)-1]
+	case "Entry":
+		if entry := r.entry(); entry != nil && !entry.history {
+			r.addEntry(entry)
+		}
+		if len(r.entries) > 0 {
+			r.entries = r.entries[:len(r.entries)-1]
+		}
+	}
+}
+
+func (r *kdbxReader) addEntry(e *kdbxEntry) {
+	title := e.fields["Title"]
+	if e.recycled {
+		r.result.skip(title, "entry is in the recycle bin")
+		return
+	}
+	var folders []string
+	if len(e.groups) > 1 {
+		folders = e.groups[1:]
+	}
+	p := buildPath(folders, title)
+
+	var item Item
+	switch username, password, notes := e.fields["UserName"], e.fields["Password"], e.fields["Notes"]; {
+	case username != "" || password != "":
+		item = newLogin(p, username, password)
+	case notes != "" || len(e.attachments) > 0:
+		item = newNote(p, notes)
+	default:
+		r.result.skip(title, "entry has no credentials, notes or attachments")
+		return
+	}
+	item.Attachments = e.attachments
+	r.result.Items = append(r.result.Items, item)
+}
@@ -0,0 +1,279 @@
+package importer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/chacha20"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+type kdbxFixture struct {
+	password string
+	cipherID []byte
+	kdf      []byte
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	t.Helper()
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func appendField(buf *bytes.Buffer, id byte, value []byte) {
+	buf.WriteByte(id)
+	_ = binary.Write(buf, binary.LittleEndian, uint32(len(value)))
+	buf.Write(value)
+}
+
+func appendVariant(buf *bytes.Buffer, kind byte, key string, value []byte) {
+	buf.WriteByte(kind)
+	_ = binary.Write(buf, binary.LittleEndian, uint32(len(key)))
+	buf.WriteString(key)
+	_ = binary.Write(buf, binary.LittleEndian, uint32(len(value)))
+	buf.Write(value)
+}
+
+func le32(v uint32) []byte {
+	return binary.LittleEndian.AppendUint32(nil, v)
+}
+
+func le64(v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, v)
+}
+
+// write produces a KDBX 4 database in the same way KeePassXC does.
+func (f kdbxFixture) write(t *testing.T) []byte {
+	t.Helper()
+	masterSeed, salt := randomBytes(t, 32), randomBytes(t, 32)
+	ivLen := aes.BlockSize
+	if bytes.Equal(f.cipherID, kdbxCipherChaCha) {
+		ivLen = chacha20.NonceSize
+	}
+	iv := randomBytes(t, ivLen)
+
+	kdf := &bytes.Buffer{}
+	_ = binary.Write(kdf, binary.LittleEndian, uint16(kdbxVariantVersion))
+	appendVariant(kdf, 0x42, "$UUID", f.kdf)
+	appendVariant(kdf, 0x42, "S", salt)
+	if bytes.Equal(f.kdf, kdbxKDFAES) {
+		appendVariant(kdf, 0x05, "R", le64(10))
+	} else {
+		appendVariant(kdf, 0x05, "I", le64(1))
+		appendVariant(kdf, 0x05, "M", le64(64*kdbxKiB))
+		appendVariant(kdf, 0x04, "P", le32(2))
+		appendVariant(kdf, 0x04, "V", le32(argon2Version))
+	}
+	kdf.WriteByte(kdbxVariantEnd)
+
+	header := &bytes.Buffer{}
+	_ = binary.Write(header, binary.LittleEndian, []uint32{kdbxSignature1, kdbxSignature2})
+	_ = binary.Write(header, binary.LittleEndian, []uint16{1, kdbxMajorVersion})
+	appendField(header, kdbxHeaderCipherID, f.cipherID)
+	appendField(header, kdbxHeaderCompressed, le32(1))
+	appendField(header, kdbxHeaderMasterSeed, masterSeed)
+	appendField(header, kdbxHeaderIV, iv)
+	appendField(header, kdbxHeaderKDF, kdf.Bytes())
+	appendField(header, kdbxHeaderEnd, []byte("\r\n\r\n"))
+
+	parsed, _, err := readKDBXHeader(header.Bytes())
+	require.NoError(t, err)
+	transformed, err := parsed.transformKey(f.password)
+	require.NoError(t, err)
+
+	innerKey := randomBytes(t, 64)
+	stream, err := newProtectedStream(kdbxInnerStreamChaCha, innerKey)
+	require.NoError(t, err)
+	protect := func(s string) string {
+		b := []byte(s)
+		stream.XORKeyStream(b, b)
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	document := fmt.Sprintf(`<?xml version="1.0" encoding="utf-8" standalone="yes"?>
+<KeePassFile>
+	<Meta><RecycleBinUUID>YmluYmluYmluYmluYmluYg==</RecycleBinUUID></Meta>
+	<Root>
+		<Group>
+			<UUID>cm9vdHJvb3Ryb290cm9vdA==</UUID>
+			<Name>Passwords</Name>
+			<Entry>
+				<UUID>ZW50cnllbnRyeWVudHJ5ZQ==</UUID>
+				<String><Key>Title</Key><Value>GitHub</Value></String>
+				<String><Key>UserName</Key><Value>octocat</Value></String>
+				<String><Key>Password</Key><Value Protected="True">%s</Value></String>
+				<History>
+					<Entry><String><Key>Password</Key><Value Protected="True">%s</Value></String></Entry>
+				</History>
+			</Entry>
+			<Group>
+				<UUID>d29ya3dvcmt3b3Jrd29yaw==</UUID>
+				<Name>Work</Name>
+				<Entry>
+					<String><Key>Title</Key><Value>VPN</Value></String>
+					<String><Key>Notes</Key><Value Protected="True">%s</Value></String>
+					<Binary><Key>vpn.conf</Key><Value Ref="0"/></Binary>
+				</Entry>
+			</Group>
+			<Group>
+				<UUID>YmluYmluYmluYmluYmluYg==</UUID>
+				<Name>Recycle Bin</Name>
+				<Entry>
+					<String><Key>Title</Key><Value>Old</Value></String>
+					<String><Key>UserName</Key><Value>old</Value></String>
+				</Entry>
+			</Group>
+		</Group>
+	</Root>
+</KeePassFile>`, protect("s3cr3t"), protect("previous"), protect("vpn config"))
+
+	payload := &bytes.Buffer{}
+	appendField(payload, kdbxInnerStreamID, le32(kdbxInnerStreamChaCha))
+	appendField(payload, kdbxInnerStreamKey, innerKey)
+	appendField(payload, kdbxInnerBinary, append([]byte{0x01}, "remote vpn.example.com"...))
+	appendField(payload, kdbxInnerEnd, nil)
+	payload.WriteString(document)
+
+	compressed := &bytes.Buffer{}
+	gz := gzip.NewWriter(compressed)
+	_, err = gz.Write(payload.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	key := sha256.Sum256(append(append([]byte(nil), masterSeed...), transformed...))
+	var encrypted []byte
+	if bytes.Equal(f.cipherID, kdbxCipherChaCha) {
+		c, chachaErr := chacha20.NewUnauthenticatedCipher(key[:], iv)
+		require.NoError(t, chachaErr)
+		encrypted = make([]byte, compressed.Len())
+		c.XORKeyStream(encrypted, compressed.Bytes())
+	} else {
+		block, aesErr := aes.NewCipher(key[:])
+		require.NoError(t, aesErr)
+		padding := aes.BlockSize - compressed.Len()%aes.BlockSize
+		plain := append(compressed.Bytes(), bytes.Repeat([]byte{byte(padding)}, padding)...)
+		encrypted = make([]byte, len(plain))
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, plain)
+	}
+
+	hmacBase := sha512.Sum512(append(append(append([]byte(nil), masterSeed...), transformed...), 0x01))
+	out := &bytes.Buffer{}
+	out.Write(header.Bytes())
+	headerHash := sha256.Sum256(header.Bytes())
+	out.Write(headerHash[:])
+	out.Write(kdbxBlockHMAC(hmacBase[:], math.MaxUint64, header.Bytes()))
+	for i, block := range [][]byte{encrypted, nil} {
+		sized := append(le32(uint32(len(block))), block...)
+		out.Write(kdbxBlockHMAC(hmacBase[:], uint64(i), sized))
+		out.Write(sized)
+	}
+	return out.Bytes()
+}
+
+func TestParseKeePass(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture kdbxFixture
+	}{
+		{
+			name:    "aes-kdf with aes-256",
+			fixture: kdbxFixture{password: "master", cipherID: kdbxCipherAES, kdf: kdbxKDFAES},
+		},
+		{
+			name:    "argon2d with chacha20",
+			fixture: kdbxFixture{password: "master", cipherID: kdbxCipherChaCha, kdf: kdbxKDFArgon2d},
+		},
+		{
+			name:    "argon2id with aes-256",
+			fixture: kdbxFixture{password: "master", cipherID: kdbxCipherAES, kdf: kdbxKDFArgon2id},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseKeePass(tt.fixture.write(t), "master")
+			require.NoError(t, err)
+			require.Len(t, result.Items, 2)
+
+			login := result.Items[0]
+			assert.Equal(t, "GitHub", login.Path())
+			assert.Equal(t, pb.DataType_DATA_TYPE_LOGIN, login.Data.GetType())
+			assert.Equal(t, "octocat", login.Data.GetLogin().GetLogin())
+			assert.Equal(t, "s3cr3t", login.Data.GetLogin().GetPassword())
+
+			note := result.Items[1]
+			assert.Equal(t, "Work/VPN", note.Path())
+			assert.Equal(t, "vpn config", note.Data.GetNote().GetText())
+			require.Len(t, note.Attachments, 1)
+			assert.Equal(t, "vpn.conf", note.Attachments[0].Name)
+			assert.Equal(t, []byte("remote vpn.example.com"), note.Attachments[0].Data)
+			assert.Equal(t, "Work/VPN/vpn.conf", note.AttachmentPath(note.Attachments[0]))
+
+			assert.Equal(t, []Skipped{{Title: "Old", Reason: "entry is in the recycle bin"}}, result.Skipped)
+		})
+	}
+
+	t.Run("wrong master password", func(t *testing.T) {
+		data := kdbxFixture{password: "master", cipherID: kdbxCipherAES, kdf: kdbxKDFAES}.write(t)
+		_, err := parseKeePass(data, "wrong")
+		require.ErrorIs(t, err, ErrInvalidMasterPass)
+	})
+
+	t.Run("not a keepass database", func(t *testing.T) {
+		_, err := parseKeePass([]byte("definitely not a database"), "master")
+		require.ErrorIs(t, err, ErrNotKDBX)
+	})
+}
+
+func TestKDBXKDFParameters(t *testing.T) {
+	argon2 := func(iterations, memory uint64, parallelism uint32) *kdbxHeader {
+		return &kdbxHeader{kdf: map[string][]byte{
+			"$UUID": kdbxKDFArgon2id,
+			"S":     make([]byte, 32),
+			"I":     le64(iterations),
+			"M":     le64(memory),
+			"P":     le32(parallelism),
+		}}
+	}
+	tests := []struct {
+		name   string
+		header *kdbxHeader
+	}{
+		{name: "no argon2 lanes", header: argon2(1, 64*kdbxKiB, 0)},
+		{name: "too many argon2 lanes", header: argon2(1, 64*kdbxKiB, 256)},
+		{name: "oversized argon2 memory", header: argon2(1, 1<<40, 2)},
+		{name: "argon2 memory not in KiB", header: argon2(1, 64*kdbxKiB+1, 2)},
+		{name: "argon2 memory below 8 blocks per lane", header: argon2(1, 8*kdbxKiB, 2)},
+		{name: "no argon2 iterations", header: argon2(0, 64*kdbxKiB, 2)},
+		{name: "too many argon2 iterations", header: argon2(1<<32, 64*kdbxKiB, 2)},
+		{name: "no aes-kdf rounds", header: &kdbxHeader{kdf: map[string][]byte{
+			"$UUID": kdbxKDFAES, "S": make([]byte, 32), "R": le64(0),
+		}}},
+		{name: "too many aes-kdf rounds", header: &kdbxHeader{kdf: map[string][]byte{
+			"$UUID": kdbxKDFAES, "S": make([]byte, 32), "R": le64(math.MaxUint64),
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.header.transformKey("master")
+			require.ErrorIs(t, err, ErrCorruptedKDBX)
+		})
+	}
+
+	_, err := argon2(1, 16*kdbxKiB, 2).transformKey("master")
+	require.NoError(t, err)
+}
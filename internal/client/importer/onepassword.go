@@ -0,0 +1,204 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// 1Password category identifiers used in 1PUX exports.
+const (
+	onePasswordLogin      = "001"
+	onePasswordCard       = "002"
+	onePasswordSecureNote = "003"
+	onePasswordPassword   = "005"
+	onePasswordDocument   = "006"
+
+	onePasswordExportData = "export.data"
+	onePasswordFilesDir   = "files/"
+	onePasswordArchived   = "archived"
+	monthYearDivider      = 100
+)
+
+var ErrMissingExportData = errors.New("1pux archive has no export.data")
+
+type onePasswordField struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Value struct {
+		String           *string `json:"string"`
+		Concealed        *string `json:"concealed"`
+		CreditCardNumber *string `json:"creditCardNumber"`
+		MonthYear        *int64  `json:"monthYear"`
+	} `json:"value"`
+}
+
+type onePasswordSection struct {
+	Fields []onePasswordField `json:"fields"`
+}
+
+type onePasswordItem struct {
+	CategoryUUID string `json:"categoryUuid"`
+	State        string `json:"state"`
+	Overview     struct {
+		Title string `json:"title"`
+	} `json:"overview"`
+	Details struct {
+		LoginFields []struct {
+			Value       string `json:"value"`
+			Designation string `json:"designation"`
+		} `json:"loginFields"`
+		NotesPlain         string               `json:"notesPlain"`
+		Password           string               `json:"password"`
+		Sections           []onePasswordSection `json:"sections"`
+		DocumentAttributes *struct {
+			FileName   string `json:"fileName"`
+			DocumentID string `json:"documentId"`
+		} `json:"documentAttributes"`
+	} `json:"details"`
+}
+
+type onePasswordExport struct {
+	Accounts []struct {
+		Vaults []struct {
+			Attrs struct {
+				Name string `json:"name"`
+			} `json:"attrs"`
+			Items []onePasswordItem `json:"items"`
+		} `json:"vaults"`
+	} `json:"accounts"`
+}
+
+// parse1PUX maps a 1Password unencrypted export archive. Every vault becomes
+// the top level folder of the resulting paths.
+func parse1PUX(data []byte) (*Result, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open 1pux archive: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+	exportFile, ok := files[onePasswordExportData]
+	if !ok {
+		return nil, ErrMissingExportData
+	}
+	raw, err := readZipFile(exportFile)
+	if err != nil {
+		return nil, err
+	}
+	var export onePasswordExport
+	if err = json.Unmarshal(raw, &export); err != nil {
+		return nil, fmt.Errorf("failed to parse 1pux export data: %w", err)
+	}
+
+	result := &Result{}
+	for _, account := range export.Accounts {
+		for _, vault := range account.Vaults {
+			for _, item := range vault.Items {
+				if err = addOnePasswordItem(result, files, vault.Attrs.Name, item); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func addOnePasswordItem(result *Result, files map[string]*zip.File, vaultName string, item onePasswordItem) error {
+	title := item.Overview.Title
+	if item.State == onePasswordArchived {
+		result.skip(title, "archived item")
+		return nil
+	}
+	p := buildPath([]string{vaultName}, title)
+	details := item.Details
+
+	switch item.CategoryUUID {
+	case onePasswordLogin:
+		var username, password string
+		for _, f := range details.LoginFields {
+			switch f.Designation {
+			case "username":
+				username = f.Value
+			case "password":
+				password = f.Value
+			}
+		}
+		result.Items = append(result.Items, newLogin(p, username, password))
+	case onePasswordPassword:
+		result.Items = append(result.Items, newLogin(p, "", details.Password))
+	case onePasswordCard:
+		result.Items = append(result.Items, newCard(p, onePasswordCardData(details.Sections)))
+	case onePasswordSecureNote:
+		result.Items = append(result.Items, newNote(p, details.NotesPlain))
+	case onePasswordDocument:
+		doc := details.DocumentAttributes
+		if doc == nil {
+			result.skip(title, "document without attributes")
+			return nil
+		}
+		f, ok := files[onePasswordFilesDir+doc.DocumentID+"__"+doc.FileName]
+		if !ok {
+			result.skip(title, "document file is missing in the archive")
+			return nil
+		}
+		content, err := readZipFile(f)
+		if err != nil {
+			return err
+		}
+		note := newNote(p, details.NotesPlain)
+		note.Attachments = []Attachment{{Name: doc.FileName, Data: content}}
+		result.Items = append(result.Items, note)
+	default:
+		result.skip(title, fmt.Sprintf("unsupported category %s", item.CategoryUUID))
+	}
+	return nil
+}
+
+func onePasswordCardData(sections []onePasswordSection) *pb.CardData {
+	card := &pb.CardData{}
+	for _, section := range sections {
+		for _, f := range section.Fields {
+			switch f.ID {
+			case "cardholder":
+				card.CardHolder = deref(f.Value.String)
+			case "ccnum":
+				card.Number = strings.ReplaceAll(deref(f.Value.CreditCardNumber), " ", "")
+			case "cvv":
+				card.Cvv = deref(f.Value.Concealed)
+			case "pin":
+				card.Pin = deref(f.Value.Concealed)
+			case "expiry":
+				if f.Value.MonthYear != nil {
+					card.ExpiryYear = *f.Value.MonthYear / monthYearDivider
+					card.ExpiryMonth = *f.Value.MonthYear % monthYearDivider
+				}
+			}
+		}
+	}
+	return card
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", f.Name, err)
+	}
+	return content, nil
+}
@@ -0,0 +1,59 @@
+package importer
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/itallix/gophkeeper/internal/common/totp"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+const otpauthScheme = "otpauth://"
+
+func newTOTP(p, uri string) Item {
+	return Item{
+		Data: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_TOTP,
+			Base: &pb.Metadata{Path: p},
+			Data: &pb.TypedData_Totp{
+				Totp: &pb.TOTPData{Uri: uri},
+			},
+		},
+	}
+}
+
+// otpauthURI turns the TOTP field of a password manager into an otpauth URI. The field holds
+// either a URI or, as Bitwarden and KeePassXC allow, the bare base32 seed.
+func otpauthURI(value, label string) string {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, otpauthScheme) {
+		return value
+	}
+	u := url.URL{Scheme: "otpauth", Host: "totp", Path: "/" + label, RawQuery: url.Values{"secret": {value}}.Encode()}
+	return u.String()
+}
+
+// parseOTPAuth reads one otpauth:// URI per line, as exported by authenticator apps. The path
+// of each TOTP is built from its issuer and account.
+func parseOTPAuth(data []byte) (*Result, error) {
+	result := &Result{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := totp.Parse(line)
+		if err != nil {
+			// the line holds the seed, it is not shown
+			result.skip(fmt.Sprintf("line %d", i+1), err.Error())
+			continue
+		}
+		var parents []string
+		if key.Issuer != "" {
+			parents = []string{key.Issuer}
+		}
+		result.Items = append(result.Items, newTOTP(buildPath(parents, key.Account), line))
+	}
+	return result, nil
+}
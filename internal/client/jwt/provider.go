@@ -2,12 +2,21 @@ package jwt
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"sync"
 )
 
+var ErrNotStored = errors.New("tokens obtained with an api key are not stored")
+
 type TokenProvider struct {
 	filename string
+
+	// exchange obtains the token from an API key instead of the token file.
+	exchange func() (*TokenData, error)
+	mu       sync.Mutex
+	token    *TokenData
 }
 
 func NewTokenProvider(filename string) *TokenProvider {
@@ -16,6 +25,14 @@ func NewTokenProvider(filename string) *TokenProvider {
 	}
 }
 
+// NewAPIKeyTokenProvider creates a provider which exchanges an API key for a short-lived token
+// on first use and keeps it in memory.
+func NewAPIKeyTokenProvider(exchange func() (*TokenData, error)) *TokenProvider {
+	return &TokenProvider{
+		exchange: exchange,
+	}
+}
+
 type TokenData struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
@@ -30,6 +47,9 @@ func NewToken(accessToken, refreshToken string) *TokenData {
 
 // Token storage with file permissions.
 func (p *TokenProvider) SaveToken(tokenData *TokenData) error {
+	if p.exchange != nil {
+		return ErrNotStored
+	}
 	jsonData, err := json.Marshal(tokenData)
 	if err != nil {
 		return fmt.Errorf("failed to marshal token: %w", err)
@@ -44,6 +64,9 @@ func (p *TokenProvider) SaveToken(tokenData *TokenData) error {
 }
 
 func (p *TokenProvider) LoadToken() (*TokenData, error) {
+	if p.exchange != nil {
+		return p.exchangeToken()
+	}
 	data, err := os.ReadFile(p.filename)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read token file: %w", err)
@@ -58,3 +81,18 @@ func (p *TokenProvider) LoadToken() (*TokenData, error) {
 
 	return &tokenData, nil
 }
+
+func (p *TokenProvider) exchangeToken() (*TokenData, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != nil {
+		return p.token, nil
+	}
+	token, err := p.exchange()
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange api key: %w", err)
+	}
+	p.token = token
+	return token, nil
+}
@@ -1,6 +1,7 @@
 package jwt_test
 
 import (
+	"errors"
 	"io/fs"
 	"os"
 	"reflect"
@@ -76,3 +77,28 @@ func TestLoadTokenInvalidJSON(t *testing.T) {
 	_, err = tokenProvider.LoadToken()
 	require.Error(t, err)
 }
+
+func TestAPIKeyTokenProvider(t *testing.T) {
+	calls := 0
+	provider := jwt.NewAPIKeyTokenProvider(func() (*jwt.TokenData, error) {
+		calls++
+		return jwt.NewToken("access123", ""), nil
+	})
+
+	for range 2 {
+		token, err := provider.LoadToken()
+		require.NoError(t, err)
+		assert.Equal(t, "access123", token.AccessToken)
+	}
+	assert.Equal(t, 1, calls, "the key is exchanged once")
+	require.ErrorIs(t, provider.SaveToken(jwt.NewToken("a", "r")), jwt.ErrNotStored)
+}
+
+func TestAPIKeyTokenProviderError(t *testing.T) {
+	provider := jwt.NewAPIKeyTokenProvider(func() (*jwt.TokenData, error) {
+		return nil, errors.New("invalid api key")
+	})
+
+	_, err := provider.LoadToken()
+	require.ErrorContains(t, err, "failed to exchange api key: invalid api key")
+}
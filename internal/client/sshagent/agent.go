@@ -0,0 +1,168 @@
+// Package sshagent serves the SSH keys kept in the vault to ssh clients over the ssh-agent protocol.
+// The keys only live in memory: they can be confirmed on every use and forgotten after a lifetime.
+package sshagent
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+var (
+	// ErrRefused is returned when the use of a key is not confirmed.
+	ErrRefused = errors.New("agent: use of the key refused")
+	// ErrReadOnly is returned to clients trying to add keys or to export the signers.
+	ErrReadOnly = errors.New("agent: keys are loaded from the vault")
+)
+
+// ConfirmFunc asks the user whether the key may sign, it is called for one key at a time.
+type ConfirmFunc func(key *agent.Key) bool
+
+// Agent is an agent.ExtendedAgent holding the keys loaded from the vault.
+type Agent struct {
+	keyring  agent.ExtendedAgent
+	confirm  ConfirmFunc
+	lifetime time.Duration
+	now      func() time.Time
+
+	// expiry holds the time each key is forgotten at, by the wire format of its public key
+	expiry map[string]time.Time
+	mu     sync.Mutex
+	// confirmMu serializes the confirmations, so the prompts don't interleave
+	confirmMu sync.Mutex
+}
+
+// Option configures the agent.
+type Option func(*Agent)
+
+// WithConfirm asks for confirmation before every signature.
+func WithConfirm(confirm ConfirmFunc) Option {
+	return func(a *Agent) {
+		a.confirm = confirm
+	}
+}
+
+// WithLifetime forgets the keys once the lifetime has passed since they were loaded, 0 keeps them.
+func WithLifetime(lifetime time.Duration) Option {
+	return func(a *Agent) {
+		a.lifetime = lifetime
+	}
+}
+
+func New(opts ...Option) *Agent {
+	keyring, _ := agent.NewKeyring().(agent.ExtendedAgent)
+	a := &Agent{
+		keyring: keyring,
+		now:     time.Now,
+		expiry:  make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Load adds a private key to the agent, it is the only way to add keys.
+func (a *Agent) Load(key crypto.PrivateKey, comment string) error {
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return err
+	}
+	if err = a.keyring.Add(agent.AddedKey{PrivateKey: key, Comment: comment}); err != nil {
+		return err
+	}
+	if a.lifetime > 0 {
+		a.mu.Lock()
+		a.expiry[string(signer.PublicKey().Marshal())] = a.now().Add(a.lifetime)
+		a.mu.Unlock()
+	}
+	return nil
+}
+
+// expire removes the keys whose lifetime has passed.
+func (a *Agent) expire() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	for blob, expiry := range a.expiry {
+		if now.Before(expiry) {
+			continue
+		}
+		if key, err := ssh.ParsePublicKey([]byte(blob)); err == nil {
+			_ = a.keyring.Remove(key)
+		}
+		delete(a.expiry, blob)
+	}
+}
+
+func (a *Agent) List() ([]*agent.Key, error) {
+	a.expire()
+	return a.keyring.List()
+}
+
+func (a *Agent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return a.SignWithFlags(key, data, 0)
+}
+
+func (a *Agent) SignWithFlags(key ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	a.expire()
+	if a.confirm != nil {
+		keys, err := a.keyring.List()
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			if !bytes.Equal(k.Marshal(), key.Marshal()) {
+				continue
+			}
+			a.confirmMu.Lock()
+			allowed := a.confirm(k)
+			a.confirmMu.Unlock()
+			if !allowed {
+				return nil, ErrRefused
+			}
+		}
+	}
+	return a.keyring.SignWithFlags(key, data, flags)
+}
+
+// Add refuses the keys of the clients, the agent only serves the keys of the vault.
+func (a *Agent) Add(agent.AddedKey) error {
+	return ErrReadOnly
+}
+
+func (a *Agent) Remove(key ssh.PublicKey) error {
+	a.mu.Lock()
+	delete(a.expiry, string(key.Marshal()))
+	a.mu.Unlock()
+	return a.keyring.Remove(key)
+}
+
+func (a *Agent) RemoveAll() error {
+	a.mu.Lock()
+	clear(a.expiry)
+	a.mu.Unlock()
+	return a.keyring.RemoveAll()
+}
+
+func (a *Agent) Lock(passphrase []byte) error {
+	return a.keyring.Lock(passphrase)
+}
+
+func (a *Agent) Unlock(passphrase []byte) error {
+	return a.keyring.Unlock(passphrase)
+}
+
+// Signers is not served, it would sign without confirmation.
+func (a *Agent) Signers() ([]ssh.Signer, error) {
+	return nil, ErrReadOnly
+}
+
+func (a *Agent) Extension(string, []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
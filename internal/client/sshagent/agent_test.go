@@ -0,0 +1,111 @@
+package sshagent
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+
+	"github.com/itallix/gophkeeper/internal/common/sshkey"
+)
+
+// serve connects a protocol client to the agent.
+func serve(t *testing.T, a *Agent) agent.ExtendedAgent {
+	t.Helper()
+	clientConn, serverConn := net.Pipe()
+	go func() {
+		_ = agent.ServeAgent(a, serverConn)
+	}()
+	t.Cleanup(func() {
+		_ = clientConn.Close()
+	})
+	return agent.NewClient(clientConn)
+}
+
+func loadKey(t *testing.T, a *Agent, comment string) ssh.PublicKey {
+	t.Helper()
+	privateKey, _, err := sshkey.Generate(sshkey.TypeEd25519, 0, "")
+	require.NoError(t, err)
+	key, err := sshkey.Parse(privateKey, nil)
+	require.NoError(t, err)
+	require.NoError(t, a.Load(key, comment))
+	publicKey, err := sshkey.PublicKey(key)
+	require.NoError(t, err)
+	return publicKey
+}
+
+func TestAgent(t *testing.T) {
+	a := New()
+	publicKey := loadKey(t, a, "deploy")
+	client := serve(t, a)
+
+	keys, err := client.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "deploy", keys[0].Comment)
+	assert.Equal(t, publicKey.Marshal(), keys[0].Marshal())
+
+	signature, err := client.Sign(publicKey, []byte("challenge"))
+	require.NoError(t, err)
+	require.NoError(t, publicKey.Verify([]byte("challenge"), signature))
+
+	privateKey, _, err := sshkey.Generate(sshkey.TypeEd25519, 0, "")
+	require.NoError(t, err)
+	key, err := sshkey.Parse(privateKey, nil)
+	require.NoError(t, err)
+	require.Error(t, client.Add(agent.AddedKey{PrivateKey: key}), "clients can't add keys")
+	_, err = a.Signers()
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	require.NoError(t, client.Lock([]byte("pass")))
+	_, err = client.Sign(publicKey, []byte("challenge"))
+	require.Error(t, err)
+	require.NoError(t, client.Unlock([]byte("pass")))
+
+	require.NoError(t, client.Remove(publicKey))
+	keys, err = client.List()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestAgentConfirm(t *testing.T) {
+	var asked []string
+	allow := false
+	a := New(WithConfirm(func(key *agent.Key) bool {
+		asked = append(asked, key.Comment)
+		return allow
+	}))
+	publicKey := loadKey(t, a, "deploy")
+	client := serve(t, a)
+
+	_, err := client.Sign(publicKey, []byte("challenge"))
+	require.Error(t, err)
+	allow = true
+	_, err = client.Sign(publicKey, []byte("challenge"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deploy", "deploy"}, asked, "every use is confirmed")
+}
+
+func TestAgentLifetime(t *testing.T) {
+	now := time.Now()
+	a := New(WithLifetime(time.Hour))
+	a.now = func() time.Time { return now }
+	publicKey := loadKey(t, a, "deploy")
+	client := serve(t, a)
+
+	now = now.Add(59 * time.Minute)
+	keys, err := client.List()
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	now = now.Add(time.Minute)
+	_, err = client.Sign(publicKey, []byte("challenge"))
+	require.Error(t, err, "the key is forgotten after its lifetime")
+	keys, err = client.List()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
@@ -0,0 +1,117 @@
+// Package card validates payment card numbers: the Luhn checksum and the length and CVC rules of the brand
+// detected from the issuer identification number (IIN), the leading digits of the number.
+package card
+
+import (
+	"slices"
+	"strconv"
+)
+
+// Brand is a card network with the IIN ranges it issues and its number and CVC lengths.
+type Brand struct {
+	Name      string
+	Lengths   []int
+	CVCLength int
+
+	ranges []iinRange
+}
+
+// iinRange is an inclusive range of prefixes of the same number of digits.
+type iinRange struct {
+	from, to int
+	digits   int
+}
+
+func prefixes(values ...int) []iinRange {
+	ranges := make([]iinRange, 0, len(values))
+	for _, v := range values {
+		ranges = append(ranges, iinRange{from: v, to: v, digits: len(strconv.Itoa(v))})
+	}
+	return ranges
+}
+
+// Brands are ordered so that the more specific ranges come first, e.g. the Discover co-branded range of
+// UnionPay is matched before UnionPay.
+var (
+	Amex       = Brand{Name: "Amex", Lengths: []int{15}, CVCLength: 4, ranges: prefixes(34, 37)}
+	Visa       = Brand{Name: "Visa", Lengths: []int{13, 16, 19}, CVCLength: 3, ranges: prefixes(4)}
+	Mastercard = Brand{Name: "Mastercard", Lengths: []int{16}, CVCLength: 3, ranges: []iinRange{
+		{from: 51, to: 55, digits: 2}, {from: 2221, to: 2720, digits: 4},
+	}}
+	Discover = Brand{Name: "Discover", Lengths: []int{16, 17, 18, 19}, CVCLength: 3, ranges: append(
+		prefixes(6011, 65), iinRange{from: 644, to: 649, digits: 3}, iinRange{from: 622126, to: 622925, digits: 6},
+	)}
+	DinersClub = Brand{Name: "Diners Club", Lengths: []int{14, 15, 16, 17, 18, 19}, CVCLength: 3, ranges: append(
+		prefixes(36, 38, 39), iinRange{from: 300, to: 305, digits: 3},
+	)}
+	JCB = Brand{Name: "JCB", Lengths: []int{16, 17, 18, 19}, CVCLength: 3, ranges: []iinRange{
+		{from: 3528, to: 3589, digits: 4},
+	}}
+	Mir = Brand{Name: "Mir", Lengths: []int{16, 17, 18, 19}, CVCLength: 3, ranges: []iinRange{
+		{from: 2200, to: 2204, digits: 4},
+	}}
+	Maestro = Brand{Name: "Maestro", Lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}, CVCLength: 3, ranges: append(
+		prefixes(5018, 5020, 5038, 5893, 6304, 6759), iinRange{from: 6761, to: 6763, digits: 4},
+	)}
+	UnionPay = Brand{Name: "UnionPay", Lengths: []int{16, 17, 18, 19}, CVCLength: 3, ranges: prefixes(62)}
+
+	brands = []Brand{Amex, Visa, Mastercard, Discover, DinersClub, JCB, Mir, Maestro, UnionPay}
+)
+
+// DetectBrand returns the brand issuing the number, ok is false for numbers of an unknown IIN.
+func DetectBrand(number string) (Brand, bool) {
+	for _, brand := range brands {
+		for _, r := range brand.ranges {
+			if len(number) < r.digits {
+				continue
+			}
+			prefix, err := strconv.Atoi(number[:r.digits])
+			if err == nil && prefix >= r.from && prefix <= r.to {
+				return brand, true
+			}
+		}
+	}
+	return Brand{}, false
+}
+
+// ValidLength reports whether the brand issues numbers of the given length.
+func (b Brand) ValidLength(length int) bool {
+	return slices.Contains(b.Lengths, length)
+}
+
+// Luhn reports whether the number of digits passes the Luhn checksum.
+func Luhn(number string) bool {
+	if number == "" {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		if number[i] < '0' || number[i] > '9' {
+			return false
+		}
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// Mask replaces all but the last four digits of the number.
+func Mask(number string) string {
+	const visible = 4
+	if len(number) <= visible {
+		return number
+	}
+	masked := make([]byte, 0, len(number))
+	for range len(number) - visible {
+		masked = append(masked, '*')
+	}
+	return string(append(masked, number[len(number)-visible:]...))
+}
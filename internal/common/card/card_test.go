@@ -0,0 +1,60 @@
+package card_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/itallix/gophkeeper/internal/common/card"
+)
+
+func TestLuhn(t *testing.T) {
+	for number, valid := range map[string]bool{
+		"4242424242424242":    true,
+		"4242424242424241":    false,
+		"378282246310005":     true,
+		"5555555555554444":    true,
+		"6011111111111117":    true,
+		"79927398713":         true,
+		"79927398710":         false,
+		"4242 4242 4242 4242": false,
+		"":                    false,
+	} {
+		assert.Equal(t, valid, card.Luhn(number), number)
+	}
+}
+
+func TestDetectBrand(t *testing.T) {
+	for number, brand := range map[string]string{
+		"4242424242424242": "Visa",
+		"378282246310005":  "Amex",
+		"341111111111111":  "Amex",
+		"5555555555554444": "Mastercard",
+		"2223003122003222": "Mastercard",
+		"6011111111111117": "Discover",
+		"6221260000000000": "Discover",
+		"6200000000000005": "UnionPay",
+		"36227206271667":   "Diners Club",
+		"3056930009020004": "Diners Club",
+		"3566002020360505": "JCB",
+		"2200000000000004": "Mir",
+		"6759649826438453": "Maestro",
+	} {
+		detected, ok := card.DetectBrand(number)
+		assert.True(t, ok, number)
+		assert.Equal(t, brand, detected.Name, number)
+	}
+
+	_, ok := card.DetectBrand("9999999999999995")
+	assert.False(t, ok)
+
+	assert.True(t, card.Amex.ValidLength(15))
+	assert.False(t, card.Amex.ValidLength(16))
+	assert.Equal(t, 4, card.Amex.CVCLength)
+}
+
+func TestMask(t *testing.T) {
+	assert.Equal(t, "************4242", card.Mask("4242424242424242"))
+	assert.Equal(t, "***********0005", card.Mask("378282246310005"))
+	assert.Equal(t, "42", card.Mask("42"))
+}
@@ -9,19 +9,18 @@ import (
 
 var (
 	log     = zap.NewNop().Sugar()
+	level   = zap.NewAtomicLevel()
 	once    sync.Once
 	errInit error
 )
 
-func Initialize(level string) error {
+func Initialize(lvl string) error {
 	once.Do(func() {
-		lvl, err := zap.ParseAtomicLevel(level)
-		if err != nil {
-			errInit = err
+		if errInit = SetLevel(lvl); errInit != nil {
 			return
 		}
 		cfg := zap.NewProductionConfig()
-		cfg.Level = lvl
+		cfg.Level = level
 		cfg.EncoderConfig.EncodeTime = zapcore.TimeEncoderOfLayout("2006-01-02 15:04:05")
 		zl, err := cfg.Build()
 		if err != nil {
@@ -33,6 +32,16 @@ func Initialize(level string) error {
 	return errInit
 }
 
+// SetLevel changes the level of the logger, also after it has been initialized.
+func SetLevel(lvl string) error {
+	parsed, err := zapcore.ParseLevel(lvl)
+	if err != nil {
+		return err
+	}
+	level.SetLevel(parsed)
+	return nil
+}
+
 func Log() *zap.SugaredLogger {
 	return log
 }
@@ -0,0 +1,279 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPollInterval is used when the provider doesn't specify how often to poll for the device token.
+	DefaultPollInterval = 5 * time.Second
+	// The interval is increased by this much when the provider asks to slow down.
+	slowDownStep = 5 * time.Second
+	randomBytes  = 32
+)
+
+var (
+	ErrDeviceFlowUnsupported = errors.New("provider doesn't support the device authorization grant")
+	ErrAccessDenied          = errors.New("authorization has been denied")
+	ErrExpired               = errors.New("authorization has expired")
+	ErrStateMismatch         = errors.New("state of the redirect doesn't match")
+	ErrNoIDToken             = errors.New("provider didn't return an id token")
+)
+
+// Token is the response of the token endpoint.
+type Token struct {
+	IDToken     string `json:"id_token"`
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// DeviceAuthorization is the response of the device authorization endpoint. The user
+// visits the verification URI and enters the user code.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval,omitempty"`
+}
+
+// tokenError is the error response of the token endpoint (RFC 6749 section 5.2).
+type tokenError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *tokenError) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return e.Code + ": " + e.Description
+}
+
+// Client signs the user in with a public client registered at the provider.
+type Client struct {
+	HTTP     *http.Client
+	Provider Provider
+	ClientID string
+	Scopes   []string
+}
+
+func (c *Client) scope() string {
+	scopes := []string{"openid"}
+	for _, scope := range c.Scopes {
+		if scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return strings.Join(scopes, " ")
+}
+
+func (c *Client) postForm(ctx context.Context, endpoint string, form url.Values, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body := json.NewDecoder(io.LimitReader(resp.Body, MaxResponseSize))
+	if resp.StatusCode != http.StatusOK {
+		tokenErr := &tokenError{}
+		if decodeErr := body.Decode(tokenErr); decodeErr != nil || tokenErr.Code == "" {
+			return fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		return tokenErr
+	}
+	return body.Decode(dst)
+}
+
+func (c *Client) exchange(ctx context.Context, form url.Values) (Token, error) {
+	var token Token
+	form.Set("client_id", c.ClientID)
+	if err := c.postForm(ctx, c.Provider.TokenEndpoint, form, &token); err != nil {
+		return token, err
+	}
+	if token.IDToken == "" {
+		return token, ErrNoIDToken
+	}
+	return token, nil
+}
+
+// AuthorizeDevice starts the device authorization grant.
+func (c *Client) AuthorizeDevice(ctx context.Context) (DeviceAuthorization, error) {
+	var auth DeviceAuthorization
+	if c.Provider.DeviceAuthorizationEndpoint == "" {
+		return auth, ErrDeviceFlowUnsupported
+	}
+	form := url.Values{"client_id": {c.ClientID}, "scope": {c.scope()}}
+	if err := c.postForm(ctx, c.Provider.DeviceAuthorizationEndpoint, form, &auth); err != nil {
+		return auth, fmt.Errorf("failed to authorize device: %w", err)
+	}
+	return auth, nil
+}
+
+// PollDeviceToken waits until the user has approved the device and returns the tokens.
+func (c *Client) PollDeviceToken(ctx context.Context, auth DeviceAuthorization) (Token, error) {
+	interval := DefaultPollInterval
+	if auth.Interval > 0 {
+		interval = time.Duration(auth.Interval) * time.Second
+	}
+	if auth.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(auth.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	form := url.Values{
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"device_code": {auth.DeviceCode},
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return Token{}, ErrExpired
+			}
+			return Token{}, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		token, err := c.exchange(ctx, form)
+		var tokenErr *tokenError
+		if !errors.As(err, &tokenErr) {
+			return token, err
+		}
+		switch tokenErr.Code {
+		case "authorization_pending":
+		case "slow_down":
+			interval += slowDownStep
+		case "access_denied":
+			return Token{}, ErrAccessDenied
+		case "expired_token":
+			return Token{}, ErrExpired
+		default:
+			return Token{}, fmt.Errorf("failed to get device token: %w", err)
+		}
+	}
+}
+
+func randomString() (string, error) {
+	b := make([]byte, randomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// LoopbackLogin runs the authorization code flow with PKCE. It listens on a random port of
+// the loopback interface, lets open direct the browser to the authorization endpoint and
+// exchanges the code the provider redirects back with. It returns the tokens and the nonce
+// the ID token must carry.
+func (c *Client) LoopbackLogin(ctx context.Context, open func(authURL string) error) (Token, string, error) {
+	var values [3]string
+	for i := range values {
+		var err error
+		if values[i], err = randomString(); err != nil {
+			return Token{}, "", err
+		}
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+	challenge := sha256.Sum256([]byte(verifier))
+
+	lis, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "127.0.0.1:0")
+	if err != nil {
+		return Token{}, "", fmt.Errorf("failed to listen on loopback: %w", err)
+	}
+	redirectURI := fmt.Sprintf("http://%s/callback", lis.Addr().String())
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+	server := &http.Server{
+		ReadHeaderTimeout: time.Minute,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/callback" {
+				http.NotFound(w, r)
+				return
+			}
+			query := r.URL.Query()
+			var res result
+			switch {
+			case query.Get("state") != state:
+				res.err = ErrStateMismatch
+			case query.Get("error") != "":
+				res.err = fmt.Errorf("%w: %s", ErrAccessDenied, query.Get("error"))
+			default:
+				res.code = query.Get("code")
+			}
+			if res.err != nil {
+				http.Error(w, "Sign-in failed, you can close this window.", http.StatusBadRequest)
+			} else {
+				_, _ = io.WriteString(w, "Signed in, you can close this window and return to the terminal.")
+			}
+			select {
+			case results <- res:
+			default:
+			}
+		}),
+	}
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Close()
+
+	authURL := c.Provider.AuthorizationEndpoint + "?" + url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.ClientID},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {c.scope()},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}.Encode()
+	if err = open(authURL); err != nil {
+		return Token{}, "", err
+	}
+
+	var res result
+	select {
+	case <-ctx.Done():
+		return Token{}, "", ctx.Err()
+	case res = <-results:
+	}
+	if res.err != nil {
+		return Token{}, "", res.err
+	}
+	token, err := c.exchange(ctx, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {res.code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier},
+	})
+	if err != nil {
+		return Token{}, "", fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	return token, nonce, nil
+}
@@ -0,0 +1,59 @@
+// Package oidc implements the parts of OpenID Connect gophkeeper needs: provider discovery,
+// the JSON Web Key Set of the provider and, for the CLI, the device authorization grant
+// (RFC 8628) and the authorization code flow with PKCE redirecting to a loopback address (RFC 8252).
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// MaxResponseSize limits the responses read from the provider.
+const MaxResponseSize = 1 << 20
+
+var ErrIssuerMismatch = errors.New("issuer of the provider metadata doesn't match")
+
+// Provider is the subset of the provider metadata (OpenID Connect Discovery 1.0) gophkeeper uses.
+type Provider struct {
+	Issuer                      string `json:"issuer"`
+	JWKSURI                     string `json:"jwks_uri"`
+	AuthorizationEndpoint       string `json:"authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
+}
+
+// Discover fetches the metadata of the issuer from its well-known location.
+func Discover(ctx context.Context, client *http.Client, issuer string) (Provider, error) {
+	var provider Provider
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, client, wellKnown, &provider); err != nil {
+		return provider, fmt.Errorf("failed to discover provider %s: %w", issuer, err)
+	}
+	if provider.Issuer != issuer {
+		return provider, fmt.Errorf("%w: %s", ErrIssuerMismatch, provider.Issuer)
+	}
+	return provider, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, MaxResponseSize)).Decode(dst)
+}
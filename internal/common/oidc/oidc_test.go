@@ -0,0 +1,197 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/common/oidc"
+	"github.com/itallix/gophkeeper/internal/common/oidc/oidctest"
+)
+
+func newClient(t *testing.T, issuer *oidctest.Issuer) *oidc.Client {
+	provider, err := oidc.Discover(context.Background(), issuer.Client(), issuer.URL)
+	require.NoError(t, err)
+	return &oidc.Client{
+		HTTP:     issuer.Client(),
+		Provider: provider,
+		ClientID: issuer.ClientID,
+		Scopes:   []string{"openid", "groups"},
+	}
+}
+
+func claimsOf(t *testing.T, idToken string) jwt.MapClaims {
+	claims := jwt.MapClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(idToken, claims)
+	require.NoError(t, err)
+	return claims
+}
+
+func TestDiscover(t *testing.T) {
+	issuer := oidctest.NewIssuer("gophkeeper")
+	defer issuer.Close()
+
+	provider, err := oidc.Discover(context.Background(), issuer.Client(), issuer.URL)
+	require.NoError(t, err)
+	assert.Equal(t, issuer.URL+"/jwks", provider.JWKSURI)
+	assert.Equal(t, issuer.URL+"/device", provider.DeviceAuthorizationEndpoint)
+
+	keys, err := oidc.FetchKeys(context.Background(), issuer.Client(), provider)
+	require.NoError(t, err)
+	require.Len(t, keys.Keys, 1)
+	pub, err := keys.Keys[0].PublicKey()
+	require.NoError(t, err)
+	assert.IsType(t, &rsa.PublicKey{}, pub)
+
+	_, err = oidc.Discover(context.Background(), issuer.Client(), issuer.URL+"/")
+	require.ErrorIs(t, err, oidc.ErrIssuerMismatch)
+}
+
+func TestJSONWebKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		keyType any
+		wantErr bool
+	}{
+		{
+			name: "ec",
+			key: `{"kty":"EC","crv":"P-256","x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU",` +
+				`"y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"}`,
+			keyType: &ecdsa.PublicKey{},
+		},
+		{
+			name:    "ed25519",
+			key:     `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`,
+			keyType: ed25519.PublicKey{},
+		},
+		{
+			name: "ec point not on the curve",
+			key: `{"kty":"EC","crv":"P-256","x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU",` +
+				`"y":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU"}`,
+			wantErr: true,
+		},
+		{
+			name:    "symmetric",
+			key:     `{"kty":"oct","k":"c2VjcmV0"}`,
+			wantErr: true,
+		},
+		{
+			name:    "rsa without modulus",
+			key:     `{"kty":"RSA","e":"AQAB"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var key oidc.JSONWebKey
+			require.NoError(t, json.Unmarshal([]byte(tt.key), &key))
+			pub, err := key.PublicKey()
+			if tt.wantErr {
+				require.ErrorIs(t, err, oidc.ErrUnsupportedKey)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, tt.keyType, pub)
+		})
+	}
+}
+
+func TestDeviceFlow(t *testing.T) {
+	issuer := oidctest.NewIssuer("gophkeeper")
+	defer issuer.Close()
+	issuer.SetClaims(map[string]any{"sub": "u-1", "groups": []string{"eng"}})
+	client := newClient(t, issuer)
+
+	t.Run("approved", func(t *testing.T) {
+		auth, err := client.AuthorizeDevice(context.Background())
+		require.NoError(t, err)
+		assert.NotEmpty(t, auth.UserCode)
+		assert.Equal(t, issuer.URL+"/activate", auth.VerificationURI)
+
+		time.AfterFunc(1500*time.Millisecond, func() { issuer.Approve(auth.UserCode) })
+		token, err := client.PollDeviceToken(context.Background(), auth)
+		require.NoError(t, err)
+		assert.Equal(t, "u-1", claimsOf(t, token.IDToken)["sub"])
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		auth, err := client.AuthorizeDevice(context.Background())
+		require.NoError(t, err)
+		issuer.Deny(auth.UserCode)
+
+		_, err = client.PollDeviceToken(context.Background(), auth)
+		require.ErrorIs(t, err, oidc.ErrAccessDenied)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		auth, err := client.AuthorizeDevice(context.Background())
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = client.PollDeviceToken(ctx, auth)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		noDevice := *client
+		noDevice.Provider.DeviceAuthorizationEndpoint = ""
+		_, err := noDevice.AuthorizeDevice(context.Background())
+		require.ErrorIs(t, err, oidc.ErrDeviceFlowUnsupported)
+	})
+
+	t.Run("wrong client", func(t *testing.T) {
+		wrong := *client
+		wrong.ClientID = "other"
+		_, err := wrong.AuthorizeDevice(context.Background())
+		require.ErrorContains(t, err, "invalid_client")
+	})
+}
+
+func TestLoopbackLogin(t *testing.T) {
+	issuer := oidctest.NewIssuer("gophkeeper")
+	defer issuer.Close()
+	client := newClient(t, issuer)
+
+	browse := func(authURL string) error {
+		resp, err := http.Get(authURL) //nolint:noctx // follows the redirect like a browser would
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	t.Run("code is exchanged", func(t *testing.T) {
+		token, nonce, err := client.LoopbackLogin(context.Background(), browse)
+		require.NoError(t, err)
+		claims := claimsOf(t, token.IDToken)
+		assert.Equal(t, "alice", claims["sub"])
+		assert.Equal(t, nonce, claims["nonce"])
+	})
+
+	t.Run("forged redirect", func(t *testing.T) {
+		forge := func(authURL string) error {
+			req := httptest.NewRequest(http.MethodGet, authURL, nil)
+			redirect := req.URL.Query().Get("redirect_uri") + "?code=stolen&state=guess"
+			resp, err := http.Get(redirect) //nolint:noctx // plays the attacker's browser
+			if err != nil {
+				return err
+			}
+			return resp.Body.Close()
+		}
+		_, _, err := client.LoopbackLogin(context.Background(), forge)
+		require.ErrorIs(t, err, oidc.ErrStateMismatch)
+	})
+}
@@ -0,0 +1,280 @@
+// Package oidctest provides a minimal OpenID Connect provider for tests. It supports
+// discovery, the key set, the device authorization grant and the authorization code
+// flow with PKCE, approving every authorization request right away.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/itallix/gophkeeper/internal/common/oidc"
+)
+
+const (
+	keyBits      = 2048
+	tokenTTL     = time.Hour
+	deviceTTLSec = 600
+)
+
+type device struct {
+	userCode string
+	approved bool
+	denied   bool
+}
+
+type authCode struct {
+	redirectURI string
+	challenge   string
+	nonce       string
+}
+
+// Issuer is an OpenID Connect provider backed by httptest.Server, its URL is the issuer identifier.
+type Issuer struct {
+	*httptest.Server
+	ClientID string
+	// Interval is the device polling interval in seconds announced to clients.
+	Interval int
+	// AutoApprove approves devices without waiting for Approve.
+	AutoApprove bool
+
+	mu      sync.Mutex
+	key     *rsa.PrivateKey
+	keyID   string
+	claims  map[string]any
+	devices map[string]*device
+	codes   map[string]authCode
+}
+
+// NewIssuer starts a provider with a public client. Tokens are issued for the subject
+// "alice" until SetClaims says otherwise.
+func NewIssuer(clientID string) *Issuer {
+	i := &Issuer{
+		ClientID: clientID,
+		Interval: 1,
+		claims:   map[string]any{"sub": "alice"},
+		devices:  make(map[string]*device),
+		codes:    make(map[string]authCode),
+	}
+	i.RotateKey()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", i.discovery)
+	mux.HandleFunc("GET /jwks", i.jwks)
+	mux.HandleFunc("POST /device", i.authorizeDevice)
+	mux.HandleFunc("GET /authorize", i.authorize)
+	mux.HandleFunc("POST /token", i.token)
+	i.Server = httptest.NewServer(mux)
+	return i
+}
+
+func random() string {
+	b := make([]byte, 16) //nolint:mnd // 128 bits
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// RotateKey replaces the signing key, tokens signed with the old key no longer verify.
+func (i *Issuer) RotateKey() {
+	key, err := rsa.GenerateKey(rand.Reader, keyBits)
+	if err != nil {
+		panic(err)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.key, i.keyID = key, random()
+}
+
+// SetClaims sets the claims of the tokens issued through the flows, on top of the registered ones.
+func (i *Issuer) SetClaims(claims map[string]any) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.claims = maps.Clone(claims)
+}
+
+// IDToken signs an ID token for the client. The claims override the defaults,
+// a nil value removes the claim.
+func (i *Issuer) IDToken(claims map[string]any) string {
+	now := time.Now()
+	all := jwt.MapClaims{
+		"iss": i.URL,
+		"aud": i.ClientID,
+		"iat": now.Unix(),
+		"exp": now.Add(tokenTTL).Unix(),
+	}
+	for name, value := range claims {
+		if value == nil {
+			delete(all, name)
+		} else {
+			all[name] = value
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, all)
+	token.Header["kid"] = i.keyID
+	signed, err := token.SignedString(i.key)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
+
+// Approve lets the device that was given the user code sign in.
+func (i *Issuer) Approve(userCode string) {
+	i.decide(userCode, true)
+}
+
+// Deny refuses the device that was given the user code.
+func (i *Issuer) Deny(userCode string) {
+	i.decide(userCode, false)
+}
+
+func (i *Issuer) decide(userCode string, approve bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, d := range i.devices {
+		if d.userCode == userCode {
+			d.approved, d.denied = approve, !approve
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func (i *Issuer) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, oidc.Provider{
+		Issuer:                      i.URL,
+		JWKSURI:                     i.URL + "/jwks",
+		AuthorizationEndpoint:       i.URL + "/authorize",
+		TokenEndpoint:               i.URL + "/token",
+		DeviceAuthorizationEndpoint: i.URL + "/device",
+	})
+}
+
+func (i *Issuer) jwks(w http.ResponseWriter, _ *http.Request) {
+	i.mu.Lock()
+	pub, kid := i.key.PublicKey, i.keyID
+	i.mu.Unlock()
+	writeJSON(w, http.StatusOK, oidc.JSONWebKeySet{Keys: []oidc.JSONWebKey{{
+		KeyType:   "RSA",
+		KeyID:     kid,
+		Use:       "sig",
+		Algorithm: "RS256",
+		N:         base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}}})
+}
+
+func (i *Issuer) authorizeDevice(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("client_id") != i.ClientID {
+		tokenError(w, "invalid_client")
+		return
+	}
+	deviceCode, userCode := random(), random()[:8]
+	i.mu.Lock()
+	i.devices[deviceCode] = &device{userCode: userCode, approved: i.AutoApprove}
+	i.mu.Unlock()
+	writeJSON(w, http.StatusOK, oidc.DeviceAuthorization{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         i.URL + "/activate",
+		VerificationURIComplete: i.URL + "/activate?user_code=" + userCode,
+		ExpiresIn:               deviceTTLSec,
+		Interval:                i.Interval,
+	})
+}
+
+func (i *Issuer) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || redirectURI.Hostname() != "127.0.0.1" || query.Get("client_id") != i.ClientID ||
+		query.Get("response_type") != "code" || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	code := random()
+	i.mu.Lock()
+	i.codes[code] = authCode{
+		redirectURI: redirectURI.String(),
+		challenge:   query.Get("code_challenge"),
+		nonce:       query.Get("nonce"),
+	}
+	i.mu.Unlock()
+
+	redirect := redirectURI.Query()
+	redirect.Set("code", code)
+	redirect.Set("state", query.Get("state"))
+	redirectURI.RawQuery = redirect.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (i *Issuer) token(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("client_id") != i.ClientID {
+		tokenError(w, "invalid_client")
+		return
+	}
+	i.mu.Lock()
+	claims := maps.Clone(i.claims)
+	switch r.FormValue("grant_type") {
+	case "urn:ietf:params:oauth:grant-type:device_code":
+		d, ok := i.devices[r.FormValue("device_code")]
+		i.mu.Unlock()
+		switch {
+		case !ok:
+			tokenError(w, "expired_token")
+			return
+		case d.denied:
+			tokenError(w, "access_denied")
+			return
+		case !d.approved:
+			tokenError(w, "authorization_pending")
+			return
+		}
+	case "authorization_code":
+		code, ok := i.codes[r.FormValue("code")]
+		delete(i.codes, r.FormValue("code"))
+		i.mu.Unlock()
+		verifier := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+		if !ok || code.redirectURI != r.FormValue("redirect_uri") ||
+			code.challenge != base64.RawURLEncoding.EncodeToString(verifier[:]) {
+			tokenError(w, "invalid_grant")
+			return
+		}
+		if code.nonce != "" {
+			claims["nonce"] = code.nonce
+		}
+	default:
+		i.mu.Unlock()
+		tokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, oidc.Token{
+		IDToken:     i.IDToken(claims),
+		AccessToken: random(),
+		TokenType:   "Bearer",
+		ExpiresIn:   int(tokenTTL.Seconds()),
+	})
+}
@@ -0,0 +1,172 @@
+// Package password generates random passwords and diceware passphrases, estimates
+// password strength and checks passwords against a policy. It is shared by the
+// client, which generates and rates passwords, and the server, which enforces
+// the policy.
+package password
+
+import (
+	"bufio"
+	"crypto/rand"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+const (
+	lowerChars     = "abcdefghijklmnopqrstuvwxyz"
+	upperChars     = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars     = "0123456789"
+	symbolChars    = "!@#$%^&*()-_=+[]{};:,.?/"
+	ambiguousChars = "0O1lI"
+
+	DefaultLength = 20
+	MaxLength     = 1024
+	DefaultWords  = 6
+	MinWords      = 3
+	MaxWords      = 64
+)
+
+var (
+	ErrNoCharClasses   = errors.New("at least one character class must be enabled")
+	ErrInvalidLength   = errors.New("invalid password length")
+	ErrInvalidWordsNum = errors.New("invalid number of words")
+)
+
+// The EFF large wordlist (https://www.eff.org/dice), licensed under CC BY 3.0 US.
+//
+//go:embed eff_large_wordlist.txt
+var effWordlist string
+
+var wordlist = sync.OnceValue(func() []string {
+	words := make([]string, 0, 7776) //nolint:mnd // 6^5 dice rolls
+	scanner := bufio.NewScanner(strings.NewReader(effWordlist))
+	for scanner.Scan() {
+		if _, word, ok := strings.Cut(scanner.Text(), "\t"); ok {
+			words = append(words, word)
+		}
+	}
+	return words
+})
+
+// Options defines the character classes and the length of a generated password.
+type Options struct {
+	Length           int
+	Lower            bool
+	Upper            bool
+	Digits           bool
+	Symbols          bool
+	ExcludeAmbiguous bool
+}
+
+// DefaultOptions returns options producing a password with all character classes.
+func DefaultOptions() Options {
+	return Options{
+		Length:  DefaultLength,
+		Lower:   true,
+		Upper:   true,
+		Digits:  true,
+		Symbols: true,
+	}
+}
+
+func (o Options) classes() []string {
+	var classes []string
+	for _, class := range []struct {
+		enabled bool
+		chars   string
+	}{
+		{o.Lower, lowerChars},
+		{o.Upper, upperChars},
+		{o.Digits, digitChars},
+		{o.Symbols, symbolChars},
+	} {
+		if !class.enabled {
+			continue
+		}
+		chars := class.chars
+		if o.ExcludeAmbiguous {
+			chars = strings.Map(func(r rune) rune {
+				if strings.ContainsRune(ambiguousChars, r) {
+					return -1
+				}
+				return r
+			}, chars)
+		}
+		classes = append(classes, chars)
+	}
+	return classes
+}
+
+// Entropy returns the number of bits of entropy of a password generated with these options.
+func (o Options) Entropy() float64 {
+	return float64(o.Length) * math.Log2(float64(len(strings.Join(o.classes(), ""))))
+}
+
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read random data: %w", err)
+	}
+	return int(i.Int64()), nil
+}
+
+// Generate returns a random password containing at least one character of every enabled class.
+func Generate(opts Options) (string, error) {
+	classes := opts.classes()
+	if len(classes) == 0 {
+		return "", ErrNoCharClasses
+	}
+	if opts.Length < len(classes) || opts.Length > MaxLength {
+		return "", fmt.Errorf("%w: should be between %d and %d", ErrInvalidLength, len(classes), MaxLength)
+	}
+
+	all := strings.Join(classes, "")
+	result := make([]byte, opts.Length)
+	for i := range result {
+		chars := all
+		if i < len(classes) {
+			chars = classes[i]
+		}
+		idx, err := randomIndex(len(chars))
+		if err != nil {
+			return "", err
+		}
+		result[i] = chars[idx]
+	}
+
+	// Fisher-Yates shuffle, so the mandatory characters do not always come first.
+	for i := len(result) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		result[i], result[j] = result[j], result[i]
+	}
+	return string(result), nil
+}
+
+// Passphrase returns the given number of random words from the EFF wordlist joined by the separator.
+func Passphrase(words int, separator string) (string, error) {
+	if words < MinWords || words > MaxWords {
+		return "", fmt.Errorf("%w: should be between %d and %d", ErrInvalidWordsNum, MinWords, MaxWords)
+	}
+	list := wordlist()
+	result := make([]string, words)
+	for i := range result {
+		idx, err := randomIndex(len(list))
+		if err != nil {
+			return "", err
+		}
+		result[i] = list[idx]
+	}
+	return strings.Join(result, separator), nil
+}
+
+// PassphraseEntropy returns the number of bits of entropy of a passphrase with the given number of words.
+func PassphraseEntropy(words int) float64 {
+	return float64(words) * math.Log2(float64(len(wordlist())))
+}
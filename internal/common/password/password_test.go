@@ -0,0 +1,128 @@
+package password_test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/common/password"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("contains every enabled class", func(t *testing.T) {
+		for range 50 {
+			p, err := password.Generate(password.Options{Length: 4, Lower: true, Upper: true, Digits: true, Symbols: true})
+			require.NoError(t, err)
+			assert.Len(t, p, 4)
+			assert.True(t, strings.IndexFunc(p, unicode.IsLower) >= 0, p)
+			assert.True(t, strings.IndexFunc(p, unicode.IsUpper) >= 0, p)
+			assert.True(t, strings.IndexFunc(p, unicode.IsDigit) >= 0, p)
+			assert.True(t, strings.ContainsAny(p, "!@#$%^&*()-_=+[]{};:,.?/"), p)
+		}
+	})
+
+	t.Run("excludes ambiguous characters", func(t *testing.T) {
+		opts := password.DefaultOptions()
+		opts.Length = 200
+		opts.ExcludeAmbiguous = true
+		p, err := password.Generate(opts)
+		require.NoError(t, err)
+		assert.False(t, strings.ContainsAny(p, "0O1lI"))
+	})
+
+	t.Run("digits only", func(t *testing.T) {
+		p, err := password.Generate(password.Options{Length: 8, Digits: true})
+		require.NoError(t, err)
+		assert.Regexp(t, `^[0-9]{8}$`, p)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := password.Generate(password.Options{Length: 10})
+		require.ErrorIs(t, err, password.ErrNoCharClasses)
+		_, err = password.Generate(password.Options{Length: 2, Lower: true, Upper: true, Digits: true})
+		require.ErrorIs(t, err, password.ErrInvalidLength)
+	})
+
+	t.Run("entropy", func(t *testing.T) {
+		assert.InDelta(t, 33.2, password.Options{Length: 10, Digits: true}.Entropy(), 0.1)
+		assert.InDelta(t, 77.5, password.PassphraseEntropy(6), 0.1)
+	})
+}
+
+func TestPassphrase(t *testing.T) {
+	p, err := password.Passphrase(6, "-")
+	require.NoError(t, err)
+	words := strings.Split(p, "-")
+	assert.Len(t, words, 6)
+	for _, w := range words {
+		assert.NotEmpty(t, w)
+	}
+
+	_, err = password.Passphrase(2, " ")
+	require.ErrorIs(t, err, password.ErrInvalidWordsNum)
+}
+
+func TestEstimate(t *testing.T) {
+	generated, err := password.Generate(password.DefaultOptions())
+	require.NoError(t, err)
+	passphrase, err := password.Passphrase(6, " ")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		minScore int
+		maxScore int
+		feedback string
+	}{
+		{name: "common password", password: "P@ssw0rd", maxScore: password.ScoreVeryWeak,
+			feedback: "this is one of the most commonly used passwords"},
+		{name: "repeated characters", password: "aaaaaaaaaaaaaaaa", maxScore: password.ScoreVeryWeak,
+			feedback: "avoid repeated characters"},
+		{name: "sequence", password: "abcdefgh12345678", maxScore: password.ScoreWeak,
+			feedback: "avoid sequences like abc or 123"},
+		{name: "keyboard walk", password: "asdfghjkl;", maxScore: password.ScoreWeak,
+			feedback: "avoid keyboard patterns like qwerty"},
+		{name: "single word", password: "Umbrella", maxScore: password.ScoreVeryWeak,
+			feedback: "avoid dictionary words or combine several unrelated ones"},
+		{name: "generated password", password: generated, minScore: password.ScoreVeryStrong,
+			maxScore: password.ScoreVeryStrong},
+		{name: "diceware passphrase", password: passphrase, minScore: password.ScoreStrong,
+			maxScore: password.ScoreVeryStrong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strength := password.Estimate(tt.password)
+			assert.GreaterOrEqual(t, strength.Score, tt.minScore, strength)
+			assert.LessOrEqual(t, strength.Score, tt.maxScore, strength)
+			if tt.feedback != "" {
+				assert.Contains(t, strength.Feedback, tt.feedback)
+			}
+		})
+	}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	policy := password.Policy{
+		MinLength:     10,
+		RequireLower:  true,
+		RequireUpper:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MinScore:      password.ScoreStrong,
+	}
+
+	assert.Empty(t, policy.Check("Tr0ub4dor&3-horse-staple"))
+	assert.Equal(t, []string{
+		"password should be at least 10 characters",
+		"password should contain an upper case letter",
+		"password should contain a digit",
+		"password should contain a symbol",
+		"password is very weak, it should be at least strong",
+	}, policy.Check("secret"))
+	assert.Empty(t, password.Policy{}.Check(""))
+}
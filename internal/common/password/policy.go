@@ -0,0 +1,63 @@
+package password
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// Policy describes the requirements a password has to meet.
+type Policy struct {
+	MinLength     int
+	RequireLower  bool
+	RequireUpper  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// MinScore is the lowest acceptable Estimate score, zero disables the check.
+	MinScore int
+}
+
+// Check returns a description of every requirement the password violates.
+func (p Policy) Check(password string) []string {
+	var violations []string
+
+	if n := len([]rune(password)); n < p.MinLength {
+		violations = append(violations, fmt.Sprintf("password should be at least %d characters", p.MinLength))
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	for _, rule := range []struct {
+		required bool
+		present  bool
+		message  string
+	}{
+		{p.RequireLower, lower, "password should contain a lower case letter"},
+		{p.RequireUpper, upper, "password should contain an upper case letter"},
+		{p.RequireDigit, digit, "password should contain a digit"},
+		{p.RequireSymbol, symbol, "password should contain a symbol"},
+	} {
+		if rule.required && !rule.present {
+			violations = append(violations, rule.message)
+		}
+	}
+
+	if p.MinScore > ScoreVeryWeak {
+		if strength := Estimate(password); strength.Score < p.MinScore {
+			violations = append(violations, fmt.Sprintf("password is %s, it should be at least %s",
+				strength.Label(), scoreLabels[min(p.MinScore, ScoreVeryStrong)]))
+		}
+	}
+
+	return violations
+}
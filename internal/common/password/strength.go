@@ -0,0 +1,280 @@
+package password
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Score thresholds in bits. The estimator follows the idea of zxcvbn: the password
+// is split into patterns an attacker would try first (common passwords, dictionary
+// words, repeats, sequences and keyboard walks) and only the remaining characters
+// are rated by the size of their character pool.
+const (
+	ScoreVeryWeak = iota
+	ScoreWeak
+	ScoreFair
+	ScoreStrong
+	ScoreVeryStrong
+
+	weakBits   = 25
+	fairBits   = 40
+	strongBits = 60
+	greatBits  = 80
+
+	minPatternLen    = 3
+	minWordLen       = 4
+	maxWordLen       = 9 // longest word of the EFF wordlist
+	recommendedLen   = 12
+	commonPassword   = 10 // bits, roughly the rank in the list of most used passwords
+	capitalizationBt = 1
+	substitutionBits = 1
+)
+
+var scoreLabels = []string{"very weak", "weak", "fair", "strong", "very strong"}
+
+var commonPasswords = []string{
+	"123456", "password", "12345678", "qwerty", "123456789", "12345", "1234", "111111",
+	"1234567", "dragon", "123123", "baseball", "abc123", "football", "monkey", "letmein",
+	"696969", "shadow", "master", "666666", "qwertyuiop", "123321", "mustang", "1234567890",
+	"michael", "654321", "superman", "1qaz2wsx", "7777777", "121212", "000000", "qazwsx",
+	"123qwe", "killer", "trustno1", "jordan", "jennifer", "zxcvbnm", "asdfgh", "hunter",
+	"buster", "soccer", "harley", "batman", "andrew", "tigger", "sunshine", "iloveyou",
+	"2000", "charlie", "robert", "thomas", "hockey", "ranger", "daniel", "starwars",
+	"klaster", "112233", "george", "computer", "michelle", "jessica", "pepper", "1111",
+	"zxcvbn", "555555", "11111111", "131313", "freedom", "777777", "pass", "maggie",
+	"159753", "aaaaaa", "ginger", "princess", "joshua", "cheese", "amanda", "summer",
+	"love", "ashley", "nicole", "chelsea", "biteme", "matthew", "access", "yankees",
+	"987654321", "dallas", "austin", "thunder", "taylor", "matrix", "admin", "welcome",
+	"passw0rd", "p@ssw0rd", "qwerty123", "password1", "changeme", "secret",
+}
+
+var keyboardRows = []string{
+	"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./",
+	"1qaz2wsx3edc4rfv5tgb6yhn7ujm8ik,9ol.0p;/", "qazwsxedcrfvtgbyhnujmikolp",
+}
+
+var leetSubstitutions = map[rune]rune{
+	'4': 'a', '@': 'a', '8': 'b', '3': 'e', '6': 'g', '1': 'l', '!': 'i',
+	'0': 'o', '5': 's', '$': 's', '7': 't', '+': 't', '2': 'z',
+}
+
+var dictionary = sync.OnceValue(func() map[string]struct{} {
+	words := make(map[string]struct{}, len(wordlist()))
+	for _, w := range wordlist() {
+		if len(w) >= minWordLen {
+			words[w] = struct{}{}
+		}
+	}
+	return words
+})
+
+// Strength is the result of a password strength estimation.
+type Strength struct {
+	// Entropy is the estimated number of bits an attacker has to guess.
+	Entropy float64
+	// Score rates the entropy from ScoreVeryWeak to ScoreVeryStrong.
+	Score int
+	// Feedback suggests how to improve the password.
+	Feedback []string
+}
+
+// Label returns a human readable description of the score.
+func (s Strength) Label() string {
+	return scoreLabels[s.Score]
+}
+
+func scoreOf(bits float64) int {
+	switch {
+	case bits < weakBits:
+		return ScoreVeryWeak
+	case bits < fairBits:
+		return ScoreWeak
+	case bits < strongBits:
+		return ScoreFair
+	case bits < greatBits:
+		return ScoreStrong
+	}
+	return ScoreVeryStrong
+}
+
+func unleet(s string) (string, bool) {
+	substituted := false
+	normalized := strings.Map(func(r rune) rune {
+		if plain, ok := leetSubstitutions[r]; ok {
+			substituted = true
+			return plain
+		}
+		return unicode.ToLower(r)
+	}, s)
+	return normalized, substituted
+}
+
+// poolSize returns the size of the character pool an attacker has to search for the password.
+func poolSize(password string) int {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range password {
+		switch {
+		case r < unicode.MaxASCII && unicode.IsLower(r):
+			lower = true
+		case r < unicode.MaxASCII && unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case r < unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+	size := 0
+	for _, class := range []struct {
+		present bool
+		size    int
+	}{
+		{lower, len(lowerChars)}, {upper, len(upperChars)}, {digit, len(digitChars)},
+		{symbol, 33}, {other, 100}, //nolint:mnd // printable ASCII symbols and a guess for the rest
+	} {
+		if class.present {
+			size += class.size
+		}
+	}
+	return max(size, 1)
+}
+
+type estimator struct {
+	runes    []rune
+	charBits float64
+	feedback []string
+	entropy  float64
+}
+
+func (e *estimator) hint(text string) {
+	if !slices.Contains(e.feedback, text) {
+		e.feedback = append(e.feedback, text)
+	}
+}
+
+// matchWord returns the length of the longest dictionary word starting at i.
+func (e *estimator) matchWord(i int) (int, float64) {
+	words := dictionary()
+	for j := min(len(e.runes), i+maxWordLen); j-i >= minWordLen; j-- {
+		candidate := string(e.runes[i:j])
+		normalized, substituted := unleet(candidate)
+		if _, ok := words[normalized]; !ok {
+			continue
+		}
+		bits := math.Log2(float64(len(wordlist())))
+		if strings.ToLower(candidate) != candidate {
+			bits += capitalizationBt
+		}
+		if substituted {
+			bits += substitutionBits
+		}
+		return j - i, bits
+	}
+	return 0, 0
+}
+
+func (e *estimator) matchRepeat(i int) int {
+	j := i + 1
+	for j < len(e.runes) && e.runes[j] == e.runes[i] {
+		j++
+	}
+	return j - i
+}
+
+func (e *estimator) matchSequence(i int) int {
+	if i+1 >= len(e.runes) {
+		return 1
+	}
+	delta := e.runes[i+1] - e.runes[i]
+	if delta != 1 && delta != -1 {
+		return 1
+	}
+	j := i + 1
+	for j < len(e.runes) && e.runes[j]-e.runes[j-1] == delta {
+		j++
+	}
+	return j - i
+}
+
+func (e *estimator) matchKeyboard(i int) int {
+	longest := 0
+	lower := strings.ToLower(string(e.runes[i:]))
+	for _, row := range keyboardRows {
+		for n := len(lower); n > longest; n-- {
+			if strings.Contains(row, lower[:n]) {
+				longest = n
+				break
+			}
+		}
+	}
+	return longest
+}
+
+func (e *estimator) run() {
+	for i := 0; i < len(e.runes); {
+		if n, bits := e.matchWord(i); n > 0 {
+			e.entropy += bits
+			e.hint("avoid dictionary words or combine several unrelated ones")
+			i += n
+			continue
+		}
+		if n := e.matchRepeat(i); n >= minPatternLen {
+			e.entropy += e.charBits + math.Log2(float64(n))
+			e.hint("avoid repeated characters")
+			i += n
+			continue
+		}
+		if n := e.matchSequence(i); n >= minPatternLen {
+			e.entropy += e.charBits + math.Log2(float64(n)) + 1
+			e.hint("avoid sequences like abc or 123")
+			i += n
+			continue
+		}
+		if n := e.matchKeyboard(i); n >= minWordLen {
+			e.entropy += math.Log2(float64(len(keyboardRows)*len(keyboardRows[0]))) + math.Log2(float64(n))
+			e.hint("avoid keyboard patterns like qwerty")
+			i += n
+			continue
+		}
+		e.entropy += e.charBits
+		i++
+	}
+}
+
+// Estimate rates how hard the password is to guess.
+func Estimate(password string) Strength {
+	normalized, _ := unleet(password)
+	for _, common := range commonPasswords {
+		if strings.EqualFold(password, common) || normalized == common {
+			return Strength{
+				Entropy:  commonPassword,
+				Score:    ScoreVeryWeak,
+				Feedback: []string{"this is one of the most commonly used passwords"},
+			}
+		}
+	}
+
+	e := &estimator{
+		runes:    []rune(password),
+		charBits: math.Log2(float64(poolSize(password))),
+	}
+	e.run()
+
+	if len(e.runes) < recommendedLen {
+		e.hint("use at least 12 characters")
+	}
+	if poolSize(password) <= len(upperChars)+len(digitChars) {
+		e.hint("mix lower and upper case letters, digits and symbols")
+	}
+
+	strength := Strength{Entropy: e.entropy, Score: scoreOf(e.entropy)}
+	if strength.Score < ScoreVeryStrong {
+		strength.Feedback = e.feedback
+	}
+	return strength
+}
@@ -0,0 +1,27 @@
+// Package rpc holds what the client and the server need to agree on about the calls of GophkeeperService.
+package rpc
+
+import (
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// publicMethods are called without an access token: registration, the logins and the token exchanges,
+// and the discovery of the single sign-on configuration and the token signing keys.
+var publicMethods = map[string]bool{
+	pb.GophkeeperService_Register_FullMethodName:       true,
+	pb.GophkeeperService_Login_FullMethodName:          true,
+	pb.GophkeeperService_RefreshToken_FullMethodName:   true,
+	pb.GophkeeperService_SRPRegister_FullMethodName:    true,
+	pb.GophkeeperService_SRPBegin_FullMethodName:       true,
+	pb.GophkeeperService_SRPFinish_FullMethodName:      true,
+	pb.GophkeeperService_GetOIDCConfig_FullMethodName:  true,
+	pb.GophkeeperService_OIDCLogin_FullMethodName:      true,
+	pb.GophkeeperService_ExchangeAPIKey_FullMethodName: true,
+	pb.GophkeeperService_GetJWKS_FullMethodName:        true,
+}
+
+// IsPublic tells whether the method of GophkeeperService is called without an access token. The server
+// doesn't authenticate these calls and limits them by client address, the client doesn't attach a token.
+func IsPublic(method string) bool {
+	return publicMethods[method]
+}
@@ -0,0 +1,19 @@
+package rpc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/itallix/gophkeeper/internal/common/rpc"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestIsPublic(t *testing.T) {
+	assert.True(t, rpc.IsPublic(pb.GophkeeperService_Login_FullMethodName))
+	assert.True(t, rpc.IsPublic(pb.GophkeeperService_SRPBegin_FullMethodName))
+	assert.True(t, rpc.IsPublic(pb.GophkeeperService_GetJWKS_FullMethodName))
+	assert.False(t, rpc.IsPublic(pb.GophkeeperService_Get_FullMethodName))
+	assert.False(t, rpc.IsPublic(pb.GophkeeperService_RotateSigningKey_FullMethodName))
+	assert.False(t, rpc.IsPublic("/grpc.health.v1.Health/Check"))
+}
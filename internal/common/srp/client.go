@@ -0,0 +1,76 @@
+package srp
+
+import (
+	"math/big"
+)
+
+// Client performs the client side of a single login.
+type Client struct {
+	login    string
+	password string
+	a        *big.Int
+	pubA     *big.Int
+	key      []byte
+	m1       []byte
+	pubB     *big.Int
+}
+
+// NewClient starts a login and generates the ephemeral key pair.
+func NewClient(login, password string) (*Client, error) {
+	secret, err := randomBytes(secretLen)
+	if err != nil {
+		return nil, err
+	}
+	a := new(big.Int).SetBytes(secret)
+	return &Client{
+		login:    login,
+		password: password,
+		a:        a,
+		pubA:     new(big.Int).Exp(groupG, a, groupN),
+	}, nil
+}
+
+// Public returns A, sent to the server to start the exchange.
+func (c *Client) Public() []byte {
+	return c.pubA.Bytes()
+}
+
+// Proof processes the server challenge and returns M1 proving the knowledge of the password.
+func (c *Client) Proof(salt, serverPublic []byte) ([]byte, error) {
+	b, err := publicValue(serverPublic)
+	if err != nil {
+		return nil, err
+	}
+	u, err := scrambler(c.pubA, b)
+	if err != nil {
+		return nil, err
+	}
+	x := privateKey(c.login, c.password, salt)
+
+	// S = (B - k * g^x) ^ (a + u * x) mod N
+	base := new(big.Int).Exp(groupG, x, groupN)
+	base.Mul(base, multiplier)
+	base.Sub(b, base)
+	base.Mod(base, groupN)
+	exp := new(big.Int).Mul(u, x)
+	exp.Add(exp, c.a)
+	s := new(big.Int).Exp(base, exp, groupN)
+
+	c.pubB = b
+	c.key = hash(s.Bytes())
+	c.m1 = clientProof(c.login, salt, c.pubA, b, c.key)
+	return c.m1, nil
+}
+
+// VerifyServer checks M2, proving the server knows the verifier and derived the same key.
+func (c *Client) VerifyServer(proof []byte) error {
+	if c.m1 == nil || !equal(proof, serverProof(c.pubA, c.m1, c.key)) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// Key returns the shared session key once the exchange is complete.
+func (c *Client) Key() []byte {
+	return c.key
+}
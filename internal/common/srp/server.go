@@ -0,0 +1,73 @@
+package srp
+
+import (
+	"math/big"
+)
+
+// Server performs the server side of a single login for the stored salt and verifier.
+type Server struct {
+	login string
+	salt  []byte
+	v     *big.Int
+	b     *big.Int
+	pubA  *big.Int
+	pubB  *big.Int
+}
+
+// NewServer answers the client public value A with a challenge.
+func NewServer(login string, salt, verifier, clientPublic []byte) (*Server, error) {
+	a, err := publicValue(clientPublic)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomBytes(secretLen)
+	if err != nil {
+		return nil, err
+	}
+	v := new(big.Int).SetBytes(verifier)
+	b := new(big.Int).SetBytes(secret)
+
+	// B = k * v + g^b mod N
+	pubB := new(big.Int).Mul(multiplier, v)
+	pubB.Add(pubB, new(big.Int).Exp(groupG, b, groupN))
+	pubB.Mod(pubB, groupN)
+
+	return &Server{
+		login: login,
+		salt:  salt,
+		v:     v,
+		b:     b,
+		pubA:  a,
+		pubB:  pubB,
+	}, nil
+}
+
+// Salt returns the salt the client derives its private key with.
+func (s *Server) Salt() []byte {
+	return s.salt
+}
+
+// Public returns B, sent to the client together with the salt.
+func (s *Server) Public() []byte {
+	return s.pubB.Bytes()
+}
+
+// Verify checks the client proof M1 and returns the server proof M2 and the shared session key.
+func (s *Server) Verify(proof []byte) ([]byte, []byte, error) {
+	u, err := scrambler(s.pubA, s.pubB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// S = (A * v^u) ^ b mod N
+	base := new(big.Int).Exp(s.v, u, groupN)
+	base.Mul(base, s.pubA)
+	base.Mod(base, groupN)
+	secret := new(big.Int).Exp(base, s.b, groupN)
+
+	key := hash(secret.Bytes())
+	if !equal(proof, clientProof(s.login, s.salt, s.pubA, s.pubB, key)) {
+		return nil, nil, ErrInvalidProof
+	}
+	return serverProof(s.pubA, proof, key), key, nil
+}
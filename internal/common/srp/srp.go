@@ -0,0 +1,123 @@
+// Package srp implements the SRP-6a password-authenticated key exchange (RFC 5054)
+// with SHA-256 over the 2048-bit group. The server only stores a salt and a verifier
+// derived from the password, so neither registration nor login reveal the password.
+//
+// The private value x is derived with Argon2id instead of a single hash, which makes
+// guessing passwords from a leaked verifier as expensive as from a password hash.
+package srp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	SaltLen = 16
+	// The length of the ephemeral private values in bytes.
+	secretLen = 32
+
+	kdfMemory  = 64 * 1024
+	kdfTime    = 3
+	kdfThreads = 2
+	kdfKeyLen  = 32
+)
+
+var (
+	ErrInvalidPublic = errors.New("invalid public value")
+	ErrInvalidProof  = errors.New("invalid proof")
+)
+
+// The 2048-bit group from RFC 5054 Appendix A.
+var (
+	groupN, _ = new(big.Int).SetString("AC6BDB41324A9A9BF166DE5E1389582FAF72B6651987EE07FC3192943DB56050"+
+		"A37329CBB4A099ED8193E0757767A13DD52312AB4B03310DCD7F48A9DA04FD50E8083969EDB767B0CF6095179A163AB3661A05FB"+
+		"D5FAAAE82918A9962F0B93B855F97993EC975EEAA80D740ADBF4FF747359D041D5C33EA71D281E446B14773BCA97B43A23FB8016"+
+		"76BD207A436C6481F1D2B9078717461A5B9D32E688F87748544523B524B0D57D5EA77A2775D2ECFA032CFBDBF52FB3786160279004"+
+		"E57AE6AF874E7303CE53299CCC041C7BC308D82A5698F3A8D0C38271AE35F8E9DBFBB694B5C803D89F7AE435DE236D525F54759B65"+
+		"E372FCD68EF20FA7111F9E4AFF73", 16)
+	groupG = big.NewInt(2)
+	// k = H(N | PAD(g)).
+	multiplier = new(big.Int).SetBytes(hash(groupN.Bytes(), pad(groupG)))
+)
+
+func hash(parts ...[]byte) []byte {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
+// pad left-pads the value with zeros to the length of N.
+func pad(v *big.Int) []byte {
+	buf := make([]byte, (groupN.BitLen()+7)/8) //nolint:mnd // bits to bytes
+	return v.FillBytes(buf)
+}
+
+func randomBytes(n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return buf, nil
+}
+
+// NewSalt generates a random salt for a new verifier.
+func NewSalt() ([]byte, error) {
+	return randomBytes(SaltLen)
+}
+
+// privateKey derives x = H(s | Argon2id(I ":" P, s)).
+func privateKey(login, password string, salt []byte) *big.Int {
+	stretched := argon2.IDKey([]byte(login+":"+password), salt, kdfTime, kdfMemory, kdfThreads, kdfKeyLen)
+	return new(big.Int).SetBytes(hash(salt, stretched))
+}
+
+// Verifier computes v = g^x mod N, stored by the server in place of a password hash.
+func Verifier(login, password string, salt []byte) []byte {
+	x := privateKey(login, password, salt)
+	return new(big.Int).Exp(groupG, x, groupN).Bytes()
+}
+
+// publicValue parses the peer's public value, rejecting values outside of 1..N-1. Larger values would not
+// fit into PAD and are the same as their remainder modulo N anyway.
+func publicValue(b []byte) (*big.Int, error) {
+	v := new(big.Int).SetBytes(b)
+	if v.Sign() == 0 || v.Cmp(groupN) >= 0 {
+		return nil, ErrInvalidPublic
+	}
+	return v, nil
+}
+
+// scrambler computes u = H(PAD(A) | PAD(B)).
+func scrambler(a, b *big.Int) (*big.Int, error) {
+	u := new(big.Int).SetBytes(hash(pad(a), pad(b)))
+	if u.Sign() == 0 {
+		return nil, ErrInvalidPublic
+	}
+	return u, nil
+}
+
+// clientProof computes M1 = H(H(N) xor H(g) | H(I) | s | A | B | K).
+func clientProof(login string, salt []byte, a, b *big.Int, key []byte) []byte {
+	hn, hg := hash(groupN.Bytes()), hash(groupG.Bytes())
+	for i := range hn {
+		hn[i] ^= hg[i]
+	}
+	return hash(hn, hash([]byte(login)), salt, a.Bytes(), b.Bytes(), key)
+}
+
+// serverProof computes M2 = H(A | M1 | K).
+func serverProof(a *big.Int, m1, key []byte) []byte {
+	return hash(a.Bytes(), m1, key)
+}
+
+func equal(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
@@ -0,0 +1,102 @@
+package srp_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/common/srp"
+)
+
+func exchange(t *testing.T, password string, salt, verifier []byte) (*srp.Client, *srp.Server, []byte) {
+	t.Helper()
+	client, err := srp.NewClient("mark", password)
+	require.NoError(t, err)
+	server, err := srp.NewServer("mark", salt, verifier, client.Public())
+	require.NoError(t, err)
+	proof, err := client.Proof(server.Salt(), server.Public())
+	require.NoError(t, err)
+	return client, server, proof
+}
+
+func TestExchange(t *testing.T) {
+	salt, err := srp.NewSalt()
+	require.NoError(t, err)
+	verifier := srp.Verifier("mark", "secret", salt)
+
+	t.Run("matching password", func(t *testing.T) {
+		client, server, proof := exchange(t, "secret", salt, verifier)
+
+		serverProof, key, err := server.Verify(proof)
+		require.NoError(t, err)
+		require.NoError(t, client.VerifyServer(serverProof))
+		assert.Equal(t, client.Key(), key)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		_, server, proof := exchange(t, "geheim", salt, verifier)
+
+		_, _, err := server.Verify(proof)
+		require.ErrorIs(t, err, srp.ErrInvalidProof)
+	})
+
+	t.Run("server without the verifier", func(t *testing.T) {
+		client, _, _ := exchange(t, "secret", salt, verifier)
+		impostor, err := srp.NewServer("mark", salt, srp.Verifier("mark", "geheim", salt), client.Public())
+		require.NoError(t, err)
+		proof, err := client.Proof(impostor.Salt(), impostor.Public())
+		require.NoError(t, err)
+
+		_, _, err = impostor.Verify(proof)
+		require.ErrorIs(t, err, srp.ErrInvalidProof)
+		require.ErrorIs(t, client.VerifyServer([]byte("forged")), srp.ErrInvalidProof)
+	})
+
+	t.Run("verifier depends on the salt and the login", func(t *testing.T) {
+		other, err := srp.NewSalt()
+		require.NoError(t, err)
+		assert.NotEqual(t, verifier, srp.Verifier("mark", "secret", other))
+		assert.NotEqual(t, verifier, srp.Verifier("steve", "secret", salt))
+	})
+}
+
+func TestRejectsZeroPublicValues(t *testing.T) {
+	salt, err := srp.NewSalt()
+	require.NoError(t, err)
+	verifier := srp.Verifier("mark", "secret", salt)
+	n, ok := new(big.Int).SetString("AC6BDB41324A9A9BF166DE5E1389582FAF72B6651987EE07FC3192943DB56050A37329CBB4A099"+
+		"ED8193E0757767A13DD52312AB4B03310DCD7F48A9DA04FD50E8083969EDB767B0CF6095179A163AB3661A05FBD5FAAAE82918A9962F"+
+		"0B93B855F97993EC975EEAA80D740ADBF4FF747359D041D5C33EA71D281E446B14773BCA97B43A23FB801676BD207A436C6481F1D2B9"+
+		"078717461A5B9D32E688F87748544523B524B0D57D5EA77A2775D2ECFA032CFBDBF52FB3786160279004E57AE6AF874E7303CE53299C"+
+		"CC041C7BC308D82A5698F3A8D0C38271AE35F8E9DBFBB694B5C803D89F7AE435DE236D525F54759B65E372FCD68EF20FA7111F9E4AFF"+
+		"73", 16)
+	require.True(t, ok)
+
+	for _, public := range [][]byte{{0}, n.Bytes(), new(big.Int).Lsh(n, 1).Bytes()} {
+		_, err = srp.NewServer("mark", salt, verifier, public)
+		require.ErrorIs(t, err, srp.ErrInvalidPublic)
+
+		client, err := srp.NewClient("mark", "secret")
+		require.NoError(t, err)
+		_, err = client.Proof(salt, public)
+		require.ErrorIs(t, err, srp.ErrInvalidPublic)
+	}
+}
+
+func TestRejectsOversizedPublicValues(t *testing.T) {
+	salt, err := srp.NewSalt()
+	require.NoError(t, err)
+	verifier := srp.Verifier("mark", "secret", salt)
+	client, err := srp.NewClient("mark", "secret")
+	require.NoError(t, err)
+
+	// Longer than N, so it could not be padded to the length of N when verifying the proof.
+	oversized := new(big.Int).Lsh(big.NewInt(1), 2100)
+	oversized.Add(oversized, new(big.Int).SetBytes(client.Public()))
+	_, err = srp.NewServer("mark", salt, verifier, oversized.Bytes())
+	require.ErrorIs(t, err, srp.ErrInvalidPublic)
+	_, err = client.Proof(salt, oversized.Bytes())
+	require.ErrorIs(t, err, srp.ErrInvalidPublic)
+}
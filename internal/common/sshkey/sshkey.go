@@ -0,0 +1,112 @@
+// Package sshkey parses and generates the SSH keys kept in the vault. It is shared by the
+// server, which validates stored keys, and the client, which generates keys and loads them
+// into its ssh-agent.
+package sshkey
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Key types of Generate.
+const (
+	TypeEd25519 = "ed25519"
+	TypeRSA     = "rsa"
+)
+
+const (
+	DefaultRSABits = 4096
+	MinRSABits     = 2048
+	MaxRSABits     = 8192
+)
+
+var (
+	ErrPassphraseRequired = errors.New("passphrase is required for an encrypted private key")
+	ErrWrongPassphrase    = errors.New("passphrase does not decrypt the private key")
+	ErrNotEncrypted       = errors.New("private key is not encrypted, passphrase must be empty")
+	ErrUnknownType        = errors.New("unknown key type")
+	ErrInvalidBits        = errors.New("invalid RSA key size")
+)
+
+// Parse decodes an OpenSSH or PEM private key, decrypting it with passphrase when it is encrypted.
+func Parse(privateKey, passphrase []byte) (crypto.PrivateKey, error) {
+	key, err := ssh.ParseRawPrivateKey(privateKey)
+	var missing *ssh.PassphraseMissingError
+	switch {
+	case errors.As(err, &missing) && len(passphrase) == 0:
+		return nil, ErrPassphraseRequired
+	case errors.As(err, &missing):
+		key, err = ssh.ParseRawPrivateKeyWithPassphrase(privateKey, passphrase)
+		if errors.Is(err, x509.IncorrectPasswordError) {
+			return nil, ErrWrongPassphrase
+		}
+	case err == nil && len(passphrase) > 0:
+		return nil, ErrNotEncrypted
+	}
+	if err != nil {
+		return nil, fmt.Errorf("private key is not a valid OpenSSH or PEM key: %w", err)
+	}
+	return key, nil
+}
+
+// PublicKey returns the public key of a parsed private key.
+func PublicKey(key crypto.PrivateKey) (ssh.PublicKey, error) {
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return signer.PublicKey(), nil
+}
+
+// AuthorizedKey formats the public key as an authorized_keys line.
+func AuthorizedKey(publicKey ssh.PublicKey, comment string) string {
+	line := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey)))
+	if comment != "" {
+		line += " " + comment
+	}
+	return line
+}
+
+// Generate creates a key of the given type in the OpenSSH format, bits only applies to RSA keys.
+// It returns the private key and the authorized_keys line of its public key.
+func Generate(keyType string, bits int, comment string) ([]byte, string, error) {
+	var key crypto.PrivateKey
+	switch keyType {
+	case TypeEd25519:
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, "", err
+		}
+		key = private
+	case TypeRSA:
+		if bits < MinRSABits || bits > MaxRSABits {
+			return nil, "", fmt.Errorf("%w: %d, expected between %d and %d", ErrInvalidBits, bits, MinRSABits, MaxRSABits)
+		}
+		private, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, "", err
+		}
+		key = private
+	default:
+		return nil, "", fmt.Errorf("%w %q, expected %s or %s", ErrUnknownType, keyType, TypeEd25519, TypeRSA)
+	}
+
+	block, err := ssh.MarshalPrivateKey(key, comment)
+	if err != nil {
+		return nil, "", err
+	}
+	publicKey, err := PublicKey(key)
+	if err != nil {
+		return nil, "", err
+	}
+	return pem.EncodeToMemory(block), AuthorizedKey(publicKey, comment), nil
+}
@@ -0,0 +1,160 @@
+// Package totp implements time-based one-time passwords (RFC 6238) for the seeds kept in the vault.
+// Seeds are exchanged as otpauth:// URIs, the format of the QR codes shown when 2FA is enabled.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // SHA-1 is the default HMAC of RFC 6238, not used for hashing.
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HMAC algorithms of the otpauth URI.
+const (
+	AlgorithmSHA1   = "SHA1"
+	AlgorithmSHA256 = "SHA256"
+	AlgorithmSHA512 = "SHA512"
+)
+
+const (
+	DefaultDigits = 6
+	DefaultPeriod = 30 * time.Second
+	MinDigits     = 6
+	MaxDigits     = 8
+)
+
+var (
+	ErrInvalidURI       = errors.New("not an otpauth://totp URI")
+	ErrInvalidSecret    = errors.New("secret must be a non-empty base32 string")
+	ErrInvalidAlgorithm = errors.New("algorithm must be SHA1, SHA256 or SHA512")
+	ErrInvalidDigits    = errors.New("digits must be between 6 and 8")
+	ErrInvalidPeriod    = errors.New("period must be a positive number of seconds")
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Key is a TOTP seed with its parameters.
+type Key struct {
+	Secret    []byte
+	Issuer    string
+	Account   string
+	Algorithm string
+	Digits    int
+	Period    time.Duration
+}
+
+// Parse decodes an otpauth://totp URI, missing parameters take the defaults of RFC 6238.
+func Parse(uri string) (*Key, error) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil || u.Scheme != "otpauth" || !strings.EqualFold(u.Host, "totp") {
+		return nil, ErrInvalidURI
+	}
+	query := u.Query()
+	key := &Key{
+		Algorithm: AlgorithmSHA1,
+		Digits:    DefaultDigits,
+		Period:    DefaultPeriod,
+		Issuer:    query.Get("issuer"),
+	}
+
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, found := strings.Cut(label, ":"); found {
+		if key.Issuer == "" {
+			key.Issuer = strings.TrimSpace(issuer)
+		}
+		label = account
+	}
+	key.Account = strings.TrimSpace(label)
+
+	secret := strings.ToUpper(strings.ReplaceAll(query.Get("secret"), " ", ""))
+	key.Secret, err = encoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil || len(key.Secret) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	if algorithm := query.Get("algorithm"); algorithm != "" {
+		key.Algorithm = strings.ToUpper(algorithm)
+		if _, err = key.hash(); err != nil {
+			return nil, err
+		}
+	}
+	if digits := query.Get("digits"); digits != "" {
+		key.Digits, err = strconv.Atoi(digits)
+		if err != nil || key.Digits < MinDigits || key.Digits > MaxDigits {
+			return nil, ErrInvalidDigits
+		}
+	}
+	if period := query.Get("period"); period != "" {
+		seconds, atoiErr := strconv.Atoi(period)
+		if atoiErr != nil || seconds <= 0 {
+			return nil, ErrInvalidPeriod
+		}
+		key.Period = time.Duration(seconds) * time.Second
+	}
+	return key, nil
+}
+
+// URI formats the key as an otpauth://totp URI.
+func (k *Key) URI() string {
+	label := k.Account
+	if k.Issuer != "" {
+		label = k.Issuer + ":" + k.Account
+	}
+	query := url.Values{}
+	query.Set("secret", encoding.EncodeToString(k.Secret))
+	if k.Issuer != "" {
+		query.Set("issuer", k.Issuer)
+	}
+	query.Set("algorithm", k.Algorithm)
+	query.Set("digits", strconv.Itoa(k.Digits))
+	query.Set("period", strconv.Itoa(int(k.Period/time.Second)))
+	u := url.URL{Scheme: "otpauth", Host: "totp", Path: "/" + label, RawQuery: query.Encode()}
+	return u.String()
+}
+
+func (k *Key) hash() (func() hash.Hash, error) {
+	switch k.Algorithm {
+	case AlgorithmSHA1:
+		return sha1.New, nil
+	case AlgorithmSHA256:
+		return sha256.New, nil
+	case AlgorithmSHA512:
+		return sha512.New, nil
+	}
+	return nil, ErrInvalidAlgorithm
+}
+
+// Code returns the code valid at t.
+func (k *Key) Code(t time.Time) (string, error) {
+	newHash, err := k.hash()
+	if err != nil {
+		return "", err
+	}
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(t.Unix()/int64(k.Period/time.Second))) // #nosec G115
+	mac := hmac.New(newHash, k.Secret)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	modulo := uint32(1)
+	for range k.Digits {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", k.Digits, value%modulo), nil
+}
+
+// Remaining returns how long the code valid at t stays valid.
+func (k *Key) Remaining(t time.Time) time.Duration {
+	elapsed := time.Duration(t.UnixNano()) % k.Period
+	return k.Period - elapsed
+}
@@ -0,0 +1,69 @@
+package totp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/common/totp"
+)
+
+func TestCode(t *testing.T) {
+	// test vectors of RFC 6238, appendix B
+	seeds := map[string]string{
+		totp.AlgorithmSHA1:   "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+		totp.AlgorithmSHA256: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQGEZA",
+		totp.AlgorithmSHA512: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" +
+			"GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQGEZDGNA",
+	}
+	for _, tt := range []struct {
+		unix      int64
+		algorithm string
+		code      string
+	}{
+		{unix: 59, algorithm: totp.AlgorithmSHA1, code: "94287082"},
+		{unix: 59, algorithm: totp.AlgorithmSHA256, code: "46119246"},
+		{unix: 59, algorithm: totp.AlgorithmSHA512, code: "90693936"},
+		{unix: 1111111109, algorithm: totp.AlgorithmSHA1, code: "07081804"},
+		{unix: 1234567890, algorithm: totp.AlgorithmSHA256, code: "91819424"},
+		{unix: 20000000000, algorithm: totp.AlgorithmSHA512, code: "47863826"},
+	} {
+		key, err := totp.Parse("otpauth://totp/RFC:6238?digits=8&algorithm=" + tt.algorithm +
+			"&secret=" + seeds[tt.algorithm])
+		require.NoError(t, err)
+		code, err := key.Code(time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.code, code, "%s at %d", tt.algorithm, tt.unix)
+	}
+}
+
+func TestParse(t *testing.T) {
+	key, err := totp.Parse("otpauth://totp/ACME%20Co:john.doe@email.com?secret=jbsw%20y3dpehpk3pxp&period=60")
+	require.NoError(t, err)
+	assert.Equal(t, "ACME Co", key.Issuer)
+	assert.Equal(t, "john.doe@email.com", key.Account)
+	assert.Equal(t, []byte("Hello!\xde\xad\xbe\xef"), key.Secret)
+	assert.Equal(t, totp.AlgorithmSHA1, key.Algorithm)
+	assert.Equal(t, totp.DefaultDigits, key.Digits)
+	assert.Equal(t, time.Minute, key.Period)
+	assert.Equal(t, 30*time.Second, key.Remaining(time.Unix(90, 0)))
+
+	again, err := totp.Parse(key.URI())
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	for uri, expected := range map[string]error{
+		"https://example.com":                                       totp.ErrInvalidURI,
+		"otpauth://hotp/acme?secret=JBSWY3DPEHPK3PXP":               totp.ErrInvalidURI,
+		"otpauth://totp/acme":                                       totp.ErrInvalidSecret,
+		"otpauth://totp/acme?secret=not-base32!":                    totp.ErrInvalidSecret,
+		"otpauth://totp/acme?secret=JBSWY3DPEHPK3PXP&digits=4":      totp.ErrInvalidDigits,
+		"otpauth://totp/acme?secret=JBSWY3DPEHPK3PXP&period=0":      totp.ErrInvalidPeriod,
+		"otpauth://totp/acme?secret=JBSWY3DPEHPK3PXP&algorithm=MD5": totp.ErrInvalidAlgorithm,
+	} {
+		_, err = totp.Parse(uri)
+		require.ErrorIs(t, err, expected, uri)
+	}
+}
@@ -0,0 +1,103 @@
+// Package tracing configures OpenTelemetry tracing shared by the server and the client.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters of the collected spans.
+const (
+	// ExporterNone disables tracing, it is the default.
+	ExporterNone = "none"
+	// ExporterOTLP sends the spans to an OTLP collector over gRPC. The collector is configured
+	// by the standard OTEL_EXPORTER_OTLP_* environment variables.
+	ExporterOTLP = "otlp"
+	// ExporterStdout prints the spans for local debugging.
+	ExporterStdout = "stdout"
+)
+
+// ErrUnknownExporter is returned for an exporter other than none, otlp or stdout.
+var ErrUnknownExporter = errors.New("unknown trace exporter")
+
+// Config selects the exporter and the share of traces being sampled.
+type Config struct {
+	ServiceName string
+	Exporter    string
+	// SampleRatio is the share of new traces recorded, calls continuing a trace follow the caller's decision.
+	SampleRatio float64
+	// Output receives the spans of the stdout exporter, os.Stdout if nil.
+	Output io.Writer
+}
+
+// Shutdown flushes the spans not exported yet and stops the exporter.
+type Shutdown func(ctx context.Context) error
+
+// Setup installs the global tracer provider and the W3C trace context propagator.
+// With the none exporter the provider is left as a no-op and the returned Shutdown does nothing.
+func Setup(ctx context.Context, cfg Config) (Shutdown, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx)
+	case ExporterStdout:
+		output := cfg.Output
+		if output == nil {
+			output = os.Stdout
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(output), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownExporter, cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", cfg.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the traced service: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of an instrumented package from the global provider.
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// End records the error, if any, on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
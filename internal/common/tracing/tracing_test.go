@@ -0,0 +1,53 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/itallix/gophkeeper/internal/common/tracing"
+)
+
+func TestSetup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("none", func(t *testing.T) {
+		shutdown, err := tracing.Setup(ctx, tracing.Config{Exporter: tracing.ExporterNone})
+		require.NoError(t, err)
+		require.NoError(t, shutdown(ctx))
+	})
+
+	t.Run("unknown exporter", func(t *testing.T) {
+		_, err := tracing.Setup(ctx, tracing.Config{Exporter: "jaeger"})
+		require.ErrorIs(t, err, tracing.ErrUnknownExporter)
+	})
+
+	t.Run("stdout", func(t *testing.T) {
+		var out bytes.Buffer
+		shutdown, err := tracing.Setup(ctx, tracing.Config{
+			ServiceName: "gophkeeper-test",
+			Exporter:    tracing.ExporterStdout,
+			SampleRatio: 1,
+			Output:      &out,
+		})
+		require.NoError(t, err)
+
+		spanCtx, span := tracing.Tracer("test").Start(ctx, "Download")
+		tracing.End(span, errors.New("object storage is down"))
+
+		carrier := propagation.MapCarrier{}
+		otel.GetTextMapPropagator().Inject(spanCtx, carrier)
+		assert.Contains(t, carrier.Get("traceparent"), span.SpanContext().TraceID().String())
+
+		require.NoError(t, shutdown(ctx))
+		assert.Contains(t, out.String(), `"Name": "Download"`)
+		assert.Contains(t, out.String(), "object storage is down")
+		assert.Contains(t, out.String(), "gophkeeper-test")
+	})
+}
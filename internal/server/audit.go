@@ -0,0 +1,44 @@
+package server
+
+import (
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// AuditLog defines the operations on the tamper-evident log of user activity.
+type AuditLog interface {
+	RecordEvent(event models.AuditEvent) error
+	ListAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error)
+	VerifyAuditLog() (models.AuditVerification, error)
+}
+
+// RecordEvent appends the event to the audit log, chaining it to the previous event.
+//
+// Parameters:
+//   - event: The actor, action, secret and request details, the owner of the secret is looked up if empty
+//
+// Returns:
+//   - error: nil if successful, otherwise an error describing what went wrong
+func (v *VaultImpl) RecordEvent(event models.AuditEvent) error {
+	return v.auditRepo.Record(v.ctx, event)
+}
+
+// ListAuditEvents returns the audit events matching the filter, the most recent first.
+//
+// Parameters:
+//   - filter: The criteria the events must match, set Participant to restrict them to a user
+//
+// Returns:
+//   - []models.AuditEvent: The matching events
+//   - error: nil if successful, otherwise an error describing what went wrong
+func (v *VaultImpl) ListAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	return v.auditRepo.List(v.ctx, filter)
+}
+
+// VerifyAuditLog recomputes the hash chain of the audit log to detect modified or deleted events.
+//
+// Returns:
+//   - models.AuditVerification: The number of checked events and the first broken one, if any
+//   - error: nil if the check could be performed, otherwise an error describing what went wrong
+func (v *VaultImpl) VerifyAuditLog() (models.AuditVerification, error) {
+	return v.auditRepo.Verify(v.ctx)
+}
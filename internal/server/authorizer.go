@@ -0,0 +1,273 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/server/authz"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+)
+
+// Organizations defines the operations on organizations, their members, teams and collections.
+// Every operation is performed on behalf of the user passed as the first argument.
+type Organizations interface {
+	CreateOrganization(user, org string) error
+	ListOrganizations(user string) ([]models.Organization, error)
+	SetMember(user, org, member string, role models.Role) error
+	RemoveMember(user, org, member string) error
+	ListMembers(user, org string) ([]models.Member, error)
+	CreateTeam(user, org, team string) error
+	AddTeamMember(user, org, team, member string) error
+	RemoveTeamMember(user, org, team, member string) error
+	ListTeams(user, org string) ([]models.Team, error)
+	CreateCollection(user, org, collection string) error
+	AddToCollection(user, org, collection, path string) error
+	RemoveFromCollection(user, org, collection, path string) error
+	AssignCollection(user, org, collection, team string, role models.Role) error
+	UnassignCollection(user, org, collection, team string) error
+	ListCollections(user, org string) ([]models.Collection, error)
+}
+
+// Authorizer sits between the API and the vault. It collects the facts about the
+// user and the resource, asks authz.Decide whether the operation is allowed and only
+// then passes the request on. It also manages organizations, which are the source of
+// the roles the decisions are based on.
+type Authorizer struct {
+	ctx    context.Context
+	vault  Vault
+	access *storage.AccessRepo
+	orgs   *storage.OrgRepo
+}
+
+// NewAuthorizer creates an authorization layer in front of the vault.
+//
+// Parameters:
+//   - ctx: Context for managing the lifecycle of operations
+//   - pool: PostgreSQL connection pool used to look up roles and permissions
+//   - vault: The vault receiving the authorized requests
+//
+// Returns:
+//   - *Authorizer: A new instance implementing both Vault and Organizations
+func NewAuthorizer(ctx context.Context, pool *pgxpool.Pool, vault Vault) *Authorizer {
+	return &Authorizer{
+		ctx:    ctx,
+		vault:  vault,
+		access: storage.NewAccessRepo(pool),
+		orgs:   storage.NewOrgRepo(pool),
+	}
+}
+
+// authorize asks for the decision on an organization resource. Users who are not
+// members of the organization get notFound, so its existence is not disclosed.
+func authorize(user string, action authz.Action, res authz.Resource, notFound error) error {
+	err := authz.Decide(user, action, res)
+	if err == nil {
+		return nil
+	}
+	if authz.Decide(user, authz.ActionViewOrg, res) != nil {
+		return notFound
+	}
+	return fmt.Errorf("%w: %w", storage.ErrAccessDenied, err)
+}
+
+func (a *Authorizer) authorizeOrg(user, org, member string, role models.Role, action authz.Action) error {
+	res, err := a.orgs.OrgFacts(a.ctx, org, user, member)
+	if err != nil {
+		return err
+	}
+	res.TargetRole = role
+	return authorize(user, action, res, storage.ErrOrgNotFound)
+}
+
+func (a *Authorizer) authorizeCollection(user, org, collection string, action authz.Action) error {
+	res, err := a.orgs.CollectionFacts(a.ctx, org, collection, user)
+	if err != nil {
+		return err
+	}
+	return authorize(user, action, res, storage.ErrOrgNotFound)
+}
+
+func (a *Authorizer) StoreSecret(ctx context.Context, secret models.Secret) error {
+	return a.vault.StoreSecret(ctx, secret)
+}
+
+func (a *Authorizer) RetrieveSecret(ctx context.Context, secret models.Secret) error {
+	meta := secret.Meta()
+	if err := a.access.CheckSecret(ctx, meta.Path, meta.RequestedBy, authz.ActionRead); err != nil {
+		return err
+	}
+	return a.vault.RetrieveSecret(ctx, secret)
+}
+
+func (a *Authorizer) DeleteSecret(ctx context.Context, secret models.Secret) error {
+	meta := secret.Meta()
+	if err := a.access.CheckSecret(ctx, meta.Path, meta.RequestedBy, authz.ActionWrite); err != nil {
+		return err
+	}
+	return a.vault.DeleteSecret(ctx, secret)
+}
+
+func (a *Authorizer) AttachTOTP(ctx context.Context, path, uri, requestedBy string) error {
+	if err := a.access.CheckSecret(ctx, path, requestedBy, authz.ActionWrite); err != nil {
+		return err
+	}
+	return a.vault.AttachTOTP(ctx, path, uri, requestedBy)
+}
+
+// FindLogins passes the request on, the vault matches only the logins the requester is allowed to read.
+func (a *Authorizer) FindLogins(ctx context.Context, rawURL, requestedBy string) ([]models.LoginMatch, error) {
+	return a.vault.FindLogins(ctx, rawURL, requestedBy)
+}
+
+// ListSecrets passes the request on, the vault lists only the secrets of the requester.
+func (a *Authorizer) ListSecrets(ctx context.Context, secret models.Secret) ([]string, error) {
+	return a.vault.ListSecrets(ctx, secret)
+}
+
+func (a *Authorizer) ShareSecret(share models.Share) error {
+	if err := a.access.CheckSecret(a.ctx, share.Path, share.Owner, authz.ActionShare); err != nil {
+		return err
+	}
+	return a.vault.ShareSecret(share)
+}
+
+func (a *Authorizer) UnshareSecret(share models.Share) error {
+	if err := a.access.CheckSecret(a.ctx, share.Path, share.Owner, authz.ActionShare); err != nil {
+		return err
+	}
+	return a.vault.UnshareSecret(share)
+}
+
+func (a *Authorizer) ListShares(owner, path string) ([]models.Share, error) {
+	if err := a.access.CheckSecret(a.ctx, path, owner, authz.ActionShare); err != nil {
+		return nil, err
+	}
+	return a.vault.ListShares(owner, path)
+}
+
+// ListSharedWith passes the request on, users can always see what is shared with them.
+func (a *Authorizer) ListSharedWith(grantee string) ([]models.Share, error) {
+	return a.vault.ListSharedWith(grantee)
+}
+
+// CreateOrganization creates a new organization, any user can create one and becomes its owner.
+func (a *Authorizer) CreateOrganization(user, org string) error {
+	return a.orgs.CreateOrg(a.ctx, org, user)
+}
+
+// ListOrganizations returns the organizations the user is a member of with their role.
+func (a *Authorizer) ListOrganizations(user string) ([]models.Organization, error) {
+	return a.orgs.ListOrgs(a.ctx, user)
+}
+
+// SetMember adds the member to the organization or changes their role. Owners manage
+// everyone, admins only editors and viewers.
+func (a *Authorizer) SetMember(user, org, member string, role models.Role) error {
+	if err := a.authorizeOrg(user, org, member, role, authz.ActionManageMembers); err != nil {
+		return err
+	}
+	return a.orgs.SetMember(a.ctx, org, member, role)
+}
+
+// RemoveMember removes the member from the organization and its teams.
+func (a *Authorizer) RemoveMember(user, org, member string) error {
+	if err := a.authorizeOrg(user, org, member, "", authz.ActionManageMembers); err != nil {
+		return err
+	}
+	return a.orgs.RemoveMember(a.ctx, org, member)
+}
+
+// ListMembers returns the members of the organization, visible to every member.
+func (a *Authorizer) ListMembers(user, org string) ([]models.Member, error) {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionViewOrg); err != nil {
+		return nil, err
+	}
+	return a.orgs.ListMembers(a.ctx, org)
+}
+
+// CreateTeam creates a team in the organization.
+func (a *Authorizer) CreateTeam(user, org, team string) error {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionManageTeams); err != nil {
+		return err
+	}
+	return a.orgs.CreateTeam(a.ctx, org, team)
+}
+
+// AddTeamMember adds a member of the organization to the team.
+func (a *Authorizer) AddTeamMember(user, org, team, member string) error {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionManageTeams); err != nil {
+		return err
+	}
+	return a.orgs.AddTeamMember(a.ctx, org, team, member)
+}
+
+// RemoveTeamMember removes the member from the team.
+func (a *Authorizer) RemoveTeamMember(user, org, team, member string) error {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionManageTeams); err != nil {
+		return err
+	}
+	return a.orgs.RemoveTeamMember(a.ctx, org, team, member)
+}
+
+// ListTeams returns the teams of the organization, visible to every member.
+func (a *Authorizer) ListTeams(user, org string) ([]models.Team, error) {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionViewOrg); err != nil {
+		return nil, err
+	}
+	return a.orgs.ListTeams(a.ctx, org)
+}
+
+// CreateCollection creates a collection in the organization.
+func (a *Authorizer) CreateCollection(user, org, collection string) error {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionManageCollections); err != nil {
+		return err
+	}
+	return a.orgs.CreateCollection(a.ctx, org, collection)
+}
+
+// AddToCollection moves the secret into the collection. The user must be able to edit
+// the collection and to share the secret, so nobody can hand over a secret they don't control.
+func (a *Authorizer) AddToCollection(user, org, collection, path string) error {
+	if err := a.authorizeCollection(user, org, collection, authz.ActionEditCollection); err != nil {
+		return err
+	}
+	if err := a.access.CheckSecret(a.ctx, path, user, authz.ActionShare); err != nil {
+		return err
+	}
+	return a.orgs.AddToCollection(a.ctx, org, collection, path)
+}
+
+// RemoveFromCollection takes the secret out of the collection.
+func (a *Authorizer) RemoveFromCollection(user, org, collection, path string) error {
+	if err := a.authorizeCollection(user, org, collection, authz.ActionEditCollection); err != nil {
+		return err
+	}
+	return a.orgs.RemoveFromCollection(a.ctx, org, collection, path)
+}
+
+// AssignCollection gives the team access to the collection with the role.
+func (a *Authorizer) AssignCollection(user, org, collection, team string, role models.Role) error {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionManageCollections); err != nil {
+		return err
+	}
+	return a.orgs.AssignCollection(a.ctx, org, collection, team, role)
+}
+
+// UnassignCollection revokes the access of the team to the collection.
+func (a *Authorizer) UnassignCollection(user, org, collection, team string) error {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionManageCollections); err != nil {
+		return err
+	}
+	return a.orgs.UnassignCollection(a.ctx, org, collection, team)
+}
+
+// ListCollections returns the collections of the organization, visible to every member.
+func (a *Authorizer) ListCollections(user, org string) ([]models.Collection, error) {
+	if err := a.authorizeOrg(user, org, "", "", authz.ActionViewOrg); err != nil {
+		return nil, err
+	}
+	return a.orgs.ListCollections(a.ctx, org)
+}
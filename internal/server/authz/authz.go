@@ -0,0 +1,130 @@
+// Package authz contains the authorization decision function. It is independent of
+// storage: callers collect the facts about the user and the resource and ask Decide
+// whether the action is allowed.
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// ErrForbidden is returned when the user is not allowed to perform the action.
+var ErrForbidden = errors.New("forbidden")
+
+// Action is an operation which requires authorization.
+type Action string
+
+const (
+	// ActionRead allows retrieving a secret.
+	ActionRead Action = "read"
+	// ActionWrite allows modifying and deleting a secret.
+	ActionWrite Action = "write"
+	// ActionShare allows granting other users access to a secret and moving it between collections.
+	ActionShare Action = "share"
+
+	// ActionViewOrg allows listing members, teams and collections of an organization.
+	ActionViewOrg Action = "view-org"
+	// ActionManageMembers allows adding and removing members and changing their roles.
+	ActionManageMembers Action = "manage-members"
+	// ActionManageTeams allows creating teams and changing their membership.
+	ActionManageTeams Action = "manage-teams"
+	// ActionManageCollections allows creating collections and assigning them to teams.
+	ActionManageCollections Action = "manage-collections"
+	// ActionEditCollection allows adding secrets to a collection and removing them from it.
+	ActionEditCollection Action = "edit-collection"
+)
+
+// Resource holds the facts about the resource the action is performed on,
+// as seen from the user asking for authorization.
+type Resource struct {
+	// Owner is the user who created the secret.
+	Owner string
+	// Shared is the permission granted to the user by a direct share of the secret.
+	Shared models.Permission
+	// OrgRole is the role of the user in the organization owning the resource.
+	OrgRole models.Role
+	// CollectionRoles are the roles of the teams of the user on the collection containing the resource.
+	CollectionRoles []models.Role
+	// MemberRole is the current role of the member being changed, empty for new members.
+	MemberRole models.Role
+	// TargetRole is the role being granted to the member.
+	TargetRole models.Role
+}
+
+// collectionRole returns the highest role the user has on the collection through their teams,
+// capped by the role they have in the organization.
+func (r Resource) collectionRole() models.Role {
+	if r.OrgRole == "" || len(r.CollectionRoles) == 0 {
+		return ""
+	}
+	best := slices.MaxFunc(r.CollectionRoles, func(a, b models.Role) int {
+		return a.Rank() - b.Rank()
+	})
+	if r.OrgRole.Rank() < best.Rank() {
+		return r.OrgRole
+	}
+	return best
+}
+
+func (r Resource) isOrgAdmin() bool {
+	return r.OrgRole.Rank() >= models.RoleAdmin.Rank()
+}
+
+func decideSecret(user string, action Action, res Resource) bool {
+	if res.Owner == user || res.isOrgAdmin() {
+		return true
+	}
+	switch action {
+	case ActionRead:
+		return res.Shared.Allows(models.PermissionRead) || res.collectionRole() != ""
+	case ActionWrite:
+		return res.Shared.Allows(models.PermissionReadWrite) ||
+			res.collectionRole().Rank() >= models.RoleEditor.Rank()
+	default:
+		return false
+	}
+}
+
+func decideMembers(res Resource) bool {
+	switch res.OrgRole {
+	case models.RoleOwner:
+		return true
+	case models.RoleAdmin:
+		// admins manage editors and viewers, but can't promote anyone to or demote anyone from admin or owner
+		return res.MemberRole.Rank() < models.RoleAdmin.Rank() && res.TargetRole.Rank() < models.RoleAdmin.Rank()
+	default:
+		return false
+	}
+}
+
+// Decide returns nil if the user is allowed to perform the action on the resource,
+// otherwise an error wrapping ErrForbidden.
+//
+// Owners and admins of an organization have full access to the secrets of its collections.
+// Editors and viewers access the collections assigned to their teams, with the role of the
+// assignment capped by their role in the organization. Users always have full access to the
+// secrets they created and to the secrets shared with them according to the share permission.
+func Decide(user string, action Action, res Resource) error {
+	allowed := false
+	if user != "" {
+		switch action {
+		case ActionRead, ActionWrite, ActionShare:
+			allowed = decideSecret(user, action, res)
+		case ActionViewOrg:
+			allowed = res.OrgRole != ""
+		case ActionManageMembers:
+			allowed = decideMembers(res)
+		case ActionManageTeams, ActionManageCollections:
+			allowed = res.isOrgAdmin()
+		case ActionEditCollection:
+			allowed = res.isOrgAdmin() || res.collectionRole().Rank() >= models.RoleEditor.Rank()
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("%w: %s is not allowed to %s", ErrForbidden, user, action)
+	}
+	return nil
+}
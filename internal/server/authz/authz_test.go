@@ -0,0 +1,173 @@
+package authz_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/itallix/gophkeeper/internal/server/authz"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+func TestDecideSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    string
+		res     authz.Resource
+		allowed []authz.Action
+	}{
+		{
+			name:    "creator",
+			user:    "mark",
+			res:     authz.Resource{Owner: "mark"},
+			allowed: []authz.Action{authz.ActionRead, authz.ActionWrite, authz.ActionShare},
+		},
+		{
+			name: "stranger",
+			user: "lucius",
+			res:  authz.Resource{Owner: "mark"},
+		},
+		{
+			name: "anonymous",
+			res:  authz.Resource{},
+		},
+		{
+			name:    "shared read",
+			user:    "lucius",
+			res:     authz.Resource{Owner: "mark", Shared: models.PermissionRead},
+			allowed: []authz.Action{authz.ActionRead},
+		},
+		{
+			name:    "shared read-write",
+			user:    "lucius",
+			res:     authz.Resource{Owner: "mark", Shared: models.PermissionReadWrite},
+			allowed: []authz.Action{authz.ActionRead, authz.ActionWrite},
+		},
+		{
+			name:    "org owner",
+			user:    "lucius",
+			res:     authz.Resource{Owner: "mark", OrgRole: models.RoleOwner},
+			allowed: []authz.Action{authz.ActionRead, authz.ActionWrite, authz.ActionShare},
+		},
+		{
+			name:    "org admin without teams",
+			user:    "lucius",
+			res:     authz.Resource{Owner: "mark", OrgRole: models.RoleAdmin},
+			allowed: []authz.Action{authz.ActionRead, authz.ActionWrite, authz.ActionShare},
+		},
+		{
+			name: "org editor without teams",
+			user: "lucius",
+			res:  authz.Resource{Owner: "mark", OrgRole: models.RoleEditor},
+		},
+		{
+			name: "org editor in editing team",
+			user: "lucius",
+			res: authz.Resource{Owner: "mark", OrgRole: models.RoleEditor,
+				CollectionRoles: []models.Role{models.RoleViewer, models.RoleEditor}},
+			allowed: []authz.Action{authz.ActionRead, authz.ActionWrite},
+		},
+		{
+			name: "org editor in viewing team",
+			user: "lucius",
+			res: authz.Resource{Owner: "mark", OrgRole: models.RoleEditor,
+				CollectionRoles: []models.Role{models.RoleViewer}},
+			allowed: []authz.Action{authz.ActionRead},
+		},
+		{
+			name: "org viewer is capped in editing team",
+			user: "lucius",
+			res: authz.Resource{Owner: "mark", OrgRole: models.RoleViewer,
+				CollectionRoles: []models.Role{models.RoleEditor}},
+			allowed: []authz.Action{authz.ActionRead},
+		},
+		{
+			name: "team assignment without membership",
+			user: "lucius",
+			res:  authz.Resource{Owner: "mark", CollectionRoles: []models.Role{models.RoleEditor}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, action := range []authz.Action{authz.ActionRead, authz.ActionWrite, authz.ActionShare} {
+				err := authz.Decide(tt.user, action, tt.res)
+				if slices.Contains(tt.allowed, action) {
+					assert.NoError(t, err, action)
+				} else {
+					assert.ErrorIs(t, err, authz.ErrForbidden, action)
+				}
+			}
+		})
+	}
+}
+
+func TestDecideOrganization(t *testing.T) {
+	tests := []struct {
+		name    string
+		res     authz.Resource
+		allowed []authz.Action
+	}{
+		{
+			name: "not a member",
+			res:  authz.Resource{},
+		},
+		{
+			name: "owner",
+			res:  authz.Resource{OrgRole: models.RoleOwner, MemberRole: models.RoleOwner, TargetRole: models.RoleViewer},
+			allowed: []authz.Action{authz.ActionViewOrg, authz.ActionManageMembers, authz.ActionManageTeams,
+				authz.ActionManageCollections, authz.ActionEditCollection},
+		},
+		{
+			name: "admin manages editors",
+			res:  authz.Resource{OrgRole: models.RoleAdmin, MemberRole: models.RoleViewer, TargetRole: models.RoleEditor},
+			allowed: []authz.Action{authz.ActionViewOrg, authz.ActionManageMembers, authz.ActionManageTeams,
+				authz.ActionManageCollections, authz.ActionEditCollection},
+		},
+		{
+			name: "admin can't promote to admin",
+			res:  authz.Resource{OrgRole: models.RoleAdmin, TargetRole: models.RoleAdmin},
+			allowed: []authz.Action{authz.ActionViewOrg, authz.ActionManageTeams,
+				authz.ActionManageCollections, authz.ActionEditCollection},
+		},
+		{
+			name: "admin can't demote owner",
+			res:  authz.Resource{OrgRole: models.RoleAdmin, MemberRole: models.RoleOwner, TargetRole: models.RoleViewer},
+			allowed: []authz.Action{authz.ActionViewOrg, authz.ActionManageTeams,
+				authz.ActionManageCollections, authz.ActionEditCollection},
+		},
+		{
+			name:    "editor",
+			res:     authz.Resource{OrgRole: models.RoleEditor, TargetRole: models.RoleViewer},
+			allowed: []authz.Action{authz.ActionViewOrg},
+		},
+		{
+			name: "editor in editing team",
+			res: authz.Resource{OrgRole: models.RoleEditor,
+				CollectionRoles: []models.Role{models.RoleEditor}},
+			allowed: []authz.Action{authz.ActionViewOrg, authz.ActionEditCollection},
+		},
+		{
+			name: "viewer in editing team",
+			res: authz.Resource{OrgRole: models.RoleViewer,
+				CollectionRoles: []models.Role{models.RoleEditor}},
+			allowed: []authz.Action{authz.ActionViewOrg},
+		},
+	}
+
+	actions := []authz.Action{authz.ActionViewOrg, authz.ActionManageMembers, authz.ActionManageTeams,
+		authz.ActionManageCollections, authz.ActionEditCollection}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, action := range actions {
+				err := authz.Decide("lucius", action, tt.res)
+				if slices.Contains(tt.allowed, action) {
+					assert.NoError(t, err, action)
+				} else {
+					assert.ErrorIs(t, err, authz.ErrForbidden, action)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+// Package config loads the configuration of the server.
+//
+// Every setting can be set in a YAML or TOML file, by an environment variable and by a command line
+// flag. Flags take precedence over environment variables, which take precedence over the file, which
+// takes precedence over the defaults. The key, the variable and the default of a setting
+// are declared by the tags of its field:
+//
+//	DSN string `mapstructure:"dsn" env:"DB_DSN" redact:"dsn"`
+//
+// The key in the file is the path of mapstructure names (database.dsn), the flag is the key with
+// dashes (--database-dsn). Fields tagged redact are hidden by Write.
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	// FileFlag is the flag naming the configuration file.
+	FileFlag = "config"
+	// FileEnv is the environment variable naming the configuration file, the flag takes precedence.
+	FileEnv = "CONFIG_FILE"
+)
+
+// Config is the configuration of the server.
+type Config struct {
+	Address        string `mapstructure:"address" env:"ADDRESS" default:"localhost:8081"`
+	HTTPAddress    string `mapstructure:"http_address" env:"HTTP_ADDRESS" default:"localhost:8082"`
+	MetricsAddress string `mapstructure:"metrics_address" env:"METRICS_ADDRESS" default:"localhost:9090"`
+	LogLevel       string `mapstructure:"log_level" env:"LOG_LEVEL" default:"DEBUG"`
+	// DevMode allows running with the default JWT secrets.
+	DevMode    bool     `mapstructure:"dev_mode" env:"DEV_MODE" default:"false"`
+	AdminUsers []string `mapstructure:"admin_users" env:"ADMIN_USERS"`
+
+	// HealthCheckInterval is how often the readiness of Postgres and the object storage is checked.
+	HealthCheckInterval time.Duration `mapstructure:"health_check_interval" env:"HEALTH_CHECK_INTERVAL" default:"10s"`
+	// Reflection registers the gRPC server reflection service, e.g. for grpcurl.
+	Reflection bool `mapstructure:"grpc_reflection" env:"GRPC_REFLECTION" default:"false"`
+	// GRPCWeb serves the gRPC-Web and Connect protocols for browsers on the HTTP listener.
+	GRPCWeb bool `mapstructure:"grpc_web" env:"GRPC_WEB" default:"false"`
+	// CORSOrigins are the origins of the pages allowed to call the HTTP listener, * allows any.
+	CORSOrigins []string `mapstructure:"cors_allowed_origins" env:"CORS_ALLOWED_ORIGINS"`
+
+	Database      Database      `mapstructure:"database"`
+	ObjectStorage ObjectStorage `mapstructure:"object_storage"`
+	KMS           KMS           `mapstructure:"kms"`
+	JWT           JWT           `mapstructure:"jwt"`
+	Password      Password      `mapstructure:"password"`
+	Argon2        Argon2        `mapstructure:"argon2"`
+	RateLimit     RateLimit     `mapstructure:"rate_limit"`
+	OIDC          OIDC          `mapstructure:"oidc"`
+	Tracing       Tracing       `mapstructure:"tracing"`
+
+	// unknown are the keys of the file which are not settings, reported by Validate.
+	unknown []string
+	file    string
+}
+
+// Database configures the Postgres connection. With AutoMigrate the pending schema migrations are applied
+// on start, otherwise they are applied with the migrate command.
+type Database struct {
+	DSN         string `mapstructure:"dsn" env:"DB_DSN" redact:"dsn"`
+	AutoMigrate bool   `mapstructure:"auto_migrate" env:"DB_AUTO_MIGRATE" default:"false"`
+}
+
+// ObjectStorage configures the S3 compatible storage of the binaries.
+type ObjectStorage struct {
+	Endpoint  string `mapstructure:"endpoint" env:"ENDPOINT" default:"localhost:9000"`
+	AccessKey string `mapstructure:"access_key" env:"ACCESS_KEY" redact:"true"`
+	SecretKey string `mapstructure:"secret_key" env:"SECRET_KEY" redact:"true"`
+	Secure    bool   `mapstructure:"secure" env:"S3_SECURE" default:"false"`
+}
+
+// KMS configures the master key protecting the data encryption keys.
+type KMS struct {
+	MasterKey    string `mapstructure:"master_key" env:"MASTER_KEY"`
+	EncryptedKey string `mapstructure:"encrypted_key" env:"ENCRYPTED_KEY"`
+}
+
+// JWT configures the tokens. Algorithm is EdDSA or ES256, HS256 signs with AccessSecret
+// and RefreshSecret instead of a keyset.
+type JWT struct {
+	Algorithm       string        `mapstructure:"algorithm" env:"JWT_ALGORITHM" default:"EdDSA"`
+	AccessSecret    string        `mapstructure:"access_secret" env:"ACCESS_SECRET" default:"access_secret" redact:"true"`
+	RefreshSecret   string        `mapstructure:"refresh_secret" env:"REFRESH_SECRET" default:"refresh_secret" redact:"true"` //nolint:lll // tags
+	ServiceTokenTTL time.Duration `mapstructure:"service_token_ttl" env:"SERVICE_TOKEN_TTL" default:"15m"`
+}
+
+// Password is the policy for the passwords of the stored logins.
+type Password struct {
+	MinLength     int  `mapstructure:"min_length" env:"PASSWORD_MIN_LENGTH" default:"6"`
+	RequireLower  bool `mapstructure:"require_lower" env:"PASSWORD_REQUIRE_LOWER" default:"false"`
+	RequireUpper  bool `mapstructure:"require_upper" env:"PASSWORD_REQUIRE_UPPER" default:"false"`
+	RequireDigit  bool `mapstructure:"require_digit" env:"PASSWORD_REQUIRE_DIGIT" default:"false"`
+	RequireSymbol bool `mapstructure:"require_symbol" env:"PASSWORD_REQUIRE_SYMBOL" default:"false"`
+	MinScore      int  `mapstructure:"min_score" env:"PASSWORD_MIN_SCORE" default:"0"`
+}
+
+// Argon2 are the parameters of the account password hashes.
+type Argon2 struct {
+	Memory      uint `mapstructure:"memory" env:"ARGON2_MEMORY" default:"65536"`
+	Iterations  uint `mapstructure:"iterations" env:"ARGON2_ITERATIONS" default:"3"`
+	Parallelism uint `mapstructure:"parallelism" env:"ARGON2_PARALLELISM" default:"2"`
+}
+
+// RateLimit configures the rate limits, per minute for unauthenticated calls and per second for
+// authenticated ones, and the lockout of accounts after failed logins. The rates are reloaded on SIGHUP.
+type RateLimit struct {
+	IP               int           `mapstructure:"ip" env:"RATE_LIMIT_IP" default:"30"`
+	Login            int           `mapstructure:"login" env:"RATE_LIMIT_LOGIN" default:"10"`
+	User             int           `mapstructure:"user" env:"RATE_LIMIT_USER" default:"20"`
+	LockoutThreshold int           `mapstructure:"lockout_threshold" env:"LOCKOUT_THRESHOLD" default:"10"`
+	LockoutDuration  time.Duration `mapstructure:"lockout_duration" env:"LOCKOUT_DURATION" default:"15m"`
+	LoginDelayBase   time.Duration `mapstructure:"login_delay_base" env:"LOGIN_DELAY_BASE" default:"1s"`
+	LoginDelayMax    time.Duration `mapstructure:"login_delay_max" env:"LOGIN_DELAY_MAX" default:"30s"`
+}
+
+// OIDC configures single sign-on, it is enabled by setting the issuer.
+// Group roles are group=admin or group=org:role.
+type OIDC struct {
+	Issuer        string   `mapstructure:"issuer" env:"OIDC_ISSUER"`
+	ClientID      string   `mapstructure:"client_id" env:"OIDC_CLIENT_ID"`
+	Scopes        []string `mapstructure:"scopes" env:"OIDC_SCOPES" default:"openid,profile,email"`
+	UsernameClaim string   `mapstructure:"username_claim" env:"OIDC_USERNAME_CLAIM" default:"preferred_username"`
+	GroupsClaim   string   `mapstructure:"groups_claim" env:"OIDC_GROUPS_CLAIM" default:"groups"`
+	GroupRoles    []string `mapstructure:"group_roles" env:"OIDC_GROUP_ROLES"`
+}
+
+// Tracing configures the export of the spans: none, otlp to the collector set by the standard
+// OTEL_EXPORTER_OTLP_* variables, or stdout for local debugging.
+type Tracing struct {
+	Exporter    string  `mapstructure:"exporter" env:"TRACES_EXPORTER" default:"none"`
+	SampleRatio float64 `mapstructure:"sample_ratio" env:"TRACES_SAMPLE_RATIO" default:"1"`
+}
+
+// setting is a leaf field of Config.
+type setting struct {
+	key    string
+	env    string
+	def    string
+	redact string
+	index  []int
+	typ    reflect.Type
+}
+
+// flag is the name of the command line flag of the setting.
+func (s setting) flag() string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(s.key)
+}
+
+// String names the setting in errors by its key and its variable.
+func (s setting) String() string {
+	return fmt.Sprintf("%s (%s)", s.key, s.env)
+}
+
+var settings = sync.OnceValue(func() []setting {
+	return collect(reflect.TypeFor[Config](), "", nil)
+})
+
+func collect(typ reflect.Type, prefix string, index []int) []setting {
+	var result []setting
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key := prefix + field.Tag.Get("mapstructure")
+		fieldIndex := append(slices.Clone(index), i)
+		if field.Type.Kind() == reflect.Struct && field.Type != reflect.TypeFor[time.Duration]() {
+			result = append(result, collect(field.Type, key+".", fieldIndex)...)
+			continue
+		}
+		result = append(result, setting{
+			key:    key,
+			env:    field.Tag.Get("env"),
+			def:    field.Tag.Get("default"),
+			redact: field.Tag.Get("redact"),
+			index:  fieldIndex,
+			typ:    field.Type,
+		})
+	}
+	return result
+}
+
+func lookup(key string) setting {
+	for _, s := range settings() {
+		if s.key == key {
+			return s
+		}
+	}
+	panic("unknown setting " + key)
+}
+
+// RegisterFlags adds the flag of the configuration file and of every setting to flags.
+func RegisterFlags(flags *pflag.FlagSet) {
+	flags.String(FileFlag, "", "configuration file, YAML or TOML (env "+FileEnv+")")
+	for _, s := range settings() {
+		usage := "env " + s.env
+		switch s.typ {
+		case reflect.TypeFor[string]():
+			flags.String(s.flag(), s.def, usage)
+		case reflect.TypeFor[bool]():
+			flags.Bool(s.flag(), must(strconv.ParseBool(s.def)), usage)
+		case reflect.TypeFor[int]():
+			flags.Int(s.flag(), must(strconv.Atoi(s.def)), usage)
+		case reflect.TypeFor[uint]():
+			flags.Uint(s.flag(), uint(must(strconv.ParseUint(s.def, 10, 0))), usage)
+		case reflect.TypeFor[float64]():
+			flags.Float64(s.flag(), must(strconv.ParseFloat(s.def, 64)), usage)
+		case reflect.TypeFor[time.Duration]():
+			flags.Duration(s.flag(), must(time.ParseDuration(s.def)), usage)
+		case reflect.TypeFor[[]string]():
+			flags.StringSlice(s.flag(), splitList(s.def), usage)
+		default:
+			panic(fmt.Sprintf("unsupported type %s of setting %s", s.typ, s.key))
+		}
+	}
+}
+
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// Read reads the configuration from the file, the environment and the flags registered by
+// RegisterFlags, flags may be nil.
+func Read(flags *pflag.FlagSet) (*Config, error) {
+	v := viper.New()
+	for _, s := range settings() {
+		v.SetDefault(s.key, s.def)
+		_ = v.BindEnv(s.key, s.env)
+		if flags == nil {
+			continue
+		}
+		if flag := flags.Lookup(s.flag()); flag != nil {
+			_ = v.BindPFlag(s.key, flag)
+		}
+	}
+
+	file := os.Getenv(FileEnv)
+	if flags != nil {
+		if flag := flags.Lookup(FileFlag); flag != nil && flag.Changed {
+			file = flag.Value.String()
+		}
+	}
+	if file != "" {
+		v.SetConfigFile(file)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read the configuration file %s: %w", file, err)
+		}
+	}
+
+	cfg := Config{file: file}
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse the configuration: %w", err)
+	}
+	for _, key := range v.AllKeys() {
+		if !slices.ContainsFunc(settings(), func(s setting) bool { return s.key == key }) {
+			cfg.unknown = append(cfg.unknown, key)
+		}
+	}
+	return &cfg, nil
+}
+
+// Load reads and validates the configuration, all problems are reported at once.
+func Load(flags *pflag.FlagSet) (*Config, error) {
+	cfg, err := Read(flags)
+	if err != nil {
+		return nil, err
+	}
+	if err = cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
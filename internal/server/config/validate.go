@@ -0,0 +1,116 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/itallix/gophkeeper/internal/common/tracing"
+	"github.com/itallix/gophkeeper/internal/server"
+	"github.com/itallix/gophkeeper/internal/server/service"
+)
+
+// JWTAlgorithmHMAC signs the tokens with the configured secrets instead of a keyset.
+const JWTAlgorithmHMAC = "HS256"
+
+const (
+	defaultAccessSecret  = "access_secret"
+	defaultRefreshSecret = "refresh_secret"
+	maxPasswordScore     = 4
+)
+
+// ErrInvalid is wrapped by the errors of Validate.
+var ErrInvalid = errors.New("invalid setting")
+
+// validator collects the problems of the configuration.
+type validator struct {
+	errs []error
+}
+
+func (v *validator) check(ok bool, key, format string, args ...any) {
+	if !ok {
+		v.errs = append(v.errs, fmt.Errorf("%w %s: %s", ErrInvalid, lookup(key), fmt.Sprintf(format, args...)))
+	}
+}
+
+func (v *validator) required(value, key string) {
+	v.check(value != "", key, "is required")
+}
+
+func (v *validator) file(path, key string) {
+	if path == "" {
+		v.required(path, key)
+		return
+	}
+	_, err := os.Stat(path)
+	v.check(err == nil, key, "%v", err)
+}
+
+// Validate checks the settings, the returned error joins all the problems found.
+func (c *Config) Validate() error {
+	var v validator
+	for _, key := range c.unknown {
+		v.errs = append(v.errs, fmt.Errorf("%w %s in %s: unknown", ErrInvalid, key, c.file))
+	}
+
+	v.required(c.Address, "address")
+	_, err := zapcore.ParseLevel(c.LogLevel)
+	v.check(err == nil, "log_level", "unknown level %q", c.LogLevel)
+	v.check(c.HealthCheckInterval > 0, "health_check_interval", "must be positive")
+	for _, origin := range c.CORSOrigins {
+		v.check(strings.TrimSpace(origin) != "", "cors_allowed_origins", "contains an empty origin")
+	}
+
+	v.required(c.Database.DSN, "database.dsn")
+	v.required(c.ObjectStorage.Endpoint, "object_storage.endpoint")
+	v.required(c.ObjectStorage.AccessKey, "object_storage.access_key")
+	v.required(c.ObjectStorage.SecretKey, "object_storage.secret_key")
+	v.file(c.KMS.MasterKey, "kms.master_key")
+	v.file(c.KMS.EncryptedKey, "kms.encrypted_key")
+
+	switch c.JWT.Algorithm {
+	case service.AlgEdDSA, service.AlgES256:
+	case JWTAlgorithmHMAC:
+		v.required(c.JWT.AccessSecret, "jwt.access_secret")
+		v.required(c.JWT.RefreshSecret, "jwt.refresh_secret")
+		v.check(c.DevMode || c.JWT.AccessSecret != defaultAccessSecret, "jwt.access_secret",
+			"must be changed from the default, set dev_mode to run with it locally")
+		v.check(c.DevMode || c.JWT.RefreshSecret != defaultRefreshSecret, "jwt.refresh_secret",
+			"must be changed from the default, set dev_mode to run with it locally")
+	default:
+		v.check(false, "jwt.algorithm", "must be %s, %s or %s", service.AlgEdDSA, service.AlgES256, JWTAlgorithmHMAC)
+	}
+	v.check(c.JWT.ServiceTokenTTL > 0, "jwt.service_token_ttl", "must be positive")
+
+	v.check(c.Password.MinLength >= 0, "password.min_length", "must not be negative")
+	v.check(c.Password.MinScore >= 0 && c.Password.MinScore <= maxPasswordScore, "password.min_score",
+		"must be between 0 and %d", maxPasswordScore)
+	v.check(c.Argon2.Memory > 0 && c.Argon2.Memory <= math.MaxUint32, "argon2.memory",
+		"must be between 1 and %d KiB", uint32(math.MaxUint32))
+	v.check(c.Argon2.Iterations > 0 && c.Argon2.Iterations <= math.MaxUint32, "argon2.iterations", "must be positive")
+	v.check(c.Argon2.Parallelism > 0 && c.Argon2.Parallelism <= math.MaxUint8, "argon2.parallelism",
+		"must be between 1 and %d", math.MaxUint8)
+
+	v.check(c.RateLimit.LockoutThreshold >= 0, "rate_limit.lockout_threshold", "must not be negative")
+	v.check(c.RateLimit.LockoutDuration > 0, "rate_limit.lockout_duration", "must be positive")
+	v.check(c.RateLimit.LoginDelayBase >= 0, "rate_limit.login_delay_base", "must not be negative")
+	v.check(c.RateLimit.LoginDelayMax >= c.RateLimit.LoginDelayBase, "rate_limit.login_delay_max",
+		"must not be less than rate_limit.login_delay_base")
+
+	if c.OIDC.Issuer != "" {
+		v.required(c.OIDC.ClientID, "oidc.client_id")
+	}
+	_, err = server.ParseGroupRoles(c.OIDC.GroupRoles)
+	v.check(err == nil, "oidc.group_roles", "%v", err)
+
+	v.check(c.Tracing.Exporter == tracing.ExporterNone || c.Tracing.Exporter == tracing.ExporterOTLP ||
+		c.Tracing.Exporter == tracing.ExporterStdout, "tracing.exporter", "must be %s, %s or %s",
+		tracing.ExporterNone, tracing.ExporterOTLP, tracing.ExporterStdout)
+	v.check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio", "must be between 0 and 1")
+
+	return errors.Join(v.errs...)
+}
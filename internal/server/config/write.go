@@ -0,0 +1,121 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats of the written configuration.
+const (
+	FormatYAML = "yaml"
+	FormatTOML = "toml"
+)
+
+// Redacted replaces the values of secrets in the written configuration.
+const Redacted = "REDACTED"
+
+// ErrUnknownFormat is returned by Write for a format other than yaml or toml.
+var ErrUnknownFormat = errors.New("unknown configuration format")
+
+// reloadable are the settings applied on SIGHUP, the others need a restart.
+var reloadable = map[string]bool{
+	"log_level":        true,
+	"rate_limit.ip":    true,
+	"rate_limit.login": true,
+	"rate_limit.user":  true,
+}
+
+var dsnPassword = regexp.MustCompile(`(password=)('[^']*'|[^&\s]+)`)
+
+// redactDSN hides the password of a connection string in the URL or the key=value form.
+func redactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), Redacted)
+		}
+		u.RawQuery = dsnPassword.ReplaceAllString(u.RawQuery, "${1}"+Redacted)
+		return u.String()
+	}
+	return dsnPassword.ReplaceAllString(dsn, "${1}"+Redacted)
+}
+
+// value returns the setting of cfg in the form written to the files.
+func (s setting) value(cfg *Config, redact bool) any {
+	field := reflect.ValueOf(cfg).Elem().FieldByIndex(s.index)
+	switch value := field.Interface().(type) {
+	case time.Duration:
+		return value.String()
+	case []string:
+		return append([]string{}, value...)
+	case string:
+		switch {
+		case !redact || value == "" || s.redact == "":
+			return value
+		case s.redact == "dsn":
+			return redactDSN(value)
+		default:
+			return Redacted
+		}
+	default:
+		return value
+	}
+}
+
+// Write prints the configuration as a YAML or TOML file with the secrets redacted.
+func (c *Config) Write(w io.Writer, format string) error {
+	tree := make(map[string]any)
+	for _, s := range settings() {
+		section := tree
+		path := strings.Split(s.key, ".")
+		for _, name := range path[:len(path)-1] {
+			if _, ok := section[name]; !ok {
+				section[name] = make(map[string]any)
+			}
+			section, _ = section[name].(map[string]any)
+		}
+		section[path[len(path)-1]] = s.value(c, true)
+	}
+
+	var data []byte
+	var err error
+	switch format {
+	case FormatYAML:
+		data, err = yaml.Marshal(tree)
+	case FormatTOML:
+		data, err = toml.Marshal(tree)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode the configuration: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Changes lists the keys of the settings that differ between the configurations.
+func Changes(old, updated *Config) []string {
+	var keys []string
+	for _, s := range settings() {
+		if !reflect.DeepEqual(s.value(old, false), s.value(updated, false)) {
+			keys = append(keys, s.key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Reloadable reports whether a change of the setting is applied without a restart.
+func Reloadable(key string) bool {
+	return reloadable[key]
+}
@@ -0,0 +1,221 @@
+// Package gateway serves GophkeeperService over HTTP/JSON for clients that can't speak gRPC.
+//
+// The calls are translated by grpc-gateway according to api/proto/v1/gateway.yaml and passed on
+// to the gRPC server, so they go through the same authentication, authorization, auditing and
+// rate limiting. The Authorization header is forwarded as is and the gRPC status codes are mapped
+// to the HTTP ones. Binaries are uploaded as multipart/form-data and downloaded as a plain stream
+// of bytes by the hand-written handlers of this package.
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/itallix/gophkeeper/internal/server/grpc/middleware"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+const (
+	// ChunkSize is the size of the chunks the uploaded files are split into, the same as used by the CLI.
+	ChunkSize = 512 * 1024
+	// FileField is the name of the multipart form field carrying the uploaded file.
+	FileField = "file"
+	// HashTrailer is the trailer carrying the SHA-256 of the downloaded file, in hex.
+	HashTrailer = "X-Content-Sha256"
+
+	uploadMethod   = "/api.v1.GophkeeperService/Upload"
+	downloadMethod = "/api.v1.GophkeeperService/Download"
+)
+
+// outgoingHeader exposes the retry delay of the rate limiter as the standard header, other metadata
+// keeps the default Grpc-Metadata- prefix.
+func outgoingHeader(key string) (string, bool) {
+	if key == middleware.RetryAfterKey {
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// New creates the HTTP handler calling the gRPC server over conn.
+func New(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+	)
+	if err := pb.RegisterGophkeeperServiceHandler(ctx, mux, conn); err != nil {
+		return nil, fmt.Errorf("failed to register gateway handlers: %w", err)
+	}
+
+	client := pb.NewGophkeeperServiceClient(conn)
+	if err := mux.HandlePath(http.MethodPost, "/v1/binaries", upload(mux, client)); err != nil {
+		return nil, fmt.Errorf("failed to register upload handler: %w", err)
+	}
+	if err := mux.HandlePath(http.MethodGet, "/v1/binaries/{filename=**}", download(mux, client)); err != nil {
+		return nil, fmt.Errorf("failed to register download handler: %w", err)
+	}
+	return mux, nil
+}
+
+// filePart returns the part of the multipart form carrying the file. The body is streamed, so the
+// parts before it are skipped and the ones after it are never read.
+func filePart(r *http.Request) (io.Reader, string, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		return nil, "", status.Error(codes.InvalidArgument, "expected a multipart/form-data body")
+	}
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "invalid multipart body: %v", err)
+	}
+	for {
+		part, partErr := reader.NextPart()
+		if errors.Is(partErr, io.EOF) {
+			return nil, "", status.Errorf(codes.InvalidArgument, "the %q form field is missing", FileField)
+		}
+		if partErr != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid multipart body: %v", partErr)
+		}
+		if part.FormName() == FileField {
+			return part, part.FileName(), nil
+		}
+	}
+}
+
+// sendFile streams the file in chunks the same way the CLI does: each chunk carries its hash,
+// the final chunk without data carries the hash of the whole file.
+func sendFile(stream pb.GophkeeperService_UploadClient, path string, file io.Reader) (*pb.UploadResponse, error) {
+	fileHash := sha256.New()
+	buffer := make([]byte, ChunkSize)
+	chunkID := int64(0)
+	for {
+		n, readErr := io.ReadFull(file, buffer)
+		if n > 0 {
+			data := buffer[:n]
+			chunkHash := sha256.Sum256(data)
+			fileHash.Write(data)
+			if err := stream.Send(&pb.Chunk{
+				Filename: path,
+				Data:     data,
+				ChunkId:  chunkID,
+				Hash:     hex.EncodeToString(chunkHash[:]),
+			}); err != nil {
+				// the server has rejected the upload, CloseAndRecv returns its status
+				return stream.CloseAndRecv()
+			}
+			chunkID++
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to read the uploaded file: %v", readErr)
+		}
+	}
+	// a failed send is reported by CloseAndRecv as well
+	_ = stream.Send(&pb.Chunk{
+		Filename: path,
+		ChunkId:  chunkID,
+		Hash:     hex.EncodeToString(fileHash.Sum(nil)),
+	})
+	return stream.CloseAndRecv()
+}
+
+// upload stores the file of a multipart form as a binary secret. The path is taken from the path
+// query parameter or, if it is missing, from the name of the uploaded file.
+func upload(mux *runtime.ServeMux, client pb.GophkeeperServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, uploadMethod,
+			runtime.WithHTTPPathPattern("/v1/binaries"))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+		file, filename, err := filePart(r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		path := r.URL.Query().Get("path")
+		if path == "" {
+			path = filename
+		}
+		if path == "" {
+			runtime.HTTPError(ctx, mux, outbound, w, r,
+				status.Error(codes.InvalidArgument, "the path of the binary is missing"))
+			return
+		}
+
+		stream, err := client.Upload(ctx)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		resp, err := sendFile(stream, path, file)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, resp)
+	}
+}
+
+// download streams the data of a binary secret. The status is only sent once the server
+// has answered with the first chunk, so failures like a missing binary get the proper code.
+// The hash of the whole file follows the data as a trailer.
+func download(mux *runtime.ServeMux, client pb.GophkeeperServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, downloadMethod,
+			runtime.WithHTTPPathPattern("/v1/binaries/{filename=**}"))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+		stream, err := client.Download(ctx, &pb.DownloadRequest{Filename: params["filename"]})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		chunk, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+			map[string]string{"filename": params["filename"]}))
+		w.Header().Set("Trailer", textproto.CanonicalMIMEHeaderKey(HashTrailer))
+		w.WriteHeader(http.StatusOK)
+		for ; err == nil; chunk, err = stream.Recv() {
+			if chunk.GetData() == nil {
+				w.Header().Set(HashTrailer, chunk.GetHash())
+				continue
+			}
+			if _, writeErr := w.Write(chunk.GetData()); writeErr != nil {
+				return
+			}
+		}
+		if !errors.Is(err, io.EOF) {
+			// the status has already been sent, aborting the response tells the client the data is incomplete
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package gateway_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/itallix/gophkeeper/internal/server/gateway"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+const token = "Bearer valid"
+
+// vaultServer keeps uploaded binaries in memory and requires the token on every call.
+type vaultServer struct {
+	pb.UnimplementedGophkeeperServiceServer
+	binaries map[string][]byte
+}
+
+func authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) == 0 || values[0] != token {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return nil
+}
+
+func (s *vaultServer) Get(ctx context.Context, req *pb.GetRequest) (*pb.GetResponse, error) {
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetPath() != "bank/mail" || req.GetType() != pb.DataType_DATA_TYPE_LOGIN {
+		return nil, status.Errorf(codes.NotFound, "secret %s not found", req.GetPath())
+	}
+	return &pb.GetResponse{Data: &pb.TypedData{
+		Type: req.GetType(),
+		Base: &pb.Metadata{Path: req.GetPath()},
+		Data: &pb.TypedData_Login{Login: &pb.LoginData{Login: "user", Password: "secret"}},
+	}}, nil
+}
+
+func (s *vaultServer) Upload(stream pb.GophkeeperService_UploadServer) error {
+	if err := authorize(stream.Context()); err != nil {
+		return err
+	}
+	var data []byte
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if chunk.GetData() == nil {
+			fileHash := sha256.Sum256(data)
+			if chunk.GetHash() != hex.EncodeToString(fileHash[:]) {
+				return status.Error(codes.Aborted, "file hash mismatch")
+			}
+			s.binaries[chunk.GetFilename()] = data
+			return stream.SendAndClose(&pb.UploadResponse{Message: "uploaded " + chunk.GetFilename()})
+		}
+		data = append(data, chunk.GetData()...)
+	}
+}
+
+func (s *vaultServer) Download(req *pb.DownloadRequest, stream pb.GophkeeperService_DownloadServer) error {
+	if err := authorize(stream.Context()); err != nil {
+		return err
+	}
+	data, ok := s.binaries[req.GetFilename()]
+	if !ok {
+		return status.Error(codes.NotFound, "binary not found")
+	}
+	for i := 0; i < len(data); i += 4 {
+		if err := stream.Send(&pb.Chunk{Filename: req.GetFilename(), Data: data[i:min(i+4, len(data))]}); err != nil {
+			return err
+		}
+	}
+	fileHash := sha256.Sum256(data)
+	return stream.Send(&pb.Chunk{Filename: req.GetFilename(), Hash: hex.EncodeToString(fileHash[:])})
+}
+
+func newGateway(t *testing.T) (*httptest.Server, *vaultServer) {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	vault := &vaultServer{binaries: make(map[string][]byte)}
+	pb.RegisterGophkeeperServiceServer(grpcServer, vault)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	handler, err := gateway.New(context.Background(), conn)
+	require.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv, vault
+}
+
+func call(t *testing.T, method, url string, body io.Reader, contentType string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), method, url, body)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func decode(t *testing.T, resp *http.Response) map[string]any {
+	t.Helper()
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body
+}
+
+func TestGet(t *testing.T) {
+	srv, _ := newGateway(t)
+
+	resp := call(t, http.MethodGet, srv.URL+"/v1/secrets/DATA_TYPE_LOGIN/bank/mail", nil, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	data := decode(t, resp)["data"].(map[string]any)
+	assert.Equal(t, map[string]any{"login": "user", "password": "secret"}, data["login"])
+	assert.Equal(t, "bank/mail", data["base"].(map[string]any)["path"])
+
+	resp = call(t, http.MethodGet, srv.URL+"/v1/secrets/DATA_TYPE_LOGIN/bank/other", nil, "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "secret bank/other not found", decode(t, resp)["message"])
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet,
+		srv.URL+"/v1/secrets/DATA_TYPE_LOGIN/bank/mail", nil)
+	require.NoError(t, err)
+	anonymous, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer anonymous.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, anonymous.StatusCode)
+}
+
+func multipartFile(t *testing.T, name string, data []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writer.WriteField("comment", "skipped"))
+	part, err := writer.CreateFormFile(gateway.FileField, name)
+	require.NoError(t, err)
+	_, err = part.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return &body, writer.FormDataContentType()
+}
+
+func TestUploadDownload(t *testing.T) {
+	srv, vault := newGateway(t)
+	data := bytes.Repeat([]byte("gopher"), gateway.ChunkSize/3)
+
+	body, contentType := multipartFile(t, "photo.png", data)
+	resp := call(t, http.MethodPost, srv.URL+"/v1/binaries?path=albums/photo.png", body, contentType)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "uploaded albums/photo.png", decode(t, resp)["message"])
+	assert.Equal(t, data, vault.binaries["albums/photo.png"])
+
+	resp = call(t, http.MethodGet, srv.URL+"/v1/binaries/albums/photo.png", nil, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+	downloaded, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+	fileHash := sha256.Sum256(data)
+	assert.Equal(t, hex.EncodeToString(fileHash[:]), resp.Trailer.Get(gateway.HashTrailer))
+}
+
+func TestUploadErrors(t *testing.T) {
+	srv, _ := newGateway(t)
+
+	resp := call(t, http.MethodPost, srv.URL+"/v1/binaries", strings.NewReader("{}"), "application/json")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	body, contentType := multipartFile(t, "", []byte("data"))
+	resp = call(t, http.MethodPost, srv.URL+"/v1/binaries", body, contentType)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, decode(t, resp)["message"], "path")
+
+	body, contentType = multipartFile(t, "photo.png", []byte("data"))
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL+"/v1/binaries", body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	anonymous, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer anonymous.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, anonymous.StatusCode)
+}
+
+func TestDownloadMissing(t *testing.T) {
+	srv, _ := newGateway(t)
+
+	resp := call(t, http.MethodGet, srv.URL+"/v1/binaries/missing.png", nil, "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "binary not found", decode(t, resp)["message"])
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// AuditTarget extracts the path and type of the secret a request refers to, if any.
+func AuditTarget(req any) (string, models.VaultItemType) {
+	var itemType models.VaultItemType
+	if r, ok := req.(interface{ GetType() pb.DataType }); ok {
+		itemType = itemTypes[r.GetType()]
+	}
+	switch r := req.(type) {
+	case *pb.CreateRequest:
+		return r.GetData().GetBase().GetPath(), itemTypes[r.GetData().GetType()]
+	case interface{ GetFilename() string }:
+		return r.GetFilename(), models.BinaryType
+	case interface{ GetPath() string }:
+		return r.GetPath(), itemType
+	}
+	return "", itemType
+}
+
+func parseTimestamp(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s must be an RFC 3339 timestamp: %v", field, err)
+	}
+	return t, nil
+}
+
+func (srv *GophkeeperServer) ListAuditEvents(ctx context.Context,
+	req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if srv.audit == nil {
+		return nil, status.Error(codes.Unimplemented, "audit log is not enabled")
+	}
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+	since, err := parseTimestamp("since", req.GetSince())
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseTimestamp("until", req.GetUntil())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := models.AuditFilter{
+		Actor:      req.GetActor(),
+		Action:     req.GetAction(),
+		PathPrefix: req.GetPath(),
+		Since:      since,
+		Until:      until,
+		Limit:      int(req.GetLimit()),
+	}
+	if !srv.isAdmin(username) {
+		filter.Participant = username
+	}
+	events, err := srv.audit.ListAuditEvents(filter)
+	if err != nil {
+		return nil, actionError(err)
+	}
+
+	resp := &pb.ListAuditEventsResponse{Events: make([]*pb.AuditEvent, 0, len(events))}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &pb.AuditEvent{
+			Id:        event.EventID,
+			Actor:     event.Actor,
+			Action:    event.Action,
+			Path:      event.Path,
+			Type:      dataTypes[event.Type],
+			Owner:     event.Owner,
+			Result:    event.Result,
+			ClientIp:  event.ClientIP,
+			UserAgent: event.UserAgent,
+			CreatedAt: event.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (srv *GophkeeperServer) VerifyAuditLog(ctx context.Context,
+	_ *pb.VerifyAuditLogRequest) (*pb.VerifyAuditLogResponse, error) {
+	if srv.audit == nil {
+		return nil, status.Error(codes.Unimplemented, "audit log is not enabled")
+	}
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+	if !srv.isAdmin(username) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not an administrator", username)
+	}
+
+	result, err := srv.audit.VerifyAuditLog()
+	if err != nil {
+		return nil, actionError(err)
+	}
+	return &pb.VerifyAuditLogResponse{
+		Valid:    result.BrokenAt == 0,
+		Events:   result.Events,
+		BrokenAt: result.BrokenAt,
+		Reason:   result.Reason,
+	}, nil
+}
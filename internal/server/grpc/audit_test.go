@@ -0,0 +1,140 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestAuditTarget(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      any
+		path     string
+		itemType models.VaultItemType
+	}{
+		{
+			name:     "get",
+			req:      &pb.GetRequest{Type: pb.DataType_DATA_TYPE_CARD, Path: "bank/visa"},
+			path:     "bank/visa",
+			itemType: models.CardType,
+		},
+		{
+			name: "create",
+			req: &pb.CreateRequest{Data: &pb.TypedData{
+				Type: pb.DataType_DATA_TYPE_NOTE,
+				Base: &pb.Metadata{Path: "notes/todo"},
+			}},
+			path:     "notes/todo",
+			itemType: models.NoteType,
+		},
+		{
+			name:     "download",
+			req:      &pb.DownloadRequest{Filename: "ops/cert.pem"},
+			path:     "ops/cert.pem",
+			itemType: models.BinaryType,
+		},
+		{
+			name: "share",
+			req:  &pb.ShareRequest{Path: "team/db"},
+			path: "team/db",
+		},
+		{
+			name:     "list",
+			req:      &pb.ListRequest{Type: pb.DataType_DATA_TYPE_LOGIN},
+			itemType: models.LoginType,
+		},
+		{
+			name: "stream without messages",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, itemType := grpc.AuditTarget(tt.req)
+			assert.Equal(t, tt.path, path)
+			assert.Equal(t, tt.itemType, itemType)
+		})
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	created := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	events := []models.AuditEvent{
+		{EventID: 2, Actor: "lucius", Action: "Get", Path: "team/db", Type: models.LoginType, Owner: "mark",
+			Result: "OK", ClientIP: "10.0.0.2", CreatedAt: created},
+	}
+
+	t.Run("users see their own activity", func(t *testing.T) {
+		audit := mocksrv.NewAuditLog(t)
+		audit.EXPECT().ListAuditEvents(models.AuditFilter{
+			Participant: "mark",
+			Action:      "Get",
+			PathPrefix:  "team/",
+			Since:       created,
+			Limit:       10,
+		}).Return(events, nil)
+
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithAuditLog(audit))
+		ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+		resp, err := server.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{
+			Action: "Get",
+			Path:   "team/",
+			Since:  "2026-10-19T12:00:00Z",
+			Limit:  10,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.GetEvents(), 1)
+		event := resp.GetEvents()[0]
+		assert.Equal(t, "lucius", event.GetActor())
+		assert.Equal(t, pb.DataType_DATA_TYPE_LOGIN, event.GetType())
+		assert.Equal(t, "2026-10-19T12:00:00Z", event.GetCreatedAt())
+	})
+
+	t.Run("admins see everything", func(t *testing.T) {
+		audit := mocksrv.NewAuditLog(t)
+		audit.EXPECT().ListAuditEvents(models.AuditFilter{Actor: "lucius"}).Return(events, nil)
+
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithAuditLog(audit), grpc.WithAdmins("root"))
+		ctx := context.WithValue(context.Background(), grpc.UsernameKey, "root")
+		_, err := server.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{Actor: "lucius"})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithAuditLog(mocksrv.NewAuditLog(t)))
+		ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+		_, err := server.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{Until: "yesterday"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	audit := mocksrv.NewAuditLog(t)
+	audit.EXPECT().VerifyAuditLog().
+		Return(models.AuditVerification{Events: 42, BrokenAt: 17, Reason: "event has been modified"}, nil).Once()
+
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithAuditLog(audit), grpc.WithAdmins("root"))
+
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+	_, err := server.VerifyAuditLog(ctx, &pb.VerifyAuditLogRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx = context.WithValue(context.Background(), grpc.UsernameKey, "root")
+	resp, err := server.VerifyAuditLog(ctx, &pb.VerifyAuditLogRequest{})
+	require.NoError(t, err)
+	assert.False(t, resp.GetValid())
+	assert.Equal(t, int64(42), resp.GetEvents())
+	assert.Equal(t, int64(17), resp.GetBrokenAt())
+}
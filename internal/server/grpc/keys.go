@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func (srv *GophkeeperServer) GetJWKS(_ context.Context, _ *pb.GetJWKSRequest) (*pb.GetJWKSResponse, error) {
+	if srv.signingKeys == nil {
+		return nil, status.Error(codes.Unimplemented, "tokens are not signed with asymmetric keys")
+	}
+
+	jwks := srv.signingKeys.JWKS()
+	resp := &pb.GetJWKSResponse{Keys: make([]*pb.JWK, 0, len(jwks))}
+	for _, key := range jwks {
+		resp.Keys = append(resp.Keys, &pb.JWK{
+			Kty: key.KeyType,
+			Crv: key.Curve,
+			X:   key.X,
+			Y:   key.Y,
+			Kid: key.KeyID,
+			Alg: key.Algorithm,
+			Use: key.Use,
+		})
+	}
+	return resp, nil
+}
+
+func (srv *GophkeeperServer) RotateSigningKey(ctx context.Context,
+	_ *pb.RotateSigningKeyRequest) (*pb.RotateSigningKeyResponse, error) {
+	if srv.signingKeys == nil {
+		return nil, status.Error(codes.Unimplemented, "tokens are not signed with asymmetric keys")
+	}
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+	if _, scoped := ctx.Value(ScopeKey).(models.Scope); scoped || !srv.isAdmin(username) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not an administrator", username)
+	}
+
+	key, err := srv.signingKeys.Rotate()
+	if err != nil {
+		logger.Log().Errorf("failed to rotate signing key: %v", err)
+		return nil, status.Error(codes.Internal, "failed to rotate signing key")
+	}
+	logger.Log().Infof("Signing key has been rotated by user=[%s], new kid=[%s].", username, key.ID)
+
+	return &pb.RotateSigningKeyResponse{Kid: key.ID, Algorithm: key.Algorithm}, nil
+}
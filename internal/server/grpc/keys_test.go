@@ -0,0 +1,64 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/service"
+	mocks "github.com/itallix/gophkeeper/mocks/internal_/server/service"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestGetJWKS(t *testing.T) {
+	_, err := grpc.NewGophkeeperServer(nil, nil, nil).GetJWKS(context.Background(), &pb.GetJWKSRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	keys := mocks.NewSigningKeys(t)
+	keys.EXPECT().JWKS().Return([]service.JWK{
+		{KeyType: "OKP", Curve: "Ed25519", X: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo", KeyID: "new",
+			Algorithm: "EdDSA", Use: "sig"},
+		{KeyType: "EC", Curve: "P-256", X: "x", Y: "y", KeyID: "old", Algorithm: "ES256", Use: "sig"},
+	}).Once()
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSigningKeys(keys))
+
+	resp, err := server.GetJWKS(context.Background(), &pb.GetJWKSRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetKeys(), 2)
+	assert.Equal(t, "new", resp.GetKeys()[0].GetKid())
+	assert.Equal(t, "Ed25519", resp.GetKeys()[0].GetCrv())
+	assert.Empty(t, resp.GetKeys()[0].GetY())
+	assert.Equal(t, "ES256", resp.GetKeys()[1].GetAlg())
+	assert.Equal(t, "y", resp.GetKeys()[1].GetY())
+}
+
+func TestRotateSigningKey(t *testing.T) {
+	keys := mocks.NewSigningKeys(t)
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSigningKeys(keys), grpc.WithAdmins("root"))
+
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+	_, err := server.RotateSigningKey(ctx, &pb.RotateSigningKeyRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx = context.WithValue(context.Background(), grpc.UsernameKey, "root")
+	scoped := context.WithValue(ctx, grpc.ScopeKey, models.Scope{})
+	_, err = server.RotateSigningKey(scoped, &pb.RotateSigningKeyRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "service accounts can't rotate keys")
+
+	keys.EXPECT().Rotate().Return(service.SigningKey{ID: "abc", Algorithm: service.AlgES256}, nil).Once()
+	resp, err := server.RotateSigningKey(ctx, &pb.RotateSigningKeyRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", resp.GetKid())
+	assert.Equal(t, service.AlgES256, resp.GetAlgorithm())
+
+	keys.EXPECT().Rotate().Return(service.SigningKey{}, errors.New("db is down")).Once()
+	_, err = server.RotateSigningKey(ctx, &pb.RotateSigningKeyRequest{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
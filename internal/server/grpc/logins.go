@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+var uriMatches = map[pb.URIMatch]models.URIMatch{
+	pb.URIMatch_URI_MATCH_BASE_DOMAIN: models.URIMatchBaseDomain,
+	pb.URIMatch_URI_MATCH_HOST:        models.URIMatchHost,
+	pb.URIMatch_URI_MATCH_EXACT:       models.URIMatchExact,
+	pb.URIMatch_URI_MATCH_REGEX:       models.URIMatchRegex,
+}
+
+// toURIMatch converts the match rule, unspecified defaults to the base domain and unknown rules are
+// passed on for the validator to report.
+func toURIMatch(match pb.URIMatch) models.URIMatch {
+	if match == pb.URIMatch_URI_MATCH_UNSPECIFIED {
+		return models.URIMatchBaseDomain
+	}
+	if m, ok := uriMatches[match]; ok {
+		return m
+	}
+	return models.URIMatch(match.String())
+}
+
+func fromURIMatch(match models.URIMatch) pb.URIMatch {
+	for m, rule := range uriMatches {
+		if rule == match {
+			return m
+		}
+	}
+	return pb.URIMatch_URI_MATCH_UNSPECIFIED
+}
+
+func fromLoginURI(uri models.LoginURI) *pb.LoginURI {
+	return &pb.LoginURI{Uri: uri.URI, Match: fromURIMatch(uri.Match)}
+}
+
+// loginOptions converts the login data of a create request.
+func loginOptions(data *pb.LoginData) []models.LoginOption {
+	opts := []models.LoginOption{
+		models.WithLogin(data.GetLogin()),
+		models.WithPassword(data.GetPassword()),
+		models.WithTOTP(data.GetTotp()),
+		models.WithNotes(data.GetNotes()),
+	}
+	for _, uri := range data.GetUris() {
+		opts = append(opts, models.WithURI(uri.GetUri(), toURIMatch(uri.GetMatch())))
+	}
+	for _, field := range data.GetFields() {
+		opts = append(opts, models.WithLoginField(field.GetName(), field.GetValue(), field.GetSensitive()))
+	}
+	return opts
+}
+
+// FindByURL returns the logins of the caller whose URIs match the URL, e.g. to fill in a sign-in form.
+func (srv *GophkeeperServer) FindByURL(ctx context.Context, req *pb.FindByURLRequest) (*pb.FindByURLResponse, error) {
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	matches, err := srv.vault.FindLogins(ctx, req.GetUrl(), username)
+	if err != nil {
+		return nil, actionError(err)
+	}
+	logins := make([]*pb.LoginMatch, 0, len(matches))
+	for _, match := range matches {
+		logins = append(logins, &pb.LoginMatch{
+			Path:  match.Path,
+			Login: match.Login,
+			Uri:   fromLoginURI(match.URI),
+		})
+	}
+	return &pb.FindByURLResponse{Logins: logins}, nil
+}
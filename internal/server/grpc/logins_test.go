@@ -0,0 +1,47 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/operation"
+	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestFindByURL(t *testing.T) {
+	vault := mocksrv.NewVault(t)
+	server := grpc.NewGophkeeperServer(vault, nil, nil)
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+
+	_, err := server.FindByURL(ctx, &pb.FindByURLRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	vault.EXPECT().FindLogins(mock.Anything, "https://mail.google.com", "mark").Return([]models.LoginMatch{
+		{Path: "google", Login: "mark", URI: models.LoginURI{URI: "google.com", Match: models.URIMatchBaseDomain}},
+		{Path: "work/gmail", Login: "mark@corp", URI: models.LoginURI{
+			URI: "https://mail.google.com", Match: models.URIMatchHost,
+		}},
+	}, nil).Once()
+	resp, err := server.FindByURL(ctx, &pb.FindByURLRequest{Url: "https://mail.google.com"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetLogins(), 2)
+	assert.Equal(t, "google", resp.GetLogins()[0].GetPath())
+	assert.Equal(t, pb.URIMatch_URI_MATCH_BASE_DOMAIN, resp.GetLogins()[0].GetUri().GetMatch())
+	assert.Equal(t, "mark@corp", resp.GetLogins()[1].GetLogin())
+	assert.Equal(t, pb.URIMatch_URI_MATCH_HOST, resp.GetLogins()[1].GetUri().GetMatch())
+
+	vault.EXPECT().FindLogins(mock.Anything, "http://", "mark").Return(nil, &operation.ValidationError{
+		Violations: []operation.FieldViolation{{Field: "url", Description: `"http://" is not a valid URL`}},
+	}).Once()
+	_, err = server.FindByURL(ctx, &pb.FindByURLRequest{Url: "http://"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
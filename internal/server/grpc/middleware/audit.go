@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server"
+	g "github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// AuditInterceptor records every call to the audit log together with its outcome.
+// It must run after the authentication interceptor, which puts the caller into the context.
+type AuditInterceptor struct {
+	auditLog  server.AuditLog
+	unaudited map[string]bool // Methods which are not recorded
+}
+
+func NewAuditInterceptor(auditLog server.AuditLog) *AuditInterceptor {
+	unaudited := map[string]bool{
+		"/api.v1.GophkeeperService/RefreshToken":  true,
+		"/api.v1.GophkeeperService/GetJWKS":       true,
+		"/api.v1.GophkeeperService/GetOIDCConfig": true,
+		// only the outcome of an SRP login is recorded, by SRPFinish
+		"/api.v1.GophkeeperService/SRPBegin": true,
+	}
+
+	return &AuditInterceptor{
+		auditLog:  auditLog,
+		unaudited: unaudited,
+	}
+}
+
+// actor returns the authenticated user or, for login and registration, the user the request is made for.
+// Single sign-on requests don't name the user, it is taken from a successful response.
+func actor(ctx context.Context, req, resp any) string {
+	if username, ok := ctx.Value(g.UsernameKey).(string); ok {
+		return username
+	}
+	if r, ok := req.(interface{ GetLogin() string }); ok {
+		return r.GetLogin()
+	}
+	if r, ok := resp.(*pb.AuthResponse); ok {
+		return r.GetUserId()
+	}
+	return ""
+}
+
+// gatewayKey is the metadata key the REST gateway sends gatewayToken with. The token is generated at
+// startup and only known to the gateway running in the same process.
+const gatewayKey = "x-gophkeeper-gateway"
+
+var gatewayToken = func() string {
+	token := make([]byte, 32) //nolint:mnd // 256 bits
+	if _, err := rand.Read(token); err != nil {
+		panic("failed to generate the gateway token: " + err.Error())
+	}
+	return hex.EncodeToString(token)
+}()
+
+type gatewayCredentials struct{}
+
+func (gatewayCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{gatewayKey: gatewayToken}, nil
+}
+
+func (gatewayCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+var _ credentials.PerRPCCredentials = gatewayCredentials{}
+
+// WithGatewayCredentials marks the calls made over the connection as calls of the REST gateway, whose
+// forwarded client address and user agent are trusted.
+func WithGatewayCredentials() grpc.DialOption {
+	return grpc.WithPerRPCCredentials(gatewayCredentials{})
+}
+
+// fromGateway reports whether the call carries the token of the REST gateway.
+func fromGateway(md metadata.MD) bool {
+	for _, token := range md.Get(gatewayKey) {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(gatewayToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// clientInfo returns the address and the user agent of the client. Calls of the REST gateway are
+// made on behalf of its client, so the address it adds last to x-forwarded-for and the user agent
+// it forwards are used instead. The forwarding headers of other callers are ignored.
+func clientInfo(ctx context.Context) (string, string) {
+	var clientIP, userAgent string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		clientIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(clientIP); err == nil {
+			clientIP = host
+		}
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("user-agent"); len(values) > 0 {
+		userAgent = values[0]
+	}
+	if !fromGateway(md) {
+		return clientIP, userAgent
+	}
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		forwarded := strings.Split(values[len(values)-1], ",")
+		clientIP = strings.TrimSpace(forwarded[len(forwarded)-1])
+	}
+	if values := md.Get("grpcgateway-user-agent"); len(values) > 0 {
+		userAgent = values[0]
+	}
+	return clientIP, userAgent
+}
+
+func (i *AuditInterceptor) record(ctx context.Context, method string, req, resp any, err error) {
+	secretPath, itemType := g.AuditTarget(req)
+	clientIP, userAgent := clientInfo(ctx)
+	event := models.AuditEvent{
+		Actor:     actor(ctx, req, resp),
+		Action:    path.Base(method),
+		Path:      secretPath,
+		Type:      itemType,
+		Result:    status.Code(err).String(),
+		ClientIP:  clientIP,
+		UserAgent: userAgent,
+	}
+	if recordErr := i.auditLog.RecordEvent(event); recordErr != nil {
+		logger.Log().Errorf("failed to record audit event %s by user=[%s]: %v", event.Action, event.Actor, recordErr)
+	}
+}
+
+func (i *AuditInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if !i.unaudited[info.FullMethod] && !isOpenService(info.FullMethod) {
+			i.record(ctx, info.FullMethod, req, resp, err)
+		}
+		return resp, err
+	}
+}
+
+// auditedStream remembers the first message received from the client, which names the secret.
+type auditedStream struct {
+	grpc.ServerStream
+	first any
+}
+
+func (s *auditedStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.first == nil {
+		s.first = m
+	}
+	return err
+}
+
+func (i *AuditInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wrapped := &auditedStream{ServerStream: ss}
+		err := handler(srv, wrapped)
+		if !i.unaudited[info.FullMethod] && !isOpenService(info.FullMethod) {
+			i.record(ss.Context(), info.FullMethod, wrapped.first, nil, err)
+		}
+		return err
+	}
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientInfo(t *testing.T) {
+	forwarded := metadata.Pairs(
+		"user-agent", "grpc-go/1.67.1",
+		"x-forwarded-for", "10.0.0.1, 203.0.113.7",
+		"grpcgateway-user-agent", "curl/8.5.0",
+	)
+	gateway := metadata.Join(forwarded, metadata.Pairs(gatewayKey, gatewayToken))
+	tests := []struct {
+		name      string
+		addr      net.Addr
+		md        metadata.MD
+		wantIP    string
+		wantAgent string
+	}{
+		{
+			name:      "direct call",
+			addr:      &net.TCPAddr{IP: net.ParseIP("198.51.100.2"), Port: 5000},
+			md:        metadata.Pairs("user-agent", "gophkeeper-cli"),
+			wantIP:    "198.51.100.2",
+			wantAgent: "gophkeeper-cli",
+		},
+		{
+			name:      "forwarded by the gateway",
+			addr:      &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 5000},
+			md:        gateway,
+			wantIP:    "203.0.113.7",
+			wantAgent: "curl/8.5.0",
+		},
+		{
+			name:      "forwarding headers from a loopback peer are ignored",
+			addr:      &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 5000},
+			md:        metadata.Join(forwarded, metadata.Pairs(gatewayKey, "guessed")),
+			wantIP:    "127.0.0.1",
+			wantAgent: "grpc-go/1.67.1",
+		},
+		{
+			name:      "forwarding headers from a remote peer are ignored",
+			addr:      &net.TCPAddr{IP: net.ParseIP("198.51.100.2"), Port: 5000},
+			md:        forwarded,
+			wantIP:    "198.51.100.2",
+			wantAgent: "grpc-go/1.67.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tt.addr})
+			ctx = metadata.NewIncomingContext(ctx, tt.md)
+			ip, agent := clientInfo(ctx)
+			assert.Equal(t, tt.wantIP, ip)
+			assert.Equal(t, tt.wantAgent, agent)
+		})
+	}
+}
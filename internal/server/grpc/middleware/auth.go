@@ -9,25 +9,35 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/itallix/gophkeeper/internal/common/rpc"
 	g "github.com/itallix/gophkeeper/internal/server/grpc"
 	"github.com/itallix/gophkeeper/internal/server/service"
 )
 
-type AuthInterceptor struct {
-	authService   service.AuthenticationService
-	noAuthMethods map[string]bool // List of methods that don't require auth
+// openServicePrefixes are the services open to everyone: the health checks, which orchestrators
+// call without credentials, and the server reflection, which is only registered when enabled.
+var openServicePrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
 }
 
-func NewAuthInterceptor(authService service.AuthenticationService) *AuthInterceptor {
-	noAuthMethods := map[string]bool{
-		"/api.v1.GophkeeperService/Register":     true,
-		"/api.v1.GophkeeperService/Login":        true,
-		"/api.v1.GophkeeperService/RefreshToken": true,
+// isOpenService tells whether the method belongs to one of the open services.
+func isOpenService(method string) bool {
+	for _, prefix := range openServicePrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
 	}
+	return false
+}
+
+type AuthInterceptor struct {
+	authService service.AuthenticationService
+}
 
+func NewAuthInterceptor(authService service.AuthenticationService) *AuthInterceptor {
 	return &AuthInterceptor{
-		authService:   authService,
-		noAuthMethods: noAuthMethods,
+		authService: authService,
 	}
 }
 
@@ -39,7 +49,7 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
 		// Skip auth for whitelisted methods
-		if i.noAuthMethods[info.FullMethod] {
+		if rpc.IsPublic(info.FullMethod) || isOpenService(info.FullMethod) {
 			return handler(ctx, req)
 		}
 
@@ -48,14 +58,25 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return nil, err
 		}
 
-		username, err := i.authService.ValidateAccessToken(token)
+		claims, err := i.authService.ParseAccessToken(token)
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
 		}
 
-		newCtx := context.WithValue(ctx, g.UsernameKey, username)
+		newCtx := context.WithValue(ctx, g.UsernameKey, claims.Username)
+		if claims.Scope == nil {
+			return handler(newCtx, req)
+		}
 
-		return handler(newCtx, req)
+		scope := *claims.Scope
+		if err = CheckScope(scope, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		resp, err := handler(context.WithValue(newCtx, g.ScopeKey, scope), req)
+		if err != nil {
+			return nil, err
+		}
+		return filterList(scope, resp), nil
 	}
 }
 
@@ -74,9 +95,12 @@ func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 	return func(
 		srv interface{},
 		ss grpc.ServerStream,
-		_ *grpc.StreamServerInfo,
+		info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler,
 	) error {
+		if rpc.IsPublic(info.FullMethod) || isOpenService(info.FullMethod) {
+			return handler(srv, ss)
+		}
 		ctx := ss.Context()
 
 		token, err := i.extractToken(ctx)
@@ -84,17 +108,24 @@ func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 			return err
 		}
 
-		username, err := i.authService.ValidateAccessToken(token)
+		claims, err := i.authService.ParseAccessToken(token)
 		if err != nil {
 			return status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
 		}
 
-		newCtx := context.WithValue(ctx, g.UsernameKey, username)
-		wrapped := &serverStream{
-			ServerStream: ss, ctx: newCtx,
+		newCtx := context.WithValue(ctx, g.UsernameKey, claims.Username)
+		if claims.Scope == nil {
+			return handler(srv, &serverStream{ServerStream: ss, ctx: newCtx})
 		}
 
-		return handler(srv, wrapped)
+		scope := *claims.Scope
+		if err = checkReadOnly(scope, info.FullMethod); err != nil {
+			return err
+		}
+		wrapped := &serverStream{
+			ServerStream: ss, ctx: context.WithValue(newCtx, g.ScopeKey, scope),
+		}
+		return handler(srv, &scopedStream{ServerStream: wrapped, scope: scope, method: info.FullMethod})
 	}
 }
 
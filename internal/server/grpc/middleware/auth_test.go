@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// emptyStream is a stream without any metadata, i.e. without a token.
+type emptyStream struct {
+	grpc.ServerStream
+}
+
+func (s *emptyStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestAuthOpenServices(t *testing.T) {
+	interceptor := NewAuthInterceptor(nil)
+	handled := func(context.Context, any) (any, error) { return "ok", nil }
+	streamed := func(any, grpc.ServerStream) error { return nil }
+
+	tests := []struct {
+		method string
+		open   bool
+	}{
+		{method: "/grpc.health.v1.Health/Check", open: true},
+		{method: "/grpc.health.v1.Health/Watch", open: true},
+		{method: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", open: true},
+		{method: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", open: true},
+		{method: "/api.v1.GophkeeperService/Login", open: true},
+		{method: "/api.v1.GophkeeperService/Get"},
+		{method: "/api.v1.GophkeeperService/Download"},
+		{method: "/grpc.healthcheck.Fake/Check"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			_, unaryErr := interceptor.Unary()(context.Background(), nil,
+				&grpc.UnaryServerInfo{FullMethod: tt.method}, handled)
+			streamErr := interceptor.Stream()(nil, &emptyStream{},
+				&grpc.StreamServerInfo{FullMethod: tt.method}, streamed)
+			if tt.open {
+				assert.NoError(t, unaryErr)
+				assert.NoError(t, streamErr)
+				return
+			}
+			assert.Equal(t, codes.Unauthenticated, status.Code(unaryErr))
+			assert.Equal(t, codes.Unauthenticated, status.Code(streamErr))
+		})
+	}
+}
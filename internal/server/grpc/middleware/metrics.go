@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/metrics"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// authFailureReasons maps the status codes of rejected calls to the reason label of the auth failure counter.
+var authFailureReasons = map[codes.Code]string{
+	codes.Unauthenticated:   "unauthenticated",
+	codes.PermissionDenied:  "permission_denied",
+	codes.ResourceExhausted: "rate_limited",
+}
+
+// MetricsInterceptor records the outcome and the latency of every call.
+// It must run first in the chain, so the calls rejected by the other interceptors are counted too.
+type MetricsInterceptor struct {
+	metrics *metrics.Metrics
+}
+
+func NewMetricsInterceptor(m *metrics.Metrics) *MetricsInterceptor {
+	return &MetricsInterceptor{metrics: m}
+}
+
+func (i *MetricsInterceptor) observe(method string, start time.Time, err error) {
+	code := status.Code(err)
+	i.metrics.ObserveRequest(method, code.String(), time.Since(start))
+	if reason, ok := authFailureReasons[code]; ok {
+		i.metrics.AuthFailure(method, reason)
+	}
+}
+
+func (i *MetricsInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		i.observe(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// chunkOf returns the binary chunk carried by a streamed message, if any.
+func chunkOf(m any) *pb.Chunk {
+	switch msg := m.(type) {
+	case *pb.Chunk:
+		return msg
+	case interface{ GetChunk() *pb.Chunk }:
+		return msg.GetChunk()
+	}
+	return nil
+}
+
+// measuredStream counts the binary data going through the stream.
+type measuredStream struct {
+	grpc.ServerStream
+	metrics *metrics.Metrics
+}
+
+func (s *measuredStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		if chunk := chunkOf(m); chunk != nil {
+			s.metrics.AddBytes(metrics.DirectionUpload, len(chunk.GetData()))
+		}
+	}
+	return err
+}
+
+func (s *measuredStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		if chunk := chunkOf(m); chunk != nil {
+			s.metrics.AddBytes(metrics.DirectionDownload, len(chunk.GetData()))
+		}
+	}
+	return err
+}
+
+func (i *MetricsInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, &measuredStream{ServerStream: ss, metrics: i.metrics})
+		i.observe(info.FullMethod, start, err)
+		return err
+	}
+}
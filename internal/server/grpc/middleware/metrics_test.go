@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/metrics"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// chunkStream hands out the queued chunks and discards the sent messages.
+type chunkStream struct {
+	grpc.ServerStream
+	in []*pb.Chunk
+}
+
+func (s *chunkStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *chunkStream) RecvMsg(m any) error {
+	chunk, _ := m.(*pb.Chunk)
+	chunk.Data = s.in[0].GetData()
+	s.in = s.in[1:]
+	return nil
+}
+
+func (s *chunkStream) SendMsg(any) error {
+	return nil
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	const get = "/api.v1.GophkeeperService/Get"
+	m := metrics.New()
+	interceptor := NewMetricsInterceptor(m)
+
+	unary := interceptor.Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: get}
+	_, err := unary(context.Background(), &pb.GetRequest{}, info, func(context.Context, any) (any, error) {
+		return &pb.GetResponse{}, nil
+	})
+	require.NoError(t, err)
+	_, err = unary(context.Background(), &pb.GetRequest{}, info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	})
+	require.Error(t, err)
+
+	stream := interceptor.Stream()
+	ss := &chunkStream{in: []*pb.Chunk{{Data: []byte("hello")}, {Data: []byte("world!")}}}
+	err = stream(nil, ss, &grpc.StreamServerInfo{FullMethod: "/api.v1.GophkeeperService/Upload"},
+		func(_ any, s grpc.ServerStream) error {
+			for range 2 {
+				if recvErr := s.RecvMsg(&pb.Chunk{}); recvErr != nil {
+					return recvErr
+				}
+			}
+			return s.SendMsg(&pb.ExportItem{Item: &pb.ExportItem_Chunk{Chunk: &pb.Chunk{Data: []byte("abc")}}})
+		})
+	require.NoError(t, err)
+
+	err = testutil.GatherAndCompare(m.Registry(), strings.NewReader(`
+# HELP gophkeeper_grpc_requests_total Number of gRPC requests handled, by method and status code.
+# TYPE gophkeeper_grpc_requests_total counter
+gophkeeper_grpc_requests_total{code="OK",method="/api.v1.GophkeeperService/Get"} 1
+gophkeeper_grpc_requests_total{code="OK",method="/api.v1.GophkeeperService/Upload"} 1
+gophkeeper_grpc_requests_total{code="Unauthenticated",method="/api.v1.GophkeeperService/Get"} 1
+# HELP gophkeeper_auth_failures_total Number of requests rejected as unauthenticated, unauthorized or rate limited.
+# TYPE gophkeeper_auth_failures_total counter
+gophkeeper_auth_failures_total{method="/api.v1.GophkeeperService/Get",reason="unauthenticated"} 1
+# HELP gophkeeper_vault_binary_bytes_total Number of bytes of binary data uploaded and downloaded.
+# TYPE gophkeeper_vault_binary_bytes_total counter
+gophkeeper_vault_binary_bytes_total{direction="download"} 3
+gophkeeper_vault_binary_bytes_total{direction="upload"} 11
+`), "gophkeeper_grpc_requests_total", "gophkeeper_auth_failures_total", "gophkeeper_vault_binary_bytes_total")
+	require.NoError(t, err)
+}
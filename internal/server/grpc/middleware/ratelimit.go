@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/common/rpc"
+	g "github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/ratelimit"
+)
+
+const (
+	loginMethod     = "/api.v1.GophkeeperService/Login"
+	srpFinishMethod = "/api.v1.GophkeeperService/SRPFinish"
+	// RetryAfterKey is the metadata key telling the client how many seconds to wait before retrying.
+	RetryAfterKey = "retry-after"
+)
+
+// RateLimits holds the limiters applied to the calls, a nil limiter doesn't limit anything.
+type RateLimits struct {
+	// PerIP limits the unauthenticated calls by client address.
+	PerIP *ratelimit.Limiter
+	// PerLogin limits the login attempts by account.
+	PerLogin *ratelimit.Limiter
+	// PerUser limits the authenticated calls by user.
+	PerUser *ratelimit.Limiter
+}
+
+// RateLimitInterceptor rejects calls exceeding the rate limits and throttles password guessing.
+// It must run after the authentication interceptor, which puts the caller into the context, and
+// before the audit interceptor, so that rejected calls don't write to the audit log.
+type RateLimitInterceptor struct {
+	limits  RateLimits
+	lockout *ratelimit.Lockout
+}
+
+func NewRateLimitInterceptor(limits RateLimits, lockout *ratelimit.Lockout) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		limits:  limits,
+		lockout: lockout,
+	}
+}
+
+func allow(limiter *ratelimit.Limiter, key string) time.Duration {
+	if limiter == nil {
+		return 0
+	}
+	return limiter.Allow(key)
+}
+
+// exhausted builds the error returned to throttled clients. The delay is both sent as
+// metadata, for simple clients, and attached to the status as RetryInfo.
+func exhausted(retryAfter time.Duration, reason string) (metadata.MD, error) {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	md := metadata.Pairs(RetryAfterKey, strconv.FormatInt(seconds, 10))
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("%s, retry in %ds", reason, seconds))
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return md, st.Err()
+	}
+	return md, detailed.Err()
+}
+
+// check applies the limits to the call, returning the retry delay and the reason if it is rejected.
+func (i *RateLimitInterceptor) check(ctx context.Context, method string) (time.Duration, string) {
+	// Health checks and reflection are exempt, so that probes keep working while a client address
+	// has used up its budget of logins.
+	if isOpenService(method) {
+		return 0, ""
+	}
+	if rpc.IsPublic(method) {
+		clientIP, _ := clientInfo(ctx)
+		if wait := allow(i.limits.PerIP, clientIP); wait > 0 {
+			return wait, "too many requests from " + clientIP
+		}
+		return 0, ""
+	}
+	if username, ok := ctx.Value(g.UsernameKey).(string); ok {
+		if wait := allow(i.limits.PerUser, username); wait > 0 {
+			return wait, "too many requests"
+		}
+	}
+	return 0, ""
+}
+
+// login throttles the login attempts of the account and records their outcome. An SRP login
+// is counted once, when the client proof is checked.
+func (i *RateLimitInterceptor) login(ctx context.Context, req any, handler grpc.UnaryHandler) (any, error) {
+	login := ""
+	if r, ok := req.(interface{ GetLogin() string }); ok {
+		login = r.GetLogin()
+	}
+	if wait := allow(i.limits.PerLogin, ratelimit.NormalizeLogin(login)); wait > 0 {
+		md, err := exhausted(wait, "too many login attempts")
+		_ = grpc.SetHeader(ctx, md)
+		return nil, err
+	}
+	if i.lockout == nil {
+		return handler(ctx, req)
+	}
+
+	wait, pending, err := i.lockout.Check(ctx, login)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check login attempts: %v", err)
+	}
+	if wait > 0 {
+		md, exhaustedErr := exhausted(wait, "too many failed login attempts")
+		_ = grpc.SetHeader(ctx, md)
+		return nil, exhaustedErr
+	}
+
+	resp, err := handler(ctx, req)
+	switch {
+	case status.Code(err) == codes.Unauthenticated:
+		if _, failErr := i.lockout.Failed(ctx, login); failErr != nil {
+			logger.Log().Errorf("failed to record failed login of user=[%s]: %v", login, failErr)
+		}
+	case err == nil && pending:
+		if resetErr := i.lockout.Succeeded(ctx, login); resetErr != nil {
+			logger.Log().Errorf("failed to reset login attempts of user=[%s]: %v", login, resetErr)
+		}
+	}
+	return resp, err
+}
+
+func (i *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if wait, reason := i.check(ctx, info.FullMethod); wait > 0 {
+			md, err := exhausted(wait, reason)
+			_ = grpc.SetHeader(ctx, md)
+			return nil, err
+		}
+		if info.FullMethod == loginMethod || info.FullMethod == srpFinishMethod {
+			return i.login(ctx, req, handler)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (i *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if wait, reason := i.check(ss.Context(), info.FullMethod); wait > 0 {
+			md, err := exhausted(wait, reason)
+			_ = ss.SetHeader(md)
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/ratelimit"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestRateLimitPerLogin(t *testing.T) {
+	interceptor := NewRateLimitInterceptor(RateLimits{PerLogin: ratelimit.NewLimiter(0.001, 1)}, nil)
+	info := &grpc.UnaryServerInfo{FullMethod: loginMethod}
+	handler := func(context.Context, any) (any, error) { return &pb.AuthResponse{}, nil }
+
+	_, err := interceptor.Unary()(context.Background(), &pb.LoginRequest{Login: "alice"}, info, handler)
+	require.NoError(t, err)
+	for _, login := range []string{"Alice", " alice", "ALICE\t"} {
+		_, err = interceptor.Unary()(context.Background(), &pb.LoginRequest{Login: login}, info, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err), "%q", login)
+	}
+
+	_, err = interceptor.Unary()(context.Background(), &pb.LoginRequest{Login: "bob"}, info, handler)
+	require.NoError(t, err)
+}
+
+func TestRateLimitExemptsHealthChecks(t *testing.T) {
+	interceptor := NewRateLimitInterceptor(RateLimits{PerIP: ratelimit.NewLimiter(0.001, 1)}, nil)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 5000},
+	})
+	login := &grpc.UnaryServerInfo{FullMethod: loginMethod}
+	loginHandler := func(context.Context, any) (any, error) { return &pb.AuthResponse{}, nil }
+
+	_, err := interceptor.Unary()(ctx, &pb.LoginRequest{Login: "alice"}, login, loginHandler)
+	require.NoError(t, err)
+	_, err = interceptor.Unary()(ctx, &pb.LoginRequest{Login: "alice"}, login, loginHandler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	check := &grpc.UnaryServerInfo{FullMethod: healthpb.Health_Check_FullMethodName}
+	checkHandler := func(context.Context, any) (any, error) {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+	}
+	for range 10 {
+		_, err = interceptor.Unary()(ctx, &healthpb.HealthCheckRequest{}, check, checkHandler)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	g "github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// readMethods don't modify anything, they are the only methods allowed with a read-only scope.
+var readMethods = map[string]bool{
+	"Get":                 true,
+	"List":                true,
+	"FindByURL":           true,
+	"Download":            true,
+	"Export":              true,
+	"ListShares":          true,
+	"ListOrganizations":   true,
+	"ListMembers":         true,
+	"ListTeams":           true,
+	"ListCollections":     true,
+	"ListAuditEvents":     true,
+	"ListServiceAccounts": true,
+	"ListAPIKeys":         true,
+	"GetSecretType":       true,
+	"ListSecretTypes":     true,
+}
+
+func checkReadOnly(scope models.Scope, method string) error {
+	name := path.Base(method)
+	if scope.ReadOnly && !readMethods[name] {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed with a read-only api key", name)
+	}
+	return nil
+}
+
+// CheckScope reports whether the call is allowed within the scope of a service account token.
+// Path-limited tokens may only call methods referring to a secret within one of the prefixes,
+// except List and FindByURL, whose responses are filtered instead.
+func CheckScope(scope models.Scope, method string, req any) error {
+	if err := checkReadOnly(scope, method); err != nil {
+		return err
+	}
+	switch req.(type) {
+	case *pb.ListRequest, *pb.FindByURLRequest:
+		return nil
+	}
+	if len(scope.PathPrefixes) == 0 {
+		return nil
+	}
+	name := path.Base(method)
+	target, _ := g.AuditTarget(req)
+	if target == "" {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed with a path-limited api key", name)
+	}
+	if !scope.AllowsPath(target) {
+		return status.Errorf(codes.PermissionDenied, "path %s is outside of the api key scope", target)
+	}
+	return nil
+}
+
+// filterList removes the secrets outside of the scope from a List or FindByURL response.
+func filterList(scope models.Scope, resp any) any {
+	if len(scope.PathPrefixes) == 0 {
+		return resp
+	}
+	switch list := resp.(type) {
+	case *pb.ListResponse:
+		secrets := make([]string, 0, len(list.GetSecrets()))
+		for _, secret := range list.GetSecrets() {
+			if scope.AllowsPath(secret) {
+				secrets = append(secrets, secret)
+			}
+		}
+		return &pb.ListResponse{Secrets: secrets}
+	case *pb.FindByURLResponse:
+		logins := make([]*pb.LoginMatch, 0, len(list.GetLogins()))
+		for _, login := range list.GetLogins() {
+			if scope.AllowsPath(login.GetPath()) {
+				logins = append(logins, login)
+			}
+		}
+		return &pb.FindByURLResponse{Logins: logins}
+	}
+	return resp
+}
+
+// scopedStream checks every message received from a service account against its scope.
+type scopedStream struct {
+	grpc.ServerStream
+	scope  models.Scope
+	method string
+}
+
+func (s *scopedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return CheckScope(s.scope, s.method, m)
+}
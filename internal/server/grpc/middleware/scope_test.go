@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestCheckScope(t *testing.T) {
+	const prefix = "/api.v1.GophkeeperService/"
+	readOnly := models.Scope{ReadOnly: true}
+	deploy := models.Scope{PathPrefixes: []string{"deploy/"}}
+
+	tests := []struct {
+		name    string
+		scope   models.Scope
+		method  string
+		req     any
+		allowed bool
+	}{
+		{name: "unrestricted", method: "Delete", req: &pb.DeleteRequest{Path: "a"}, allowed: true},
+		{name: "read-only get", scope: readOnly, method: "Get", req: &pb.GetRequest{Path: "a"}, allowed: true},
+		{name: "read-only create", scope: readOnly, method: "Create", req: &pb.CreateRequest{}},
+		{name: "read-only share", scope: readOnly, method: "Share", req: &pb.ShareRequest{Path: "a"}},
+		{name: "path within prefix", scope: deploy, method: "Get", req: &pb.GetRequest{Path: "deploy/db"}, allowed: true},
+		{name: "path outside prefix", scope: deploy, method: "Get", req: &pb.GetRequest{Path: "bank"}},
+		{
+			name:    "create within prefix",
+			scope:   deploy,
+			method:  "Create",
+			req:     &pb.CreateRequest{Data: &pb.TypedData{Base: &pb.Metadata{Path: "deploy/key"}}},
+			allowed: true,
+		},
+		{name: "upload outside prefix", scope: deploy, method: "Upload", req: &pb.Chunk{Filename: "bank.pdf"}},
+		{name: "list is filtered", scope: deploy, method: "List", req: &pb.ListRequest{}, allowed: true},
+		{name: "find by url is filtered", scope: deploy, method: "FindByURL", req: &pb.FindByURLRequest{},
+			allowed: true},
+		{name: "read-only find by url", scope: readOnly, method: "FindByURL", req: &pb.FindByURLRequest{},
+			allowed: true},
+		{name: "export has no path", scope: deploy, method: "Export", req: &pb.ExportRequest{}},
+		{name: "organization has no path", scope: deploy, method: "CreateOrganization",
+			req: &pb.CreateOrganizationRequest{Name: "rome"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckScope(tt.scope, prefix+tt.method, tt.req)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestFilterList(t *testing.T) {
+	resp := &pb.ListResponse{Secrets: []string{"deploy/db", "bank", "deploy/key"}}
+
+	filtered := filterList(models.Scope{PathPrefixes: []string{"deploy/"}}, resp)
+	assert.Equal(t, []string{"deploy/db", "deploy/key"}, filtered.(*pb.ListResponse).GetSecrets())
+	assert.Same(t, resp, filterList(models.Scope{ReadOnly: true}, resp))
+
+	found := &pb.FindByURLResponse{Logins: []*pb.LoginMatch{{Path: "bank"}, {Path: "deploy/github"}}}
+	filtered = filterList(models.Scope{PathPrefixes: []string{"deploy/"}}, found)
+	assert.Equal(t, []*pb.LoginMatch{{Path: "deploy/github"}}, filtered.(*pb.FindByURLResponse).GetLogins())
+}
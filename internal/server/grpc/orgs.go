@@ -0,0 +1,285 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+var roles = map[pb.Role]models.Role{
+	pb.Role_ROLE_OWNER:  models.RoleOwner,
+	pb.Role_ROLE_ADMIN:  models.RoleAdmin,
+	pb.Role_ROLE_EDITOR: models.RoleEditor,
+	pb.Role_ROLE_VIEWER: models.RoleViewer,
+}
+
+func toRole(role pb.Role) (models.Role, error) {
+	if role == pb.Role_ROLE_UNSPECIFIED {
+		return "", status.Error(codes.InvalidArgument, "unspecified role is not allowed")
+	}
+	r, ok := roles[role]
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "unknown role: %v", role)
+	}
+	return r, nil
+}
+
+func fromRole(role models.Role) pb.Role {
+	for k, v := range roles {
+		if v == role {
+			return k
+		}
+	}
+	return pb.Role_ROLE_UNSPECIFIED
+}
+
+// orgUser returns the user performing the request, failing if organizations are not enabled
+// or if any of the required request fields is empty.
+func (srv *GophkeeperServer) orgUser(ctx context.Context, required ...string) (string, error) {
+	if srv.orgs == nil {
+		return "", status.Error(codes.Unimplemented, "organizations are not enabled")
+	}
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return "", status.Error(codes.Internal, "username not found in context")
+	}
+	for _, field := range required {
+		if field == "" {
+			return "", status.Error(codes.InvalidArgument, "required field is missing")
+		}
+	}
+	return username, nil
+}
+
+func orgResponse(format string, args ...any) *pb.OrganizationResponse {
+	return &pb.OrganizationResponse{Message: fmt.Sprintf(format, args...)}
+}
+
+func (srv *GophkeeperServer) CreateOrganization(ctx context.Context,
+	req *pb.CreateOrganizationRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.CreateOrganization(username, req.GetName()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("organization %s has been created", req.GetName()), nil
+}
+
+func (srv *GophkeeperServer) ListOrganizations(ctx context.Context,
+	_ *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	username, err := srv.orgUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := srv.orgs.ListOrganizations(username)
+	if err != nil {
+		return nil, actionError(err)
+	}
+
+	resp := &pb.ListOrganizationsResponse{Organizations: make([]*pb.Organization, 0, len(orgs))}
+	for _, org := range orgs {
+		resp.Organizations = append(resp.Organizations, &pb.Organization{Name: org.Name, Role: fromRole(org.Role)})
+	}
+	return resp, nil
+}
+
+func (srv *GophkeeperServer) SetMember(ctx context.Context,
+	req *pb.SetMemberRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetLogin())
+	if err != nil {
+		return nil, err
+	}
+	role, err := toRole(req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.SetMember(username, req.GetOrg(), req.GetLogin(), role); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("%s is now %s of organization %s", req.GetLogin(), role, req.GetOrg()), nil
+}
+
+func (srv *GophkeeperServer) RemoveMember(ctx context.Context,
+	req *pb.RemoveMemberRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetLogin())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.RemoveMember(username, req.GetOrg(), req.GetLogin()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("%s has been removed from organization %s", req.GetLogin(), req.GetOrg()), nil
+}
+
+func (srv *GophkeeperServer) ListMembers(ctx context.Context,
+	req *pb.ListMembersRequest) (*pb.ListMembersResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg())
+	if err != nil {
+		return nil, err
+	}
+	members, err := srv.orgs.ListMembers(username, req.GetOrg())
+	if err != nil {
+		return nil, actionError(err)
+	}
+
+	resp := &pb.ListMembersResponse{Members: make([]*pb.Member, 0, len(members))}
+	for _, member := range members {
+		resp.Members = append(resp.Members, &pb.Member{
+			Login: member.Login,
+			Role:  fromRole(member.Role),
+			Teams: member.Teams,
+		})
+	}
+	return resp, nil
+}
+
+func (srv *GophkeeperServer) CreateTeam(ctx context.Context,
+	req *pb.CreateTeamRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.CreateTeam(username, req.GetOrg(), req.GetName()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("team %s has been created in organization %s", req.GetName(), req.GetOrg()), nil
+}
+
+func (srv *GophkeeperServer) AddTeamMember(ctx context.Context,
+	req *pb.AddTeamMemberRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetTeam(), req.GetLogin())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.AddTeamMember(username, req.GetOrg(), req.GetTeam(), req.GetLogin()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("%s has been added to team %s", req.GetLogin(), req.GetTeam()), nil
+}
+
+func (srv *GophkeeperServer) RemoveTeamMember(ctx context.Context,
+	req *pb.RemoveTeamMemberRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetTeam(), req.GetLogin())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.RemoveTeamMember(username, req.GetOrg(), req.GetTeam(), req.GetLogin()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("%s has been removed from team %s", req.GetLogin(), req.GetTeam()), nil
+}
+
+func (srv *GophkeeperServer) ListTeams(ctx context.Context, req *pb.ListTeamsRequest) (*pb.ListTeamsResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg())
+	if err != nil {
+		return nil, err
+	}
+	teams, err := srv.orgs.ListTeams(username, req.GetOrg())
+	if err != nil {
+		return nil, actionError(err)
+	}
+
+	resp := &pb.ListTeamsResponse{Teams: make([]*pb.Team, 0, len(teams))}
+	for _, team := range teams {
+		resp.Teams = append(resp.Teams, &pb.Team{Name: team.Name, Members: team.Members})
+	}
+	return resp, nil
+}
+
+func (srv *GophkeeperServer) CreateCollection(ctx context.Context,
+	req *pb.CreateCollectionRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.CreateCollection(username, req.GetOrg(), req.GetName()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("collection %s has been created in organization %s", req.GetName(), req.GetOrg()), nil
+}
+
+func (srv *GophkeeperServer) AddToCollection(ctx context.Context,
+	req *pb.AddToCollectionRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetCollection(), req.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.AddToCollection(username, req.GetOrg(), req.GetCollection(), req.GetPath()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("secret with path=%s has been added to collection %s", req.GetPath(), req.GetCollection()), nil
+}
+
+func (srv *GophkeeperServer) RemoveFromCollection(ctx context.Context,
+	req *pb.RemoveFromCollectionRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetCollection(), req.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.RemoveFromCollection(username, req.GetOrg(), req.GetCollection(), req.GetPath()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("secret with path=%s has been removed from collection %s", req.GetPath(),
+		req.GetCollection()), nil
+}
+
+func (srv *GophkeeperServer) AssignCollection(ctx context.Context,
+	req *pb.AssignCollectionRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetCollection(), req.GetTeam())
+	if err != nil {
+		return nil, err
+	}
+	role, err := toRole(req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	if role != models.RoleEditor && role != models.RoleViewer {
+		return nil, status.Errorf(codes.InvalidArgument, "collections can only be assigned as editor or viewer, got %s",
+			role)
+	}
+	if err = srv.orgs.AssignCollection(username, req.GetOrg(), req.GetCollection(), req.GetTeam(), role); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("team %s is now %s of collection %s", req.GetTeam(), role, req.GetCollection()), nil
+}
+
+func (srv *GophkeeperServer) UnassignCollection(ctx context.Context,
+	req *pb.UnassignCollectionRequest) (*pb.OrganizationResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg(), req.GetCollection(), req.GetTeam())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.orgs.UnassignCollection(username, req.GetOrg(), req.GetCollection(), req.GetTeam()); err != nil {
+		return nil, actionError(err)
+	}
+	return orgResponse("team %s has no longer access to collection %s", req.GetTeam(), req.GetCollection()), nil
+}
+
+func (srv *GophkeeperServer) ListCollections(ctx context.Context,
+	req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	username, err := srv.orgUser(ctx, req.GetOrg())
+	if err != nil {
+		return nil, err
+	}
+	collections, err := srv.orgs.ListCollections(username, req.GetOrg())
+	if err != nil {
+		return nil, actionError(err)
+	}
+
+	resp := &pb.ListCollectionsResponse{Collections: make([]*pb.Collection, 0, len(collections))}
+	for _, collection := range collections {
+		item := &pb.Collection{Name: collection.Name, Secrets: collection.Secrets}
+		for _, team := range collection.Teams {
+			item.Teams = append(item.Teams, &pb.CollectionTeam{Team: team.Team, Role: fromRole(team.Role)})
+		}
+		resp.Collections = append(resp.Collections, item)
+	}
+	return resp, nil
+}
@@ -0,0 +1,158 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestOrganizationsDisabled(t *testing.T) {
+	server := grpc.NewGophkeeperServer(nil, nil, nil)
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "owner")
+
+	_, err := server.CreateOrganization(ctx, &pb.CreateOrganizationRequest{Name: "acme"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestSetMember(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(*mocksrv.Organizations)
+		request   *pb.SetMemberRequest
+		errorCode codes.Code
+	}{
+		{
+			name: "add_editor",
+			setup: func(mo *mocksrv.Organizations) {
+				mo.EXPECT().SetMember("owner", "acme", "alice", models.RoleEditor).Return(nil)
+			},
+			request:   &pb.SetMemberRequest{Org: "acme", Login: "alice", Role: pb.Role_ROLE_EDITOR},
+			errorCode: codes.OK,
+		},
+		{
+			name: "not_allowed",
+			setup: func(mo *mocksrv.Organizations) {
+				mo.EXPECT().SetMember("owner", "acme", "alice", models.RoleOwner).
+					Return(fmt.Errorf("%w: owner is not allowed to manage-members", storage.ErrAccessDenied))
+			},
+			request:   &pb.SetMemberRequest{Org: "acme", Login: "alice", Role: pb.Role_ROLE_OWNER},
+			errorCode: codes.PermissionDenied,
+		},
+		{
+			name: "last_owner",
+			setup: func(mo *mocksrv.Organizations) {
+				mo.EXPECT().SetMember("owner", "acme", "owner", models.RoleAdmin).
+					Return(fmt.Errorf("[SET MEMBER] %w", storage.ErrLastOwner))
+			},
+			request:   &pb.SetMemberRequest{Org: "acme", Login: "owner", Role: pb.Role_ROLE_ADMIN},
+			errorCode: codes.FailedPrecondition,
+		},
+		{
+			name: "unknown_org",
+			setup: func(mo *mocksrv.Organizations) {
+				mo.EXPECT().SetMember("owner", "other", "alice", models.RoleViewer).Return(storage.ErrOrgNotFound)
+			},
+			request:   &pb.SetMemberRequest{Org: "other", Login: "alice", Role: pb.Role_ROLE_VIEWER},
+			errorCode: codes.NotFound,
+		},
+		{
+			name:      "unspecified_role",
+			request:   &pb.SetMemberRequest{Org: "acme", Login: "alice"},
+			errorCode: codes.InvalidArgument,
+		},
+		{
+			name:      "missing_login",
+			request:   &pb.SetMemberRequest{Org: "acme", Role: pb.Role_ROLE_VIEWER},
+			errorCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgs := mocksrv.NewOrganizations(t)
+			if tt.setup != nil {
+				tt.setup(orgs)
+			}
+
+			server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithOrganizations(orgs))
+			ctx := context.WithValue(context.Background(), grpc.UsernameKey, "owner")
+			resp, err := server.SetMember(ctx, tt.request)
+
+			assert.Equal(t, tt.errorCode, status.Code(err))
+			if tt.errorCode == codes.OK {
+				assert.Equal(t, "alice is now editor of organization acme", resp.GetMessage())
+			}
+		})
+	}
+}
+
+func TestListMembers(t *testing.T) {
+	orgs := mocksrv.NewOrganizations(t)
+	orgs.EXPECT().ListMembers("owner", "acme").Return([]models.Member{
+		{Login: "alice", Role: models.RoleEditor, Teams: []string{"backend"}},
+		{Login: "owner", Role: models.RoleOwner},
+	}, nil)
+
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithOrganizations(orgs))
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "owner")
+	resp, err := server.ListMembers(ctx, &pb.ListMembersRequest{Org: "acme"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetMembers(), 2)
+	assert.Equal(t, pb.Role_ROLE_EDITOR, resp.GetMembers()[0].GetRole())
+	assert.Equal(t, []string{"backend"}, resp.GetMembers()[0].GetTeams())
+	assert.Equal(t, pb.Role_ROLE_OWNER, resp.GetMembers()[1].GetRole())
+}
+
+func TestAssignCollection(t *testing.T) {
+	orgs := mocksrv.NewOrganizations(t)
+	orgs.EXPECT().AssignCollection("owner", "acme", "prod", "backend", models.RoleViewer).Return(nil).Once()
+
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithOrganizations(orgs))
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "owner")
+
+	resp, err := server.AssignCollection(ctx, &pb.AssignCollectionRequest{
+		Org: "acme", Collection: "prod", Team: "backend", Role: pb.Role_ROLE_VIEWER,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "team backend is now viewer of collection prod", resp.GetMessage())
+
+	_, err = server.AssignCollection(ctx, &pb.AssignCollectionRequest{
+		Org: "acme", Collection: "prod", Team: "backend", Role: pb.Role_ROLE_ADMIN,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListCollections(t *testing.T) {
+	orgs := mocksrv.NewOrganizations(t)
+	orgs.EXPECT().ListCollections("owner", "acme").Return([]models.Collection{
+		{
+			Name:    "prod",
+			Secrets: []string{"/prod/db"},
+			Teams:   []models.CollectionTeam{{Team: "backend", Role: models.RoleEditor}},
+		},
+	}, nil)
+
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithOrganizations(orgs))
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "owner")
+	resp, err := server.ListCollections(ctx, &pb.ListCollectionsRequest{Org: "acme"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetCollections(), 1)
+	collection := resp.GetCollections()[0]
+	assert.Equal(t, []string{"/prod/db"}, collection.GetSecrets())
+	require.Len(t, collection.GetTeams(), 1)
+	assert.Equal(t, "backend", collection.GetTeams()[0].GetTeam())
+	assert.Equal(t, pb.Role_ROLE_EDITOR, collection.GetTeams()[0].GetRole())
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func toSecretType(secretType *models.SecretType) *pb.SecretType {
+	fields := make([]*pb.SecretTypeField, 0, len(secretType.Fields))
+	for _, field := range secretType.Fields {
+		fields = append(fields, &pb.SecretTypeField{
+			Name:        field.Name,
+			Type:        string(field.Type),
+			Description: field.Description,
+			Required:    field.Required,
+			Sensitive:   field.Sensitive,
+			Pattern:     field.Pattern,
+		})
+	}
+	return &pb.SecretType{
+		Name:        secretType.Name,
+		Description: secretType.Description,
+		Fields:      fields,
+		CreatedBy:   secretType.CreatedBy,
+		CreatedAt:   formatTime(secretType.CreatedAt),
+	}
+}
+
+func (srv *GophkeeperServer) RegisterSecretType(ctx context.Context,
+	req *pb.RegisterSecretTypeRequest) (*pb.RegisterSecretTypeResponse, error) {
+	if srv.secretTypes == nil {
+		return nil, status.Error(codes.Unimplemented, "secret types are not enabled")
+	}
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+	if _, scoped := ctx.Value(ScopeKey).(models.Scope); scoped || !srv.isAdmin(username) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not an administrator", username)
+	}
+
+	definition := req.GetType()
+	secretType := &models.SecretType{
+		Name:        definition.GetName(),
+		Description: definition.GetDescription(),
+		Fields:      make([]models.SchemaField, 0, len(definition.GetFields())),
+		CreatedBy:   username,
+	}
+	for _, field := range definition.GetFields() {
+		secretType.Fields = append(secretType.Fields, models.SchemaField{
+			Name:        field.GetName(),
+			Type:        models.FieldType(field.GetType()),
+			Description: field.GetDescription(),
+			Required:    field.GetRequired(),
+			Sensitive:   field.GetSensitive(),
+			Pattern:     field.GetPattern(),
+		})
+	}
+	if err := srv.secretTypes.RegisterSecretType(ctx, secretType); err != nil {
+		return nil, actionError(err)
+	}
+	return &pb.RegisterSecretTypeResponse{
+		Message: fmt.Sprintf("secret type %s has been registered", secretType.Name),
+	}, nil
+}
+
+func (srv *GophkeeperServer) GetSecretType(ctx context.Context,
+	req *pb.GetSecretTypeRequest) (*pb.GetSecretTypeResponse, error) {
+	if srv.secretTypes == nil {
+		return nil, status.Error(codes.Unimplemented, "secret types are not enabled")
+	}
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "required field is missing")
+	}
+	secretType, err := srv.secretTypes.GetSecretType(ctx, req.GetName())
+	if err != nil {
+		return nil, actionError(err)
+	}
+	return &pb.GetSecretTypeResponse{Type: toSecretType(secretType)}, nil
+}
+
+func (srv *GophkeeperServer) ListSecretTypes(ctx context.Context,
+	_ *pb.ListSecretTypesRequest) (*pb.ListSecretTypesResponse, error) {
+	if srv.secretTypes == nil {
+		return nil, status.Error(codes.Unimplemented, "secret types are not enabled")
+	}
+	secretTypes, err := srv.secretTypes.ListSecretTypes(ctx)
+	if err != nil {
+		return nil, actionError(err)
+	}
+	resp := &pb.ListSecretTypesResponse{Types: make([]*pb.SecretType, 0, len(secretTypes))}
+	for i := range secretTypes {
+		resp.Types = append(resp.Types, toSecretType(&secretTypes[i]))
+	}
+	return resp, nil
+}
@@ -0,0 +1,84 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestRegisterSecretType(t *testing.T) {
+	secretTypes := mocksrv.NewSecretTypes(t)
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSecretTypes(secretTypes), grpc.WithAdmins("root"))
+	req := &pb.RegisterSecretTypeRequest{Type: &pb.SecretType{
+		Name: "database",
+		Fields: []*pb.SecretTypeField{
+			{Name: "host", Type: "string", Required: true},
+			{Name: "password", Type: "string", Required: true, Sensitive: true, Pattern: ".{8,}"},
+		},
+	}}
+
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+	_, err := server.RegisterSecretType(ctx, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx = context.WithValue(context.Background(), grpc.UsernameKey, "root")
+	secretTypes.EXPECT().RegisterSecretType(mock.Anything, &models.SecretType{
+		Name: "database",
+		Fields: []models.SchemaField{
+			{Name: "host", Type: models.FieldTypeString, Required: true},
+			{Name: "password", Type: models.FieldTypeString, Required: true, Sensitive: true, Pattern: ".{8,}"},
+		},
+		CreatedBy: "root",
+	}).Return(nil).Once()
+	resp, err := server.RegisterSecretType(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "secret type database has been registered", resp.GetMessage())
+}
+
+func TestGetSecretType(t *testing.T) {
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+	_, err := grpc.NewGophkeeperServer(nil, nil, nil).GetSecretType(ctx, &pb.GetSecretTypeRequest{Name: "x"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	secretTypes := mocksrv.NewSecretTypes(t)
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSecretTypes(secretTypes))
+	createdAt := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	secretTypes.EXPECT().GetSecretType(mock.Anything, "database").Return(&models.SecretType{
+		Name:      "database",
+		Fields:    []models.SchemaField{{Name: "port", Type: models.FieldTypeInteger, Description: "TCP port"}},
+		CreatedBy: "root",
+		CreatedAt: createdAt,
+	}, nil).Once()
+	resp, err := server.GetSecretType(ctx, &pb.GetSecretTypeRequest{Name: "database"})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-20T12:00:00Z", resp.GetType().GetCreatedAt())
+	require.Len(t, resp.GetType().GetFields(), 1)
+	assert.Equal(t, "integer", resp.GetType().GetFields()[0].GetType())
+	assert.Equal(t, "TCP port", resp.GetType().GetFields()[0].GetDescription())
+
+	secretTypes.EXPECT().GetSecretType(mock.Anything, "ldap").
+		Return(nil, fmt.Errorf("[GET SECRET TYPE] %w", storage.ErrSecretTypeNotFound)).Once()
+	_, err = server.GetSecretType(ctx, &pb.GetSecretTypeRequest{Name: "ldap"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	secretTypes.EXPECT().ListSecretTypes(mock.Anything).Return([]models.SecretType{
+		{Name: "database"}, {Name: "ldap"},
+	}, nil).Once()
+	list, err := server.ListSecretTypes(ctx, &pb.ListSecretTypesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetTypes(), 2)
+	assert.Equal(t, "ldap", list.GetTypes()[1].GetName())
+}
@@ -8,13 +8,14 @@ import (
 	"fmt"
 	"io"
 
-	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/itallix/gophkeeper/internal/common/logger"
 	"github.com/itallix/gophkeeper/internal/server"
 	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/operation"
 	"github.com/itallix/gophkeeper/internal/server/service"
 	"github.com/itallix/gophkeeper/internal/server/storage"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
@@ -28,17 +29,91 @@ type GophkeeperServer struct {
 	authService service.AuthenticationService
 	authRepo    *storage.UserRepo
 	vault       server.Vault
+	orgs        server.Organizations
+	audit       server.AuditLog
+	admins      map[string]bool
+	srp         service.SRPAuthenticator
+	accounts    server.ServiceAccounts
+	signingKeys service.SigningKeys
+	sso         server.SSO
+	secretTypes server.SecretTypes
 
 	pb.UnimplementedGophkeeperServiceServer
 }
 
+// ServerOption configures optional GophkeeperServer dependencies.
+type ServerOption func(*GophkeeperServer)
+
+// WithOrganizations enables the organization management RPCs.
+func WithOrganizations(orgs server.Organizations) ServerOption {
+	return func(srv *GophkeeperServer) {
+		srv.orgs = orgs
+	}
+}
+
+// WithAuditLog enables the audit log RPCs.
+func WithAuditLog(audit server.AuditLog) ServerOption {
+	return func(srv *GophkeeperServer) {
+		srv.audit = audit
+	}
+}
+
+// WithAdmins grants the users access to administrative operations.
+func WithAdmins(logins ...string) ServerOption {
+	return func(srv *GophkeeperServer) {
+		for _, login := range logins {
+			srv.admins[login] = true
+		}
+	}
+}
+
+// WithSRP enables the zero-knowledge login RPCs.
+func WithSRP(srp service.SRPAuthenticator) ServerOption {
+	return func(srv *GophkeeperServer) {
+		srv.srp = srp
+	}
+}
+
+// WithServiceAccounts enables service accounts and API keys.
+func WithServiceAccounts(accounts server.ServiceAccounts) ServerOption {
+	return func(srv *GophkeeperServer) {
+		srv.accounts = accounts
+	}
+}
+
+// WithSigningKeys publishes the JWT verification keys and enables their rotation.
+func WithSigningKeys(keys service.SigningKeys) ServerOption {
+	return func(srv *GophkeeperServer) {
+		srv.signingKeys = keys
+	}
+}
+
+// WithSSO enables single sign-on through an OpenID Connect issuer.
+func WithSSO(sso server.SSO) ServerOption {
+	return func(srv *GophkeeperServer) {
+		srv.sso = sso
+	}
+}
+
+// WithSecretTypes enables the registration of user-defined secret types.
+func WithSecretTypes(secretTypes server.SecretTypes) ServerOption {
+	return func(srv *GophkeeperServer) {
+		srv.secretTypes = secretTypes
+	}
+}
+
 func NewGophkeeperServer(vault server.Vault, authService service.AuthenticationService,
-	authRepo *storage.UserRepo) *GophkeeperServer {
-	return &GophkeeperServer{
+	authRepo *storage.UserRepo, opts ...ServerOption) *GophkeeperServer {
+	srv := &GophkeeperServer{
 		authService: authService,
 		authRepo:    authRepo,
 		vault:       vault,
+		admins:      make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(srv)
 	}
+	return srv
 }
 
 func (srv *GophkeeperServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.AuthResponse, error) {
@@ -46,6 +121,12 @@ func (srv *GophkeeperServer) Login(ctx context.Context, req *pb.LoginRequest) (*
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
+	if srv.srp != nil && len(req.GetSrpVerifier()) > 0 {
+		err = srv.srp.Enroll(ctx, req.GetLogin(), req.GetSrpSalt(), req.GetSrpVerifier())
+		if err != nil {
+			logger.Log().Errorf("failed to store srp verifier of user=[%s]: %v", req.GetLogin(), err)
+		}
+	}
 
 	resp := &pb.AuthResponse{
 		AccessToken:  pair.AccessToken,
@@ -65,12 +146,12 @@ func (srv *GophkeeperServer) Register(ctx context.Context, req *pb.RegisterReque
 		return nil, status.Errorf(codes.Unauthenticated, "user with login %s already exists", req.GetLogin())
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.GetPassword()), bcrypt.DefaultCost)
+	hashedPassword, err := srv.authService.HashPassword(req.GetPassword())
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to hash password: %v", err)
 	}
 
-	if err = srv.authRepo.CreateUser(ctx, req.GetLogin(), string(hashedPassword)); err != nil {
+	if err = srv.authRepo.CreateUser(ctx, req.GetLogin(), hashedPassword); err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "error creating a new user %v", err)
 	}
 
@@ -104,27 +185,40 @@ func (srv *GophkeeperServer) RefreshToken(_ context.Context, req *pb.RefreshToke
 	}, nil
 }
 
-func (srv *GophkeeperServer) List(_ context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+func (srv *GophkeeperServer) List(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
 	var secret models.Secret
+	opts := []models.SecretOption{
+		models.WithRequestedBy(username),
+	}
 
 	switch req.GetType() {
 	case pb.DataType_DATA_TYPE_LOGIN:
-		secret = models.NewLogin(nil, nil)
+		secret = models.NewLogin(opts, nil)
 	case pb.DataType_DATA_TYPE_CARD:
-		secret = models.NewCard(nil, nil)
+		secret = models.NewCard(opts, nil)
 	case pb.DataType_DATA_TYPE_NOTE:
-		secret = models.NewNote(nil, nil)
+		secret = models.NewNote(opts, nil)
+	case pb.DataType_DATA_TYPE_SSH_KEY:
+		secret = models.NewSSHKey(opts, nil)
+	case pb.DataType_DATA_TYPE_TOTP:
+		secret = models.NewTOTP(opts, nil)
+	case pb.DataType_DATA_TYPE_KV:
+		secret = models.NewKV(opts, []models.KVOption{models.WithSecretType(req.GetSecretType())})
 	case pb.DataType_DATA_TYPE_BINARY:
-		secret = models.NewBinary(nil, nil)
+		secret = models.NewBinary(opts, nil)
 	case pb.DataType_DATA_TYPE_UNSPECIFIED:
 		return nil, status.Error(codes.Internal, "unspecified data type is not allowed")
 	default:
 		return nil, status.Errorf(codes.Internal, "unknown data type: %v", req.GetType())
 	}
 
-	list, err := srv.vault.ListSecrets(secret)
+	list, err := srv.vault.ListSecrets(ctx, secret)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot perform the action %v", err)
+		return nil, actionError(err)
 	}
 
 	return &pb.ListResponse{
@@ -144,18 +238,12 @@ func (srv *GophkeeperServer) Create(ctx context.Context, req *pb.CreateRequest)
 		models.WithPath(path),
 		models.WithCreatedBy(username),
 		models.WithModifiedBy(username),
+		models.WithRequestedBy(username),
 	}
 
 	switch req.GetData().GetType() {
 	case pb.DataType_DATA_TYPE_LOGIN:
-		loginData := data.GetLogin()
-		secret = models.NewLogin(
-			opts,
-			[]models.LoginOption{
-				models.WithLogin(loginData.GetLogin()),
-				models.WithPassword(loginData.GetPassword()),
-			},
-		)
+		secret = models.NewLogin(opts, loginOptions(data.GetLogin()))
 	case pb.DataType_DATA_TYPE_CARD:
 		cardData := data.GetCard()
 		secret = models.NewCard(
@@ -165,6 +253,8 @@ func (srv *GophkeeperServer) Create(ctx context.Context, req *pb.CreateRequest)
 				models.WithCardNumber(cardData.GetNumber()),
 				models.WithExpiry(cardData.GetExpiryMonth(), cardData.GetExpiryYear()),
 				models.WithCVC(cardData.GetCvv()),
+				models.WithBillingAddress(cardData.GetBillingAddress()),
+				models.WithPIN(cardData.GetPin()),
 			})
 	case pb.DataType_DATA_TYPE_NOTE:
 		secret = models.NewNote(
@@ -173,6 +263,30 @@ func (srv *GophkeeperServer) Create(ctx context.Context, req *pb.CreateRequest)
 				models.WithText(data.GetNote().GetText()),
 			},
 		)
+	case pb.DataType_DATA_TYPE_SSH_KEY:
+		keyData := data.GetSshKey()
+		secret = models.NewSSHKey(
+			opts,
+			[]models.SSHKeyOption{
+				models.WithPrivateKey(keyData.GetPrivateKey()),
+				models.WithPublicKey(keyData.GetPublicKey()),
+				models.WithPassphrase(keyData.GetPassphrase()),
+				models.WithComment(keyData.GetComment()),
+			},
+		)
+	case pb.DataType_DATA_TYPE_TOTP:
+		secret = models.NewTOTP(
+			opts,
+			[]models.TOTPOption{
+				models.WithOTPAuthURI(data.GetTotp().GetUri()),
+			},
+		)
+	case pb.DataType_DATA_TYPE_KV:
+		kvOpts := []models.KVOption{models.WithSecretType(data.GetKv().GetType())}
+		for _, field := range data.GetKv().GetFields() {
+			kvOpts = append(kvOpts, models.WithField(field.GetName(), field.GetValue(), field.GetSensitive()))
+		}
+		secret = models.NewKV(opts, kvOpts)
 	case pb.DataType_DATA_TYPE_UNSPECIFIED:
 		return nil, status.Error(codes.Internal, "unspecified data type is not allowed")
 	case pb.DataType_DATA_TYPE_BINARY:
@@ -181,8 +295,8 @@ func (srv *GophkeeperServer) Create(ctx context.Context, req *pb.CreateRequest)
 		return nil, status.Errorf(codes.Internal, "unknown data type: %v", req.GetData().GetType())
 	}
 
-	if err := srv.vault.StoreSecret(secret); err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot perform the action %v", err)
+	if err := srv.vault.StoreSecret(ctx, secret); err != nil {
+		return nil, actionError(err)
 	}
 
 	return &pb.CreateResponse{
@@ -190,10 +304,81 @@ func (srv *GophkeeperServer) Create(ctx context.Context, req *pb.CreateRequest)
 	}, nil
 }
 
-func (srv *GophkeeperServer) Delete(_ context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+// AttachTOTP adds the second factor to an existing login, the caller needs write access to it.
+func (srv *GophkeeperServer) AttachTOTP(ctx context.Context,
+	req *pb.AttachTOTPRequest) (*pb.AttachTOTPResponse, error) {
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+	if err := srv.vault.AttachTOTP(ctx, req.GetPath(), req.GetUri(), username); err != nil {
+		return nil, actionError(err)
+	}
+	return &pb.AttachTOTPResponse{
+		Message: fmt.Sprintf("totp has been attached to login with path=%s", req.GetPath()),
+	}, nil
+}
+
+// actionError translates vault errors into gRPC statuses. Validation failures are reported
+// as InvalidArgument with a BadRequest detail listing every invalid field, so clients can show
+// them next to the input, access control failures as NotFound or PermissionDenied.
+func actionError(err error) error {
+	var validationErr *operation.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return validationError(validationErr)
+	case errors.Is(err, storage.ErrSecretNotFound),
+		errors.Is(err, storage.ErrShareNotFound),
+		errors.Is(err, storage.ErrUserNotFound),
+		errors.Is(err, storage.ErrOrgNotFound),
+		errors.Is(err, storage.ErrMemberNotFound),
+		errors.Is(err, storage.ErrTeamNotFound),
+		errors.Is(err, storage.ErrCollectionNotFound),
+		errors.Is(err, storage.ErrAccountNotFound),
+		errors.Is(err, storage.ErrKeyNotFound),
+		errors.Is(err, storage.ErrSecretTypeNotFound):
+		return status.Errorf(codes.NotFound, "cannot perform the action %v", err)
+	case errors.Is(err, storage.ErrAccessDenied):
+		return status.Errorf(codes.PermissionDenied, "cannot perform the action %v", err)
+	case errors.Is(err, storage.ErrSecretExists),
+		errors.Is(err, storage.ErrOrgExists),
+		errors.Is(err, storage.ErrTeamExists),
+		errors.Is(err, storage.ErrCollectionExists),
+		errors.Is(err, storage.ErrAccountExists):
+		return status.Errorf(codes.AlreadyExists, "cannot perform the action %v", err)
+	case errors.Is(err, storage.ErrShareWithSelf):
+		return status.Errorf(codes.InvalidArgument, "cannot perform the action %v", err)
+	case errors.Is(err, storage.ErrLastOwner):
+		return status.Errorf(codes.FailedPrecondition, "cannot perform the action %v", err)
+	}
+	return status.Errorf(codes.Internal, "cannot perform the action %v", err)
+}
+
+func validationError(validationErr *operation.ValidationError) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range validationErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	st := status.New(codes.InvalidArgument, "invalid secret")
+	detailed, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid secret: %v", validationErr)
+	}
+	return detailed.Err()
+}
+
+func (srv *GophkeeperServer) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
 	var secret models.Secret
 	opts := []models.SecretOption{
 		models.WithPath(req.GetPath()),
+		models.WithRequestedBy(username),
 	}
 
 	switch req.GetType() {
@@ -203,6 +388,12 @@ func (srv *GophkeeperServer) Delete(_ context.Context, req *pb.DeleteRequest) (*
 		secret = models.NewCard(opts, nil)
 	case pb.DataType_DATA_TYPE_NOTE:
 		secret = models.NewNote(opts, nil)
+	case pb.DataType_DATA_TYPE_SSH_KEY:
+		secret = models.NewSSHKey(opts, nil)
+	case pb.DataType_DATA_TYPE_TOTP:
+		secret = models.NewTOTP(opts, nil)
+	case pb.DataType_DATA_TYPE_KV:
+		secret = models.NewKV(opts, nil)
 	case pb.DataType_DATA_TYPE_BINARY:
 		secret = models.NewBinary(opts, nil)
 	case pb.DataType_DATA_TYPE_UNSPECIFIED:
@@ -211,8 +402,8 @@ func (srv *GophkeeperServer) Delete(_ context.Context, req *pb.DeleteRequest) (*
 		return nil, status.Errorf(codes.Internal, "unknown data type: %v", req.GetType())
 	}
 
-	if err := srv.vault.DeleteSecret(secret); err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot perform the action %v", err)
+	if err := srv.vault.DeleteSecret(ctx, secret); err != nil {
+		return nil, actionError(err)
 	}
 
 	return &pb.DeleteResponse{
@@ -220,10 +411,15 @@ func (srv *GophkeeperServer) Delete(_ context.Context, req *pb.DeleteRequest) (*
 	}, nil
 }
 
-func (srv *GophkeeperServer) Get(_ context.Context, req *pb.GetRequest) (*pb.GetResponse, error) {
+func (srv *GophkeeperServer) Get(ctx context.Context, req *pb.GetRequest) (*pb.GetResponse, error) {
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
 	var secret models.Secret
 	opts := []models.SecretOption{
 		models.WithPath(req.GetPath()),
+		models.WithRequestedBy(username),
 	}
 
 	switch req.GetType() {
@@ -233,6 +429,12 @@ func (srv *GophkeeperServer) Get(_ context.Context, req *pb.GetRequest) (*pb.Get
 		secret = models.NewCard(opts, nil)
 	case pb.DataType_DATA_TYPE_NOTE:
 		secret = models.NewNote(opts, nil)
+	case pb.DataType_DATA_TYPE_SSH_KEY:
+		secret = models.NewSSHKey(opts, nil)
+	case pb.DataType_DATA_TYPE_TOTP:
+		secret = models.NewTOTP(opts, nil)
+	case pb.DataType_DATA_TYPE_KV:
+		secret = models.NewKV(opts, nil)
 	case pb.DataType_DATA_TYPE_UNSPECIFIED:
 		return nil, status.Error(codes.Internal, "unspecified data type is not allowed")
 	case pb.DataType_DATA_TYPE_BINARY:
@@ -241,8 +443,8 @@ func (srv *GophkeeperServer) Get(_ context.Context, req *pb.GetRequest) (*pb.Get
 		return nil, status.Errorf(codes.Internal, "unknown data type: %v", req.GetType())
 	}
 
-	if err := srv.vault.RetrieveSecret(secret); err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot perform the action %v", err)
+	if err := srv.vault.RetrieveSecret(ctx, secret); err != nil {
+		return nil, actionError(err)
 	}
 
 	switch req.GetType() {
@@ -252,6 +454,18 @@ func (srv *GophkeeperServer) Get(_ context.Context, req *pb.GetRequest) (*pb.Get
 			return nil, status.Errorf(codes.Internal,
 				"invalid type assertion: expected *models.Login, got %T", secret)
 		}
+		uris := make([]*pb.LoginURI, 0, len(login.URIs))
+		for _, uri := range login.URIs {
+			uris = append(uris, fromLoginURI(uri))
+		}
+		fields := make([]*pb.KVField, 0, len(login.Fields))
+		for _, field := range login.Fields {
+			fields = append(fields, &pb.KVField{
+				Name:      field.Name,
+				Value:     string(field.Value),
+				Sensitive: field.Sensitive,
+			})
+		}
 		return &pb.GetResponse{
 			Data: &pb.TypedData{
 				Base: &pb.Metadata{
@@ -264,6 +478,10 @@ func (srv *GophkeeperServer) Get(_ context.Context, req *pb.GetRequest) (*pb.Get
 					Login: &pb.LoginData{
 						Login:    login.Login,
 						Password: string(login.Password),
+						Totp:     string(login.TOTP),
+						Uris:     uris,
+						Notes:    string(login.Notes),
+						Fields:   fields,
 					},
 				},
 			},
@@ -284,11 +502,14 @@ func (srv *GophkeeperServer) Get(_ context.Context, req *pb.GetRequest) (*pb.Get
 				},
 				Data: &pb.TypedData_Card{
 					Card: &pb.CardData{
-						Number:      string(card.Number),
-						CardHolder:  card.CardholderName,
-						ExpiryMonth: card.ExpiryMonth,
-						ExpiryYear:  card.ExpiryYear,
-						Cvv:         string(card.CVC),
+						Number:         string(card.Number),
+						CardHolder:     card.CardholderName,
+						ExpiryMonth:    card.ExpiryMonth,
+						ExpiryYear:     card.ExpiryYear,
+						Cvv:            string(card.CVC),
+						Brand:          card.Brand,
+						BillingAddress: string(card.BillingAddress),
+						Pin:            string(card.PIN),
 					},
 				},
 			},
@@ -314,6 +535,82 @@ func (srv *GophkeeperServer) Get(_ context.Context, req *pb.GetRequest) (*pb.Get
 				},
 			},
 		}, nil
+	case pb.DataType_DATA_TYPE_SSH_KEY:
+		key, ok := secret.(*models.SSHKey)
+		if !ok {
+			return nil, status.Errorf(codes.Internal,
+				"invalid type assertion: expected *models.SSHKey, got %T", secret)
+		}
+		return &pb.GetResponse{
+			Data: &pb.TypedData{
+				Base: &pb.Metadata{
+					CreatedBy: key.CreatedBy,
+					CreatedAt: key.CreatedAt.Format("2006-01-02 15:04:05"),
+					Path:      key.Path,
+					Metadata:  fmt.Sprintf("%v", key.CustomMeta),
+				},
+				Data: &pb.TypedData_SshKey{
+					SshKey: &pb.SSHKeyData{
+						PrivateKey:  string(key.PrivateKey),
+						PublicKey:   key.PublicKey,
+						Passphrase:  string(key.Passphrase),
+						Comment:     key.Comment,
+						Fingerprint: key.Fingerprint,
+					},
+				},
+			},
+		}, nil
+	case pb.DataType_DATA_TYPE_TOTP:
+		totp, ok := secret.(*models.TOTP)
+		if !ok {
+			return nil, status.Errorf(codes.Internal,
+				"invalid type assertion: expected *models.TOTP, got %T", secret)
+		}
+		return &pb.GetResponse{
+			Data: &pb.TypedData{
+				Base: &pb.Metadata{
+					CreatedBy: totp.CreatedBy,
+					CreatedAt: totp.CreatedAt.Format("2006-01-02 15:04:05"),
+					Path:      totp.Path,
+					Metadata:  fmt.Sprintf("%v", totp.CustomMeta),
+				},
+				Data: &pb.TypedData_Totp{
+					Totp: &pb.TOTPData{
+						Uri: string(totp.URI),
+					},
+				},
+			},
+		}, nil
+	case pb.DataType_DATA_TYPE_KV:
+		kv, ok := secret.(*models.KV)
+		if !ok {
+			return nil, status.Errorf(codes.Internal,
+				"invalid type assertion: expected *models.KV, got %T", secret)
+		}
+		fields := make([]*pb.KVField, 0, len(kv.Fields))
+		for _, field := range kv.Fields {
+			fields = append(fields, &pb.KVField{
+				Name:      field.Name,
+				Value:     string(field.Value),
+				Sensitive: field.Sensitive,
+			})
+		}
+		return &pb.GetResponse{
+			Data: &pb.TypedData{
+				Base: &pb.Metadata{
+					CreatedBy: kv.CreatedBy,
+					CreatedAt: kv.CreatedAt.Format("2006-01-02 15:04:05"),
+					Path:      kv.Path,
+					Metadata:  fmt.Sprintf("%v", kv.CustomMeta),
+				},
+				Data: &pb.TypedData_Kv{
+					Kv: &pb.KVData{
+						Fields: fields,
+						Type:   kv.Type,
+					},
+				},
+			},
+		}, nil
 	case pb.DataType_DATA_TYPE_UNSPECIFIED:
 		return nil, status.Error(codes.Internal, "unspecified data type is not allowed")
 	case pb.DataType_DATA_TYPE_BINARY:
@@ -351,13 +648,14 @@ func (srv *GophkeeperServer) Upload(stream pb.GophkeeperService_UploadServer) er
 			[]models.SecretOption{
 				models.WithPath(chunk.GetFilename()),
 				models.WithEncryptedDataKey(encDataKey),
+				models.WithRequestedBy(username),
 			},
 			[]models.BinaryOption{
 				models.WithChunkID(chunk.GetChunkId()),
 				models.WithData(chunk.GetData()),
 			})
-		if err = srv.vault.StoreSecret(binary); err != nil {
-			return status.Errorf(codes.Internal, "failed to store chunk: %v", err)
+		if err = srv.vault.StoreSecret(stream.Context(), binary); err != nil {
+			return actionError(err)
 		}
 		if encDataKey == nil {
 			encDataKey = binary.EncryptedDataKey
@@ -368,6 +666,7 @@ func (srv *GophkeeperServer) Upload(stream pb.GophkeeperService_UploadServer) er
 			models.WithPath(lastChunk.GetFilename()),
 			models.WithCreatedBy(username),
 			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
 			models.WithEncryptedDataKey(encDataKey),
 		},
 		[]models.BinaryOption{
@@ -376,7 +675,7 @@ func (srv *GophkeeperServer) Upload(stream pb.GophkeeperService_UploadServer) er
 			models.WithData(nil),
 		},
 	)
-	if err := srv.vault.StoreSecret(binary); err != nil {
+	if err := srv.vault.StoreSecret(stream.Context(), binary); err != nil {
 		return status.Errorf(codes.Internal, "failed to store chunk: %v", err)
 	}
 	if err := stream.SendAndClose(&pb.UploadResponse{
@@ -389,33 +688,46 @@ func (srv *GophkeeperServer) Upload(stream pb.GophkeeperService_UploadServer) er
 }
 
 func (srv *GophkeeperServer) Download(req *pb.DownloadRequest, stream pb.GophkeeperService_DownloadServer) error {
+	username, ok := stream.Context().Value(UsernameKey).(string)
+	if !ok {
+		return status.Error(codes.Internal, "username not found in context")
+	}
 	binary := models.NewBinary(
 		[]models.SecretOption{
 			models.WithPath(req.GetFilename()),
+			models.WithRequestedBy(username),
 		},
 		nil,
 	)
-	if err := srv.vault.RetrieveSecret(binary); err != nil {
-		return status.Errorf(codes.Internal, "failed to retrieve binary metadata: %v", err)
+	if err := srv.vault.RetrieveSecret(stream.Context(), binary); err != nil {
+		return actionError(err)
 	}
 
+	return srv.sendChunks(stream.Context(), binary, stream.Send)
+}
+
+// sendChunks streams the data of a binary whose metadata has already been retrieved,
+// followed by a final chunk without data carrying the hash of the whole file.
+func (srv *GophkeeperServer) sendChunks(ctx context.Context, binary *models.Binary,
+	send func(*pb.Chunk) error) error {
 	for i := range binary.Chunks {
 		chunk := models.NewBinary(
 			[]models.SecretOption{
-				models.WithPath(req.GetFilename()),
+				models.WithPath(binary.Path),
 				models.WithEncryptedDataKey(binary.EncryptedDataKey),
+				models.WithRequestedBy(binary.RequestedBy),
 			},
 			[]models.BinaryOption{
 				models.WithChunkID(i),
 				models.WithChunks(binary.Chunks),
 			},
 		)
-		if err := srv.vault.RetrieveSecret(chunk); err != nil {
+		if err := srv.vault.RetrieveSecret(ctx, chunk); err != nil {
 			return status.Errorf(codes.Internal, "failed to retrieve chunk data: %v", err)
 		}
 		logger.Log().Infof("Download chunk: %d %d", chunk.ChunkID, len(chunk.Data))
 		chunkHash := sha256.Sum256(chunk.Data)
-		if err := stream.Send(&pb.Chunk{
+		if err := send(&pb.Chunk{
 			Filename: binary.Path,
 			Data:     chunk.Data,
 			ChunkId:  chunk.ChunkID,
@@ -425,7 +737,7 @@ func (srv *GophkeeperServer) Download(req *pb.DownloadRequest, stream pb.Gophkee
 		}
 	}
 
-	if err := stream.Send(&pb.Chunk{
+	if err := send(&pb.Chunk{
 		Filename: binary.Path,
 		Hash:     binary.Hash,
 	}); err != nil {
@@ -434,3 +746,76 @@ func (srv *GophkeeperServer) Download(req *pb.DownloadRequest, stream pb.Gophkee
 
 	return nil
 }
+
+// Export streams every secret created by the current user. Logins, cards, notes, SSH keys, TOTPs and key-value
+// secrets are sent in the same shape as returned by Get, binaries are sent as metadata followed by their chunks.
+func (srv *GophkeeperServer) Export(_ *pb.ExportRequest, stream pb.GophkeeperService_ExportServer) error {
+	ctx := stream.Context()
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return status.Error(codes.Internal, "username not found in context")
+	}
+
+	for _, dataType := range []pb.DataType{
+		pb.DataType_DATA_TYPE_LOGIN,
+		pb.DataType_DATA_TYPE_CARD,
+		pb.DataType_DATA_TYPE_NOTE,
+		pb.DataType_DATA_TYPE_SSH_KEY,
+		pb.DataType_DATA_TYPE_TOTP,
+		pb.DataType_DATA_TYPE_KV,
+	} {
+		list, err := srv.List(ctx, &pb.ListRequest{Type: dataType})
+		if err != nil {
+			return err
+		}
+		for _, path := range list.GetSecrets() {
+			resp, getErr := srv.Get(ctx, &pb.GetRequest{Type: dataType, Path: path})
+			if getErr != nil {
+				return getErr
+			}
+			data := resp.GetData()
+			if data.GetBase().GetCreatedBy() != username {
+				continue
+			}
+			data.Type = dataType
+			if err = stream.Send(&pb.ExportItem{Item: &pb.ExportItem_Secret{Secret: data}}); err != nil {
+				return status.Errorf(codes.Internal, "failed to send secret: %v", err)
+			}
+		}
+	}
+
+	list, err := srv.List(ctx, &pb.ListRequest{Type: pb.DataType_DATA_TYPE_BINARY})
+	if err != nil {
+		return err
+	}
+	for _, path := range list.GetSecrets() {
+		binary := models.NewBinary([]models.SecretOption{
+			models.WithPath(path),
+			models.WithRequestedBy(username),
+		}, nil)
+		if err = srv.vault.RetrieveSecret(ctx, binary); err != nil {
+			return status.Errorf(codes.Internal, "failed to retrieve binary metadata: %v", err)
+		}
+		if binary.CreatedBy != username {
+			continue
+		}
+		if err = stream.Send(&pb.ExportItem{Item: &pb.ExportItem_Secret{Secret: &pb.TypedData{
+			Type: pb.DataType_DATA_TYPE_BINARY,
+			Base: &pb.Metadata{
+				CreatedBy: binary.CreatedBy,
+				CreatedAt: binary.CreatedAt.Format("2006-01-02 15:04:05"),
+				Path:      binary.Path,
+				Metadata:  fmt.Sprintf("%v", binary.CustomMeta),
+			},
+		}}}); err != nil {
+			return status.Errorf(codes.Internal, "failed to send secret: %v", err)
+		}
+		if err = srv.sendChunks(ctx, binary, func(chunk *pb.Chunk) error {
+			return stream.Send(&pb.ExportItem{Item: &pb.ExportItem_Chunk{Chunk: chunk}})
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,18 +3,23 @@ package grpc_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	googlegrpc "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/itallix/gophkeeper/internal/server/grpc"
 	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/operation"
 	"github.com/itallix/gophkeeper/internal/server/service"
+	"github.com/itallix/gophkeeper/internal/server/storage"
 	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
 	mocks "github.com/itallix/gophkeeper/mocks/internal_/server/service"
 	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
@@ -213,9 +218,14 @@ func TestCreate(t *testing.T) {
 			name: "create_login",
 			setup: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					StoreSecret(mock.MatchedBy(func(s models.Secret) bool {
+					StoreSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						login, ok := s.(*models.Login)
-						return ok && login.Path == "/test/path"
+						return ok && login.Path == "/test/path" && string(login.Notes) == "recovery codes in safe" &&
+							assert.ObjectsAreEqual([]models.LoginURI{
+								{URI: "github.com", Match: models.URIMatchBaseDomain},
+								{URI: "https://github.com/login", Match: models.URIMatchExact},
+							}, login.URIs) &&
+							len(login.Fields) == 1 && login.Fields[0].Name == "pin" && login.Fields[0].Sensitive
 					})).
 					Return(nil)
 			},
@@ -226,6 +236,12 @@ func TestCreate(t *testing.T) {
 						Login: &pb.LoginData{
 							Login:    "testuser",
 							Password: "testpass",
+							Uris: []*pb.LoginURI{
+								{Uri: "github.com"},
+								{Uri: "https://github.com/login", Match: pb.URIMatch_URI_MATCH_EXACT},
+							},
+							Notes:  "recovery codes in safe",
+							Fields: []*pb.KVField{{Name: "pin", Value: "1234", Sensitive: true}},
 						},
 					},
 					Type: pb.DataType_DATA_TYPE_LOGIN,
@@ -234,13 +250,64 @@ func TestCreate(t *testing.T) {
 			username:  "testuser",
 			wantError: false,
 		},
+		{
+			name: "create_totp",
+			setup: func(mv *mocksrv.Vault) {
+				mv.EXPECT().
+					StoreSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
+						totp, ok := s.(*models.TOTP)
+						return ok && string(totp.URI) == "otpauth://totp/GitHub:mark?secret=JBSWY3DPEHPK3PXP"
+					})).
+					Return(nil)
+			},
+			request: &pb.CreateRequest{
+				Data: &pb.TypedData{
+					Base: &pb.Metadata{Path: "/test/totp"},
+					Data: &pb.TypedData_Totp{
+						Totp: &pb.TOTPData{Uri: "otpauth://totp/GitHub:mark?secret=JBSWY3DPEHPK3PXP"},
+					},
+					Type: pb.DataType_DATA_TYPE_TOTP,
+				},
+			},
+			username:  "testuser",
+			wantError: false,
+		},
+		{
+			name: "create_kv",
+			setup: func(mv *mocksrv.Vault) {
+				mv.EXPECT().
+					StoreSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
+						kv, ok := s.(*models.KV)
+						return ok && assert.ObjectsAreEqual([]models.KVField{
+							{Name: "url", Value: []byte("https://hooks.example.com")},
+							{Name: "secret", Value: []byte("whsec_123"), Sensitive: true},
+						}, kv.Fields)
+					})).
+					Return(nil)
+			},
+			request: &pb.CreateRequest{
+				Data: &pb.TypedData{
+					Base: &pb.Metadata{Path: "/test/webhook"},
+					Data: &pb.TypedData_Kv{
+						Kv: &pb.KVData{Fields: []*pb.KVField{
+							{Name: "url", Value: "https://hooks.example.com"},
+							{Name: "secret", Value: "whsec_123", Sensitive: true},
+						}},
+					},
+					Type: pb.DataType_DATA_TYPE_KV,
+				},
+			},
+			username:  "testuser",
+			wantError: false,
+		},
 		{
 			name: "create_card",
 			setup: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					StoreSecret(mock.MatchedBy(func(s models.Secret) bool {
-						note, ok := s.(*models.Card)
-						return ok && note.Path == "/test/card"
+					StoreSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
+						card, ok := s.(*models.Card)
+						return ok && card.Path == "/test/card" && string(card.PIN) == "1234" &&
+							string(card.BillingAddress) == "1 Main St"
 					})).
 					Return(nil)
 			},
@@ -249,11 +316,13 @@ func TestCreate(t *testing.T) {
 					Base: &pb.Metadata{Path: "/test/card"},
 					Data: &pb.TypedData_Card{
 						Card: &pb.CardData{
-							CardHolder:  "Adam Smith",
-							Number:      "2233445566778899",
-							ExpiryMonth: 8,
-							ExpiryYear:  int64(time.Now().Year() + 2),
-							Cvv:         "237",
+							CardHolder:     "Adam Smith",
+							Number:         "2233445566778899",
+							ExpiryMonth:    8,
+							ExpiryYear:     int64(time.Now().Year() + 2),
+							Cvv:            "237",
+							BillingAddress: "1 Main St",
+							Pin:            "1234",
 						},
 					},
 					Type: pb.DataType_DATA_TYPE_CARD,
@@ -266,7 +335,7 @@ func TestCreate(t *testing.T) {
 			name: "create_note",
 			setup: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					StoreSecret(mock.MatchedBy(func(s models.Secret) bool {
+					StoreSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						note, ok := s.(*models.Note)
 						return ok && note.Path == "/test/note"
 					})).
@@ -335,6 +404,56 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestAttachTOTP(t *testing.T) {
+	const uri = "otpauth://totp/GitHub:mark?secret=JBSWY3DPEHPK3PXP"
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+
+	vault := mocksrv.NewVault(t)
+	vault.EXPECT().AttachTOTP(mock.Anything, "github", uri, "mark").Return(nil).Once()
+	server := grpc.NewGophkeeperServer(vault, nil, nil)
+	resp, err := server.AttachTOTP(ctx, &pb.AttachTOTPRequest{Path: "github", Uri: uri})
+	require.NoError(t, err)
+	assert.Contains(t, resp.GetMessage(), "github")
+
+	vault.EXPECT().AttachTOTP(mock.Anything, "shared", uri, "mark").
+		Return(fmt.Errorf("[ATTACH TOTP] %w", storage.ErrAccessDenied)).Once()
+	_, err = server.AttachTOTP(ctx, &pb.AttachTOTPRequest{Path: "shared", Uri: uri})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCreateInvalidSecret(t *testing.T) {
+	vault := mocksrv.NewVault(t)
+	vault.EXPECT().StoreSecret(mock.Anything, mock.Anything).
+		Return(fmt.Errorf("processing error at *operation.Validator: %w",
+			&operation.ValidationError{Violations: []operation.FieldViolation{
+				{Field: "password", Description: "password should contain a digit"},
+				{Field: "password", Description: "password should contain a symbol"},
+			}}))
+
+	server := grpc.NewGophkeeperServer(vault, nil, nil)
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "testuser")
+	_, err := server.Create(ctx, &pb.CreateRequest{
+		Data: &pb.TypedData{
+			Base: &pb.Metadata{Path: "/test/path"},
+			Data: &pb.TypedData_Login{
+				Login: &pb.LoginData{Login: "testuser", Password: "testpass"},
+			},
+			Type: pb.DataType_DATA_TYPE_LOGIN,
+		},
+	})
+
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 2)
+	assert.Equal(t, "password", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "password should contain a symbol", badRequest.GetFieldViolations()[1].GetDescription())
+}
+
 func TestGet(t *testing.T) {
 	testTime := time.Now()
 	tests := []struct {
@@ -348,7 +467,7 @@ func TestGet(t *testing.T) {
 			name: "get_login",
 			setup: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					RetrieveSecret(mock.MatchedBy(func(s models.Secret) bool {
+					RetrieveSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						login, ok := s.(*models.Login)
 						if ok {
 							login.Login = "testuser"
@@ -371,7 +490,7 @@ func TestGet(t *testing.T) {
 			name: "secret_not_found",
 			setup: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					RetrieveSecret(mock.Anything).
+					RetrieveSecret(mock.Anything, mock.Anything).
 					Return(errors.New("secret not found"))
 			},
 			request: &pb.GetRequest{
@@ -385,7 +504,7 @@ func TestGet(t *testing.T) {
 			name: "get_card",
 			setup: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					RetrieveSecret(mock.MatchedBy(func(s models.Secret) bool {
+					RetrieveSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						card, ok := s.(*models.Card)
 						if ok {
 							card.CardholderName = "testuser"
@@ -411,7 +530,7 @@ func TestGet(t *testing.T) {
 			name: "get_note",
 			setup: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					RetrieveSecret(mock.MatchedBy(func(s models.Secret) bool {
+					RetrieveSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						note, ok := s.(*models.Note)
 						if ok {
 							note.Text = []byte("lorem ipsum")
@@ -457,7 +576,8 @@ func TestGet(t *testing.T) {
 			}
 
 			server := grpc.NewGophkeeperServer(vault, nil, nil)
-			resp, err := server.Get(context.Background(), tt.request)
+			ctx := context.WithValue(context.Background(), grpc.UsernameKey, "testuser")
+			resp, err := server.Get(ctx, tt.request)
 
 			if tt.wantError {
 				require.Error(t, err)
@@ -489,7 +609,7 @@ func TestDelete(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					DeleteSecret(mock.MatchedBy(func(s models.Secret) bool {
+					DeleteSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						login, ok := s.(*models.Login)
 						return ok && login.Path == "/test/login"
 					})).
@@ -506,7 +626,7 @@ func TestDelete(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					DeleteSecret(mock.MatchedBy(func(s models.Secret) bool {
+					DeleteSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						card, ok := s.(*models.Card)
 						return ok && card.Path == "/test/card"
 					})).
@@ -523,7 +643,7 @@ func TestDelete(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					DeleteSecret(mock.MatchedBy(func(s models.Secret) bool {
+					DeleteSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						note, ok := s.(*models.Note)
 						return ok && note.Path == "/test/note"
 					})).
@@ -540,7 +660,7 @@ func TestDelete(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					DeleteSecret(mock.MatchedBy(func(s models.Secret) bool {
+					DeleteSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						binary, ok := s.(*models.Binary)
 						return ok && binary.Path == "/test/binary"
 					})).
@@ -557,7 +677,7 @@ func TestDelete(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					DeleteSecret(mock.Anything).
+					DeleteSecret(mock.Anything, mock.Anything).
 					Return(errors.New("vault error"))
 			},
 			expectedMsg:   "",
@@ -589,7 +709,7 @@ func TestDelete(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					DeleteSecret(mock.MatchedBy(func(s models.Secret) bool {
+					DeleteSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
 						login, ok := s.(*models.Login)
 						return ok && login.Path == ""
 					})).
@@ -608,7 +728,8 @@ func TestDelete(t *testing.T) {
 			}
 
 			server := grpc.NewGophkeeperServer(mockVault, nil, nil)
-			resp, err := server.Delete(context.Background(), tt.request)
+			ctx := context.WithValue(context.Background(), grpc.UsernameKey, "testuser")
+			resp, err := server.Delete(ctx, tt.request)
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -641,7 +762,7 @@ func TestList(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					ListSecrets(mock.Anything).
+					ListSecrets(mock.Anything, mock.Anything).
 					Return([]string{"login1", "login2"}, nil)
 			},
 			expectedList:  []string{"login1", "login2"},
@@ -654,7 +775,7 @@ func TestList(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					ListSecrets(mock.Anything).
+					ListSecrets(mock.Anything, mock.Anything).
 					Return([]string{"card1", "card2"}, nil)
 			},
 			expectedList:  []string{"card1", "card2"},
@@ -667,7 +788,7 @@ func TestList(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					ListSecrets(mock.Anything).
+					ListSecrets(mock.Anything, mock.Anything).
 					Return([]string{"note1", "note2"}, nil)
 			},
 			expectedList:  []string{"note1", "note2"},
@@ -680,7 +801,7 @@ func TestList(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					ListSecrets(mock.Anything).
+					ListSecrets(mock.Anything, mock.Anything).
 					Return([]string{"binary1", "binary2"}, nil)
 			},
 			expectedList:  []string{"binary1", "binary2"},
@@ -693,7 +814,7 @@ func TestList(t *testing.T) {
 			},
 			setupMock: func(mv *mocksrv.Vault) {
 				mv.EXPECT().
-					ListSecrets(mock.Anything).
+					ListSecrets(mock.Anything, mock.Anything).
 					Return(nil, errors.New("vault error"))
 			},
 			expectedList:  nil,
@@ -725,7 +846,8 @@ func TestList(t *testing.T) {
 			}
 
 			server := grpc.NewGophkeeperServer(mockVault, nil, nil)
-			resp, err := server.List(context.Background(), tt.request)
+			ctx := context.WithValue(context.Background(), grpc.UsernameKey, "testuser")
+			resp, err := server.List(ctx, tt.request)
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -742,3 +864,90 @@ func TestList(t *testing.T) {
 		})
 	}
 }
+
+type exportStream struct {
+	googlegrpc.ServerStream
+
+	ctx   context.Context
+	items []*pb.ExportItem
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(item *pb.ExportItem) error {
+	s.items = append(s.items, item)
+	return nil
+}
+
+func TestExport(t *testing.T) {
+	testTime := time.Now()
+	vault := mocksrv.NewVault(t)
+	listed := map[string][]string{
+		"*models.Login":  {"mail"},
+		"*models.Card":   nil,
+		"*models.Note":   {"foreign"},
+		"*models.Binary": {"photo.png"},
+		"*models.SSHKey": {"deploy"},
+		"*models.TOTP":   {"github-2fa"},
+		"*models.KV":     {"stripe"},
+	}
+	vault.EXPECT().ListSecrets(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, s models.Secret) ([]string, error) {
+			return listed[fmt.Sprintf("%T", s)], nil
+		}).Times(7)
+	vault.EXPECT().RetrieveSecret(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context,
+		s models.Secret) error {
+		switch secret := s.(type) {
+		case *models.Login:
+			secret.Login, secret.Password = "user1", []byte("pass")
+			secret.CreatedBy, secret.CreatedAt = "user1", testTime
+		case *models.Note:
+			secret.Text = []byte("not mine")
+			secret.CreatedBy, secret.CreatedAt = "user2", testTime
+		case *models.SSHKey:
+			secret.PrivateKey, secret.Fingerprint = []byte("private"), "SHA256:fingerprint"
+			secret.CreatedBy, secret.CreatedAt = "user1", testTime
+		case *models.TOTP:
+			secret.URI = []byte("otpauth://totp/GitHub:user1?secret=JBSWY3DPEHPK3PXP")
+			secret.CreatedBy, secret.CreatedAt = "user1", testTime
+		case *models.KV:
+			secret.Fields = []models.KVField{{Name: "api_key", Value: []byte("sk_live_123"), Sensitive: true}}
+			secret.CreatedBy, secret.CreatedAt = "user1", testTime
+		case *models.Binary:
+			if secret.Chunks == 0 {
+				secret.Chunks, secret.Hash = 1, "filehash"
+				secret.CreatedBy, secret.CreatedAt = "user1", testTime
+			} else {
+				secret.Data = []byte("png")
+			}
+		}
+		return nil
+	})
+
+	stream := &exportStream{ctx: context.WithValue(context.Background(), grpc.UsernameKey, "user1")}
+	server := grpc.NewGophkeeperServer(vault, nil, nil)
+	require.NoError(t, server.Export(&pb.ExportRequest{}, stream))
+
+	require.Len(t, stream.items, 7)
+	login := stream.items[0].GetSecret()
+	assert.Equal(t, pb.DataType_DATA_TYPE_LOGIN, login.GetType())
+	assert.Equal(t, "pass", login.GetLogin().GetPassword())
+	key := stream.items[1].GetSecret()
+	assert.Equal(t, pb.DataType_DATA_TYPE_SSH_KEY, key.GetType())
+	assert.Equal(t, "private", key.GetSshKey().GetPrivateKey())
+	assert.Equal(t, "SHA256:fingerprint", key.GetSshKey().GetFingerprint())
+	totp := stream.items[2].GetSecret()
+	assert.Equal(t, pb.DataType_DATA_TYPE_TOTP, totp.GetType())
+	assert.Contains(t, totp.GetTotp().GetUri(), "secret=JBSWY3DPEHPK3PXP")
+	kv := stream.items[3].GetSecret()
+	assert.Equal(t, pb.DataType_DATA_TYPE_KV, kv.GetType())
+	assert.Equal(t, []*pb.KVField{{Name: "api_key", Value: "sk_live_123", Sensitive: true}}, kv.GetKv().GetFields())
+	binary := stream.items[4].GetSecret()
+	assert.Equal(t, pb.DataType_DATA_TYPE_BINARY, binary.GetType())
+	assert.Equal(t, "photo.png", binary.GetBase().GetPath())
+	assert.Equal(t, []byte("png"), stream.items[5].GetChunk().GetData())
+	assert.Nil(t, stream.items[6].GetChunk().GetData())
+	assert.Equal(t, "filehash", stream.items[6].GetChunk().GetHash())
+}
@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+// ScopeKey holds the scope of a service account token, it is absent for regular users.
+const ScopeKey contextKey = "scope"
+
+// accountOwner returns the user managing service accounts. Service accounts themselves
+// can't manage accounts or keys, otherwise a leaked key could mint new ones.
+func (srv *GophkeeperServer) accountOwner(ctx context.Context, required ...string) (string, error) {
+	if srv.accounts == nil {
+		return "", status.Error(codes.Unimplemented, "service accounts are not enabled")
+	}
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return "", status.Error(codes.Internal, "username not found in context")
+	}
+	if _, scoped := ctx.Value(ScopeKey).(models.Scope); scoped {
+		return "", status.Error(codes.PermissionDenied, "service accounts cannot manage service accounts")
+	}
+	for _, field := range required {
+		if field == "" {
+			return "", status.Error(codes.InvalidArgument, "required field is missing")
+		}
+	}
+	return username, nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func toAPIKey(key models.APIKey) *pb.APIKey {
+	return &pb.APIKey{
+		KeyId:        key.ID,
+		ReadOnly:     key.Scope.ReadOnly,
+		PathPrefixes: key.Scope.PathPrefixes,
+		CreatedAt:    formatTime(key.CreatedAt),
+		ExpiresAt:    formatTime(key.ExpiresAt),
+		LastUsedAt:   formatTime(key.LastUsedAt),
+	}
+}
+
+func accountResponse(format string, args ...any) *pb.ServiceAccountResponse {
+	return &pb.ServiceAccountResponse{Message: fmt.Sprintf(format, args...)}
+}
+
+func (srv *GophkeeperServer) CreateServiceAccount(ctx context.Context,
+	req *pb.CreateServiceAccountRequest) (*pb.ServiceAccountResponse, error) {
+	username, err := srv.accountOwner(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.accounts.CreateServiceAccount(username, req.GetName()); err != nil {
+		return nil, actionError(err)
+	}
+	return accountResponse("service account %s has been created", req.GetName()), nil
+}
+
+func (srv *GophkeeperServer) ListServiceAccounts(ctx context.Context,
+	_ *pb.ListServiceAccountsRequest) (*pb.ListServiceAccountsResponse, error) {
+	username, err := srv.accountOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := srv.accounts.ListServiceAccounts(username)
+	if err != nil {
+		return nil, actionError(err)
+	}
+
+	resp := &pb.ListServiceAccountsResponse{Accounts: make([]*pb.ServiceAccount, 0, len(accounts))}
+	for _, account := range accounts {
+		resp.Accounts = append(resp.Accounts, &pb.ServiceAccount{
+			Name:      account.Name,
+			CreatedAt: formatTime(account.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (srv *GophkeeperServer) DeleteServiceAccount(ctx context.Context,
+	req *pb.DeleteServiceAccountRequest) (*pb.ServiceAccountResponse, error) {
+	username, err := srv.accountOwner(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.accounts.DeleteServiceAccount(username, req.GetName()); err != nil {
+		return nil, actionError(err)
+	}
+	return accountResponse("service account %s has been deleted", req.GetName()), nil
+}
+
+func (srv *GophkeeperServer) CreateAPIKey(ctx context.Context,
+	req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	username, err := srv.accountOwner(ctx, req.GetAccount())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTtlSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl must not be negative")
+	}
+	scope := models.Scope{ReadOnly: req.GetReadOnly()}
+	for _, prefix := range req.GetPathPrefixes() {
+		if prefix == "" {
+			return nil, status.Error(codes.InvalidArgument, "path prefix must not be empty")
+		}
+		scope.PathPrefixes = append(scope.PathPrefixes, prefix)
+	}
+
+	ttl := time.Duration(req.GetTtlSeconds()) * time.Second
+	apiKey, key, err := srv.accounts.CreateAPIKey(username, req.GetAccount(), scope, ttl)
+	if err != nil {
+		return nil, actionError(err)
+	}
+	return &pb.CreateAPIKeyResponse{ApiKey: apiKey, Key: toAPIKey(key)}, nil
+}
+
+func (srv *GophkeeperServer) ListAPIKeys(ctx context.Context,
+	req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	username, err := srv.accountOwner(ctx, req.GetAccount())
+	if err != nil {
+		return nil, err
+	}
+	keys, err := srv.accounts.ListAPIKeys(username, req.GetAccount())
+	if err != nil {
+		return nil, actionError(err)
+	}
+
+	resp := &pb.ListAPIKeysResponse{Keys: make([]*pb.APIKey, 0, len(keys))}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, toAPIKey(key))
+	}
+	return resp, nil
+}
+
+func (srv *GophkeeperServer) RevokeAPIKey(ctx context.Context,
+	req *pb.RevokeAPIKeyRequest) (*pb.ServiceAccountResponse, error) {
+	username, err := srv.accountOwner(ctx, req.GetAccount(), req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.accounts.RevokeAPIKey(username, req.GetAccount(), req.GetKeyId()); err != nil {
+		return nil, actionError(err)
+	}
+	return accountResponse("api key %s has been revoked", req.GetKeyId()), nil
+}
+
+func (srv *GophkeeperServer) ExchangeAPIKey(_ context.Context,
+	req *pb.ExchangeAPIKeyRequest) (*pb.AuthResponse, error) {
+	if srv.accounts == nil {
+		return nil, status.Error(codes.Unimplemented, "service accounts are not enabled")
+	}
+	key, err := srv.accounts.AuthenticateAPIKey(req.GetApiKey())
+	if errors.Is(err, server.ErrInvalidAPIKey) || errors.Is(err, server.ErrAPIKeyExpired) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check api key: %v", err)
+	}
+
+	token, err := srv.authService.GetScopedToken(key.Account, key.Scope)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate a token: %v", err)
+	}
+	return &pb.AuthResponse{
+		AccessToken: token,
+		UserId:      key.Account,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server"
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
+	mocks "github.com/itallix/gophkeeper/mocks/internal_/server/service"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestServiceAccounts(t *testing.T) {
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+
+	t.Run("create api key", func(t *testing.T) {
+		accounts := mocksrv.NewServiceAccounts(t)
+		scope := models.Scope{ReadOnly: true, PathPrefixes: []string{"deploy/"}}
+		accounts.EXPECT().CreateAPIKey("mark", "deployer", scope, 24*time.Hour).
+			Return("gk_id_secret", models.APIKey{ID: "id", Account: "deployer", Scope: scope}, nil)
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithServiceAccounts(accounts))
+
+		resp, err := server.CreateAPIKey(ctx, &pb.CreateAPIKeyRequest{
+			Account:      "deployer",
+			ReadOnly:     true,
+			PathPrefixes: []string{"deploy/"},
+			TtlSeconds:   int64((24 * time.Hour).Seconds()),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "gk_id_secret", resp.GetApiKey())
+		assert.Equal(t, "id", resp.GetKey().GetKeyId())
+		assert.True(t, resp.GetKey().GetReadOnly())
+		assert.Empty(t, resp.GetKey().GetExpiresAt())
+	})
+
+	t.Run("empty path prefix", func(t *testing.T) {
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithServiceAccounts(mocksrv.NewServiceAccounts(t)))
+
+		_, err := server.CreateAPIKey(ctx, &pb.CreateAPIKeyRequest{Account: "deployer", PathPrefixes: []string{""}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		accounts := mocksrv.NewServiceAccounts(t)
+		accounts.EXPECT().ListAPIKeys("mark", "deployer").Return(nil, storage.ErrAccountNotFound)
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithServiceAccounts(accounts))
+
+		_, err := server.ListAPIKeys(ctx, &pb.ListAPIKeysRequest{Account: "deployer"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("service accounts can't manage accounts", func(t *testing.T) {
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithServiceAccounts(mocksrv.NewServiceAccounts(t)))
+		scoped := context.WithValue(ctx, grpc.ScopeKey, models.Scope{})
+
+		_, err := server.CreateServiceAccount(scoped, &pb.CreateServiceAccountRequest{Name: "minion"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		server := grpc.NewGophkeeperServer(nil, nil, nil)
+
+		_, err := server.ListServiceAccounts(ctx, &pb.ListServiceAccountsRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestExchangeAPIKey(t *testing.T) {
+	scope := models.Scope{PathPrefixes: []string{"deploy/"}}
+
+	t.Run("valid key", func(t *testing.T) {
+		accounts := mocksrv.NewServiceAccounts(t)
+		accounts.EXPECT().AuthenticateAPIKey("gk_id_secret").
+			Return(models.APIKey{ID: "id", Account: "deployer", Scope: scope}, nil)
+		authService := mocks.NewAuthenticationService(t)
+		authService.EXPECT().GetScopedToken("deployer", scope).Return("at", nil)
+		server := grpc.NewGophkeeperServer(nil, authService, nil, grpc.WithServiceAccounts(accounts))
+
+		resp, err := server.ExchangeAPIKey(context.Background(), &pb.ExchangeAPIKeyRequest{ApiKey: "gk_id_secret"})
+		require.NoError(t, err)
+		assert.Equal(t, "at", resp.GetAccessToken())
+		assert.Empty(t, resp.GetRefreshToken())
+		assert.Equal(t, "deployer", resp.GetUserId())
+	})
+
+	for _, keyErr := range []error{server.ErrInvalidAPIKey, server.ErrAPIKeyExpired} {
+		t.Run(keyErr.Error(), func(t *testing.T) {
+			accounts := mocksrv.NewServiceAccounts(t)
+			accounts.EXPECT().AuthenticateAPIKey("gk_id_secret").Return(models.APIKey{}, keyErr)
+			srv := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithServiceAccounts(accounts))
+
+			_, err := srv.ExchangeAPIKey(context.Background(), &pb.ExchangeAPIKeyRequest{ApiKey: "gk_id_secret"})
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	}
+}
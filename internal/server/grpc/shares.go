@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+var dataTypes = map[models.VaultItemType]pb.DataType{
+	models.LoginType:  pb.DataType_DATA_TYPE_LOGIN,
+	models.CardType:   pb.DataType_DATA_TYPE_CARD,
+	models.NoteType:   pb.DataType_DATA_TYPE_NOTE,
+	models.BinaryType: pb.DataType_DATA_TYPE_BINARY,
+	models.SSHKeyType: pb.DataType_DATA_TYPE_SSH_KEY,
+	models.TOTPType:   pb.DataType_DATA_TYPE_TOTP,
+	models.KVType:     pb.DataType_DATA_TYPE_KV,
+}
+
+var itemTypes = map[pb.DataType]models.VaultItemType{
+	pb.DataType_DATA_TYPE_LOGIN:   models.LoginType,
+	pb.DataType_DATA_TYPE_CARD:    models.CardType,
+	pb.DataType_DATA_TYPE_NOTE:    models.NoteType,
+	pb.DataType_DATA_TYPE_BINARY:  models.BinaryType,
+	pb.DataType_DATA_TYPE_SSH_KEY: models.SSHKeyType,
+	pb.DataType_DATA_TYPE_TOTP:    models.TOTPType,
+	pb.DataType_DATA_TYPE_KV:      models.KVType,
+}
+
+func toPermission(permission pb.Permission) (models.Permission, error) {
+	switch permission {
+	case pb.Permission_PERMISSION_READ:
+		return models.PermissionRead, nil
+	case pb.Permission_PERMISSION_READ_WRITE:
+		return models.PermissionReadWrite, nil
+	case pb.Permission_PERMISSION_UNSPECIFIED:
+		return "", status.Error(codes.InvalidArgument, "unspecified permission is not allowed")
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unknown permission: %v", permission)
+}
+
+func fromPermission(permission models.Permission) pb.Permission {
+	switch permission {
+	case models.PermissionRead:
+		return pb.Permission_PERMISSION_READ
+	case models.PermissionReadWrite:
+		return pb.Permission_PERMISSION_READ_WRITE
+	}
+	return pb.Permission_PERMISSION_UNSPECIFIED
+}
+
+func (srv *GophkeeperServer) Share(ctx context.Context, req *pb.ShareRequest) (*pb.ShareResponse, error) {
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+	if req.GetPath() == "" || req.GetGrantee() == "" {
+		return nil, status.Error(codes.InvalidArgument, "path and grantee are required")
+	}
+	permission, err := toPermission(req.GetPermission())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = srv.vault.ShareSecret(models.Share{
+		Path:       req.GetPath(),
+		Owner:      username,
+		Grantee:    req.GetGrantee(),
+		Permission: permission,
+	}); err != nil {
+		return nil, actionError(err)
+	}
+
+	return &pb.ShareResponse{
+		Message: fmt.Sprintf("secret with path=%s has been shared with %s (%s)", req.GetPath(), req.GetGrantee(),
+			permission),
+	}, nil
+}
+
+func (srv *GophkeeperServer) Unshare(ctx context.Context, req *pb.UnshareRequest) (*pb.UnshareResponse, error) {
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+	if req.GetPath() == "" || req.GetGrantee() == "" {
+		return nil, status.Error(codes.InvalidArgument, "path and grantee are required")
+	}
+
+	if err := srv.vault.UnshareSecret(models.Share{
+		Path:    req.GetPath(),
+		Owner:   username,
+		Grantee: req.GetGrantee(),
+	}); err != nil {
+		return nil, actionError(err)
+	}
+
+	return &pb.UnshareResponse{
+		Message: fmt.Sprintf("access of %s to secret with path=%s has been revoked", req.GetGrantee(), req.GetPath()),
+	}, nil
+}
+
+func (srv *GophkeeperServer) ListShares(ctx context.Context,
+	req *pb.ListSharesRequest) (*pb.ListSharesResponse, error) {
+	username, ok := ctx.Value(UsernameKey).(string)
+	if !ok {
+		return nil, status.Error(codes.Internal, "username not found in context")
+	}
+
+	var (
+		shares []models.Share
+		err    error
+	)
+	switch {
+	case req.GetSharedWithMe():
+		shares, err = srv.vault.ListSharedWith(username)
+	case req.GetPath() != "":
+		shares, err = srv.vault.ListShares(username, req.GetPath())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either path or shared_with_me is required")
+	}
+	if err != nil {
+		return nil, actionError(err)
+	}
+
+	resp := &pb.ListSharesResponse{Shares: make([]*pb.Share, 0, len(shares))}
+	for _, share := range shares {
+		resp.Shares = append(resp.Shares, &pb.Share{
+			Path:       share.Path,
+			Type:       dataTypes[share.Type],
+			Owner:      share.Owner,
+			Grantee:    share.Grantee,
+			Permission: fromPermission(share.Permission),
+			CreatedAt:  share.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,183 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestShare(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(*mocksrv.Vault)
+		request   *pb.ShareRequest
+		errorCode codes.Code
+	}{
+		{
+			name: "share_read_write",
+			setup: func(mv *mocksrv.Vault) {
+				mv.EXPECT().ShareSecret(models.Share{
+					Path:       "/team/db",
+					Owner:      "owner",
+					Grantee:    "colleague",
+					Permission: models.PermissionReadWrite,
+				}).Return(nil)
+			},
+			request: &pb.ShareRequest{
+				Path:       "/team/db",
+				Grantee:    "colleague",
+				Permission: pb.Permission_PERMISSION_READ_WRITE,
+			},
+			errorCode: codes.OK,
+		},
+		{
+			name: "not_owner",
+			setup: func(mv *mocksrv.Vault) {
+				mv.EXPECT().ShareSecret(mock.Anything).
+					Return(fmt.Errorf("[SHARE SECRET] %w", storage.ErrAccessDenied))
+			},
+			request: &pb.ShareRequest{
+				Path:       "/team/db",
+				Grantee:    "colleague",
+				Permission: pb.Permission_PERMISSION_READ,
+			},
+			errorCode: codes.PermissionDenied,
+		},
+		{
+			name: "unknown_grantee",
+			setup: func(mv *mocksrv.Vault) {
+				mv.EXPECT().ShareSecret(mock.Anything).
+					Return(fmt.Errorf("[SHARE SECRET] %w", storage.ErrUserNotFound))
+			},
+			request: &pb.ShareRequest{
+				Path:       "/team/db",
+				Grantee:    "nobody",
+				Permission: pb.Permission_PERMISSION_READ,
+			},
+			errorCode: codes.NotFound,
+		},
+		{
+			name: "unspecified_permission",
+			request: &pb.ShareRequest{
+				Path:    "/team/db",
+				Grantee: "colleague",
+			},
+			errorCode: codes.InvalidArgument,
+		},
+		{
+			name:      "missing_grantee",
+			request:   &pb.ShareRequest{Path: "/team/db", Permission: pb.Permission_PERMISSION_READ},
+			errorCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vault := mocksrv.NewVault(t)
+			if tt.setup != nil {
+				tt.setup(vault)
+			}
+
+			server := grpc.NewGophkeeperServer(vault, nil, nil)
+			ctx := context.WithValue(context.Background(), grpc.UsernameKey, "owner")
+			resp, err := server.Share(ctx, tt.request)
+
+			assert.Equal(t, tt.errorCode, status.Code(err))
+			if tt.errorCode == codes.OK {
+				assert.Contains(t, resp.GetMessage(), "has been shared with colleague")
+			}
+		})
+	}
+}
+
+func TestUnshare(t *testing.T) {
+	vault := mocksrv.NewVault(t)
+	vault.EXPECT().UnshareSecret(models.Share{Path: "/team/db", Owner: "owner", Grantee: "colleague"}).
+		Return(nil).Once()
+	vault.EXPECT().UnshareSecret(models.Share{Path: "/team/db", Owner: "owner", Grantee: "stranger"}).
+		Return(fmt.Errorf("[UNSHARE SECRET] %w", storage.ErrShareNotFound)).Once()
+
+	server := grpc.NewGophkeeperServer(vault, nil, nil)
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "owner")
+
+	resp, err := server.Unshare(ctx, &pb.UnshareRequest{Path: "/team/db", Grantee: "colleague"})
+	require.NoError(t, err)
+	assert.Contains(t, resp.GetMessage(), "has been revoked")
+
+	_, err = server.Unshare(ctx, &pb.UnshareRequest{Path: "/team/db", Grantee: "stranger"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListShares(t *testing.T) {
+	createdAt := time.Date(2024, 11, 2, 10, 0, 0, 0, time.UTC)
+	vault := mocksrv.NewVault(t)
+	vault.EXPECT().ListShares("owner", "/team/db").Return([]models.Share{
+		{
+			Path:       "/team/db",
+			Type:       models.LoginType,
+			Owner:      "owner",
+			Grantee:    "colleague",
+			Permission: models.PermissionRead,
+			CreatedAt:  createdAt,
+		},
+	}, nil).Once()
+	vault.EXPECT().ListSharedWith("owner").Return([]models.Share{
+		{
+			Path:       "/ops/cert.pem",
+			Type:       models.BinaryType,
+			Owner:      "admin",
+			Grantee:    "owner",
+			Permission: models.PermissionReadWrite,
+			CreatedAt:  createdAt,
+		},
+	}, nil).Once()
+
+	server := grpc.NewGophkeeperServer(vault, nil, nil)
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "owner")
+
+	resp, err := server.ListShares(ctx, &pb.ListSharesRequest{Path: "/team/db"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetShares(), 1)
+	assert.Equal(t, "colleague", resp.GetShares()[0].GetGrantee())
+	assert.Equal(t, pb.DataType_DATA_TYPE_LOGIN, resp.GetShares()[0].GetType())
+	assert.Equal(t, pb.Permission_PERMISSION_READ, resp.GetShares()[0].GetPermission())
+	assert.Equal(t, "2024-11-02 10:00:00", resp.GetShares()[0].GetCreatedAt())
+
+	resp, err = server.ListShares(ctx, &pb.ListSharesRequest{SharedWithMe: true})
+	require.NoError(t, err)
+	require.Len(t, resp.GetShares(), 1)
+	assert.Equal(t, "admin", resp.GetShares()[0].GetOwner())
+	assert.Equal(t, pb.DataType_DATA_TYPE_BINARY, resp.GetShares()[0].GetType())
+	assert.Equal(t, pb.Permission_PERMISSION_READ_WRITE, resp.GetShares()[0].GetPermission())
+
+	_, err = server.ListShares(ctx, &pb.ListSharesRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetSharedSecret(t *testing.T) {
+	vault := mocksrv.NewVault(t)
+	vault.EXPECT().RetrieveSecret(mock.Anything, mock.MatchedBy(func(s models.Secret) bool {
+		login, ok := s.(*models.Login)
+		return ok && login.RequestedBy == "colleague"
+	})).Return(fmt.Errorf("processing error at *storage.Retriever: [RETRIEVE LOGIN] %w",
+		storage.ErrSecretNotFound))
+
+	server := grpc.NewGophkeeperServer(vault, nil, nil)
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "colleague")
+	_, err := server.Get(ctx, &pb.GetRequest{Type: pb.DataType_DATA_TYPE_LOGIN, Path: "/team/db"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
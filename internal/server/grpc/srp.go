@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/common/srp"
+	"github.com/itallix/gophkeeper/internal/server/service"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func srpError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound), errors.Is(err, service.ErrInvalidCreds),
+		errors.Is(err, service.ErrSRPSession):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrSRPNotEnrolled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrUserExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrInvalidVerifier), errors.Is(err, srp.ErrInvalidPublic):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (srv *GophkeeperServer) SRPRegister(ctx context.Context, req *pb.SRPRegisterRequest) (*pb.AuthResponse, error) {
+	if srv.srp == nil {
+		return nil, status.Error(codes.Unimplemented, "srp login is not enabled")
+	}
+	pair, err := srv.srp.Register(ctx, req.GetLogin(), req.GetSalt(), req.GetVerifier())
+	if err != nil {
+		return nil, srpError(err)
+	}
+
+	return &pb.AuthResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		UserId:       req.GetLogin(),
+	}, nil
+}
+
+func (srv *GophkeeperServer) SRPBegin(ctx context.Context, req *pb.SRPBeginRequest) (*pb.SRPBeginResponse, error) {
+	if srv.srp == nil {
+		return nil, status.Error(codes.Unimplemented, "srp login is not enabled")
+	}
+	challenge, err := srv.srp.Begin(ctx, req.GetLogin(), req.GetClientPublic())
+	if err != nil {
+		return nil, srpError(err)
+	}
+
+	return &pb.SRPBeginResponse{
+		SessionId:    challenge.SessionID,
+		Salt:         challenge.Salt,
+		ServerPublic: challenge.ServerPublic,
+	}, nil
+}
+
+func (srv *GophkeeperServer) SRPFinish(ctx context.Context, req *pb.SRPFinishRequest) (*pb.SRPFinishResponse, error) {
+	if srv.srp == nil {
+		return nil, status.Error(codes.Unimplemented, "srp login is not enabled")
+	}
+	proof, pair, err := srv.srp.Finish(ctx, req.GetSessionId(), req.GetLogin(), req.GetClientProof())
+	if err != nil {
+		return nil, srpError(err)
+	}
+
+	return &pb.SRPFinishResponse{
+		ServerProof:  proof,
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		UserId:       req.GetLogin(),
+	}, nil
+}
@@ -0,0 +1,146 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/common/srp"
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/service"
+	mocks "github.com/itallix/gophkeeper/mocks/internal_/server/service"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestSRPDisabled(t *testing.T) {
+	server := grpc.NewGophkeeperServer(nil, nil, nil)
+
+	_, err := server.SRPBegin(context.Background(), &pb.SRPBeginRequest{Login: "mark"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestSRPRegister(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "registered", wantCode: codes.OK},
+		{name: "user exists", err: service.ErrUserExists, wantCode: codes.AlreadyExists},
+		{name: "invalid verifier", err: service.ErrInvalidVerifier, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srpService := mocks.NewSRPAuthenticator(t)
+			call := srpService.EXPECT().Register(mock.Anything, "mark", []byte("salt"), []byte("verifier"))
+			if tt.err != nil {
+				call.Return(nil, tt.err)
+			} else {
+				call.Return(&service.TokenPair{AccessToken: "at", RefreshToken: "rt"}, nil)
+			}
+
+			server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSRP(srpService))
+			resp, err := server.SRPRegister(context.Background(), &pb.SRPRegisterRequest{
+				Login:    "mark",
+				Salt:     []byte("salt"),
+				Verifier: []byte("verifier"),
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.err == nil {
+				assert.Equal(t, "at", resp.GetAccessToken())
+				assert.Equal(t, "mark", resp.GetUserId())
+			}
+		})
+	}
+}
+
+func TestSRPLogin(t *testing.T) {
+	t.Run("begin and finish", func(t *testing.T) {
+		srpService := mocks.NewSRPAuthenticator(t)
+		srpService.EXPECT().Begin(mock.Anything, "mark", []byte("A")).Return(&service.SRPChallenge{
+			SessionID:    "s1",
+			Salt:         []byte("salt"),
+			ServerPublic: []byte("B"),
+		}, nil)
+		srpService.EXPECT().Finish(mock.Anything, "s1", "mark", []byte("M1")).
+			Return([]byte("M2"), &service.TokenPair{AccessToken: "at", RefreshToken: "rt"}, nil)
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSRP(srpService))
+
+		challenge, err := server.SRPBegin(context.Background(), &pb.SRPBeginRequest{
+			Login:        "mark",
+			ClientPublic: []byte("A"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "s1", challenge.GetSessionId())
+		assert.Equal(t, []byte("salt"), challenge.GetSalt())
+		assert.Equal(t, []byte("B"), challenge.GetServerPublic())
+
+		resp, err := server.SRPFinish(context.Background(), &pb.SRPFinishRequest{
+			SessionId:   "s1",
+			Login:       "mark",
+			ClientProof: []byte("M1"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("M2"), resp.GetServerProof())
+		assert.Equal(t, "at", resp.GetAccessToken())
+		assert.Equal(t, "rt", resp.GetRefreshToken())
+	})
+
+	beginErrors := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "unknown user", err: service.ErrUserNotFound, wantCode: codes.Unauthenticated},
+		{name: "not enrolled", err: service.ErrSRPNotEnrolled, wantCode: codes.FailedPrecondition},
+		{name: "invalid public value", err: srp.ErrInvalidPublic, wantCode: codes.InvalidArgument},
+		{name: "storage failure", err: errors.New("connection refused"), wantCode: codes.Internal},
+	}
+	for _, tt := range beginErrors {
+		t.Run(tt.name, func(t *testing.T) {
+			srpService := mocks.NewSRPAuthenticator(t)
+			srpService.EXPECT().Begin(mock.Anything, "mark", mock.Anything).Return(nil, tt.err)
+			server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSRP(srpService))
+
+			_, err := server.SRPBegin(context.Background(), &pb.SRPBeginRequest{Login: "mark"})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+
+	t.Run("invalid proof", func(t *testing.T) {
+		srpService := mocks.NewSRPAuthenticator(t)
+		srpService.EXPECT().Finish(mock.Anything, "s1", "mark", mock.Anything).Return(nil, nil, service.ErrInvalidCreds)
+		server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSRP(srpService))
+
+		_, err := server.SRPFinish(context.Background(), &pb.SRPFinishRequest{SessionId: "s1", Login: "mark"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestLoginEnrollsSRPVerifier(t *testing.T) {
+	authService := mocks.NewAuthenticationService(t)
+	authService.EXPECT().Authenticate(mock.Anything, "mark", "secret").
+		Return(&service.TokenPair{AccessToken: "at", RefreshToken: "rt"}, nil)
+	srpService := mocks.NewSRPAuthenticator(t)
+	srpService.EXPECT().Enroll(mock.Anything, "mark", []byte("salt"), []byte("verifier")).
+		Return(errors.New("connection refused"))
+	server := grpc.NewGophkeeperServer(nil, authService, nil, grpc.WithSRP(srpService))
+
+	resp, err := server.Login(context.Background(), &pb.LoginRequest{
+		Login:       "mark",
+		Password:    "secret",
+		SrpSalt:     []byte("salt"),
+		SrpVerifier: []byte("verifier"),
+	})
+
+	require.NoError(t, err, "a failed enrollment doesn't fail the login")
+	assert.Equal(t, "at", resp.GetAccessToken())
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/service"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func ssoError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidIDToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrIssuerUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		logger.Log().Errorf("single sign-on failed: %v", err)
+		return status.Error(codes.Internal, "single sign-on failed")
+	}
+}
+
+// isAdmin reports whether the user is an administrator, either listed in the configuration
+// or granted the role by their identity provider groups.
+func (srv *GophkeeperServer) isAdmin(username string) bool {
+	return srv.admins[username] || (srv.sso != nil && srv.sso.IsAdmin(username))
+}
+
+func (srv *GophkeeperServer) GetOIDCConfig(_ context.Context,
+	_ *pb.GetOIDCConfigRequest) (*pb.GetOIDCConfigResponse, error) {
+	if srv.sso == nil {
+		return nil, status.Error(codes.Unimplemented, "single sign-on is not enabled")
+	}
+	cfg := srv.sso.Config()
+	return &pb.GetOIDCConfigResponse{
+		Issuer:   cfg.Issuer,
+		ClientId: cfg.ClientID,
+		Scopes:   cfg.Scopes,
+	}, nil
+}
+
+func (srv *GophkeeperServer) OIDCLogin(_ context.Context, req *pb.OIDCLoginRequest) (*pb.AuthResponse, error) {
+	if srv.sso == nil {
+		return nil, status.Error(codes.Unimplemented, "single sign-on is not enabled")
+	}
+	if req.GetIdToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id token is required")
+	}
+	login, err := srv.sso.Login(req.GetIdToken(), req.GetNonce())
+	if err != nil {
+		return nil, ssoError(err)
+	}
+	pair, err := srv.authService.GetTokenPair(login)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AuthResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		UserId:       login,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itallix/gophkeeper/internal/server/grpc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/service"
+	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
+	mocks "github.com/itallix/gophkeeper/mocks/internal_/server/service"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+func TestGetOIDCConfig(t *testing.T) {
+	_, err := grpc.NewGophkeeperServer(nil, nil, nil).GetOIDCConfig(context.Background(), &pb.GetOIDCConfigRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	sso := mocksrv.NewSSO(t)
+	sso.EXPECT().Config().Return(service.OIDCConfig{
+		Issuer:        "https://idp.example.com",
+		ClientID:      "gophkeeper",
+		Scopes:        []string{"openid", "groups"},
+		GroupsClaim:   "groups",
+		UsernameClaim: "preferred_username",
+	}).Once()
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithSSO(sso))
+
+	resp, err := server.GetOIDCConfig(context.Background(), &pb.GetOIDCConfigRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com", resp.GetIssuer())
+	assert.Equal(t, "gophkeeper", resp.GetClientId())
+	assert.Equal(t, []string{"openid", "groups"}, resp.GetScopes())
+}
+
+func TestOIDCLogin(t *testing.T) {
+	sso := mocksrv.NewSSO(t)
+	authService := mocks.NewAuthenticationService(t)
+	server := grpc.NewGophkeeperServer(nil, authService, nil, grpc.WithSSO(sso))
+	ctx := context.Background()
+
+	t.Run("tokens are issued for the provisioned user", func(t *testing.T) {
+		sso.EXPECT().Login("id-token", "nonce").Return("portia", nil).Once()
+		authService.EXPECT().GetTokenPair("portia").
+			Return(&service.TokenPair{AccessToken: "access", RefreshToken: "refresh"}, nil).Once()
+
+		resp, err := server.OIDCLogin(ctx, &pb.OIDCLoginRequest{IdToken: "id-token", Nonce: "nonce"})
+		require.NoError(t, err)
+		assert.Equal(t, "portia", resp.GetUserId())
+		assert.Equal(t, "access", resp.GetAccessToken())
+		assert.Equal(t, "refresh", resp.GetRefreshToken())
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "invalid token", err: service.ErrInvalidIDToken, code: codes.Unauthenticated},
+		{name: "issuer down", err: service.ErrIssuerUnavailable, code: codes.Unavailable},
+		{name: "storage error", err: errors.New("db is down"), code: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sso.EXPECT().Login("id-token", "").Return("", tt.err).Once()
+			_, err := server.OIDCLogin(ctx, &pb.OIDCLoginRequest{IdToken: "id-token"})
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := server.OIDCLogin(ctx, &pb.OIDCLoginRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSSOAdmins(t *testing.T) {
+	sso := mocksrv.NewSSO(t)
+	audit := mocksrv.NewAuditLog(t)
+	server := grpc.NewGophkeeperServer(nil, nil, nil, grpc.WithAuditLog(audit), grpc.WithSSO(sso),
+		grpc.WithAdmins("root"))
+
+	sso.EXPECT().IsAdmin("mark").Return(false).Once()
+	ctx := context.WithValue(context.Background(), grpc.UsernameKey, "mark")
+	_, err := server.VerifyAuditLog(ctx, &pb.VerifyAuditLogRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	sso.EXPECT().IsAdmin("portia").Return(true).Once()
+	audit.EXPECT().VerifyAuditLog().Return(models.AuditVerification{Events: 3}, nil).Once()
+	ctx = context.WithValue(context.Background(), grpc.UsernameKey, "portia")
+	resp, err := server.VerifyAuditLog(ctx, &pb.VerifyAuditLogRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.GetValid())
+
+	audit.EXPECT().VerifyAuditLog().Return(models.AuditVerification{Events: 3}, nil).Once()
+	ctx = context.WithValue(context.Background(), grpc.UsernameKey, "root")
+	_, err = server.VerifyAuditLog(ctx, &pb.VerifyAuditLogRequest{})
+	require.NoError(t, err, "configured administrators don't need the identity provider")
+}
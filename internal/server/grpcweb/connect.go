@@ -0,0 +1,160 @@
+package grpcweb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// codec converts the messages of a Connect JSON call to protobuf and back, it passes protobuf messages as is.
+type codec struct {
+	request, response protoreflect.MessageType
+}
+
+// newCodec looks up the message types of the called method, which are only needed for JSON.
+func newCodec(proto protocol, path string) (codec, error) {
+	if !proto.json {
+		return codec{}, nil
+	}
+	service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if !ok {
+		return codec{}, status.Errorf(codes.Unimplemented, "unknown method %s", path)
+	}
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return codec{}, status.Errorf(codes.Unimplemented, "unknown service %s", service)
+	}
+	serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return codec{}, status.Errorf(codes.Unimplemented, "unknown service %s", service)
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(method))
+	if methodDescriptor == nil {
+		return codec{}, status.Errorf(codes.Unimplemented, "unknown method %s", path)
+	}
+	request, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Input().FullName())
+	if err != nil {
+		return codec{}, status.Errorf(codes.Internal, "unknown message %s", methodDescriptor.Input().FullName())
+	}
+	response, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Output().FullName())
+	if err != nil {
+		return codec{}, status.Errorf(codes.Internal, "unknown message %s", methodDescriptor.Output().FullName())
+	}
+	return codec{request: request, response: response}, nil
+}
+
+// decode converts a request message of the client to protobuf.
+func (c codec) decode(data []byte) ([]byte, error) {
+	if c.request == nil {
+		return data, nil
+	}
+	message := c.request.New().Interface()
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, message); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid JSON message: %v", err)
+	}
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal the request: %v", err)
+	}
+	return data, nil
+}
+
+// encode converts a protobuf response message to the format of the client.
+func (c codec) encode(data []byte) ([]byte, error) {
+	if c.response == nil {
+		return data, nil
+	}
+	message := c.response.New().Interface()
+	if err := proto.Unmarshal(data, message); err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid response message: %v", err)
+	}
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal the response: %v", err)
+	}
+	return data, nil
+}
+
+// connectCodes are the names and the HTTP statuses of the codes in the Connect protocol.
+var connectCodes = map[codes.Code]struct {
+	name       string
+	httpStatus int
+}{
+	codes.Canceled:           {"canceled", 499}, //nolint:mnd // client closed request, not in net/http
+	codes.Unknown:            {"unknown", http.StatusInternalServerError},
+	codes.InvalidArgument:    {"invalid_argument", http.StatusBadRequest},
+	codes.DeadlineExceeded:   {"deadline_exceeded", http.StatusGatewayTimeout},
+	codes.NotFound:           {"not_found", http.StatusNotFound},
+	codes.AlreadyExists:      {"already_exists", http.StatusConflict},
+	codes.PermissionDenied:   {"permission_denied", http.StatusForbidden},
+	codes.ResourceExhausted:  {"resource_exhausted", http.StatusTooManyRequests},
+	codes.FailedPrecondition: {"failed_precondition", http.StatusBadRequest},
+	codes.Aborted:            {"aborted", http.StatusConflict},
+	codes.OutOfRange:         {"out_of_range", http.StatusBadRequest},
+	codes.Unimplemented:      {"unimplemented", http.StatusNotImplemented},
+	codes.Internal:           {"internal", http.StatusInternalServerError},
+	codes.Unavailable:        {"unavailable", http.StatusServiceUnavailable},
+	codes.DataLoss:           {"data_loss", http.StatusInternalServerError},
+	codes.Unauthenticated:    {"unauthenticated", http.StatusUnauthorized},
+}
+
+func connectHTTPStatus(code codes.Code) int {
+	if c, ok := connectCodes[code]; ok {
+		return c.httpStatus
+	}
+	return http.StatusInternalServerError
+}
+
+type connectDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type connectError struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message,omitempty"`
+	Details []connectDetail `json:"details,omitempty"`
+}
+
+func newConnectError(st *status.Status) *connectError {
+	c, ok := connectCodes[st.Code()]
+	if !ok {
+		c = connectCodes[codes.Unknown]
+	}
+	connectErr := &connectError{Code: c.name, Message: st.Message()}
+	for _, detail := range st.Proto().GetDetails() {
+		connectErr.Details = append(connectErr.Details, connectDetail{
+			Type:  strings.TrimPrefix(detail.GetTypeUrl(), "type.googleapis.com/"),
+			Value: base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+		})
+	}
+	return connectErr
+}
+
+// connectErrorJSON is the body of a failed Connect unary call.
+func connectErrorJSON(st *status.Status) []byte {
+	data, _ := json.Marshal(newConnectError(st))
+	return data
+}
+
+// connectEndStream is the final message of a Connect stream carrying the status and the trailers.
+func connectEndStream(st *status.Status, trailer metadata.MD) []byte {
+	end := struct {
+		Error    *connectError       `json:"error,omitempty"`
+		Metadata map[string][]string `json:"metadata,omitempty"`
+	}{Metadata: trailer}
+	if st.Code() != codes.OK {
+		end.Error = newConnectError(st)
+	}
+	data, _ := json.Marshal(end)
+	return data
+}
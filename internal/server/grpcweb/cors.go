@@ -0,0 +1,25 @@
+package grpcweb
+
+import (
+	"net/http"
+
+	"github.com/rs/cors"
+)
+
+// CORS allows the browser pages served from origins to call next, "*" allows any origin.
+// The headers of the gRPC-Web and Connect protocols, the REST gateway and the token are allowed
+// in requests, the status and the retry delay are exposed to the scripts.
+func CORS(origins []string, next http.Handler) http.Handler {
+	return cors.New(cors.Options{
+		AllowedOrigins: origins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete},
+		AllowedHeaders: []string{
+			"Authorization", "Content-Type", "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout",
+			"Connect-Protocol-Version", "Connect-Timeout-Ms",
+		},
+		ExposedHeaders: []string{
+			"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin", "Retry-After", "Content-Disposition",
+		},
+		MaxAge: 3600, //nolint:mnd // preflight cache of an hour
+	}).Handler(next)
+}
@@ -0,0 +1,300 @@
+// Package grpcweb serves the gRPC services to browsers, which can't speak native gRPC.
+//
+// Calls in the gRPC-Web protocol (binary and base64 text) and in the Connect protocol (unary calls
+// and streams, protobuf and JSON messages) are translated to gRPC and passed to grpc.Server.ServeHTTP,
+// so they go through the same interceptors as the native calls: authentication, auditing, metrics
+// and rate limiting. Both protocols work over HTTP/1.1, server streams like Download are written
+// and flushed message by message.
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxMessageSize is the largest message accepted in a Connect unary call, the default limit of the gRPC server.
+const MaxMessageSize = 4 << 20
+
+const (
+	frameHeaderLen = 5
+
+	// flagCompressed marks a compressed message in both the gRPC and the Connect framing.
+	flagCompressed = 0x01
+	// flagEndStream marks the final JSON message of a Connect stream.
+	flagEndStream = 0x02
+	// flagTrailer marks the trailers frame of a gRPC-Web response.
+	flagTrailer = 0x80
+)
+
+type kind int
+
+const (
+	kindWeb kind = iota
+	kindConnectUnary
+	kindConnectStream
+)
+
+// protocol describes the wire format of a call, it is chosen by the request content type.
+type protocol struct {
+	kind kind
+	// text is set for the base64 encoded gRPC-Web variant.
+	text bool
+	// json is set for Connect calls with JSON messages.
+	json bool
+	// contentType is the content type of a successful response.
+	contentType string
+}
+
+func protocolOf(contentType string) (protocol, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return protocol{}, false
+	}
+	switch mediaType {
+	case "application/grpc-web", "application/grpc-web+proto":
+		return protocol{kind: kindWeb, contentType: "application/grpc-web+proto"}, true
+	case "application/grpc-web-text", "application/grpc-web-text+proto":
+		return protocol{kind: kindWeb, text: true, contentType: "application/grpc-web-text+proto"}, true
+	case "application/proto":
+		return protocol{kind: kindConnectUnary, contentType: mediaType}, true
+	case "application/json":
+		return protocol{kind: kindConnectUnary, json: true, contentType: mediaType}, true
+	case "application/connect+proto":
+		return protocol{kind: kindConnectStream, contentType: mediaType}, true
+	case "application/connect+json":
+		return protocol{kind: kindConnectStream, json: true, contentType: mediaType}, true
+	}
+	return protocol{}, false
+}
+
+// droppedHeaders are the protocol specific request headers, the others are passed on as gRPC metadata.
+var droppedHeaders = map[string]bool{
+	"Content-Type":             true,
+	"Content-Length":           true,
+	"Content-Encoding":         true,
+	"Accept-Encoding":          true,
+	"Connect-Protocol-Version": true,
+	"Connect-Timeout-Ms":       true,
+	"Connect-Content-Encoding": true,
+	"Connect-Accept-Encoding":  true,
+	"X-Grpc-Web":               true,
+	"Connection":               true,
+	"Te":                       true,
+}
+
+// Handler serves gRPC-Web and Connect calls by a gRPC server.
+type Handler struct {
+	server *grpc.Server
+}
+
+// New creates the handler for the services registered with server.
+func New(server *grpc.Server) *Handler {
+	return &Handler{server: server}
+}
+
+// Register routes the calls of every service registered with the gRPC server to the handler.
+func (h *Handler) Register(mux *http.ServeMux) {
+	for name := range h.server.GetServiceInfo() {
+		mux.Handle("POST /"+name+"/", h)
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proto, ok := protocolOf(r.Header.Get("Content-Type"))
+	if !ok {
+		http.Error(w, fmt.Sprintf("unsupported content type %q", r.Header.Get("Content-Type")),
+			http.StatusUnsupportedMediaType)
+		return
+	}
+	rw := &responseWriter{w: w, proto: proto, header: make(http.Header)}
+
+	codec, err := newCodec(proto, r.URL.Path)
+	if err != nil {
+		rw.finishWith(status.Convert(err), nil)
+		return
+	}
+	rw.codec = codec
+	body, err := requestBody(proto, codec, r)
+	if err != nil {
+		rw.finishWith(status.Convert(err), nil)
+		return
+	}
+	header, err := requestHeader(proto, r.Header)
+	if err != nil {
+		rw.finishWith(status.Convert(err), nil)
+		return
+	}
+
+	req := r.Clone(r.Context())
+	req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2", 2, 0
+	req.Header = header
+	req.Body = io.NopCloser(body)
+	req.ContentLength = -1
+	h.server.ServeHTTP(rw, req)
+	rw.finish()
+}
+
+// requestHeader converts the headers of the call to the ones of a gRPC request.
+func requestHeader(proto protocol, header http.Header) (http.Header, error) {
+	grpcHeader := make(http.Header, len(header))
+	for key, values := range header {
+		if !droppedHeaders[key] {
+			grpcHeader[key] = values
+		}
+	}
+	grpcHeader.Set("Content-Type", "application/grpc+proto")
+	if proto.kind == kindWeb {
+		return grpcHeader, nil
+	}
+
+	if encoding := header.Get(contentEncodingHeader(proto)); encoding != "" && encoding != "identity" {
+		return nil, status.Errorf(codes.Unimplemented, "unsupported compression %q", encoding)
+	}
+	grpcHeader.Del("Grpc-Timeout")
+	if timeout := header.Get("Connect-Timeout-Ms"); timeout != "" {
+		ms, err := strconv.ParseUint(timeout, 10, 64)
+		if err != nil || len(timeout) > 8 { // gRPC allows at most 8 digits
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timeout %q", timeout)
+		}
+		grpcHeader.Set("Grpc-Timeout", strconv.FormatUint(ms, 10)+"m")
+	}
+	return grpcHeader, nil
+}
+
+func contentEncodingHeader(proto protocol) string {
+	if proto.kind == kindConnectStream {
+		return "Connect-Content-Encoding"
+	}
+	return "Content-Encoding"
+}
+
+// requestBody converts the body of the call to a stream of gRPC frames.
+func requestBody(proto protocol, codec codec, r *http.Request) (io.Reader, error) {
+	switch {
+	case proto.kind == kindWeb && proto.text:
+		return &base64Reader{src: r.Body}, nil
+	case proto.kind == kindWeb:
+		return r.Body, nil
+	case proto.kind == kindConnectStream && proto.json:
+		return &frameReader{src: r.Body, convert: codec.decode}, nil
+	case proto.kind == kindConnectStream:
+		return r.Body, nil
+	}
+
+	// a Connect unary call sends a single message without framing
+	data, err := io.ReadAll(io.LimitReader(r.Body, MaxMessageSize+1))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to read the request: %v", err)
+	}
+	if len(data) > MaxMessageSize {
+		return nil, status.Errorf(codes.ResourceExhausted, "message larger than %d bytes", MaxMessageSize)
+	}
+	if data, err = codec.decode(data); err != nil {
+		return nil, err
+	}
+	var frame bytes.Buffer
+	writeFrame(&frame, 0, data)
+	return &frame, nil
+}
+
+func writeFrame(w *bytes.Buffer, flags byte, data []byte) {
+	var header [frameHeaderLen]byte
+	header[0] = flags
+	binary.BigEndian.PutUint32(header[1:], uint32(len(data))) //nolint:gosec // messages are limited by the server
+	w.Write(header[:])
+	w.Write(data)
+}
+
+// frameReader converts the messages of a framed stream one by one.
+type frameReader struct {
+	src     io.Reader
+	convert func([]byte) ([]byte, error)
+	buf     bytes.Buffer
+}
+
+func (r *frameReader) Read(p []byte) (int, error) {
+	for r.buf.Len() == 0 {
+		var header [frameHeaderLen]byte
+		if _, err := io.ReadFull(r.src, header[:]); err != nil {
+			return 0, err
+		}
+		size := binary.BigEndian.Uint32(header[1:])
+		if size > MaxMessageSize {
+			return 0, fmt.Errorf("message larger than %d bytes", MaxMessageSize)
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r.src, data); err != nil {
+			return 0, err
+		}
+		data, err := r.convert(data)
+		if err != nil {
+			return 0, err
+		}
+		writeFrame(&r.buf, header[0], data)
+	}
+	return r.buf.Read(p)
+}
+
+// base64Reader decodes a gRPC-Web text body. Clients may encode every message separately,
+// so padding can occur in the middle of the body and each quantum is decoded on its own.
+type base64Reader struct {
+	src     io.Reader
+	quantum [4]byte
+	n       int
+	buf     bytes.Buffer
+}
+
+func (r *base64Reader) Read(p []byte) (int, error) {
+	for r.buf.Len() == 0 {
+		chunk := make([]byte, len(p)+len(r.quantum))
+		n, err := r.src.Read(chunk)
+		for _, c := range chunk[:n] {
+			if c == '\r' || c == '\n' {
+				continue
+			}
+			r.quantum[r.n] = c
+			if r.n++; r.n < len(r.quantum) {
+				continue
+			}
+			var decoded [3]byte
+			size, decodeErr := base64.StdEncoding.Decode(decoded[:], r.quantum[:])
+			if decodeErr != nil {
+				return 0, fmt.Errorf("invalid base64 body: %w", decodeErr)
+			}
+			r.buf.Write(decoded[:size])
+			r.n = 0
+		}
+		if errors.Is(err, io.EOF) && r.n != 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if err != nil && r.buf.Len() == 0 {
+			return 0, err
+		}
+		if err != nil {
+			break
+		}
+	}
+	return r.buf.Read(p)
+}
+
+// metadataKey reports whether a response header of the gRPC server is metadata of the call.
+func metadataKey(key string) bool {
+	switch strings.ToLower(key) {
+	case "content-type", "content-length", "trailer", "date", "grpc-status", "grpc-message",
+		"grpc-status-details-bin", "grpc-encoding":
+		return false
+	}
+	return !strings.HasPrefix(strings.ToLower(key), strings.ToLower(http.TrailerPrefix))
+}
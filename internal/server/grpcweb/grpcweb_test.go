@@ -0,0 +1,403 @@
+package grpcweb_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/itallix/gophkeeper/internal/server/grpcweb"
+	pb "github.com/itallix/gophkeeper/pkg/generated/api/proto/v1"
+)
+
+const (
+	token        = "Bearer valid"
+	getPath      = "/api.v1.GophkeeperService/Get"
+	downloadPath = "/api.v1.GophkeeperService/Download"
+)
+
+var fox = []byte("the quick brown fox jumps over the lazy dog")
+
+// vaultServer serves a single login and a single binary to the callers with the token.
+type vaultServer struct {
+	pb.UnimplementedGophkeeperServiceServer
+	// release lets the download of slow.txt continue after its first chunk
+	release chan struct{}
+}
+
+func authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) == 0 || values[0] != token {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return nil
+}
+
+func (s *vaultServer) Get(ctx context.Context, req *pb.GetRequest) (*pb.GetResponse, error) {
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetPath() != "bank/mail" {
+		return nil, status.Errorf(codes.NotFound, "secret %s not found", req.GetPath())
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-secret-version", "3"))
+	_ = grpc.SetTrailer(ctx, metadata.Pairs("x-served-by", "test"))
+	return &pb.GetResponse{Data: &pb.TypedData{
+		Type: req.GetType(),
+		Base: &pb.Metadata{Path: req.GetPath()},
+		Data: &pb.TypedData_Login{Login: &pb.LoginData{Login: "user", Password: "secret"}},
+	}}, nil
+}
+
+func (s *vaultServer) Download(req *pb.DownloadRequest, stream pb.GophkeeperService_DownloadServer) error {
+	if err := authorize(stream.Context()); err != nil {
+		return err
+	}
+	if req.GetFilename() == "slow.txt" {
+		if err := stream.Send(&pb.Chunk{Filename: req.GetFilename(), Data: fox[:10]}); err != nil {
+			return err
+		}
+		<-s.release
+		return nil
+	}
+	if req.GetFilename() != "fox.txt" {
+		return status.Error(codes.NotFound, "binary not found")
+	}
+	for i := 0; i < len(fox); i += 10 {
+		chunk := &pb.Chunk{Filename: req.GetFilename(), Data: fox[i:min(i+10, len(fox))]}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newServer(t *testing.T, origins ...string) (*httptest.Server, *vaultServer) {
+	t.Helper()
+	grpcServer := grpc.NewServer()
+	vault := &vaultServer{release: make(chan struct{})}
+	pb.RegisterGophkeeperServiceServer(grpcServer, vault)
+	mux := http.NewServeMux()
+	grpcweb.New(grpcServer).Register(mux)
+
+	var handler http.Handler = mux
+	if len(origins) > 0 {
+		handler = grpcweb.CORS(origins, mux)
+	}
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv, vault
+}
+
+func frame(flags byte, data []byte) []byte {
+	var header [5]byte
+	header[0] = flags
+	binary.BigEndian.PutUint32(header[1:], uint32(len(data)))
+	return append(header[:], data...)
+}
+
+func post(t *testing.T, url, contentType string, body []byte, header http.Header) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func authorized() http.Header {
+	return http.Header{"Authorization": {token}, "X-Grpc-Web": {"1"}}
+}
+
+// webResponse is a decoded gRPC-Web response body.
+type webResponse struct {
+	messages [][]byte
+	trailer  map[string]string
+}
+
+func readFrames(t *testing.T, r io.Reader) ([]byte, [][]byte) {
+	t.Helper()
+	var flags []byte
+	var frames [][]byte
+	for {
+		var header [5]byte
+		_, err := io.ReadFull(r, header[:])
+		if errors.Is(err, io.EOF) {
+			return flags, frames
+		}
+		require.NoError(t, err)
+		data := make([]byte, binary.BigEndian.Uint32(header[1:]))
+		_, err = io.ReadFull(r, data)
+		require.NoError(t, err)
+		flags = append(flags, header[0])
+		frames = append(frames, data)
+	}
+}
+
+func readWeb(t *testing.T, r io.Reader) webResponse {
+	t.Helper()
+	flags, frames := readFrames(t, r)
+	require.NotEmpty(t, frames)
+	require.Equal(t, byte(0x80), flags[len(flags)-1], "the last frame carries the trailers")
+	resp := webResponse{messages: frames[:len(frames)-1], trailer: make(map[string]string)}
+	for _, line := range strings.Split(strings.TrimSpace(string(frames[len(frames)-1])), "\r\n") {
+		key, value, _ := strings.Cut(line, ": ")
+		resp.trailer[key] = value
+	}
+	return resp
+}
+
+func TestWebUnary(t *testing.T) {
+	srv, _ := newServer(t)
+	request, err := proto.Marshal(&pb.GetRequest{Type: pb.DataType_DATA_TYPE_LOGIN, Path: "bank/mail"})
+	require.NoError(t, err)
+
+	resp := post(t, srv.URL+getPath, "application/grpc-web+proto", frame(0, request), authorized())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/grpc-web+proto", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "3", resp.Header.Get("X-Secret-Version"))
+	web := readWeb(t, resp.Body)
+	assert.Equal(t, "0", web.trailer["grpc-status"])
+	assert.Equal(t, "test", web.trailer["x-served-by"])
+	require.Len(t, web.messages, 1)
+	var got pb.GetResponse
+	require.NoError(t, proto.Unmarshal(web.messages[0], &got))
+	assert.Equal(t, "secret", got.GetData().GetLogin().GetPassword())
+}
+
+func TestWebErrors(t *testing.T) {
+	srv, _ := newServer(t)
+	request, err := proto.Marshal(&pb.GetRequest{Type: pb.DataType_DATA_TYPE_LOGIN, Path: "bank/other"})
+	require.NoError(t, err)
+
+	resp := post(t, srv.URL+getPath, "application/grpc-web+proto", frame(0, request), authorized())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	web := readWeb(t, resp.Body)
+	assert.Empty(t, web.messages)
+	assert.Equal(t, "5", web.trailer["grpc-status"])
+	assert.Equal(t, "secret%20bank%2Fother%20not%20found", web.trailer["grpc-message"])
+
+	resp = post(t, srv.URL+getPath, "application/grpc-web+proto", frame(0, request), nil)
+	assert.Equal(t, "16", readWeb(t, resp.Body).trailer["grpc-status"])
+
+	resp = post(t, srv.URL+"/api.v1.GophkeeperService/Missing", "application/grpc-web+proto", frame(0, nil),
+		authorized())
+	assert.Equal(t, "12", readWeb(t, resp.Body).trailer["grpc-status"])
+
+	resp = post(t, srv.URL+getPath, "text/plain", request, authorized())
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+}
+
+func TestWebServerStreaming(t *testing.T) {
+	srv, _ := newServer(t)
+	request, err := proto.Marshal(&pb.DownloadRequest{Filename: "fox.txt"})
+	require.NoError(t, err)
+
+	resp := post(t, srv.URL+downloadPath, "application/grpc-web+proto", frame(0, request), authorized())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	web := readWeb(t, resp.Body)
+	assert.Equal(t, "0", web.trailer["grpc-status"])
+	require.Len(t, web.messages, 5)
+	var data []byte
+	for _, message := range web.messages {
+		var chunk pb.Chunk
+		require.NoError(t, proto.Unmarshal(message, &chunk))
+		data = append(data, chunk.GetData()...)
+	}
+	assert.Equal(t, fox, data)
+
+	missing, err := proto.Marshal(&pb.DownloadRequest{Filename: "cat.txt"})
+	require.NoError(t, err)
+	resp = post(t, srv.URL+downloadPath, "application/grpc-web+proto", frame(0, missing), authorized())
+	web = readWeb(t, resp.Body)
+	assert.Empty(t, web.messages)
+	assert.Equal(t, "5", web.trailer["grpc-status"])
+}
+
+func TestWebStreamingFlush(t *testing.T) {
+	srv, vault := newServer(t)
+	request, err := proto.Marshal(&pb.DownloadRequest{Filename: "slow.txt"})
+	require.NoError(t, err)
+
+	resp := post(t, srv.URL+downloadPath, "application/grpc-web+proto", frame(0, request), authorized())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	// the first chunk arrives while the server still holds the stream open
+	var header [5]byte
+	_, err = io.ReadFull(resp.Body, header[:])
+	require.NoError(t, err)
+	assert.Equal(t, byte(0), header[0])
+	close(vault.release)
+
+	web := readWeb(t, io.MultiReader(bytes.NewReader(header[:]), resp.Body))
+	assert.Equal(t, "0", web.trailer["grpc-status"])
+	assert.Len(t, web.messages, 1)
+}
+
+// textReader decodes a gRPC-Web text body, each frame is encoded separately.
+func textReader(t *testing.T, body io.Reader) io.Reader {
+	t.Helper()
+	var decoded bytes.Buffer
+	scanner := bufio.NewScanner(body)
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if end := bytes.IndexByte(data, '='); end >= 0 {
+			for end < len(data) && data[end] == '=' {
+				end++
+			}
+			return end, data[:end], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	})
+	for scanner.Scan() {
+		chunk, err := base64.StdEncoding.DecodeString(scanner.Text())
+		require.NoError(t, err)
+		decoded.Write(chunk)
+	}
+	require.NoError(t, scanner.Err())
+	return &decoded
+}
+
+func TestWebText(t *testing.T) {
+	srv, _ := newServer(t)
+	request, err := proto.Marshal(&pb.DownloadRequest{Filename: "fox.txt"})
+	require.NoError(t, err)
+	body := base64.StdEncoding.EncodeToString(frame(0, request))
+
+	resp := post(t, srv.URL+downloadPath, "application/grpc-web-text", []byte(body), authorized())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/grpc-web-text+proto", resp.Header.Get("Content-Type"))
+	web := readWeb(t, textReader(t, resp.Body))
+	assert.Equal(t, "0", web.trailer["grpc-status"])
+	assert.Len(t, web.messages, 5)
+}
+
+func TestConnectUnary(t *testing.T) {
+	srv, _ := newServer(t)
+	header := http.Header{"Authorization": {token}, "Connect-Protocol-Version": {"1"}}
+
+	resp := post(t, srv.URL+getPath, "application/json",
+		[]byte(`{"type":"DATA_TYPE_LOGIN","path":"bank/mail"}`), header)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "test", resp.Header.Get("Trailer-X-Served-By"))
+	var got map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	assert.Equal(t, map[string]any{"login": "user", "password": "secret"}, got["data"].(map[string]any)["login"])
+
+	request, err := proto.Marshal(&pb.GetRequest{Type: pb.DataType_DATA_TYPE_LOGIN, Path: "bank/mail"})
+	require.NoError(t, err)
+	resp = post(t, srv.URL+getPath, "application/proto", request, header)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var message pb.GetResponse
+	require.NoError(t, proto.Unmarshal(data, &message))
+	assert.Equal(t, "user", message.GetData().GetLogin().GetLogin())
+
+	tests := []struct {
+		name   string
+		body   string
+		header http.Header
+		status int
+		code   string
+	}{
+		{name: "not found", body: `{"path":"bank/other"}`, header: header, status: http.StatusNotFound,
+			code: "not_found"},
+		{name: "unauthenticated", body: `{"path":"bank/mail"}`, status: http.StatusUnauthorized,
+			code: "unauthenticated"},
+		{name: "invalid json", body: `{"path":`, header: header, status: http.StatusBadRequest,
+			code: "invalid_argument"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := post(t, srv.URL+getPath, "application/json", []byte(tt.body), tt.header)
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+			var connectErr map[string]any
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&connectErr))
+			assert.Equal(t, tt.code, connectErr["code"])
+		})
+	}
+}
+
+func TestConnectServerStreaming(t *testing.T) {
+	srv, _ := newServer(t)
+	header := http.Header{"Authorization": {token}, "Connect-Protocol-Version": {"1"}}
+
+	resp := post(t, srv.URL+downloadPath, "application/connect+json", frame(0, []byte(`{"filename":"fox.txt"}`)), header)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/connect+json", resp.Header.Get("Content-Type"))
+	flags, frames := readFrames(t, resp.Body)
+	require.Len(t, frames, 6)
+	var data []byte
+	for _, message := range frames[:5] {
+		var chunk struct {
+			Data []byte `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(message, &chunk))
+		data = append(data, chunk.Data...)
+	}
+	assert.Equal(t, fox, data)
+	assert.Equal(t, byte(0x02), flags[5])
+	assert.JSONEq(t, `{}`, string(frames[5]))
+
+	resp = post(t, srv.URL+downloadPath, "application/connect+json", frame(0, []byte(`{"filename":"cat.txt"}`)), header)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	flags, frames = readFrames(t, resp.Body)
+	require.Len(t, frames, 1)
+	assert.Equal(t, byte(0x02), flags[0])
+	assert.JSONEq(t, `{"error":{"code":"not_found","message":"binary not found"}}`, string(frames[0]))
+}
+
+func TestCORS(t *testing.T) {
+	srv, _ := newServer(t, "https://ui.example.com")
+
+	preflight := func(origin string) *http.Response {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodOptions, srv.URL+getPath, nil)
+		require.NoError(t, err)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "authorization,content-type,x-grpc-web")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+
+	resp := preflight("https://ui.example.com")
+	assert.Equal(t, "https://ui.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, strings.ToLower(resp.Header.Get("Access-Control-Allow-Headers")), "x-grpc-web")
+	assert.Empty(t, preflight("https://evil.example.com").Header.Get("Access-Control-Allow-Origin"))
+
+	request, err := proto.Marshal(&pb.GetRequest{Type: pb.DataType_DATA_TYPE_LOGIN, Path: "bank/mail"})
+	require.NoError(t, err)
+	header := authorized()
+	header.Set("Origin", "https://ui.example.com")
+	resp = post(t, srv.URL+getPath, "application/grpc-web+proto", frame(0, request), header)
+	assert.Equal(t, "https://ui.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, resp.Header.Get("Access-Control-Expose-Headers"), "Grpc-Status")
+	assert.Equal(t, "0", readWeb(t, resp.Body).trailer["grpc-status"])
+}
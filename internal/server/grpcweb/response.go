@@ -0,0 +1,222 @@
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// responseWriter receives the gRPC response of the server and writes it in the protocol of the call.
+// The status and the trailers are only known once the server is done, they are written by finish.
+type responseWriter struct {
+	w      http.ResponseWriter
+	proto  protocol
+	codec  codec
+	header http.Header
+
+	headerSent bool
+	// raw is set when the server has rejected the request with a plain HTTP error, which is passed on as is.
+	raw bool
+	// pending keeps the bytes of an incomplete frame.
+	pending []byte
+	// message is the response of a Connect unary call, it is written along with the status.
+	message []byte
+	// err is the conversion failure of a response message, it overrides the status of the server.
+	err error
+}
+
+func (rw *responseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *responseWriter) WriteHeader(code int) {
+	if code != http.StatusOK && !rw.headerSent {
+		rw.raw = true
+		copyHeader(rw.w.Header(), rw.header, func(string) bool { return true })
+		rw.w.WriteHeader(code)
+	}
+	rw.sendHeader()
+}
+
+func (rw *responseWriter) Write(p []byte) (int, error) {
+	rw.sendHeader()
+	if rw.raw {
+		return rw.w.Write(p)
+	}
+	rw.pending = append(rw.pending, p...)
+	for len(rw.pending) >= frameHeaderLen {
+		size := int(binary.BigEndian.Uint32(rw.pending[1:frameHeaderLen]))
+		if len(rw.pending) < frameHeaderLen+size {
+			break
+		}
+		flags, data := rw.pending[0], rw.pending[frameHeaderLen:frameHeaderLen+size]
+		rw.pending = rw.pending[frameHeaderLen+size:]
+		if err := rw.writeMessage(flags, data); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (rw *responseWriter) Flush() {
+	rw.sendHeader()
+	if flusher, ok := rw.w.(http.Flusher); ok && rw.proto.kind != kindConnectUnary {
+		flusher.Flush()
+	}
+}
+
+// sendHeader writes the response headers of a stream, the ones of a Connect unary call wait for the status.
+func (rw *responseWriter) sendHeader() {
+	if rw.headerSent || rw.raw || rw.proto.kind == kindConnectUnary {
+		return
+	}
+	rw.headerSent = true
+	copyHeader(rw.w.Header(), rw.header, metadataKey)
+	rw.w.Header().Set("Content-Type", rw.proto.contentType)
+	rw.w.WriteHeader(http.StatusOK)
+}
+
+func (rw *responseWriter) writeMessage(flags byte, data []byte) error {
+	if rw.err != nil {
+		return rw.err
+	}
+	var frame bytes.Buffer
+	switch rw.proto.kind {
+	case kindWeb:
+		writeFrame(&frame, flags, data)
+	case kindConnectStream:
+		converted, err := rw.codec.encode(data)
+		if err != nil {
+			rw.err = err
+			return err
+		}
+		writeFrame(&frame, flags&flagCompressed, converted)
+	case kindConnectUnary:
+		converted, err := rw.codec.encode(data)
+		if err != nil {
+			rw.err = err
+			return err
+		}
+		rw.message = bytes.Clone(converted)
+		return nil
+	}
+	return rw.writeFrame(frame.Bytes())
+}
+
+func (rw *responseWriter) writeFrame(frame []byte) error {
+	if rw.proto.text {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+	_, err := rw.w.Write(frame)
+	return err
+}
+
+// finish writes the status and the trailers set by the server.
+func (rw *responseWriter) finish() {
+	if rw.raw {
+		return
+	}
+	st, trailer := rw.status()
+	if rw.err != nil {
+		st = status.Convert(rw.err)
+	}
+	rw.finishWith(st, trailer)
+}
+
+func (rw *responseWriter) finishWith(st *status.Status, trailer metadata.MD) {
+	switch rw.proto.kind {
+	case kindWeb:
+		rw.sendHeader()
+		_ = rw.writeFrame(webTrailers(st, trailer))
+	case kindConnectStream:
+		rw.sendHeader()
+		var frame bytes.Buffer
+		writeFrame(&frame, flagEndStream, connectEndStream(st, trailer))
+		_ = rw.writeFrame(frame.Bytes())
+	case kindConnectUnary:
+		header := rw.w.Header()
+		copyHeader(header, rw.header, metadataKey)
+		for key, values := range trailer {
+			for _, value := range values {
+				header.Add("Trailer-"+key, value)
+			}
+		}
+		if st.Code() != codes.OK {
+			header.Set("Content-Type", "application/json")
+			rw.w.WriteHeader(connectHTTPStatus(st.Code()))
+			_, _ = rw.w.Write(connectErrorJSON(st))
+			return
+		}
+		header.Set("Content-Type", rw.proto.contentType)
+		rw.w.WriteHeader(http.StatusOK)
+		_, _ = rw.w.Write(rw.message)
+	}
+}
+
+// status reads the status and the trailers the gRPC server has set after the response.
+func (rw *responseWriter) status() (*status.Status, metadata.MD) {
+	trailer := metadata.MD{}
+	for key, values := range rw.header {
+		if len(key) > len(http.TrailerPrefix) && strings.EqualFold(key[:len(http.TrailerPrefix)], http.TrailerPrefix) {
+			trailer.Append(strings.ToLower(key[len(http.TrailerPrefix):]), values...)
+		}
+	}
+
+	code, err := strconv.ParseUint(rw.header.Get("Grpc-Status"), 10, 32)
+	if err != nil {
+		return status.New(codes.Internal, "the server has not sent a status"), trailer
+	}
+	message := rw.header.Get("Grpc-Message")
+	if decoded, decodeErr := url.PathUnescape(message); decodeErr == nil {
+		message = decoded
+	}
+	st := status.New(codes.Code(code), message)
+	if details := rw.header.Get("Grpc-Status-Details-Bin"); details != "" {
+		var pbStatus spb.Status
+		data, decodeErr := base64.RawStdEncoding.DecodeString(strings.TrimRight(details, "="))
+		if decodeErr == nil && proto.Unmarshal(data, &pbStatus) == nil {
+			st = status.FromProto(&pbStatus)
+		}
+	}
+	return st, trailer
+}
+
+// webTrailers encodes the status and the trailers as the final frame of a gRPC-Web response.
+func webTrailers(st *status.Status, trailer metadata.MD) []byte {
+	var block strings.Builder
+	block.WriteString("grpc-status: " + strconv.Itoa(int(st.Code())) + "\r\n")
+	if st.Message() != "" {
+		block.WriteString("grpc-message: " + url.PathEscape(st.Message()) + "\r\n")
+	}
+	if pbStatus := st.Proto(); len(pbStatus.GetDetails()) > 0 {
+		if data, err := proto.Marshal(pbStatus); err == nil {
+			block.WriteString("grpc-status-details-bin: " + base64.RawStdEncoding.EncodeToString(data) + "\r\n")
+		}
+	}
+	for key, values := range trailer {
+		for _, value := range values {
+			block.WriteString(key + ": " + value + "\r\n")
+		}
+	}
+	var frame bytes.Buffer
+	writeFrame(&frame, flagTrailer, []byte(block.String()))
+	return frame.Bytes()
+}
+
+func copyHeader(dst, src http.Header, keep func(string) bool) {
+	for key, values := range src {
+		if keep(key) {
+			dst[key] = values
+		}
+	}
+}
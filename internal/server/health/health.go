@@ -0,0 +1,192 @@
+// Package health reports whether the server is able to serve requests, both over the standard
+// grpc.health.v1 service and as HTTP liveness and readiness probes.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+)
+
+const (
+	DefaultInterval = 10 * time.Second
+	DefaultTimeout  = 3 * time.Second
+)
+
+// Probe checks a dependency of the server, e.g. that the database is reachable.
+type Probe func(ctx context.Context) error
+
+// Checker runs the probes periodically and keeps the serving status of the services up to date.
+// The server is ready when all the probes succeeded in the last round. It starts as not ready
+// until the first round is done.
+type Checker struct {
+	server   *health.Server
+	probes   map[string]Probe
+	services []string
+	interval time.Duration
+	timeout  time.Duration
+
+	mu       sync.RWMutex
+	failures map[string]string
+	checked  bool
+	stopped  bool
+}
+
+// Option configures optional Checker behaviour.
+type Option func(*Checker)
+
+// WithInterval sets how often the probes are run.
+func WithInterval(interval time.Duration) Option {
+	return func(c *Checker) {
+		c.interval = interval
+	}
+}
+
+// WithTimeout sets how long a single probe may take before it is considered failed.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Checker) {
+		c.timeout = timeout
+	}
+}
+
+// WithServices names the gRPC services whose status follows the readiness, in addition to the
+// overall status of the server reported for the empty service name.
+func WithServices(services ...string) Option {
+	return func(c *Checker) {
+		c.services = append(c.services, services...)
+	}
+}
+
+func NewChecker(probes map[string]Probe, opts ...Option) *Checker {
+	c := &Checker{
+		server:   health.NewServer(),
+		probes:   probes,
+		services: []string{""},
+		interval: DefaultInterval,
+		timeout:  DefaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Server is the grpc.health.v1 service to register with the gRPC server.
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Check runs all the probes concurrently and updates the serving status.
+// It returns the failed probes with their errors.
+func (c *Checker) Check(ctx context.Context) map[string]string {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failures = make(map[string]string)
+	)
+	for name, probe := range c.probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			if err := probe(probeCtx); err != nil {
+				mu.Lock()
+				failures[name] = err.Error()
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stopped {
+		return failures
+	}
+	wasReady := c.checked && len(c.failures) == 0
+	c.failures, c.checked = failures, true
+	if len(failures) == 0 {
+		c.setStatus(healthpb.HealthCheckResponse_SERVING)
+		if !wasReady {
+			logger.Log().Info("Server is ready")
+		}
+		return failures
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	logger.Log().Warnf("Server is not ready: %v", failures)
+	return failures
+}
+
+// Run checks the probes right away and then periodically until the context is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown reports the server as not serving for good, so the load balancers stop sending
+// new requests while the server drains the current ones.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	c.server.Shutdown()
+}
+
+// Ready tells whether the server can serve requests, with the failed probes if it can't.
+func (c *Checker) Ready() (bool, map[string]string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.checked && !c.stopped && len(c.failures) == 0, c.failures
+}
+
+type readiness struct {
+	Status   string            `json:"status"`
+	Failures map[string]string `json:"failures,omitempty"`
+}
+
+// LiveHandler answers the liveness probe, the server is alive as long as it responds.
+func (c *Checker) LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, readiness{Status: "ok"})
+	})
+}
+
+// ReadyHandler answers the readiness probe with 503 while the server isn't ready.
+func (c *Checker) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if ready, failures := c.Ready(); !ready {
+			writeJSON(w, http.StatusServiceUnavailable, readiness{Status: "not ready", Failures: failures})
+			return
+		}
+		writeJSON(w, http.StatusOK, readiness{Status: "ok"})
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body readiness) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,114 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/itallix/gophkeeper/internal/server/health"
+)
+
+const service = "api.v1.GophkeeperService"
+
+func servingStatus(t *testing.T, c *health.Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := c.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.GetStatus()
+}
+
+func probe(t *testing.T, handler http.Handler) (int, map[string]any) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return rec.Code, body
+}
+
+func TestChecker(t *testing.T) {
+	var storageDown atomic.Bool
+	c := health.NewChecker(map[string]health.Probe{
+		"postgres": func(context.Context) error { return nil },
+		"object_storage": func(context.Context) error {
+			if storageDown.Load() {
+				return errors.New("connection refused")
+			}
+			return nil
+		},
+	}, health.WithServices(service))
+
+	// not ready until the probes have been run
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, ""))
+	code, _ := probe(t, c.ReadyHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	assert.Empty(t, c.Check(context.Background()))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, c, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, c, service))
+	code, body := probe(t, c.ReadyHandler())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", body["status"])
+
+	storageDown.Store(true)
+	assert.Equal(t, map[string]string{"object_storage": "connection refused"}, c.Check(context.Background()))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, service))
+	code, body = probe(t, c.ReadyHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, map[string]any{"object_storage": "connection refused"}, body["failures"])
+
+	// the server is alive even when it isn't ready
+	code, _ = probe(t, c.LiveHandler())
+	assert.Equal(t, http.StatusOK, code)
+
+	storageDown.Store(false)
+	c.Check(context.Background())
+	c.Shutdown()
+	c.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, ""))
+	ready, _ := c.Ready()
+	assert.False(t, ready, "a server shutting down is not ready")
+}
+
+func TestCheckerTimeout(t *testing.T) {
+	c := health.NewChecker(map[string]health.Probe{
+		"postgres": func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}, health.WithTimeout(10*time.Millisecond))
+
+	failures := c.Check(context.Background())
+	assert.Equal(t, context.DeadlineExceeded.Error(), failures["postgres"])
+}
+
+func TestRun(t *testing.T) {
+	var checks atomic.Int32
+	c := health.NewChecker(map[string]health.Probe{
+		"postgres": func(context.Context) error {
+			checks.Add(1)
+			return nil
+		},
+	}, health.WithInterval(5*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.Run(ctx)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool { return checks.Load() >= 3 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+	ready, _ := c.Ready()
+	assert.True(t, ready)
+}
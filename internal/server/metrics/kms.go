@@ -0,0 +1,32 @@
+package metrics
+
+import "github.com/itallix/gophkeeper/internal/server/service"
+
+// KMS operation label values.
+const (
+	OpGenerate = "generate"
+	OpDecrypt  = "decrypt"
+)
+
+// KMS records the data key operations passed on to the underlying KMS.
+type KMS struct {
+	kms     service.KMS
+	metrics *Metrics
+}
+
+// NewKMS instruments the KMS with the metrics.
+func NewKMS(kms service.KMS, metrics *Metrics) *KMS {
+	return &KMS{kms: kms, metrics: metrics}
+}
+
+func (k *KMS) GenerateDataKey() ([]byte, []byte, error) {
+	plaintext, encrypted, err := k.kms.GenerateDataKey()
+	k.metrics.ObserveKMSOp(OpGenerate, err)
+	return plaintext, encrypted, err
+}
+
+func (k *KMS) DecryptDataKey(encryptedDataKey []byte) ([]byte, error) {
+	plaintext, err := k.kms.DecryptDataKey(encryptedDataKey)
+	k.metrics.ObserveKMSOp(OpDecrypt, err)
+	return plaintext, err
+}
@@ -0,0 +1,138 @@
+// Package metrics collects the Prometheus metrics of the server and exposes them over HTTP.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "gophkeeper"
+
+// Result label values of the vault and KMS operations.
+const (
+	ResultOK    = "ok"
+	ResultError = "error"
+)
+
+// Directions of the transferred binary data.
+const (
+	DirectionUpload   = "upload"
+	DirectionDownload = "download"
+)
+
+// Metrics holds the collectors of the server, all of them registered in a dedicated registry.
+type Metrics struct {
+	registry      *prometheus.Registry
+	requests      *prometheus.CounterVec
+	latency       *prometheus.HistogramVec
+	authFailures  *prometheus.CounterVec
+	vaultOps      *prometheus.CounterVec
+	vaultDuration *prometheus.HistogramVec
+	bytes         *prometheus.CounterVec
+	kmsOps        *prometheus.CounterVec
+}
+
+// New creates the server metrics together with the Go runtime and process collectors.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of gRPC requests handled, by method and status code.",
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Time taken to handle gRPC requests, by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		authFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "failures_total",
+			Help:      "Number of requests rejected as unauthenticated, unauthorized or rate limited.",
+		}, []string{"method", "reason"}),
+		vaultOps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "vault",
+			Name:      "operations_total",
+			Help:      "Number of vault operations, by operation, secret type and result.",
+		}, []string{"operation", "type", "result"}),
+		vaultDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "vault",
+			Name:      "operation_duration_seconds",
+			Help:      "Time taken by vault operations, by operation and secret type.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "type"}),
+		bytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "vault",
+			Name:      "binary_bytes_total",
+			Help:      "Number of bytes of binary data uploaded and downloaded.",
+		}, []string{"direction"}),
+		kmsOps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "kms",
+			Name:      "data_key_operations_total",
+			Help:      "Number of data keys generated and decrypted, by result.",
+		}, []string{"operation", "result"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests, m.latency, m.authFailures, m.vaultOps, m.vaultDuration, m.bytes, m.kmsOps,
+	)
+	return m
+}
+
+// Registry gives access to the registry, e.g. to add more collectors.
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler serves the collected metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveRequest records a handled gRPC request.
+func (m *Metrics) ObserveRequest(method, code string, elapsed time.Duration) {
+	m.requests.WithLabelValues(method, code).Inc()
+	m.latency.WithLabelValues(method, code).Observe(elapsed.Seconds())
+}
+
+// AuthFailure records a request rejected for the given reason.
+func (m *Metrics) AuthFailure(method, reason string) {
+	m.authFailures.WithLabelValues(method, reason).Inc()
+}
+
+// AddBytes records binary data transferred in the given direction.
+func (m *Metrics) AddBytes(direction string, n int) {
+	m.bytes.WithLabelValues(direction).Add(float64(n))
+}
+
+// ObserveVaultOp records a vault operation on a secret of the given type.
+func (m *Metrics) ObserveVaultOp(operation, secretType string, err error, elapsed time.Duration) {
+	m.vaultOps.WithLabelValues(operation, secretType, result(err)).Inc()
+	m.vaultDuration.WithLabelValues(operation, secretType).Observe(elapsed.Seconds())
+}
+
+// ObserveKMSOp records a data key operation.
+func (m *Metrics) ObserveKMSOp(operation string, err error) {
+	m.kmsOps.WithLabelValues(operation, result(err)).Inc()
+}
+
+func result(err error) string {
+	if err != nil {
+		return ResultError
+	}
+	return ResultOK
+}
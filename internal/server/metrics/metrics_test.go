@@ -0,0 +1,105 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/server/metrics"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	mocksrv "github.com/itallix/gophkeeper/mocks/internal_/server"
+	mockservice "github.com/itallix/gophkeeper/mocks/internal_/server/service"
+)
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	return rec.Body.String()
+}
+
+func TestVault(t *testing.T) {
+	m := metrics.New()
+	inner := mocksrv.NewVault(t)
+	vault := metrics.NewVault(inner, m)
+	ctx := context.Background()
+
+	login := models.NewLogin([]models.SecretOption{models.WithPath("mail")}, nil)
+	card := models.NewCard([]models.SecretOption{models.WithPath("visa")}, nil)
+	inner.EXPECT().StoreSecret(ctx, login).Return(nil).Twice()
+	inner.EXPECT().RetrieveSecret(ctx, card).Return(errors.New("not found")).Once()
+	inner.EXPECT().DeleteSecret(ctx, card).Return(nil).Once()
+
+	require.NoError(t, vault.StoreSecret(ctx, login))
+	require.NoError(t, vault.StoreSecret(ctx, login))
+	require.Error(t, vault.RetrieveSecret(ctx, card))
+	require.NoError(t, vault.DeleteSecret(ctx, card))
+
+	err := testutil.GatherAndCompare(m.Registry(), strings.NewReader(`
+# HELP gophkeeper_vault_operations_total Number of vault operations, by operation, secret type and result.
+# TYPE gophkeeper_vault_operations_total counter
+gophkeeper_vault_operations_total{operation="delete",result="ok",type="card"} 1
+gophkeeper_vault_operations_total{operation="retrieve",result="error",type="card"} 1
+gophkeeper_vault_operations_total{operation="store",result="ok",type="login"} 2
+`), "gophkeeper_vault_operations_total")
+	require.NoError(t, err)
+	assert.Contains(t, scrape(t, m), `gophkeeper_vault_operation_duration_seconds_count{operation="store",type="login"} 2`)
+}
+
+func TestKMS(t *testing.T) {
+	m := metrics.New()
+	inner := mockservice.NewKMS(t)
+	kms := metrics.NewKMS(inner, m)
+
+	inner.EXPECT().GenerateDataKey().Return([]byte("key"), []byte("encrypted"), nil).Once()
+	inner.EXPECT().DecryptDataKey([]byte("broken")).Return(nil, errors.New("bad key")).Once()
+
+	plaintext, encrypted, err := kms.GenerateDataKey()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), plaintext)
+	assert.Equal(t, []byte("encrypted"), encrypted)
+	_, err = kms.DecryptDataKey([]byte("broken"))
+	require.Error(t, err)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `gophkeeper_kms_data_key_operations_total{operation="generate",result="ok"} 1`)
+	assert.Contains(t, body, `gophkeeper_kms_data_key_operations_total{operation="decrypt",result="error"} 1`)
+}
+
+func TestPool(t *testing.T) {
+	// the pool connects lazily, so no database is needed to read its statistics
+	pool, err := pgxpool.New(context.Background(), "postgres://user@127.0.0.1:1/db?pool_max_conns=7")
+	require.NoError(t, err)
+	defer pool.Close()
+
+	m := metrics.New()
+	require.NoError(t, m.RegisterPool(pool))
+	require.Error(t, m.RegisterPool(pool), "the same collector can't be registered twice")
+
+	body := scrape(t, m)
+	assert.Contains(t, body, "gophkeeper_db_pool_max_connections 7")
+	assert.Contains(t, body, "gophkeeper_db_pool_acquired_connections 0")
+}
+
+func TestRequests(t *testing.T) {
+	m := metrics.New()
+	m.ObserveRequest("/api.v1.GophkeeperService/Get", "OK", 0)
+	m.AuthFailure("/api.v1.GophkeeperService/Get", "unauthenticated")
+	m.AddBytes(metrics.DirectionUpload, 42)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `gophkeeper_grpc_requests_total{code="OK",method="/api.v1.GophkeeperService/Get"} 1`)
+	assert.Contains(t, body,
+		`gophkeeper_auth_failures_total{method="/api.v1.GophkeeperService/Get",reason="unauthenticated"} 1`)
+	assert.Contains(t, body, `gophkeeper_vault_binary_bytes_total{direction="upload"} 42`)
+	assert.Contains(t, body, "go_goroutines")
+}
@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/itallix/gophkeeper/internal/server"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// Vault operation label values.
+const (
+	OpStore    = "store"
+	OpRetrieve = "retrieve"
+	OpDelete   = "delete"
+	OpList     = "list"
+)
+
+// typeVisitor resolves the type of a secret.
+type typeVisitor struct {
+	itemType models.VaultItemType
+}
+
+func (v *typeVisitor) VisitCard(*models.Card) error {
+	v.itemType = models.CardType
+	return nil
+}
+
+func (v *typeVisitor) VisitLogin(*models.Login) error {
+	v.itemType = models.LoginType
+	return nil
+}
+
+func (v *typeVisitor) VisitNote(*models.Note) error {
+	v.itemType = models.NoteType
+	return nil
+}
+
+func (v *typeVisitor) VisitBinary(*models.Binary) error {
+	v.itemType = models.BinaryType
+	return nil
+}
+
+func (v *typeVisitor) VisitSSHKey(*models.SSHKey) error {
+	v.itemType = models.SSHKeyType
+	return nil
+}
+
+func (v *typeVisitor) VisitTOTP(*models.TOTP) error {
+	v.itemType = models.TOTPType
+	return nil
+}
+
+func (v *typeVisitor) VisitKV(*models.KV) error {
+	v.itemType = models.KVType
+	return nil
+}
+
+func (v *typeVisitor) GetResult() any {
+	return v.itemType
+}
+
+func secretType(secret models.Secret) string {
+	v := &typeVisitor{}
+	if err := secret.Accept(v); err != nil || v.itemType == "" {
+		return "unknown"
+	}
+	return string(v.itemType)
+}
+
+// Vault records the operations passed on to the underlying vault.
+type Vault struct {
+	server.Vault
+	metrics *Metrics
+}
+
+// NewVault instruments the vault with the metrics.
+func NewVault(vault server.Vault, metrics *Metrics) *Vault {
+	return &Vault{Vault: vault, metrics: metrics}
+}
+
+func (v *Vault) observe(operation string, secret models.Secret, start time.Time, err error) {
+	v.metrics.ObserveVaultOp(operation, secretType(secret), err, time.Since(start))
+}
+
+func (v *Vault) StoreSecret(ctx context.Context, secret models.Secret) error {
+	start := time.Now()
+	err := v.Vault.StoreSecret(ctx, secret)
+	v.observe(OpStore, secret, start, err)
+	return err
+}
+
+func (v *Vault) RetrieveSecret(ctx context.Context, secret models.Secret) error {
+	start := time.Now()
+	err := v.Vault.RetrieveSecret(ctx, secret)
+	v.observe(OpRetrieve, secret, start, err)
+	return err
+}
+
+func (v *Vault) DeleteSecret(ctx context.Context, secret models.Secret) error {
+	start := time.Now()
+	err := v.Vault.DeleteSecret(ctx, secret)
+	v.observe(OpDelete, secret, start, err)
+	return err
+}
+
+func (v *Vault) ListSecrets(ctx context.Context, secret models.Secret) ([]string, error) {
+	start := time.Now()
+	paths, err := v.Vault.ListSecrets(ctx, secret)
+	v.observe(OpList, secret, start, err)
+	return paths, err
+}
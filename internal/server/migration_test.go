@@ -0,0 +1,35 @@
+package server_test
+
+import (
+	"fmt"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/db/migrations"
+	"github.com/itallix/gophkeeper/internal/server"
+)
+
+func TestListMigrations(t *testing.T) {
+	list, err := server.ListMigrations(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, list)
+	assert.Equal(t, server.Migration{Version: 20241021193746, Name: "initialize_schema"}, list[0])
+
+	for i, migration := range list {
+		if i > 0 {
+			assert.Greater(t, migration.Version, list[i-1].Version)
+		}
+		_, err = fs.Stat(migrations.FS, fmt.Sprintf("%d_%s.down.sql", migration.Version, migration.Name))
+		assert.NoError(t, err, "every migration can be reverted")
+	}
+	assert.Equal(t, list[len(list)-1].Version, server.MigrationStatus{Migrations: list}.Latest())
+
+	list, err = server.ListMigrations(fstest.MapFS{})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	assert.Zero(t, server.MigrationStatus{}.Latest())
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// LoginAttempts tracks the failed logins of an account.
+type LoginAttempts struct {
+	// Failures is the number of consecutive failures since the last success or lockout.
+	Failures    int
+	LastFailure time.Time
+	// LockedUntil is set while the account is locked out.
+	LockedUntil time.Time
+}
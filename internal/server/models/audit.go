@@ -0,0 +1,65 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+)
+
+// AuditEvent records an operation performed by a user. Events are chained: the hash of
+// each event covers its fields and the hash of the previous event, so modifying or
+// deleting any event breaks the chain from that point on.
+type AuditEvent struct {
+	EventID   int64
+	Actor     string
+	Action    string
+	Path      string
+	Type      VaultItemType
+	Owner     string
+	Result    string
+	ClientIP  string
+	UserAgent string
+	CreatedAt time.Time
+	PrevHash  []byte
+	Hash      []byte
+}
+
+// ComputeHash returns the hash of the event chained to the previous hash.
+// Every field is length-prefixed, so no two different events produce the same input.
+func (e *AuditEvent) ComputeHash(prevHash []byte) []byte {
+	h := sha256.New()
+	write := func(b []byte) {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(b)))
+		h.Write(b)
+	}
+	write(prevHash)
+	for _, field := range []string{e.Actor, e.Action, e.Path, string(e.Type), e.Owner, e.Result, e.ClientIP,
+		e.UserAgent} {
+		write([]byte(field))
+	}
+	_ = binary.Write(h, binary.BigEndian, e.CreatedAt.UnixMicro())
+	return h.Sum(nil)
+}
+
+// AuditFilter narrows down the audit events being listed, zero values match everything.
+type AuditFilter struct {
+	// Participant limits the events to those performed by the user or concerning secrets they own.
+	Participant string
+	Actor       string
+	Action      string
+	// PathPrefix matches the events on the secrets whose path starts with it.
+	PathPrefix string
+	Since      time.Time
+	Until      time.Time
+	Limit      int
+}
+
+// AuditVerification is the outcome of checking the audit log hash chain.
+type AuditVerification struct {
+	// Events is the number of events checked.
+	Events int64
+	// BrokenAt is the first event whose hash doesn't match, zero when the chain is intact.
+	BrokenAt int64
+	// Reason explains why the chain is broken.
+	Reason string
+}
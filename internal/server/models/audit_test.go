@@ -0,0 +1,44 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+func TestAuditEventComputeHash(t *testing.T) {
+	created := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	event := models.AuditEvent{
+		Actor:     "mark",
+		Action:    "Get",
+		Path:      "team/db",
+		Type:      models.LoginType,
+		Owner:     "mark",
+		Result:    "OK",
+		ClientIP:  "127.0.0.1",
+		UserAgent: "grpc-go/1.67.1",
+		CreatedAt: created,
+	}
+	first := event.ComputeHash(nil)
+	assert.Len(t, first, 32)
+	assert.Equal(t, first, event.ComputeHash([]byte{}), "empty previous hash starts the chain")
+
+	second := event.ComputeHash(first)
+	assert.NotEqual(t, first, second, "hash depends on the previous event")
+
+	tampered := event
+	tampered.Result = "PermissionDenied"
+	assert.NotEqual(t, first, tampered.ComputeHash(nil))
+
+	// fields are length-prefixed, so moving bytes from one field to another changes the hash
+	shifted := event
+	shifted.Actor, shifted.Action = "markG", "et"
+	assert.NotEqual(t, first, shifted.ComputeHash(nil))
+
+	later := event
+	later.CreatedAt = created.Add(time.Microsecond)
+	assert.NotEqual(t, first, later.ComputeHash(nil))
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// ExternalIdentity links a user to the subject of an OpenID Connect issuer, the user
+// is provisioned on the first single sign-on.
+type ExternalIdentity struct {
+	Issuer  string
+	Subject string
+	Login   string
+	Groups  []string
+	// Admin is granted by the groups of the last login.
+	Admin       bool
+	LastLoginAt time.Time
+}
+
+// GroupRole grants the members of an identity provider group either the server
+// administrator role or a role within an organization.
+type GroupRole struct {
+	Group string
+	Admin bool
+	Org   string
+	Role  Role
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// URIMatch is the rule deciding whether a login is offered for a URL.
+type URIMatch string
+
+const (
+	// URIMatchBaseDomain matches URLs of the same scheme and registrable domain, e.g. mail.google.com for
+	// google.com.
+	URIMatchBaseDomain URIMatch = "base_domain"
+	// URIMatchHost matches URLs of the same scheme, host and port.
+	URIMatchHost URIMatch = "host"
+	// URIMatchExact matches the URL only.
+	URIMatchExact URIMatch = "exact"
+	// URIMatchRegex matches URLs the regular expression matches.
+	URIMatchRegex URIMatch = "regex"
+)
+
+// LoginURI is a website or application a login is used for.
+type LoginURI struct {
+	URI   string
+	Match URIMatch
+}
+
+// LoginMatch is a login found for a URL with the URI that matched it.
+type LoginMatch struct {
+	Path  string
+	Login string
+	URI   LoginURI
+}
+
+// ParseURI parses a URI, a missing scheme defaults to https so that plain host names are accepted.
+func ParseURI(uri string) (*url.URL, error) {
+	uri = strings.TrimSpace(uri)
+	if !strings.Contains(uri, "://") {
+		uri = "https://" + uri
+	}
+	return url.Parse(uri)
+}
+
+// baseDomain returns the registrable domain of the host, IP addresses and hosts without a public suffix
+// such as localhost are their own base domain.
+func baseDomain(host string) string {
+	if net.ParseIP(host) != nil {
+		return host
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
+
+// Matches reports whether the login is offered for the target URL according to the match rule of the URI.
+func (u LoginURI) Matches(target *url.URL) bool {
+	if u.Match == URIMatchRegex {
+		re, err := regexp.Compile(u.URI)
+		return err == nil && re.MatchString(target.String())
+	}
+	parsed, err := ParseURI(u.URI)
+	if err != nil {
+		return false
+	}
+	if u.Match == URIMatchExact {
+		return parsed.String() == target.String()
+	}
+	// A login saved for https must not be offered to the same site over plain http.
+	if !strings.EqualFold(parsed.Scheme, target.Scheme) {
+		return false
+	}
+	if u.Match == URIMatchHost {
+		return strings.EqualFold(parsed.Host, target.Host)
+	}
+	return strings.EqualFold(baseDomain(parsed.Hostname()), baseDomain(target.Hostname()))
+}
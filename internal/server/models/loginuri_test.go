@@ -0,0 +1,63 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+func TestLoginURIMatches(t *testing.T) {
+	for _, tt := range []struct {
+		uri    models.LoginURI
+		target string
+		want   bool
+	}{
+		{uri: models.LoginURI{URI: "google.com", Match: models.URIMatchBaseDomain},
+			target: "https://mail.google.com/u/0", want: true},
+		{uri: models.LoginURI{URI: "https://accounts.google.co.uk", Match: models.URIMatchBaseDomain},
+			target: "https://mail.google.co.uk", want: true},
+		{uri: models.LoginURI{URI: "google.com", Match: models.URIMatchBaseDomain},
+			target: "https://google.co.uk", want: false},
+		{uri: models.LoginURI{URI: "http://localhost:8080", Match: models.URIMatchBaseDomain},
+			target: "http://localhost:3000/login", want: true},
+		{uri: models.LoginURI{URI: "https://192.168.0.1", Match: models.URIMatchBaseDomain},
+			target: "https://192.168.0.1:8443/login", want: true},
+		{uri: models.LoginURI{URI: "https://192.168.0.1", Match: models.URIMatchBaseDomain},
+			target: "https://10.20.0.1", want: false},
+		{uri: models.LoginURI{URI: "https://[fd00::1]", Match: models.URIMatchBaseDomain},
+			target: "https://[fd00::1]:8443/", want: true},
+		{uri: models.LoginURI{URI: "https://[fd00::1]", Match: models.URIMatchBaseDomain},
+			target: "https://[fd00::2]", want: false},
+		{uri: models.LoginURI{URI: "http://localhost", Match: models.URIMatchBaseDomain},
+			target: "http://127.0.0.1", want: false},
+		{uri: models.LoginURI{URI: "http://localhost", Match: models.URIMatchBaseDomain},
+			target: "http://example.localhost", want: false},
+		{uri: models.LoginURI{URI: "https://gitlab.example.com", Match: models.URIMatchHost},
+			target: "https://gitlab.example.com/users/sign_in", want: true},
+		{uri: models.LoginURI{URI: "https://gitlab.example.com", Match: models.URIMatchHost},
+			target: "https://example.com", want: false},
+		{uri: models.LoginURI{URI: "https://bank.com", Match: models.URIMatchHost},
+			target: "http://bank.com/login", want: false},
+		{uri: models.LoginURI{URI: "https://bank.com", Match: models.URIMatchBaseDomain},
+			target: "http://www.bank.com", want: false},
+		{uri: models.LoginURI{URI: "bank.com", Match: models.URIMatchBaseDomain},
+			target: "http://bank.com", want: false},
+		{uri: models.LoginURI{URI: "https://gitlab.example.com:8443", Match: models.URIMatchHost},
+			target: "https://gitlab.example.com", want: false},
+		{uri: models.LoginURI{URI: "https://bank.example.com/login", Match: models.URIMatchExact},
+			target: "https://bank.example.com/login", want: true},
+		{uri: models.LoginURI{URI: "https://bank.example.com/login", Match: models.URIMatchExact},
+			target: "https://bank.example.com/login?next=/", want: false},
+		{uri: models.LoginURI{URI: `^https://[a-z]+\.corp\.example\.com/`, Match: models.URIMatchRegex},
+			target: "https://wiki.corp.example.com/home", want: true},
+		{uri: models.LoginURI{URI: `^https://[a-z]+\.corp\.example\.com/`, Match: models.URIMatchRegex},
+			target: "https://wiki.example.com/home", want: false},
+	} {
+		target, err := models.ParseURI(tt.target)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, tt.uri.Matches(target), "%s %s %s", tt.uri.Match, tt.uri.URI, tt.target)
+	}
+}
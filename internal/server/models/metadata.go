@@ -9,12 +9,16 @@ type SecretVisitor interface {
 	VisitLogin(login *Login) error
 	VisitNote(note *Note) error
 	VisitBinary(binary *Binary) error
+	VisitSSHKey(key *SSHKey) error
+	VisitTOTP(totp *TOTP) error
+	VisitKV(kv *KV) error
 	GetResult() any
 }
 
 // Secret interface to implement double dispatch with algorithm decoupling.
 type Secret interface {
 	Accept(visitor SecretVisitor) error
+	Meta() *SecretMetadata
 }
 
 // SecretMetadata represents secret metadata to be saved in DB.
@@ -27,12 +31,25 @@ type SecretMetadata struct {
 	EncryptedDataKey []byte
 	CreatedBy        string
 	ModifiedBy       string
+	// RequestedBy is the user performing the operation, storage checks their access to the secret.
+	RequestedBy string
+}
+
+// Meta gives access to the metadata common to all secret types.
+func (m *SecretMetadata) Meta() *SecretMetadata {
+	return m
 }
 
 type Login struct {
 	LoginID  int64
 	Login    string
 	Password []byte
+	// TOTP is the otpauth URI of the second factor, empty when none is attached.
+	TOTP []byte
+	// URIs are stored unencrypted so that logins can be found by URL.
+	URIs   []LoginURI
+	Notes  []byte
+	Fields []KVField
 
 	SecretMetadata
 }
@@ -48,6 +65,10 @@ type Card struct {
 	ExpiryMonth    int64
 	ExpiryYear     int64
 	CVC            []byte
+	// Brand is detected from the number by the validator and stored unencrypted.
+	Brand          string
+	BillingAddress []byte
+	PIN            []byte
 
 	SecretMetadata
 }
@@ -81,6 +102,59 @@ func (binary *Binary) Accept(v SecretVisitor) error {
 	return v.VisitBinary(binary)
 }
 
+// SSHKey keeps a private key with the public parts that identify it. The private key and its passphrase
+// are encrypted, the public key, comment and fingerprint are stored as is.
+type SSHKey struct {
+	SSHKeyID    int64
+	PrivateKey  []byte
+	PublicKey   string
+	Passphrase  []byte
+	Comment     string
+	Fingerprint string
+
+	SecretMetadata
+}
+
+func (key *SSHKey) Accept(v SecretVisitor) error {
+	return v.VisitSSHKey(key)
+}
+
+// TOTP keeps the seed of a second factor as an encrypted otpauth URI.
+type TOTP struct {
+	TOTPID int64
+	URI    []byte
+
+	SecretMetadata
+}
+
+func (totp *TOTP) Accept(v SecretVisitor) error {
+	return v.VisitTOTP(totp)
+}
+
+// KVField is a named value of a key-value secret. The value is encrypted, the name and the sensitive flag are not.
+type KVField struct {
+	Name  string
+	Value []byte
+	// Sensitive fields are masked when the secret is printed.
+	Sensitive bool
+}
+
+// KV keeps an arbitrary set of named fields such as API tokens, webhook secrets and connection strings.
+type KV struct {
+	KVID   int64
+	Fields []KVField
+	// Type is the name of the user-defined secret type the fields follow, empty for free-form fields.
+	Type string
+	// Schema is the definition of Type, loaded by the vault to validate the fields.
+	Schema *SecretType
+
+	SecretMetadata
+}
+
+func (kv *KV) Accept(v SecretVisitor) error {
+	return v.VisitKV(kv)
+}
+
 // IsLast indicates the final chunk which doesn't have any data, but contains full file hash.
 func (binary *Binary) IsLast() bool {
 	return binary.Data == nil && binary.Chunks > 0
@@ -0,0 +1,59 @@
+package models
+
+// Role defines what a member can do within an organization. Roles are ordered,
+// every role includes the abilities of the roles below it.
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+// Rank returns the position of the role in the hierarchy, zero for unknown roles.
+func (r Role) Rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2 //nolint:mnd // position in the hierarchy
+	case RoleAdmin:
+		return 3 //nolint:mnd // position in the hierarchy
+	case RoleOwner:
+		return 4 //nolint:mnd // position in the hierarchy
+	}
+	return 0
+}
+
+// Organization is an organization the user is a member of.
+type Organization struct {
+	Name string
+	Role Role
+}
+
+// Member is a user belonging to an organization.
+type Member struct {
+	Login string
+	Role  Role
+	Teams []string
+}
+
+// Team groups members of an organization which are given access to collections.
+type Team struct {
+	Name    string
+	Members []string
+}
+
+// CollectionTeam is a team assigned to a collection with the given role.
+type CollectionTeam struct {
+	Team string
+	Role Role
+}
+
+// Collection is a set of secrets owned by an organization.
+type Collection struct {
+	Name    string
+	Secrets []string
+	Teams   []CollectionTeam
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// FieldType is the type of a field value of a user-defined secret type.
+type FieldType string
+
+const (
+	FieldTypeString  FieldType = "string"
+	FieldTypeInteger FieldType = "integer"
+	FieldTypeNumber  FieldType = "number"
+	FieldTypeBoolean FieldType = "boolean"
+)
+
+// SchemaField describes a field of a user-defined secret type.
+type SchemaField struct {
+	Name        string    `json:"name"`
+	Type        FieldType `json:"type"`
+	Description string    `json:"description,omitempty"`
+	Required    bool      `json:"required,omitempty"`
+	// Sensitive fields are masked when the secret is printed.
+	Sensitive bool `json:"sensitive,omitempty"`
+	// Pattern is a regular expression the whole value has to match.
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// SecretType is a secret type registered by an administrator. Its secrets are stored as
+// key-value secrets whose fields are validated against the schema.
+type SecretType struct {
+	Name        string
+	Description string
+	Fields      []SchemaField
+	CreatedBy   string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Scope restricts what the tokens of a service account can do.
+type Scope struct {
+	ReadOnly bool `json:"read_only,omitempty"`
+	// PathPrefixes limit the accessible secrets, no prefixes allow every path.
+	PathPrefixes []string `json:"path_prefixes,omitempty"`
+}
+
+// AllowsPath reports whether the secret at the path is within the scope.
+func (s Scope) AllowsPath(path string) bool {
+	if len(s.PathPrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range s.PathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceAccount is a non-interactive user owned by a regular user, used by automation.
+type ServiceAccount struct {
+	Name      string
+	Owner     string
+	CreatedAt time.Time
+}
+
+// APIKey is a long-lived credential of a service account. Only its hash is stored,
+// the key itself is shown once when it is created.
+type APIKey struct {
+	ID         string
+	Account    string
+	Scope      Scope
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+}
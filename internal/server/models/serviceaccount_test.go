@@ -0,0 +1,19 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+func TestScopeAllowsPath(t *testing.T) {
+	assert.True(t, models.Scope{}.AllowsPath("anything"))
+
+	scope := models.Scope{PathPrefixes: []string{"deploy/", "ci/"}}
+	assert.True(t, scope.AllowsPath("deploy/db"))
+	assert.True(t, scope.AllowsPath("ci/token"))
+	assert.False(t, scope.AllowsPath("personal/bank"))
+	assert.False(t, scope.AllowsPath("deploy"))
+}
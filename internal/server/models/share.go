@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Permission defines the access level granted to a user a secret is shared with.
+type Permission string
+
+const (
+	PermissionRead      Permission = "read"
+	PermissionReadWrite Permission = "read-write"
+)
+
+// Allows reports whether the permission grants the required access level.
+func (p Permission) Allows(required Permission) bool {
+	switch required {
+	case PermissionRead:
+		return p == PermissionRead || p == PermissionReadWrite
+	case PermissionReadWrite:
+		return p == PermissionReadWrite
+	}
+	return false
+}
+
+// Share is an entry of the secret access control list.
+type Share struct {
+	Path       string
+	Type       VaultItemType
+	Owner      string
+	Grantee    string
+	Permission Permission
+	CreatedAt  time.Time
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// EncryptedSigningKey is a JWT signing key as it is stored, the private key is encrypted with the data key.
+type EncryptedSigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey []byte
+	DataKey    []byte
+	CreatedAt  time.Time
+}
@@ -9,6 +9,9 @@ const (
 	LoginType  VaultItemType = "login"
 	NoteType   VaultItemType = "note"
 	BinaryType VaultItemType = "binary"
+	SSHKeyType VaultItemType = "ssh_key"
+	TOTPType   VaultItemType = "totp"
+	KVType     VaultItemType = "kv"
 )
 
 type SecretOptions struct {
@@ -19,6 +22,7 @@ type SecretOptions struct {
 	CustomMetadata   map[string]string
 	CreatedBy        string
 	ModifiedBy       string
+	RequestedBy      string
 }
 
 type SecretOption func(*SecretOptions)
@@ -59,11 +63,20 @@ func WithModifiedBy(modifiedBy string) SecretOption {
 	}
 }
 
+func WithRequestedBy(requestedBy string) SecretOption {
+	return func(o *SecretOptions) {
+		o.RequestedBy = requestedBy
+	}
+}
+
 // Login-specific options.
 type LoginOptions struct {
 	Login    string
 	Password string
-	URL      string
+	URIs     []LoginURI
+	TOTP     string
+	Notes    string
+	Fields   []KVField
 
 	SecretOptions
 }
@@ -82,6 +95,37 @@ func WithPassword(password string) LoginOption {
 	}
 }
 
+// WithTOTP attaches the otpauth URI of a second factor.
+func WithTOTP(uri string) LoginOption {
+	return func(o *LoginOptions) {
+		o.TOTP = uri
+	}
+}
+
+// WithURI adds a website the login is used for, an empty match defaults to the base domain.
+func WithURI(uri string, match URIMatch) LoginOption {
+	return func(o *LoginOptions) {
+		if match == "" {
+			match = URIMatchBaseDomain
+		}
+		o.URIs = append(o.URIs, LoginURI{URI: uri, Match: match})
+	}
+}
+
+// WithNotes attaches free-form notes.
+func WithNotes(notes string) LoginOption {
+	return func(o *LoginOptions) {
+		o.Notes = notes
+	}
+}
+
+// WithLoginField adds a custom field such as a security question or an account number.
+func WithLoginField(name, value string, sensitive bool) LoginOption {
+	return func(o *LoginOptions) {
+		o.Fields = append(o.Fields, KVField{Name: name, Value: []byte(value), Sensitive: sensitive})
+	}
+}
+
 // Card-specific options.
 type CardOptions struct {
 	Number      string
@@ -89,6 +133,8 @@ type CardOptions struct {
 	ExpiryMonth int64
 	ExpiryYear  int64
 	CardHolder  string
+	Address     string
+	PIN         string
 
 	SecretOptions
 }
@@ -120,6 +166,18 @@ func WithCardHolder(name string) CardOption {
 	}
 }
 
+func WithBillingAddress(address string) CardOption {
+	return func(o *CardOptions) {
+		o.Address = address
+	}
+}
+
+func WithPIN(pin string) CardOption {
+	return func(o *CardOptions) {
+		o.PIN = pin
+	}
+}
+
 // Note-specific options.
 type NoteOptions struct {
 	Text string
@@ -171,6 +229,80 @@ func WithData(data []byte) BinaryOption {
 	}
 }
 
+// SSH key specific options.
+type SSHKeyOptions struct {
+	PrivateKey string
+	PublicKey  string
+	Passphrase string
+	Comment    string
+
+	SecretOptions
+}
+
+type SSHKeyOption func(*SSHKeyOptions)
+
+func WithPrivateKey(key string) SSHKeyOption {
+	return func(o *SSHKeyOptions) {
+		o.PrivateKey = key
+	}
+}
+
+func WithPublicKey(key string) SSHKeyOption {
+	return func(o *SSHKeyOptions) {
+		o.PublicKey = key
+	}
+}
+
+func WithPassphrase(passphrase string) SSHKeyOption {
+	return func(o *SSHKeyOptions) {
+		o.Passphrase = passphrase
+	}
+}
+
+func WithComment(comment string) SSHKeyOption {
+	return func(o *SSHKeyOptions) {
+		o.Comment = comment
+	}
+}
+
+// TOTP specific options.
+type TOTPOptions struct {
+	URI string
+
+	SecretOptions
+}
+
+type TOTPOption func(*TOTPOptions)
+
+func WithOTPAuthURI(uri string) TOTPOption {
+	return func(o *TOTPOptions) {
+		o.URI = uri
+	}
+}
+
+type KVOptions struct {
+	Fields []KVField
+	Type   string
+
+	SecretOptions
+}
+
+type KVOption func(*KVOptions)
+
+// WithField appends a named field, sensitive fields are masked when the secret is printed.
+func WithField(name, value string, sensitive bool) KVOption {
+	return func(o *KVOptions) {
+		o.Fields = append(o.Fields, KVField{Name: name, Value: []byte(value), Sensitive: sensitive})
+	}
+}
+
+// WithSecretType binds the fields to a user-defined secret type.
+func WithSecretType(name string) KVOption {
+	return func(o *KVOptions) {
+		o.Type = name
+	}
+}
+
 // Factory functions.
 func NewLogin(commonOpts []SecretOption, loginOpts []LoginOption) *Login {
 	// Initialize with defaults
@@ -202,9 +334,14 @@ func NewLogin(commonOpts []SecretOption, loginOpts []LoginOption) *Login {
 			CustomMeta:       options.CustomMetadata,
 			CreatedBy:        options.CreatedBy,
 			ModifiedBy:       options.ModifiedBy,
+			RequestedBy:      options.RequestedBy,
 		},
 		Login:    options.Login,
 		Password: []byte(options.Password),
+		TOTP:     []byte(options.TOTP),
+		URIs:     options.URIs,
+		Notes:    []byte(options.Notes),
+		Fields:   options.Fields,
 	}
 }
 
@@ -234,12 +371,15 @@ func NewCard(commonOpts []SecretOption, cardOpts []CardOption) *Card {
 			CustomMeta:       options.CustomMetadata,
 			CreatedBy:        options.CreatedBy,
 			ModifiedBy:       options.ModifiedBy,
+			RequestedBy:      options.RequestedBy,
 		},
 		Number:         []byte(options.Number),
 		CVC:            []byte(options.CVC),
 		ExpiryMonth:    options.ExpiryMonth,
 		ExpiryYear:     options.ExpiryYear,
 		CardholderName: options.CardHolder,
+		BillingAddress: []byte(options.Address),
+		PIN:            []byte(options.PIN),
 	}
 }
 
@@ -273,6 +413,7 @@ func NewNote(commonOpts []SecretOption, noteOpts []NoteOption) *Note {
 			CustomMeta:       options.CustomMetadata,
 			CreatedBy:        options.CreatedBy,
 			ModifiedBy:       options.ModifiedBy,
+			RequestedBy:      options.RequestedBy,
 		},
 		Text: []byte(options.Text),
 	}
@@ -308,6 +449,7 @@ func NewBinary(commonOpts []SecretOption, binaryOpts []BinaryOption) *Binary {
 			CustomMeta:       options.CustomMetadata,
 			CreatedBy:        options.CreatedBy,
 			ModifiedBy:       options.ModifiedBy,
+			RequestedBy:      options.RequestedBy,
 		},
 		ChunkID: options.ChunkID,
 		Chunks:  options.Chunks,
@@ -315,3 +457,103 @@ func NewBinary(commonOpts []SecretOption, binaryOpts []BinaryOption) *Binary {
 		Data:    options.Data,
 	}
 }
+
+func NewSSHKey(commonOpts []SecretOption, keyOpts []SSHKeyOption) *SSHKey {
+	options := &SSHKeyOptions{
+		SecretOptions: SecretOptions{
+			CreatedAt:      time.Now(),
+			ModifiedAt:     time.Now(),
+			CustomMetadata: make(map[string]string),
+		},
+	}
+
+	for _, opt := range commonOpts {
+		opt(&options.SecretOptions)
+	}
+
+	for _, opt := range keyOpts {
+		opt(options)
+	}
+
+	return &SSHKey{
+		SecretMetadata: SecretMetadata{
+			Path:             options.Path,
+			CreatedAt:        options.CreatedAt,
+			ModifiedAt:       options.ModifiedAt,
+			EncryptedDataKey: options.EncryptedDataKey,
+			CustomMeta:       options.CustomMetadata,
+			CreatedBy:        options.CreatedBy,
+			ModifiedBy:       options.ModifiedBy,
+			RequestedBy:      options.RequestedBy,
+		},
+		PrivateKey: []byte(options.PrivateKey),
+		PublicKey:  options.PublicKey,
+		Passphrase: []byte(options.Passphrase),
+		Comment:    options.Comment,
+	}
+}
+
+func NewTOTP(commonOpts []SecretOption, totpOpts []TOTPOption) *TOTP {
+	options := &TOTPOptions{
+		SecretOptions: SecretOptions{
+			CreatedAt:      time.Now(),
+			ModifiedAt:     time.Now(),
+			CustomMetadata: make(map[string]string),
+		},
+	}
+
+	for _, opt := range commonOpts {
+		opt(&options.SecretOptions)
+	}
+
+	for _, opt := range totpOpts {
+		opt(options)
+	}
+
+	return &TOTP{
+		SecretMetadata: SecretMetadata{
+			Path:             options.Path,
+			CreatedAt:        options.CreatedAt,
+			ModifiedAt:       options.ModifiedAt,
+			EncryptedDataKey: options.EncryptedDataKey,
+			CustomMeta:       options.CustomMetadata,
+			CreatedBy:        options.CreatedBy,
+			ModifiedBy:       options.ModifiedBy,
+			RequestedBy:      options.RequestedBy,
+		},
+		URI: []byte(options.URI),
+	}
+}
+
+func NewKV(commonOpts []SecretOption, kvOpts []KVOption) *KV {
+	options := &KVOptions{
+		SecretOptions: SecretOptions{
+			CreatedAt:      time.Now(),
+			ModifiedAt:     time.Now(),
+			CustomMetadata: make(map[string]string),
+		},
+	}
+
+	for _, opt := range commonOpts {
+		opt(&options.SecretOptions)
+	}
+
+	for _, opt := range kvOpts {
+		opt(options)
+	}
+
+	return &KV{
+		SecretMetadata: SecretMetadata{
+			Path:             options.Path,
+			CreatedAt:        options.CreatedAt,
+			ModifiedAt:       options.ModifiedAt,
+			EncryptedDataKey: options.EncryptedDataKey,
+			CustomMeta:       options.CustomMetadata,
+			CreatedBy:        options.CreatedBy,
+			ModifiedBy:       options.ModifiedBy,
+			RequestedBy:      options.RequestedBy,
+		},
+		Fields: options.Fields,
+		Type:   options.Type,
+	}
+}
@@ -26,7 +26,30 @@ func (enc *Decryptor) VisitLogin(login *models.Login) error {
 		return fmt.Errorf("cannot decrypt password: %w", err)
 	}
 
-	login.Password = buf.Bytes()
+	login.Password = append([]byte(nil), buf.Bytes()...)
+
+	if len(login.TOTP) > 0 {
+		buf.Reset()
+		if err = enc.encryptionService.Decrypt(login.TOTP, &buf, login.EncryptedDataKey); err != nil {
+			return fmt.Errorf("cannot decrypt totp: %w", err)
+		}
+		login.TOTP = append([]byte(nil), buf.Bytes()...)
+	}
+	if len(login.Notes) > 0 {
+		buf.Reset()
+		if err = enc.encryptionService.Decrypt(login.Notes, &buf, login.EncryptedDataKey); err != nil {
+			return fmt.Errorf("cannot decrypt notes: %w", err)
+		}
+		login.Notes = append([]byte(nil), buf.Bytes()...)
+	}
+	for i := range login.Fields {
+		var fieldBuf buffer.Buffer
+		field := &login.Fields[i]
+		if err = enc.encryptionService.Decrypt(field.Value, &fieldBuf, login.EncryptedDataKey); err != nil {
+			return fmt.Errorf("cannot decrypt field %s: %w", field.Name, err)
+		}
+		field.Value = fieldBuf.Bytes()
+	}
 
 	return nil
 }
@@ -46,7 +69,22 @@ func (enc *Decryptor) VisitCard(card *models.Card) error {
 	if err != nil {
 		return fmt.Errorf("cannot decrypt cvc code: %w", err)
 	}
-	card.CVC = buf.Bytes()
+	card.CVC = append([]byte(nil), buf.Bytes()...)
+
+	if len(card.BillingAddress) > 0 {
+		buf.Reset()
+		if err = enc.encryptionService.Decrypt(card.BillingAddress, &buf, key); err != nil {
+			return fmt.Errorf("cannot decrypt billing address: %w", err)
+		}
+		card.BillingAddress = append([]byte(nil), buf.Bytes()...)
+	}
+	if len(card.PIN) > 0 {
+		buf.Reset()
+		if err = enc.encryptionService.Decrypt(card.PIN, &buf, key); err != nil {
+			return fmt.Errorf("cannot decrypt pin: %w", err)
+		}
+		card.PIN = buf.Bytes()
+	}
 
 	return nil
 }
@@ -63,6 +101,50 @@ func (enc *Decryptor) VisitNote(note *models.Note) error {
 	return nil
 }
 
+func (enc *Decryptor) VisitSSHKey(key *models.SSHKey) error {
+	var buf buffer.Buffer
+
+	err := enc.encryptionService.Decrypt(key.PrivateKey, &buf, key.EncryptedDataKey)
+	if err != nil {
+		return fmt.Errorf("cannot decrypt private key: %w", err)
+	}
+	key.PrivateKey = append([]byte(nil), buf.Bytes()...)
+	buf.Reset()
+
+	err = enc.encryptionService.Decrypt(key.Passphrase, &buf, key.EncryptedDataKey)
+	if err != nil {
+		return fmt.Errorf("cannot decrypt passphrase: %w", err)
+	}
+	key.Passphrase = buf.Bytes()
+
+	return nil
+}
+
+func (enc *Decryptor) VisitTOTP(secret *models.TOTP) error {
+	var buf buffer.Buffer
+	err := enc.encryptionService.Decrypt(secret.URI, &buf, secret.EncryptedDataKey)
+	if err != nil {
+		return fmt.Errorf("cannot decrypt totp: %w", err)
+	}
+
+	secret.URI = buf.Bytes()
+
+	return nil
+}
+
+func (enc *Decryptor) VisitKV(kv *models.KV) error {
+	for i := range kv.Fields {
+		var buf buffer.Buffer
+		field := &kv.Fields[i]
+		if err := enc.encryptionService.Decrypt(field.Value, &buf, kv.EncryptedDataKey); err != nil {
+			return fmt.Errorf("cannot decrypt field %s: %w", field.Name, err)
+		}
+		field.Value = buf.Bytes()
+	}
+
+	return nil
+}
+
 func (enc *Decryptor) VisitBinary(binary *models.Binary) error {
 	if binary.IsLast() {
 		return nil
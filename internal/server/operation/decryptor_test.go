@@ -79,6 +79,34 @@ func TestDecryptor_VisitLogin(t *testing.T) {
 	}
 }
 
+func TestDecryptor_VisitLoginNotesAndFields(t *testing.T) {
+	mockService := mocks.NewEncryptionService(t)
+	for encrypted, plain := range map[string]string{
+		"encryptedpassword": "mysecretpassword",
+		"encryptednotes":    "recovery codes in the safe",
+		"encryptedfield":    "1234-5678",
+	} {
+		mockService.EXPECT().
+			Decrypt([]byte(encrypted), mock.Anything, []byte("encrypteddatakey")).
+			Run(func(_ []byte, dst io.Writer, _ []byte) {
+				_, _ = dst.Write([]byte(plain))
+			}).
+			Return(nil).Once()
+	}
+	visitor := operation.NewDecryptor(mockService)
+
+	login := &models.Login{
+		Password:       []byte("encryptedpassword"),
+		Notes:          []byte("encryptednotes"),
+		Fields:         []models.KVField{{Name: "recovery_code", Value: []byte("encryptedfield")}},
+		SecretMetadata: models.SecretMetadata{EncryptedDataKey: []byte("encrypteddatakey")},
+	}
+	require.NoError(t, visitor.VisitLogin(login))
+	assert.Equal(t, []byte("mysecretpassword"), login.Password)
+	assert.Equal(t, []byte("recovery codes in the safe"), login.Notes)
+	assert.Equal(t, []byte("1234-5678"), login.Fields[0].Value)
+}
+
 func TestDecryptor_VisitCard(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -182,6 +210,33 @@ func TestDecryptor_VisitCard(t *testing.T) {
 	}
 }
 
+func TestDecryptor_VisitCardOptionalFields(t *testing.T) {
+	mockService := mocks.NewEncryptionService(t)
+	for encrypted, plain := range map[string]string{
+		"encryptednumber":  "4111111111111111",
+		"encryptedcvc":     "123",
+		"encryptedaddress": "1 Main St, NYC",
+	} {
+		mockService.EXPECT().
+			Decrypt([]byte(encrypted), mock.Anything, []byte("encrypteddatakey")).
+			Run(func(_ []byte, dst io.Writer, _ []byte) {
+				_, _ = dst.Write([]byte(plain))
+			}).
+			Return(nil).Once()
+	}
+	visitor := operation.NewDecryptor(mockService)
+
+	card := &models.Card{
+		Number:         []byte("encryptednumber"),
+		CVC:            []byte("encryptedcvc"),
+		BillingAddress: []byte("encryptedaddress"),
+		SecretMetadata: models.SecretMetadata{EncryptedDataKey: []byte("encrypteddatakey")},
+	}
+	require.NoError(t, visitor.VisitCard(card))
+	assert.Equal(t, []byte("1 Main St, NYC"), card.BillingAddress)
+	assert.Empty(t, card.PIN, "a card without a pin is not decrypted")
+}
+
 func TestDecryptor_VisitNote(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -311,3 +366,125 @@ func TestDecryptor_VisitBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestDecryptor_VisitSSHKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func(*mocks.EncryptionService)
+		expectError bool
+	}{
+		{
+			name: "successful ssh key decryption",
+			setupMock: func(m *mocks.EncryptionService) {
+				m.EXPECT().
+					Decrypt([]byte("encryptedkey"), mock.Anything, []byte("encrypteddatakey")).
+					Run(func(_ []byte, dst io.Writer, _ []byte) {
+						_, _ = dst.Write([]byte("privatekey"))
+					}).
+					Return(nil).Once()
+				m.EXPECT().
+					Decrypt([]byte("encryptedpassphrase"), mock.Anything, []byte("encrypteddatakey")).
+					Run(func(_ []byte, dst io.Writer, _ []byte) {
+						_, _ = dst.Write([]byte("passphrase"))
+					}).
+					Return(nil).Once()
+			},
+		},
+		{
+			name: "private key decryption failure",
+			setupMock: func(m *mocks.EncryptionService) {
+				m.EXPECT().
+					Decrypt(mock.Anything, mock.Anything, []byte("encrypteddatakey")).
+					Return(errors.New("key decryption failed")).
+					Once()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewEncryptionService(t)
+			tt.setupMock(mockService)
+			key := &models.SSHKey{
+				PrivateKey: []byte("encryptedkey"),
+				Passphrase: []byte("encryptedpassphrase"),
+				SecretMetadata: models.SecretMetadata{
+					EncryptedDataKey: []byte("encrypteddatakey"),
+				},
+			}
+
+			err := operation.NewDecryptor(mockService).VisitSSHKey(key)
+
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []byte("privatekey"), key.PrivateKey)
+			assert.Equal(t, []byte("passphrase"), key.Passphrase)
+		})
+	}
+}
+
+func TestDecryptor_VisitTOTP(t *testing.T) {
+	mockService := mocks.NewEncryptionService(t)
+	mockService.EXPECT().
+		Decrypt([]byte("encrypteduri"), mock.Anything, []byte("encrypteddatakey")).
+		Run(func(_ []byte, dst io.Writer, _ []byte) {
+			_, _ = dst.Write([]byte("otpauth://totp/GitHub:mark"))
+		}).
+		Return(nil).Once()
+	mockService.EXPECT().
+		Decrypt([]byte("encryptedpassword"), mock.Anything, []byte("encrypteddatakey")).
+		Run(func(_ []byte, dst io.Writer, _ []byte) {
+			_, _ = dst.Write([]byte("decryptedpassword"))
+		}).
+		Return(nil).Once()
+	mockService.EXPECT().
+		Decrypt([]byte("encryptedtotp"), mock.Anything, []byte("encrypteddatakey")).
+		Return(errors.New("totp decryption failed")).Once()
+	visitor := operation.NewDecryptor(mockService)
+
+	secret := &models.TOTP{
+		URI:            []byte("encrypteduri"),
+		SecretMetadata: models.SecretMetadata{EncryptedDataKey: []byte("encrypteddatakey")},
+	}
+	require.NoError(t, visitor.VisitTOTP(secret))
+	assert.Equal(t, []byte("otpauth://totp/GitHub:mark"), secret.URI)
+
+	login := &models.Login{
+		Password:       []byte("encryptedpassword"),
+		TOTP:           []byte("encryptedtotp"),
+		SecretMetadata: models.SecretMetadata{EncryptedDataKey: []byte("encrypteddatakey")},
+	}
+	err := visitor.VisitLogin(login)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot decrypt totp")
+}
+
+func TestDecryptor_VisitKV(t *testing.T) {
+	mockService := mocks.NewEncryptionService(t)
+	mockService.EXPECT().
+		Decrypt([]byte("encryptedurl"), mock.Anything, []byte("encrypteddatakey")).
+		Run(func(_ []byte, dst io.Writer, _ []byte) {
+			_, _ = dst.Write([]byte("https://hooks.example.com"))
+		}).
+		Return(nil).Once()
+	mockService.EXPECT().
+		Decrypt([]byte("encryptedsecret"), mock.Anything, []byte("encrypteddatakey")).
+		Return(errors.New("decryption failed")).Once()
+	visitor := operation.NewDecryptor(mockService)
+
+	kv := &models.KV{
+		Fields: []models.KVField{
+			{Name: "url", Value: []byte("encryptedurl")},
+			{Name: "signing_secret", Value: []byte("encryptedsecret"), Sensitive: true},
+		},
+		SecretMetadata: models.SecretMetadata{EncryptedDataKey: []byte("encrypteddatakey")},
+	}
+	err := visitor.VisitKV(kv)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot decrypt field signing_secret")
+	assert.Equal(t, []byte("https://hooks.example.com"), kv.Fields[0].Value)
+}
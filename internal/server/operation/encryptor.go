@@ -27,7 +27,30 @@ func (enc *Encryptor) VisitLogin(login *models.Login) error {
 	}
 
 	login.EncryptedDataKey = encDataKey
-	login.Password = buf.Bytes()
+	login.Password = append([]byte(nil), buf.Bytes()...)
+
+	if len(login.TOTP) > 0 {
+		buf.Reset()
+		if err = enc.encryptionService.EncryptWithKey(login.TOTP, &buf, encDataKey); err != nil {
+			return fmt.Errorf("cannot encrypt totp: %w", err)
+		}
+		login.TOTP = append([]byte(nil), buf.Bytes()...)
+	}
+	if len(login.Notes) > 0 {
+		buf.Reset()
+		if err = enc.encryptionService.EncryptWithKey(login.Notes, &buf, encDataKey); err != nil {
+			return fmt.Errorf("cannot encrypt notes: %w", err)
+		}
+		login.Notes = append([]byte(nil), buf.Bytes()...)
+	}
+	for i := range login.Fields {
+		var fieldBuf buffer.Buffer
+		field := &login.Fields[i]
+		if err = enc.encryptionService.EncryptWithKey(field.Value, &fieldBuf, encDataKey); err != nil {
+			return fmt.Errorf("cannot encrypt field %s: %w", field.Name, err)
+		}
+		field.Value = fieldBuf.Bytes()
+	}
 
 	return nil
 }
@@ -47,7 +70,22 @@ func (enc *Encryptor) VisitCard(card *models.Card) error {
 	if err != nil {
 		return fmt.Errorf("cannot encrypt cvc code: %w", err)
 	}
-	card.CVC = buf.Bytes()
+	card.CVC = append([]byte(nil), buf.Bytes()...)
+
+	if len(card.BillingAddress) > 0 {
+		buf.Reset()
+		if err = enc.encryptionService.EncryptWithKey(card.BillingAddress, &buf, encDataKey); err != nil {
+			return fmt.Errorf("cannot encrypt billing address: %w", err)
+		}
+		card.BillingAddress = append([]byte(nil), buf.Bytes()...)
+	}
+	if len(card.PIN) > 0 {
+		buf.Reset()
+		if err = enc.encryptionService.EncryptWithKey(card.PIN, &buf, encDataKey); err != nil {
+			return fmt.Errorf("cannot encrypt pin: %w", err)
+		}
+		card.PIN = buf.Bytes()
+	}
 
 	return nil
 }
@@ -65,6 +103,59 @@ func (enc *Encryptor) VisitNote(note *models.Note) error {
 	return nil
 }
 
+func (enc *Encryptor) VisitSSHKey(key *models.SSHKey) error {
+	var buf buffer.Buffer
+
+	encDataKey, err := enc.encryptionService.Encrypt(key.PrivateKey, &buf)
+	if err != nil {
+		return fmt.Errorf("cannot encrypt private key: %w", err)
+	}
+	key.EncryptedDataKey = encDataKey
+	key.PrivateKey = append([]byte(nil), buf.Bytes()...)
+	buf.Reset()
+
+	err = enc.encryptionService.EncryptWithKey(key.Passphrase, &buf, encDataKey)
+	if err != nil {
+		return fmt.Errorf("cannot encrypt passphrase: %w", err)
+	}
+	key.Passphrase = buf.Bytes()
+
+	return nil
+}
+
+func (enc *Encryptor) VisitTOTP(secret *models.TOTP) error {
+	var buf buffer.Buffer
+
+	encDataKey, err := enc.encryptionService.Encrypt(secret.URI, &buf)
+	if err != nil {
+		return fmt.Errorf("cannot encrypt totp: %w", err)
+	}
+	secret.EncryptedDataKey = encDataKey
+	secret.URI = buf.Bytes()
+
+	return nil
+}
+
+// VisitKV encrypts every field value with the data key of the first one.
+func (enc *Encryptor) VisitKV(kv *models.KV) error {
+	for i := range kv.Fields {
+		var buf buffer.Buffer
+		field := &kv.Fields[i]
+		if i == 0 {
+			encDataKey, err := enc.encryptionService.Encrypt(field.Value, &buf)
+			if err != nil {
+				return fmt.Errorf("cannot encrypt field %s: %w", field.Name, err)
+			}
+			kv.EncryptedDataKey = encDataKey
+		} else if err := enc.encryptionService.EncryptWithKey(field.Value, &buf, kv.EncryptedDataKey); err != nil {
+			return fmt.Errorf("cannot encrypt field %s: %w", field.Name, err)
+		}
+		field.Value = buf.Bytes()
+	}
+
+	return nil
+}
+
 func (enc *Encryptor) VisitBinary(binary *models.Binary) error {
 	if binary.IsLast() {
 		return nil
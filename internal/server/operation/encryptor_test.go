@@ -69,6 +69,41 @@ func TestEncryptor_VisitLogin(t *testing.T) {
 	}
 }
 
+func TestEncryptor_VisitLoginNotesAndFields(t *testing.T) {
+	mockService := mocks.NewEncryptionService(t)
+	mockService.EXPECT().
+		Encrypt([]byte("mysecretpassword"), mock.Anything).
+		Run(func(_ []byte, dst io.Writer) {
+			_, _ = dst.Write([]byte("encryptedpassword"))
+		}).
+		Return([]byte("encrypteddatakey"), nil).
+		Once()
+	for plain, encrypted := range map[string]string{
+		"recovery codes in the safe": "encryptednotes",
+		"1234-5678":                  "encryptedfield",
+	} {
+		mockService.EXPECT().
+			EncryptWithKey([]byte(plain), mock.Anything, []byte("encrypteddatakey")).
+			Run(func(_ []byte, dst io.Writer, _ []byte) {
+				_, _ = dst.Write([]byte(encrypted))
+			}).
+			Return(nil).
+			Once()
+	}
+	visitor := operation.NewEncryptor(mockService)
+
+	login := &models.Login{
+		Password: []byte("mysecretpassword"),
+		URIs:     []models.LoginURI{{URI: "github.com", Match: models.URIMatchBaseDomain}},
+		Notes:    []byte("recovery codes in the safe"),
+		Fields:   []models.KVField{{Name: "recovery_code", Value: []byte("1234-5678"), Sensitive: true}},
+	}
+	require.NoError(t, visitor.VisitLogin(login))
+	assert.Equal(t, []byte("encryptednotes"), login.Notes)
+	assert.Equal(t, []byte("encryptedfield"), login.Fields[0].Value)
+	assert.Equal(t, "github.com", login.URIs[0].URI, "uris are stored unencrypted")
+}
+
 func TestEncryptor_VisitCard(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -157,6 +192,44 @@ func TestEncryptor_VisitCard(t *testing.T) {
 	}
 }
 
+func TestEncryptor_VisitCardOptionalFields(t *testing.T) {
+	mockService := mocks.NewEncryptionService(t)
+	mockService.EXPECT().
+		Encrypt([]byte("4111111111111111"), mock.Anything).
+		Run(func(_ []byte, dst io.Writer) {
+			_, _ = dst.Write([]byte("encryptednumber"))
+		}).
+		Return([]byte("encrypteddatakey"), nil).
+		Once()
+	for plain, encrypted := range map[string]string{
+		"123":            "encryptedcvc",
+		"1 Main St, NYC": "encryptedaddress",
+		"4321":           "encryptedpin",
+	} {
+		mockService.EXPECT().
+			EncryptWithKey([]byte(plain), mock.Anything, []byte("encrypteddatakey")).
+			Run(func(_ []byte, dst io.Writer, _ []byte) {
+				_, _ = dst.Write([]byte(encrypted))
+			}).
+			Return(nil).
+			Once()
+	}
+	visitor := operation.NewEncryptor(mockService)
+
+	card := &models.Card{
+		Number:         []byte("4111111111111111"),
+		CVC:            []byte("123"),
+		BillingAddress: []byte("1 Main St, NYC"),
+		PIN:            []byte("4321"),
+		Brand:          "Visa",
+	}
+	require.NoError(t, visitor.VisitCard(card))
+	assert.Equal(t, []byte("encryptedcvc"), card.CVC)
+	assert.Equal(t, []byte("encryptedaddress"), card.BillingAddress)
+	assert.Equal(t, []byte("encryptedpin"), card.PIN)
+	assert.Equal(t, "Visa", card.Brand, "the brand is stored unencrypted")
+}
+
 func TestEncryptor_VisitNote(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -266,3 +339,134 @@ func TestEncryptor_VisitBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestEncryptor_VisitSSHKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func(*mocks.EncryptionService)
+		expectError bool
+	}{
+		{
+			name: "successful ssh key encryption",
+			setupMock: func(m *mocks.EncryptionService) {
+				m.EXPECT().
+					Encrypt([]byte("privatekey"), mock.Anything).
+					Run(func(_ []byte, dst io.Writer) {
+						_, _ = dst.Write([]byte("encryptedkey"))
+					}).
+					Return([]byte("encrypteddatakey"), nil).
+					Once()
+				m.EXPECT().
+					EncryptWithKey([]byte("passphrase"), mock.Anything, []byte("encrypteddatakey")).
+					Run(func(_ []byte, dst io.Writer, _ []byte) {
+						_, _ = dst.Write([]byte("encryptedpassphrase"))
+					}).
+					Return(nil).
+					Once()
+			},
+		},
+		{
+			name: "passphrase encryption failure",
+			setupMock: func(m *mocks.EncryptionService) {
+				m.EXPECT().
+					Encrypt(mock.Anything, mock.Anything).
+					Return([]byte("encrypteddatakey"), nil)
+				m.EXPECT().
+					EncryptWithKey(mock.Anything, mock.Anything, []byte("encrypteddatakey")).
+					Return(errors.New("passphrase encryption failed"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewEncryptionService(t)
+			tt.setupMock(mockService)
+			key := &models.SSHKey{
+				PrivateKey:  []byte("privatekey"),
+				Passphrase:  []byte("passphrase"),
+				PublicKey:   "ssh-ed25519 AAAA",
+				Fingerprint: "SHA256:fingerprint",
+			}
+
+			err := operation.NewEncryptor(mockService).VisitSSHKey(key)
+
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []byte("encrypteddatakey"), key.EncryptedDataKey)
+			assert.Equal(t, []byte("encryptedkey"), key.PrivateKey)
+			assert.Equal(t, []byte("encryptedpassphrase"), key.Passphrase)
+			assert.Equal(t, "ssh-ed25519 AAAA", key.PublicKey, "the public parts are kept as is")
+		})
+	}
+}
+
+func TestEncryptor_VisitTOTP(t *testing.T) {
+	mockService := mocks.NewEncryptionService(t)
+	mockService.EXPECT().
+		Encrypt([]byte("otpauth://totp/GitHub:mark"), mock.Anything).
+		Run(func(_ []byte, dst io.Writer) {
+			_, _ = dst.Write([]byte("encrypteduri"))
+		}).
+		Return([]byte("encrypteddatakey"), nil).
+		Once()
+	mockService.EXPECT().
+		Encrypt([]byte("mysecretpassword"), mock.Anything).
+		Run(func(_ []byte, dst io.Writer) {
+			_, _ = dst.Write([]byte("encryptedpassword"))
+		}).
+		Return([]byte("encrypteddatakey"), nil).
+		Once()
+	mockService.EXPECT().
+		EncryptWithKey([]byte("otpauth://totp/GitHub:mark"), mock.Anything, []byte("encrypteddatakey")).
+		Run(func(_ []byte, dst io.Writer, _ []byte) {
+			_, _ = dst.Write([]byte("encryptedtotp"))
+		}).
+		Return(nil).
+		Once()
+	visitor := operation.NewEncryptor(mockService)
+
+	secret := &models.TOTP{URI: []byte("otpauth://totp/GitHub:mark")}
+	require.NoError(t, visitor.VisitTOTP(secret))
+	assert.Equal(t, []byte("encrypteddatakey"), secret.EncryptedDataKey)
+	assert.Equal(t, []byte("encrypteduri"), secret.URI)
+
+	login := &models.Login{Password: []byte("mysecretpassword"), TOTP: []byte("otpauth://totp/GitHub:mark")}
+	require.NoError(t, visitor.VisitLogin(login))
+	assert.Equal(t, []byte("encryptedpassword"), login.Password)
+	assert.Equal(t, []byte("encryptedtotp"), login.TOTP, "the totp of a login shares its data key")
+}
+
+func TestEncryptor_VisitKV(t *testing.T) {
+	mockService := mocks.NewEncryptionService(t)
+	mockService.EXPECT().
+		Encrypt([]byte("https://hooks.example.com"), mock.Anything).
+		Run(func(_ []byte, dst io.Writer) {
+			_, _ = dst.Write([]byte("encryptedurl"))
+		}).
+		Return([]byte("encrypteddatakey"), nil).
+		Once()
+	mockService.EXPECT().
+		EncryptWithKey([]byte("whsec_123"), mock.Anything, []byte("encrypteddatakey")).
+		Run(func(_ []byte, dst io.Writer, _ []byte) {
+			_, _ = dst.Write([]byte("encryptedsecret"))
+		}).
+		Return(nil).
+		Once()
+	visitor := operation.NewEncryptor(mockService)
+
+	kv := &models.KV{Fields: []models.KVField{
+		{Name: "url", Value: []byte("https://hooks.example.com")},
+		{Name: "signing_secret", Value: []byte("whsec_123"), Sensitive: true},
+	}}
+	require.NoError(t, visitor.VisitKV(kv))
+	assert.Equal(t, []byte("encrypteddatakey"), kv.EncryptedDataKey)
+	assert.Equal(t, []models.KVField{
+		{Name: "url", Value: []byte("encryptedurl")},
+		{Name: "signing_secret", Value: []byte("encryptedsecret"), Sensitive: true},
+	}, kv.Fields)
+}
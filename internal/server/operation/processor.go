@@ -3,9 +3,12 @@ package operation
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/itallix/gophkeeper/internal/common/tracing"
 	"github.com/itallix/gophkeeper/internal/server/models"
 	"github.com/itallix/gophkeeper/internal/server/s3"
 	"github.com/itallix/gophkeeper/internal/server/service"
@@ -20,9 +23,18 @@ func NewSecretProcessor(visitors ...models.SecretVisitor) *SecretProcessor {
 	return &SecretProcessor{visitors: visitors}
 }
 
-func (p *SecretProcessor) Process(secret models.Secret) error {
+var tracer = tracing.Tracer("github.com/itallix/gophkeeper/internal/server/operation")
+
+// Process passes the secret to the visitors in order, each of them traced as a child span of ctx.
+// The visitors work with the context they were created with, so the spans of their queries and
+// object storage calls show up next to the visitor span rather than inside it.
+func (p *SecretProcessor) Process(ctx context.Context, secret models.Secret) error {
 	for _, visitor := range p.visitors {
-		if err := secret.Accept(visitor); err != nil {
+		_, span := tracer.Start(ctx, strings.TrimPrefix(fmt.Sprintf("%T", visitor), "*"))
+		span.SetAttributes(attribute.String("secret.path", secret.Meta().Path))
+		err := secret.Accept(visitor)
+		tracing.End(span, err)
+		if err != nil {
 			return fmt.Errorf("processing error at %T: %w", visitor, err)
 		}
 	}
@@ -37,8 +49,8 @@ func NewProcessorBuilder(visitors ...models.SecretVisitor) *ProcessorBuilder {
 	return &ProcessorBuilder{visitors: visitors}
 }
 
-func (b *ProcessorBuilder) WithValidation() *ProcessorBuilder {
-	b.visitors = append(b.visitors, NewValidator())
+func (b *ProcessorBuilder) WithValidation(opts ...ValidatorOption) *ProcessorBuilder {
+	b.visitors = append(b.visitors, NewValidator(opts...))
 	return b
 }
 
@@ -0,0 +1,49 @@
+package operation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/operation"
+	mockmodels "github.com/itallix/gophkeeper/mocks/internal_/server/models"
+)
+
+func TestProcessTracesVisitors(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "Create")
+	login := models.NewLogin([]models.SecretOption{models.WithPath("mail")},
+		[]models.LoginOption{models.WithLogin("user"), models.WithPassword("secret")})
+
+	failing := mockmodels.NewSecretVisitor(t)
+	failing.EXPECT().VisitLogin(login).Return(errors.New("storage is down")).Once()
+	skipped := mockmodels.NewSecretVisitor(t)
+
+	err := operation.NewProcessorBuilder().
+		WithValidation().
+		Build().
+		Process(ctx, login)
+	require.NoError(t, err)
+	err = operation.NewSecretProcessor(failing, skipped).Process(ctx, login)
+	require.ErrorContains(t, err, "storage is down")
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "operation.Validator", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "models.SecretVisitor", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "Create", spans[2].Name())
+}
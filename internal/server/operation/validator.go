@@ -1,19 +1,76 @@
 package operation
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"golang.org/x/crypto/ssh"
+
+	cardbrand "github.com/itallix/gophkeeper/internal/common/card"
+	"github.com/itallix/gophkeeper/internal/common/password"
+	"github.com/itallix/gophkeeper/internal/common/sshkey"
+	"github.com/itallix/gophkeeper/internal/common/totp"
 	"github.com/itallix/gophkeeper/internal/server/models"
 )
 
+// FieldViolation describes why a single field of a secret is invalid.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError is returned by Validator when a secret has one or more invalid fields.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		descriptions = append(descriptions, v.Description)
+	}
+	return strings.Join(descriptions, "\n")
+}
+
+func (e *ValidationError) add(field, description string) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: description})
+}
+
+func (e *ValidationError) orNil() error {
+	if len(e.Violations) > 0 {
+		return e
+	}
+	return nil
+}
+
 type Validator struct {
+	passwordPolicy password.Policy
 }
 
-func NewValidator() *Validator {
-	return &Validator{}
+// ValidatorOption configures optional validation rules.
+type ValidatorOption func(*Validator)
+
+// WithPasswordPolicy replaces the default password length check with the given policy.
+func WithPasswordPolicy(policy password.Policy) ValidatorOption {
+	return func(v *Validator) {
+		v.passwordPolicy = policy
+	}
+}
+
+func NewValidator(opts ...ValidatorOption) *Validator {
+	v := &Validator{
+		passwordPolicy: password.Policy{MinLength: MinPasswordLen},
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
 }
 
 const (
@@ -23,22 +80,74 @@ const (
 	MaxCardNumberLen = 19
 	MinCVCLen        = 3
 	MaxCVCLen        = 4
+	MinPINLen        = 4
+	MaxPINLen        = 12
+	MaxKVFields      = 100
+	MaxLoginURIs     = 20
+
+	MaxBillingAddressLen = 1024
+)
+
+var (
+	kvFieldName = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+	digits      = regexp.MustCompile(`^[0-9]+$`)
 )
 
 func (v *Validator) VisitLogin(login *models.Login) error {
-	var errs []error
+	errs := &ValidationError{}
 
 	if len(login.Login) < MinLoginLen {
-		errs = append(errs, fmt.Errorf("login should be at least %d characters", MinLoginLen))
+		errs.add("login", fmt.Sprintf("login should be at least %d characters", MinLoginLen))
+	}
+	for _, violation := range v.passwordPolicy.Check(string(login.Password)) {
+		errs.add("password", violation)
+	}
+	if len(login.TOTP) > 0 {
+		if uri, err := normalizeTOTP(string(login.TOTP)); err != nil {
+			errs.add("totp", err.Error())
+		} else {
+			login.TOTP = []byte(uri)
+		}
 	}
-	if len(string(login.Password)) < MinPasswordLen {
-		errs = append(errs, fmt.Errorf("password should be at least %d characters", MinPasswordLen))
+	checkLoginURIs(login.URIs, errs)
+	if len(login.Fields) > MaxKVFields {
+		errs.add("fields", fmt.Sprintf("at most %d fields are allowed", MaxKVFields))
 	}
+	checkFieldNames(login.Fields, errs)
 
-	if len(errs) > 0 {
-		return errors.Join(errs...)
+	return errs.orNil()
+}
+
+// checkLoginURIs reports the URIs that can't be matched: regular expressions have to compile and the other
+// rules need a URI with a host.
+func checkLoginURIs(uris []models.LoginURI, errs *ValidationError) {
+	if len(uris) > MaxLoginURIs {
+		errs.add("uris", fmt.Sprintf("at most %d URIs are allowed", MaxLoginURIs))
+	}
+	for _, uri := range uris {
+		switch uri.Match {
+		case models.URIMatchRegex:
+			if _, err := regexp.Compile(uri.URI); err != nil {
+				errs.add("uris", fmt.Sprintf("%s is not a valid regular expression: %v", uri.URI, err))
+			}
+		case models.URIMatchBaseDomain, models.URIMatchHost, models.URIMatchExact:
+			if parsed, err := models.ParseURI(uri.URI); err != nil || parsed.Hostname() == "" {
+				errs.add("uris", fmt.Sprintf("%q is not a valid URI", uri.URI))
+			}
+		default:
+			errs.add("uris", fmt.Sprintf("%s has unknown match rule %q, expected base_domain, host, exact or regex",
+				uri.URI, uri.Match))
+		}
 	}
-	return nil
+}
+
+// normalizeTOTP checks the otpauth URI and spells out its default parameters.
+func normalizeTOTP(uri string) (string, error) {
+	key, err := totp.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	return key.URI(), nil
 }
 
 func validateExpiry(month, year int64) error {
@@ -63,42 +172,276 @@ func validateExpiry(month, year int64) error {
 	return nil
 }
 
+// VisitCard checks the number against the Luhn checksum and the length and CVC rules of the brand detected from
+// the number, the detected brand is kept on the card. Numbers of an unknown brand only have to be 13 to 19 digits.
 func (v *Validator) VisitCard(card *models.Card) error {
-	var errs []error
+	errs := &ValidationError{}
 
 	// Card Number validation
-	cardNumberLen := len(string(card.Number))
-	if cardNumberLen < MinCardNumberLen || cardNumberLen > MaxCardNumberLen {
-		errs = append(errs, fmt.Errorf("card number length should be between %d and %d", MinCardNumberLen, MaxCardNumberLen))
+	number := string(card.Number)
+	brand, known := cardbrand.DetectBrand(number)
+	cardNumberLen := len(number)
+	switch {
+	case known && !brand.ValidLength(cardNumberLen):
+		errs.add("number", fmt.Sprintf("%s card numbers have %s digits", brand.Name, describeLengths(brand.Lengths)))
+	case !known && (cardNumberLen < MinCardNumberLen || cardNumberLen > MaxCardNumberLen):
+		errs.add("number", fmt.Sprintf("card number length should be between %d and %d", MinCardNumberLen, MaxCardNumberLen))
+	}
+	if !digits.MatchString(number) {
+		errs.add("number", "card number must contain only digits")
 	}
-	if !regexp.MustCompile(`^[0-9]+$`).MatchString(string(card.Number)) {
-		errs = append(errs, errors.New("card number must contain only digits"))
+	// the checksum only points out typos in numbers that are otherwise well-formed
+	if len(errs.Violations) == 0 && !cardbrand.Luhn(number) {
+		errs.add("number", "card number checksum is invalid, check the number for typos")
 	}
+	card.Brand = brand.Name
 
 	// Expiry validation
 	if err := validateExpiry(card.ExpiryMonth, card.ExpiryYear); err != nil {
-		errs = append(errs, err)
+		errs.add("expiry", err.Error())
 	}
 
 	// CVC validation
-	if !regexp.MustCompile(`^[0-9]+$`).MatchString(string(card.CVC)) {
-		errs = append(errs, errors.New("CVC must contain only digits"))
+	if !digits.MatchString(string(card.CVC)) {
+		errs.add("cvc", "CVC must contain only digits")
 	}
 	cvcLen := len(string(card.CVC))
-	if cvcLen < MinCVCLen || cvcLen > MaxCVCLen {
-		errs = append(errs, fmt.Errorf("CVC length should be between %d and %d", MinCVCLen, MaxCVCLen))
+	switch {
+	case known && cvcLen != brand.CVCLength:
+		errs.add("cvc", fmt.Sprintf("%s CVC length should be %d", brand.Name, brand.CVCLength))
+	case !known && (cvcLen < MinCVCLen || cvcLen > MaxCVCLen):
+		errs.add("cvc", fmt.Sprintf("CVC length should be between %d and %d", MinCVCLen, MaxCVCLen))
 	}
 
-	if len(errs) > 0 {
-		return errors.Join(errs...)
+	// PIN is optional
+	if pinLen := len(card.PIN); pinLen > 0 {
+		if !digits.MatchString(string(card.PIN)) {
+			errs.add("pin", "PIN must contain only digits")
+		}
+		if pinLen < MinPINLen || pinLen > MaxPINLen {
+			errs.add("pin", fmt.Sprintf("PIN length should be between %d and %d", MinPINLen, MaxPINLen))
+		}
 	}
-	return nil
+	if len(card.BillingAddress) > MaxBillingAddressLen {
+		errs.add("billing_address", fmt.Sprintf("billing address should be at most %d characters",
+			MaxBillingAddressLen))
+	}
+
+	return errs.orNil()
+}
+
+// describeLengths formats the lengths as "15", "16 to 19" or "13, 16 or 19".
+func describeLengths(lengths []int) string {
+	if len(lengths) > 2 && lengths[len(lengths)-1]-lengths[0] == len(lengths)-1 {
+		return fmt.Sprintf("%d to %d", lengths[0], lengths[len(lengths)-1])
+	}
+	formatted := make([]string, 0, len(lengths))
+	for _, length := range lengths {
+		formatted = append(formatted, strconv.Itoa(length))
+	}
+	if len(formatted) == 1 {
+		return formatted[0]
+	}
+	return strings.Join(formatted[:len(formatted)-1], ", ") + " or " + formatted[len(formatted)-1]
 }
 
 func (v *Validator) VisitNote(_ *models.Note) error {
 	return nil
 }
 
+// VisitSSHKey checks that the private key parses and matches the public key if one is given.
+// The missing public key and comment are filled from the private key, the fingerprint is always computed.
+func (v *Validator) VisitSSHKey(key *models.SSHKey) error {
+	errs := &ValidationError{}
+
+	privateKey, err := sshkey.Parse(key.PrivateKey, key.Passphrase)
+	switch {
+	case errors.Is(err, sshkey.ErrPassphraseRequired), errors.Is(err, sshkey.ErrWrongPassphrase),
+		errors.Is(err, sshkey.ErrNotEncrypted):
+		errs.add("passphrase", err.Error())
+		return errs
+	case err != nil:
+		errs.add("private_key", err.Error())
+		return errs
+	}
+	publicKey, err := sshkey.PublicKey(privateKey)
+	if err != nil {
+		errs.add("private_key", err.Error())
+		return errs
+	}
+
+	if key.PublicKey != "" {
+		given, comment, _, _, parseErr := ssh.ParseAuthorizedKey([]byte(key.PublicKey))
+		switch {
+		case parseErr != nil:
+			errs.add("public_key", "public key must be an authorized_keys line")
+			return errs
+		case !bytes.Equal(given.Marshal(), publicKey.Marshal()):
+			errs.add("public_key", "public key does not match the private key")
+			return errs
+		case key.Comment == "":
+			key.Comment = comment
+		}
+	}
+
+	key.PublicKey = sshkey.AuthorizedKey(publicKey, key.Comment)
+	key.Fingerprint = ssh.FingerprintSHA256(publicKey)
+	return nil
+}
+
+// VisitTOTP checks the otpauth URI, it is stored with all of its parameters.
+func (v *Validator) VisitTOTP(secret *models.TOTP) error {
+	uri, err := normalizeTOTP(string(secret.URI))
+	if err != nil {
+		errs := &ValidationError{}
+		errs.add("uri", err.Error())
+		return errs
+	}
+	secret.URI = []byte(uri)
+	return nil
+}
+
+// VisitKV checks that there is at least one field and the field names are unique identifiers.
+// The fields of a user-defined secret type are checked against its schema as well.
+func (v *Validator) VisitKV(kv *models.KV) error {
+	errs := &ValidationError{}
+
+	if len(kv.Fields) == 0 {
+		errs.add("fields", "at least one field is required")
+	}
+	if len(kv.Fields) > MaxKVFields {
+		errs.add("fields", fmt.Sprintf("at most %d fields are allowed", MaxKVFields))
+	}
+	checkFieldNames(kv.Fields, errs)
+
+	switch {
+	case kv.Type == "":
+	case kv.Schema == nil:
+		errs.add("type", fmt.Sprintf("secret type %s is not registered", kv.Type))
+	default:
+		checkSchema(kv, errs)
+	}
+
+	return errs.orNil()
+}
+
+// checkFieldNames reports the invalid and duplicate names of key-value and custom login fields.
+func checkFieldNames(fields []models.KVField, errs *ValidationError) {
+	names := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if !kvFieldName.MatchString(field.Name) {
+			errs.add("fields", fmt.Sprintf("field name %q must contain only letters, digits, '_', '.' and '-'",
+				field.Name))
+		}
+		if _, ok := names[field.Name]; ok {
+			errs.add("fields", fmt.Sprintf("field name %q is used more than once", field.Name))
+		}
+		names[field.Name] = struct{}{}
+	}
+}
+
+// checkSchema reports the fields missing, unknown to the schema or not matching its type and pattern.
+// The sensitive flags are taken from the schema.
+func checkSchema(kv *models.KV, errs *ValidationError) {
+	defined := make(map[string]struct{}, len(kv.Schema.Fields))
+	for _, schemaField := range kv.Schema.Fields {
+		defined[schemaField.Name] = struct{}{}
+		i := slices.IndexFunc(kv.Fields, func(field models.KVField) bool { return field.Name == schemaField.Name })
+		if i < 0 || len(kv.Fields[i].Value) == 0 {
+			if schemaField.Required {
+				errs.add(schemaField.Name, fmt.Sprintf("%s is required", schemaField.Name))
+			}
+			continue
+		}
+		field := &kv.Fields[i]
+		field.Sensitive = schemaField.Sensitive
+		if err := checkFieldType(schemaField.Type, string(field.Value)); err != nil {
+			errs.add(field.Name, fmt.Sprintf("%s %s", field.Name, err))
+			continue
+		}
+		if schemaField.Pattern == "" {
+			continue
+		}
+		if pattern, err := compilePattern(schemaField.Pattern); err != nil || !pattern.MatchString(string(field.Value)) {
+			errs.add(field.Name, fmt.Sprintf("%s must match %s", field.Name, schemaField.Pattern))
+		}
+	}
+	for _, field := range kv.Fields {
+		if _, ok := defined[field.Name]; !ok {
+			errs.add(field.Name, fmt.Sprintf("%s is not a field of secret type %s", field.Name, kv.Schema.Name))
+		}
+	}
+}
+
+func isFieldType(fieldType models.FieldType) bool {
+	switch fieldType {
+	case models.FieldTypeString, models.FieldTypeInteger, models.FieldTypeNumber, models.FieldTypeBoolean:
+		return true
+	}
+	return false
+}
+
+func checkFieldType(fieldType models.FieldType, value string) error {
+	switch fieldType {
+	case models.FieldTypeString:
+	case models.FieldTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.New("must be an integer")
+		}
+	case models.FieldTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New("must be a number")
+		}
+	case models.FieldTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("must be true or false")
+		}
+	default:
+		return fmt.Errorf("has unknown type %s", fieldType)
+	}
+	return nil
+}
+
+// compilePattern anchors the pattern, so that it has to match the whole value.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
+// ValidateSecretType checks the definition of a user-defined secret type before it is registered.
+func ValidateSecretType(secretType *models.SecretType) error {
+	errs := &ValidationError{}
+
+	if !kvFieldName.MatchString(secretType.Name) {
+		errs.add("name", "name must contain only letters, digits, '_', '.' and '-'")
+	}
+	if len(secretType.Fields) == 0 {
+		errs.add("fields", "at least one field is required")
+	}
+	if len(secretType.Fields) > MaxKVFields {
+		errs.add("fields", fmt.Sprintf("at most %d fields are allowed", MaxKVFields))
+	}
+	names := make(map[string]struct{}, len(secretType.Fields))
+	for _, field := range secretType.Fields {
+		if !kvFieldName.MatchString(field.Name) {
+			errs.add("fields", fmt.Sprintf("field name %q must contain only letters, digits, '_', '.' and '-'",
+				field.Name))
+		}
+		if _, ok := names[field.Name]; ok {
+			errs.add("fields", fmt.Sprintf("field name %q is used more than once", field.Name))
+		}
+		names[field.Name] = struct{}{}
+		if !isFieldType(field.Type) {
+			errs.add(field.Name, fmt.Sprintf("%s has unknown type %q, expected string, integer, number or boolean",
+				field.Name, field.Type))
+		}
+		if _, err := compilePattern(field.Pattern); field.Pattern != "" && err != nil {
+			errs.add(field.Name, fmt.Sprintf("%s has an invalid pattern: %v", field.Name, err))
+		}
+	}
+
+	return errs.orNil()
+}
+
 func (v *Validator) VisitBinary(_ *models.Binary) error {
 	return nil
 }
@@ -1,13 +1,17 @@
 package operation_test
 
 import (
+	"encoding/pem"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 
+	"github.com/itallix/gophkeeper/internal/common/password"
+	"github.com/itallix/gophkeeper/internal/common/sshkey"
 	"github.com/itallix/gophkeeper/internal/server/models"
 	"github.com/itallix/gophkeeper/internal/server/operation"
 )
@@ -45,6 +49,62 @@ func TestValidateLogin(t *testing.T) {
 			wantErr:  true,
 			errCount: 1,
 		},
+		{
+			name: "login with totp",
+			login: &models.Login{
+				Login:    "johndoe",
+				Password: []byte("securepass123"),
+				TOTP:     []byte("otpauth://totp/GitHub:johndoe?secret=JBSWY3DPEHPK3PXP"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid totp",
+			login: &models.Login{
+				Login:    "johndoe",
+				Password: []byte("securepass123"),
+				TOTP:     []byte("otpauth://hotp/GitHub:johndoe?secret=JBSWY3DPEHPK3PXP"),
+			},
+			wantErr:  true,
+			errCount: 1,
+		},
+		{
+			name: "login with uris and custom fields",
+			login: &models.Login{
+				Login:    "johndoe",
+				Password: []byte("securepass123"),
+				URIs: []models.LoginURI{
+					{URI: "github.com", Match: models.URIMatchBaseDomain},
+					{URI: `^https://gist\.github\.com/`, Match: models.URIMatchRegex},
+				},
+				Fields: []models.KVField{{Name: "recovery_code", Value: []byte("1234-5678"), Sensitive: true}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid uris",
+			login: &models.Login{
+				Login:    "johndoe",
+				Password: []byte("securepass123"),
+				URIs: []models.LoginURI{
+					{URI: "https://", Match: models.URIMatchHost},
+					{URI: "gist.github.com/(", Match: models.URIMatchRegex},
+					{URI: "github.com", Match: "domain"},
+				},
+			},
+			wantErr:  true,
+			errCount: 3,
+		},
+		{
+			name: "duplicate custom fields",
+			login: &models.Login{
+				Login:    "johndoe",
+				Password: []byte("securepass123"),
+				Fields:   []models.KVField{{Name: "pin"}, {Name: "pin"}},
+			},
+			wantErr:  true,
+			errCount: 1,
+		},
 		{
 			name: "both short login and password",
 			login: &models.Login{
@@ -71,6 +131,26 @@ func TestValidateLogin(t *testing.T) {
 	}
 }
 
+func TestValidateLoginWithPasswordPolicy(t *testing.T) {
+	v := operation.NewValidator(operation.WithPasswordPolicy(password.Policy{
+		MinLength:     12,
+		RequireUpper:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+	}))
+
+	require.NoError(t, v.VisitLogin(&models.Login{Login: "johndoe", Password: []byte("Correct-Horse-42")}))
+
+	err := v.VisitLogin(&models.Login{Login: "jo", Password: []byte("securepass123")})
+	var validationErr *operation.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []operation.FieldViolation{
+		{Field: "login", Description: "login should be at least 3 characters"},
+		{Field: "password", Description: "password should contain an upper case letter"},
+		{Field: "password", Description: "password should contain a symbol"},
+	}, validationErr.Violations)
+}
+
 func TestVisitCard(t *testing.T) {
 	currentYear := int64(time.Now().Year())
 	currentMonth := int64(time.Now().Month())
@@ -212,6 +292,62 @@ func TestVisitCard(t *testing.T) {
 			wantErr:  true,
 			errCount: 1,
 		},
+		{
+			name: "invalid checksum",
+			card: &models.Card{
+				Number:      []byte("4532015112830367"),
+				ExpiryMonth: 12,
+				ExpiryYear:  currentYear + 1,
+				CVC:         []byte("123"),
+			},
+			wantErr:  true,
+			errCount: 1,
+		},
+		{
+			name: "valid amex",
+			card: &models.Card{
+				Number:      []byte("378282246310005"),
+				ExpiryMonth: 12,
+				ExpiryYear:  currentYear + 1,
+				CVC:         []byte("1234"),
+				PIN:         []byte("4321"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "amex with 3-digit CVC",
+			card: &models.Card{
+				Number:      []byte("378282246310005"),
+				ExpiryMonth: 12,
+				ExpiryYear:  currentYear + 1,
+				CVC:         []byte("123"),
+			},
+			wantErr:  true,
+			errCount: 1,
+		},
+		{
+			name: "amex with 16 digits",
+			card: &models.Card{
+				Number:      []byte("3782822463100052"),
+				ExpiryMonth: 12,
+				ExpiryYear:  currentYear + 1,
+				CVC:         []byte("1234"),
+			},
+			wantErr:  true,
+			errCount: 1,
+		},
+		{
+			name: "invalid PIN",
+			card: &models.Card{
+				Number:      []byte("4532015112830366"),
+				ExpiryMonth: 12,
+				ExpiryYear:  currentYear + 1,
+				CVC:         []byte("123"),
+				PIN:         []byte("12a"),
+			},
+			wantErr:  true,
+			errCount: 2,
+		},
 		{
 			name: "multiple validation errors",
 			card: &models.Card{
@@ -239,3 +375,237 @@ func TestVisitCard(t *testing.T) {
 		})
 	}
 }
+
+func TestVisitCardBrand(t *testing.T) {
+	v := operation.NewValidator()
+	card := &models.Card{
+		Number:      []byte("378282246310005"),
+		ExpiryMonth: 12,
+		ExpiryYear:  int64(time.Now().Year() + 1),
+		CVC:         []byte("123"),
+	}
+
+	var validationErr *operation.ValidationError
+	require.ErrorAs(t, v.VisitCard(card), &validationErr)
+	assert.Equal(t, []operation.FieldViolation{
+		{Field: "cvc", Description: "Amex CVC length should be 4"},
+	}, validationErr.Violations)
+	assert.Equal(t, "Amex", card.Brand)
+
+	card.Number = []byte("3782822463100052")
+	card.CVC = []byte("1234")
+	require.ErrorAs(t, v.VisitCard(card), &validationErr)
+	assert.Equal(t, "Amex card numbers have 15 digits", validationErr.Violations[0].Description)
+
+	card.Number = []byte("4111111111111")
+	card.CVC = []byte("123")
+	require.ErrorAs(t, v.VisitCard(card), &validationErr)
+	assert.Equal(t, "card number checksum is invalid, check the number for typos",
+		validationErr.Violations[0].Description)
+
+	card.Number = []byte("41111111111111111")
+	require.ErrorAs(t, v.VisitCard(card), &validationErr)
+	assert.Equal(t, "Visa card numbers have 13, 16 or 19 digits", validationErr.Violations[0].Description)
+
+	card.Number = []byte("6011111111111117")
+	require.NoError(t, v.VisitCard(card))
+	assert.Equal(t, "Discover", card.Brand)
+}
+
+func TestVisitSSHKey(t *testing.T) {
+	privateKey, publicKey, err := sshkey.Generate(sshkey.TypeEd25519, 0, "alice@laptop")
+	require.NoError(t, err)
+	otherKey, otherPublicKey, err := sshkey.Generate(sshkey.TypeEd25519, 0, "")
+	require.NoError(t, err)
+	parsed, err := sshkey.Parse(otherKey, nil)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(parsed, "", []byte("secret"))
+	require.NoError(t, err)
+	encryptedKey := pem.EncodeToMemory(block)
+
+	tests := []struct {
+		name    string
+		key     *models.SSHKey
+		field   string
+		comment string
+	}{
+		{
+			name:    "public key and comment derived from the private key",
+			key:     &models.SSHKey{PrivateKey: privateKey, Comment: "deploy"},
+			comment: "deploy",
+		},
+		{
+			name:    "comment taken from the public key",
+			key:     &models.SSHKey{PrivateKey: privateKey, PublicKey: publicKey},
+			comment: "alice@laptop",
+		},
+		{
+			name: "encrypted key with its passphrase",
+			key:  &models.SSHKey{PrivateKey: encryptedKey, Passphrase: []byte("secret")},
+		},
+		{
+			name:  "not a key",
+			key:   &models.SSHKey{PrivateKey: []byte("ssh-ed25519 AAAA")},
+			field: "private_key",
+		},
+		{
+			name:  "encrypted key without passphrase",
+			key:   &models.SSHKey{PrivateKey: encryptedKey},
+			field: "passphrase",
+		},
+		{
+			name:  "wrong passphrase",
+			key:   &models.SSHKey{PrivateKey: encryptedKey, Passphrase: []byte("guess")},
+			field: "passphrase",
+		},
+		{
+			name:  "passphrase of a plain key",
+			key:   &models.SSHKey{PrivateKey: privateKey, Passphrase: []byte("secret")},
+			field: "passphrase",
+		},
+		{
+			name:  "public key of another private key",
+			key:   &models.SSHKey{PrivateKey: privateKey, PublicKey: otherPublicKey},
+			field: "public_key",
+		},
+	}
+
+	v := operation.NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.VisitSSHKey(tt.key)
+			if tt.field != "" {
+				var validationErr *operation.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				require.Len(t, validationErr.Violations, 1)
+				assert.Equal(t, tt.field, validationErr.Violations[0].Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(tt.key.PublicKey, "ssh-ed25519 "))
+			assert.Equal(t, tt.comment, tt.key.Comment)
+			if tt.comment != "" {
+				assert.True(t, strings.HasSuffix(tt.key.PublicKey, " "+tt.comment))
+			}
+			assert.True(t, strings.HasPrefix(tt.key.Fingerprint, "SHA256:"))
+		})
+	}
+}
+
+func TestVisitTOTP(t *testing.T) {
+	v := operation.NewValidator()
+
+	secret := &models.TOTP{URI: []byte("otpauth://totp/GitHub:mark?secret=jbswy3dpehpk3pxp")}
+	require.NoError(t, v.VisitTOTP(secret))
+	assert.Equal(t,
+		"otpauth://totp/GitHub:mark?algorithm=SHA1&digits=6&issuer=GitHub&period=30&secret=JBSWY3DPEHPK3PXP",
+		string(secret.URI), "the defaults are spelled out")
+
+	err := v.VisitTOTP(&models.TOTP{URI: []byte("otpauth://totp/GitHub:mark?secret=JBSWY3DPEHPK3PXP&digits=10")})
+	var validationErr *operation.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Violations, 1)
+	assert.Equal(t, "uri", validationErr.Violations[0].Field)
+}
+
+func TestVisitKV(t *testing.T) {
+	v := operation.NewValidator()
+
+	require.NoError(t, v.VisitKV(&models.KV{Fields: []models.KVField{
+		{Name: "url", Value: []byte("https://hooks.example.com")},
+		{Name: "signing_secret", Value: []byte("whsec_123"), Sensitive: true},
+	}}))
+
+	tests := []struct {
+		name   string
+		fields []models.KVField
+		errMsg string
+	}{
+		{name: "no fields", errMsg: "at least one field is required"},
+		{
+			name:   "invalid name",
+			fields: []models.KVField{{Name: "api key", Value: []byte("secret")}},
+			errMsg: `field name "api key" must contain only letters, digits, '_', '.' and '-'`,
+		},
+		{
+			name:   "duplicate name",
+			fields: []models.KVField{{Name: "token"}, {Name: "token"}},
+			errMsg: `field name "token" is used more than once`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.VisitKV(&models.KV{Fields: tt.fields})
+			var validationErr *operation.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			require.Len(t, validationErr.Violations, 1)
+			assert.Equal(t, "fields", validationErr.Violations[0].Field)
+			assert.Equal(t, tt.errMsg, validationErr.Violations[0].Description)
+		})
+	}
+}
+
+func TestVisitKVWithSchema(t *testing.T) {
+	v := operation.NewValidator()
+	schema := &models.SecretType{
+		Name: "database",
+		Fields: []models.SchemaField{
+			{Name: "host", Type: models.FieldTypeString, Required: true},
+			{Name: "port", Type: models.FieldTypeInteger},
+			{Name: "password", Type: models.FieldTypeString, Required: true, Sensitive: true, Pattern: `.{8,}`},
+			{Name: "tls", Type: models.FieldTypeBoolean},
+		},
+	}
+
+	kv := &models.KV{Type: "database", Schema: schema, Fields: []models.KVField{
+		{Name: "host", Value: []byte("db.internal")},
+		{Name: "port", Value: []byte("5432")},
+		{Name: "password", Value: []byte("correct horse")},
+	}}
+	require.NoError(t, v.VisitKV(kv))
+	assert.True(t, kv.Fields[2].Sensitive, "the sensitive flag is taken from the schema")
+
+	err := v.VisitKV(&models.KV{Type: "database", Schema: schema, Fields: []models.KVField{
+		{Name: "port", Value: []byte("fifty")},
+		{Name: "password", Value: []byte("short")},
+		{Name: "tls", Value: []byte("maybe")},
+		{Name: "user", Value: []byte("admin")},
+	}})
+	var validationErr *operation.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []operation.FieldViolation{
+		{Field: "host", Description: "host is required"},
+		{Field: "port", Description: "port must be an integer"},
+		{Field: "password", Description: "password must match .{8,}"},
+		{Field: "tls", Description: "tls must be true or false"},
+		{Field: "user", Description: "user is not a field of secret type database"},
+	}, validationErr.Violations)
+
+	err = v.VisitKV(&models.KV{Type: "ldap", Fields: []models.KVField{{Name: "dn", Value: []byte("cn=admin")}}})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []operation.FieldViolation{
+		{Field: "type", Description: "secret type ldap is not registered"},
+	}, validationErr.Violations)
+}
+
+func TestValidateSecretType(t *testing.T) {
+	require.NoError(t, operation.ValidateSecretType(&models.SecretType{
+		Name:   "database",
+		Fields: []models.SchemaField{{Name: "host", Type: models.FieldTypeString, Pattern: `[a-z.]+`}},
+	}))
+
+	err := operation.ValidateSecretType(&models.SecretType{
+		Name: "my database",
+		Fields: []models.SchemaField{
+			{Name: "host", Type: "hostname"},
+			{Name: "port", Type: models.FieldTypeInteger, Pattern: `[0-9`},
+		},
+	})
+	var validationErr *operation.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Violations, 3)
+	assert.Equal(t, "name", validationErr.Violations[0].Field)
+	assert.Equal(t, `host has unknown type "hostname", expected string, integer, number or boolean`,
+		validationErr.Violations[1].Description)
+	assert.Equal(t, "port", validationErr.Violations[2].Field)
+}
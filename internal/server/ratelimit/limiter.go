@@ -0,0 +1,99 @@
+// Package ratelimit protects the server from brute force and abuse: token buckets limit
+// the rate of calls per key and the lockout slows down and then blocks password guessing.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// idleBuckets is how many refill periods a bucket may stay full before it is forgotten.
+const idleBuckets = 2
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter is a set of token buckets, one per key. Every bucket holds up to burst tokens
+// and is refilled at rate tokens per second, every call takes one token.
+type Limiter struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     int
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewLimiter creates a limiter allowing rate calls per second per key with bursts of up to burst calls.
+func NewLimiter(rate float64, burst int) *Limiter {
+	return &Limiter{
+		rate:    rate,
+		burst:   max(burst, 1),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Limits returns the rate and the burst.
+func (l *Limiter) Limits() (float64, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate, l.burst
+}
+
+// SetLimits changes the rate and burst, existing buckets keep their tokens.
+func (l *Limiter) SetLimits(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = rate
+	l.burst = max(burst, 1)
+}
+
+// Allow takes a token from the bucket of the key. It returns zero if the call is allowed,
+// otherwise how long to wait until a token is available.
+func (l *Limiter) Allow(key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.burst), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = min(float64(l.burst), b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	if l.rate <= 0 {
+		return time.Duration(1<<63 - 1)
+	}
+	return time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+}
+
+// sweep forgets the buckets which have been refilled long ago, so the map doesn't grow
+// with every client ever seen.
+func (l *Limiter) sweep(now time.Time) {
+	if l.rate <= 0 {
+		return
+	}
+	refill := time.Duration(float64(l.burst) / l.rate * float64(time.Second))
+	if now.Sub(l.lastSweep) < refill {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.last) > idleBuckets*refill {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// FailureStore keeps the failed login attempts, shared by all server instances.
+type FailureStore interface {
+	GetAttempts(ctx context.Context, login string) (models.LoginAttempts, error)
+	// RecordFailure counts a failure at now, failures before since are forgotten.
+	RecordFailure(ctx context.Context, login string, now, since time.Time) (models.LoginAttempts, error)
+	Lock(ctx context.Context, login string, until time.Time) error
+	Reset(ctx context.Context, login string) error
+}
+
+// LockoutPolicy defines how failed logins are throttled.
+type LockoutPolicy struct {
+	// Threshold is the number of consecutive failures locking the account, zero disables the lockout.
+	Threshold int
+	// Duration is how long the account stays locked, it is also the period after which failures are forgotten.
+	Duration time.Duration
+	// BaseDelay is the wait imposed after the first failure, doubled after every next one.
+	BaseDelay time.Duration
+	// MaxDelay caps the wait between attempts.
+	MaxDelay time.Duration
+}
+
+// Delay returns how long to wait after the given number of consecutive failures.
+func (p LockoutPolicy) Delay(failures int) time.Duration {
+	if failures <= 0 || p.BaseDelay <= 0 {
+		return 0
+	}
+	delay := p.BaseDelay
+	for i := 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 {
+		delay = min(delay, p.MaxDelay)
+	}
+	return delay
+}
+
+// Lockout imposes progressive delays between failed logins and locks the account after too many of them.
+type Lockout struct {
+	store  FailureStore
+	policy LockoutPolicy
+	now    func() time.Time
+}
+
+func NewLockout(store FailureStore, policy LockoutPolicy) *Lockout {
+	return &Lockout{
+		store:  store,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// NormalizeLogin returns the key the attempts of a login are tracked by, so that variants in case and
+// surrounding whitespace count as the same account.
+func NormalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
+
+// Check returns zero if the login may be attempted now, otherwise how long to wait.
+// It also reports whether earlier failures are pending, so a success needs to reset them.
+func (l *Lockout) Check(ctx context.Context, login string) (time.Duration, bool, error) {
+	attempts, err := l.store.GetAttempts(ctx, NormalizeLogin(login))
+	if err != nil {
+		return 0, false, err
+	}
+	now := l.now()
+	wait := max(attempts.LockedUntil.Sub(now), attempts.LastFailure.Add(l.policy.Delay(attempts.Failures)).Sub(now))
+	return max(wait, 0), attempts.Failures > 0 || !attempts.LockedUntil.IsZero(), nil
+}
+
+// Failed records a failed login, locking the account once the threshold is reached.
+// It returns how long to wait before the next attempt.
+func (l *Lockout) Failed(ctx context.Context, login string) (time.Duration, error) {
+	now := l.now()
+	attempts, err := l.store.RecordFailure(ctx, NormalizeLogin(login), now, now.Add(-l.policy.Duration))
+	if err != nil {
+		return 0, err
+	}
+	if l.policy.Threshold > 0 && attempts.Failures >= l.policy.Threshold {
+		if err = l.store.Lock(ctx, NormalizeLogin(login), now.Add(l.policy.Duration)); err != nil {
+			return 0, err
+		}
+		return l.policy.Duration, nil
+	}
+	return l.policy.Delay(attempts.Failures), nil
+}
+
+// Succeeded forgets the failures of the login.
+func (l *Lockout) Succeeded(ctx context.Context, login string) error {
+	return l.store.Reset(ctx, NormalizeLogin(login))
+}
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	mocks "github.com/itallix/gophkeeper/mocks/internal_/server/ratelimit"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func TestLimiter(t *testing.T) {
+	c := &clock{now: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+	limiter := NewLimiter(2, 3)
+	limiter.now = c.Now
+
+	for range 3 {
+		assert.Zero(t, limiter.Allow("10.0.0.1"), "burst is allowed")
+	}
+	assert.Equal(t, 500*time.Millisecond, limiter.Allow("10.0.0.1"))
+	assert.Zero(t, limiter.Allow("10.0.0.2"), "keys have separate buckets")
+
+	c.now = c.now.Add(250 * time.Millisecond)
+	assert.Equal(t, 250*time.Millisecond, limiter.Allow("10.0.0.1"))
+	c.now = c.now.Add(250 * time.Millisecond)
+	assert.Zero(t, limiter.Allow("10.0.0.1"), "bucket is refilled over time")
+
+	c.now = c.now.Add(time.Hour)
+	limiter.Allow("10.0.0.3")
+	assert.Len(t, limiter.buckets, 1, "idle buckets are forgotten")
+
+	limiter.SetLimits(0, 1)
+	rate, burst := limiter.Limits()
+	assert.Zero(t, rate)
+	assert.Equal(t, 1, burst)
+	assert.Zero(t, limiter.Allow("10.0.0.4"))
+	assert.Positive(t, limiter.Allow("10.0.0.4"), "zero rate never refills")
+}
+
+func TestLockoutPolicyDelay(t *testing.T) {
+	policy := LockoutPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	assert.Zero(t, policy.Delay(0))
+	assert.Equal(t, time.Second, policy.Delay(1))
+	assert.Equal(t, 2*time.Second, policy.Delay(2))
+	assert.Equal(t, 8*time.Second, policy.Delay(4))
+	assert.Equal(t, 10*time.Second, policy.Delay(5))
+	assert.Equal(t, 10*time.Second, policy.Delay(100))
+}
+
+func TestLockout(t *testing.T) {
+	ctx := context.Background()
+	c := &clock{now: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+	policy := LockoutPolicy{Threshold: 3, Duration: 15 * time.Minute, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	t.Run("progressive delay", func(t *testing.T) {
+		store := mocks.NewFailureStore(t)
+		lockout := NewLockout(store, policy)
+		lockout.now = c.Now
+
+		store.EXPECT().GetAttempts(ctx, "mark").
+			Return(models.LoginAttempts{Failures: 2, LastFailure: c.now.Add(-500 * time.Millisecond)}, nil)
+		wait, pending, err := lockout.Check(ctx, " Mark ")
+		require.NoError(t, err)
+		assert.Equal(t, 1500*time.Millisecond, wait)
+		assert.True(t, pending)
+
+		store.EXPECT().RecordFailure(ctx, "mark", c.now, c.now.Add(-15*time.Minute)).
+			Return(models.LoginAttempts{Failures: 2, LastFailure: c.now}, nil)
+		wait, err = lockout.Failed(ctx, "mark")
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Second, wait)
+	})
+
+	t.Run("lock after threshold", func(t *testing.T) {
+		store := mocks.NewFailureStore(t)
+		lockout := NewLockout(store, policy)
+		lockout.now = c.Now
+
+		store.EXPECT().RecordFailure(ctx, "mark", c.now, c.now.Add(-15*time.Minute)).
+			Return(models.LoginAttempts{Failures: 3, LastFailure: c.now}, nil)
+		store.EXPECT().Lock(ctx, "mark", c.now.Add(15*time.Minute)).Return(nil)
+		wait, err := lockout.Failed(ctx, "mark")
+		require.NoError(t, err)
+		assert.Equal(t, 15*time.Minute, wait)
+
+		store.EXPECT().GetAttempts(ctx, "mark").
+			Return(models.LoginAttempts{LastFailure: c.now, LockedUntil: c.now.Add(15 * time.Minute)}, nil)
+		wait, _, err = lockout.Check(ctx, "mark")
+		require.NoError(t, err)
+		assert.Equal(t, 15*time.Minute, wait)
+	})
+
+	t.Run("unknown login", func(t *testing.T) {
+		store := mocks.NewFailureStore(t)
+		lockout := NewLockout(store, policy)
+		lockout.now = c.Now
+
+		store.EXPECT().GetAttempts(ctx, "nobody").Return(models.LoginAttempts{}, nil)
+		wait, pending, err := lockout.Check(ctx, "nobody")
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+		assert.False(t, pending)
+	})
+}
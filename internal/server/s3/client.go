@@ -5,30 +5,42 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/caarlos0/env/v11"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/itallix/gophkeeper/internal/common/tracing"
 )
 
+var tracer = tracing.Tracer("github.com/itallix/gophkeeper/internal/server/s3")
+
+// startSpan traces a call to the object storage.
+func startSpan(ctx context.Context, operation, bucket, name string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "s3 "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("s3.bucket", bucket), attribute.String("s3.key", name)),
+	)
+}
+
 // ObjectStorage is the wrapper for S3 compatible client using minio.
 type ObjectStorage struct {
 	client *minio.Client
 }
 
-type config struct {
-	AccessKey string `env:"ACCESS_KEY" envDefault:"superadmin"`
-	SecretKey string `env:"SECRET_KEY" envDefault:"superpassword"`
-	Endpoint  string `env:"ENDPOINT" envDefault:"localhost:9000"`
+// Config holds the address and the credentials of the storage.
+type Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	// Secure connects over TLS.
+	Secure bool
 }
 
-func NewObjectStorage() (*ObjectStorage, error) {
-	cfg := config{}
-	if err := env.Parse(&cfg); err != nil {
-		return nil, fmt.Errorf("cannot parse minio env vars %w", err)
-	}
+func NewObjectStorage(cfg Config) (*ObjectStorage, error) {
 	minioClient, err := minio.New(cfg.Endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
-		Secure: false,
+		Secure: cfg.Secure,
 	})
 	if err != nil {
 		return nil, err
@@ -39,7 +51,11 @@ func NewObjectStorage() (*ObjectStorage, error) {
 	}, nil
 }
 
-func (s *ObjectStorage) Upload(ctx context.Context, bucket, name string, size int64, reader io.Reader) (int64, error) {
+func (s *ObjectStorage) Upload(ctx context.Context, bucket, name string, size int64,
+	reader io.Reader) (_ int64, err error) {
+	ctx, span := startSpan(ctx, "PutObject", bucket, name)
+	defer func() { tracing.End(span, err) }()
+
 	info, err := s.client.PutObject(
 		ctx, bucket, name, reader, size,
 		minio.PutObjectOptions{},
@@ -50,7 +66,11 @@ func (s *ObjectStorage) Upload(ctx context.Context, bucket, name string, size in
 	return info.Size, nil
 }
 
-func (s *ObjectStorage) GetObject(ctx context.Context, bucket, name string) (io.ReadCloser, int64, error) {
+// GetObject opens the object for reading, the span covers getting its metadata but not reading the data.
+func (s *ObjectStorage) GetObject(ctx context.Context, bucket, name string) (_ io.ReadCloser, _ int64, err error) {
+	ctx, span := startSpan(ctx, "GetObject", bucket, name)
+	defer func() { tracing.End(span, err) }()
+
 	object, err := s.client.GetObject(ctx, bucket, name, minio.GetObjectOptions{})
 	if err != nil {
 		return nil, 0, fmt.Errorf("error fetching object from storage: %w", err)
@@ -62,7 +82,10 @@ func (s *ObjectStorage) GetObject(ctx context.Context, bucket, name string) (io.
 	return object, stat.Size, nil
 }
 
-func (s *ObjectStorage) DeleteChunks(ctx context.Context, bucket, name string) error {
+func (s *ObjectStorage) DeleteChunks(ctx context.Context, bucket, name string) (err error) {
+	ctx, span := startSpan(ctx, "DeleteChunks", bucket, name)
+	defer func() { tracing.End(span, err) }()
+
 	objectsCh := s.client.ListObjects(ctx, bucket, minio.ListObjectsOptions{
 		Prefix:    name,
 		Recursive: true,
@@ -75,3 +98,15 @@ func (s *ObjectStorage) DeleteChunks(ctx context.Context, bucket, name string) e
 	}
 	return nil
 }
+
+// Ping checks that the storage is reachable and the bucket exists.
+func (s *ObjectStorage) Ping(ctx context.Context, bucket string) error {
+	exists, err := s.client.BucketExists(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("bucket %s does not exist", bucket)
+	}
+	return nil
+}
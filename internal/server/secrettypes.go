@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/operation"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+)
+
+// SecretTypes defines the registration of user-defined secret types. Their secrets are stored
+// as key-value secrets and validated against the registered schema by the vault.
+type SecretTypes interface {
+	// RegisterSecretType validates and stores the definition, replacing the one registered under the same name.
+	RegisterSecretType(ctx context.Context, secretType *models.SecretType) error
+	GetSecretType(ctx context.Context, name string) (*models.SecretType, error)
+	ListSecretTypes(ctx context.Context) ([]models.SecretType, error)
+}
+
+// SecretTypeRegistry implements SecretTypes on top of the database.
+type SecretTypeRegistry struct {
+	repo *storage.SecretTypeRepo
+}
+
+func NewSecretTypeRegistry(pool *pgxpool.Pool) *SecretTypeRegistry {
+	return &SecretTypeRegistry{
+		repo: storage.NewSecretTypeRepo(pool),
+	}
+}
+
+func (r *SecretTypeRegistry) RegisterSecretType(ctx context.Context, secretType *models.SecretType) error {
+	if err := operation.ValidateSecretType(secretType); err != nil {
+		return err
+	}
+	if secretType.CreatedAt.IsZero() {
+		secretType.CreatedAt = time.Now()
+	}
+	return r.repo.Save(ctx, secretType)
+}
+
+func (r *SecretTypeRegistry) GetSecretType(ctx context.Context, name string) (*models.SecretType, error) {
+	return r.repo.Get(ctx, name)
+}
+
+func (r *SecretTypeRegistry) ListSecretTypes(ctx context.Context) ([]models.SecretType, error) {
+	return r.repo.List(ctx)
+}
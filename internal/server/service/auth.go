@@ -6,8 +6,9 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
 
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
 	"github.com/itallix/gophkeeper/internal/server/storage"
 )
 
@@ -28,10 +29,15 @@ const (
 	RefreshToken TokenType = "refresh"
 )
 
+// DefaultServiceTokenTTL is the lifetime of access tokens issued for API keys.
+const DefaultServiceTokenTTL = 15 * time.Minute
+
 // Claims represents the custom JWT claims.
 type Claims struct {
 	Username string    `json:"username"`
 	Type     TokenType `json:"type"`
+	// Scope restricts the tokens of service accounts, it is nil for regular users.
+	Scope *models.Scope `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -45,7 +51,11 @@ type TokenPair struct {
 type AuthenticationService interface {
 	GetTokenPair(username string) (*TokenPair, error)
 	Authenticate(ctx context.Context, username, password string) (*TokenPair, error)
+	HashPassword(password string) (string, error)
+	// GetScopedToken issues a short-lived access token restricted to the scope, without a refresh token.
+	GetScopedToken(username string, scope models.Scope) (string, error)
 	ValidateAccessToken(accessToken string) (string, error)
+	ParseAccessToken(accessToken string) (*Claims, error)
 	RefreshTokens(refreshToken string) (*TokenPair, error)
 }
 
@@ -55,26 +65,61 @@ type JWTAuthService struct {
 	refreshTokenKey []byte
 	accessTokenTTL  time.Duration
 	refreshTokenTTL time.Duration
+	hasher          PasswordHasher
+	serviceTokenTTL time.Duration
+	keyset          *Keyset
+}
+
+// AuthOption configures optional JWTAuthService behaviour.
+type AuthOption func(*JWTAuthService)
+
+// WithServiceTokenTTL sets the lifetime of the access tokens issued for API keys.
+func WithServiceTokenTTL(ttl time.Duration) AuthOption {
+	return func(s *JWTAuthService) {
+		s.serviceTokenTTL = ttl
+	}
+}
+
+// WithKeyset signs tokens with the asymmetric keys of the keyset instead of the HMAC secrets.
+func WithKeyset(keyset *Keyset) AuthOption {
+	return func(s *JWTAuthService) {
+		s.keyset = keyset
+	}
+}
+
+// WithPasswordHasher replaces the default Argon2id password hasher.
+func WithPasswordHasher(hasher PasswordHasher) AuthOption {
+	return func(s *JWTAuthService) {
+		s.hasher = hasher
+	}
 }
 
 func NewJWTAuthService(userRepo *storage.UserRepo, accessTokenKey []byte,
-	refreshTokenKey []byte, accessTokenTTL time.Duration, refreshTokenTTL time.Duration) *JWTAuthService {
-	return &JWTAuthService{
+	refreshTokenKey []byte, accessTokenTTL time.Duration, refreshTokenTTL time.Duration,
+	opts ...AuthOption) *JWTAuthService {
+	s := &JWTAuthService{
 		userRepo:        userRepo,
 		accessTokenKey:  accessTokenKey,
 		refreshTokenKey: refreshTokenKey,
 		accessTokenTTL:  accessTokenTTL,
 		refreshTokenTTL: refreshTokenTTL,
+		hasher:          NewArgon2Hasher(DefaultArgon2Params),
+		serviceTokenTTL: DefaultServiceTokenTTL,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // createToken generates a new JWT token with the specified claims.
 func (s *JWTAuthService) createToken(username string, tokenType TokenType, ttl time.Duration,
-	key []byte) (string, error) {
+	key []byte, scope *models.Scope) (string, error) {
 	now := time.Now()
 	claims := Claims{
 		Username: username,
 		Type:     tokenType,
+		Scope:    scope,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -82,16 +127,26 @@ func (s *JWTAuthService) createToken(username string, tokenType TokenType, ttl t
 		},
 	}
 
+	if s.keyset != nil {
+		signingKey := s.keyset.Current()
+		token := jwt.NewWithClaims(signingKey.Method(), claims)
+		token.Header["kid"] = signingKey.ID
+		return token.SignedString(signingKey.Private)
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString(key)
 }
 
 func (s *JWTAuthService) createAccessToken(username string) (string, error) {
-	return s.createToken(username, AccessToken, s.accessTokenTTL, s.accessTokenKey)
+	return s.createToken(username, AccessToken, s.accessTokenTTL, s.accessTokenKey, nil)
 }
 
 func (s *JWTAuthService) createRefreshToken(username string) (string, error) {
-	return s.createToken(username, RefreshToken, s.refreshTokenTTL, s.refreshTokenKey)
+	return s.createToken(username, RefreshToken, s.refreshTokenTTL, s.refreshTokenKey, nil)
+}
+
+func (s *JWTAuthService) GetScopedToken(username string, scope models.Scope) (string, error) {
+	return s.createToken(username, AccessToken, s.serviceTokenTTL, s.accessTokenKey, &scope)
 }
 
 func (s *JWTAuthService) GetTokenPair(username string) (*TokenPair, error) {
@@ -117,16 +172,40 @@ func (s *JWTAuthService) Authenticate(ctx context.Context, username, password st
 		return nil, ErrUserNotFound
 	}
 
-	if err = bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)); err != nil {
+	ok, rehash, err := s.hasher.Verify(hashedPassword, password)
+	if err != nil || !ok {
 		return nil, ErrInvalidCreds
 	}
+	if rehash {
+		s.upgradeHash(ctx, username, password)
+	}
 
 	return s.GetTokenPair(username)
 }
 
+// upgradeHash replaces an outdated password hash while the plain password is at hand.
+// Failing to do so doesn't prevent the login, the upgrade is retried next time.
+func (s *JWTAuthService) upgradeHash(ctx context.Context, username, password string) {
+	hashedPassword, err := s.hasher.Hash(password)
+	if err == nil {
+		err = s.userRepo.UpdatePasswordHash(ctx, username, hashedPassword)
+	}
+	if err != nil {
+		logger.Log().Errorf("failed to upgrade password hash of user=[%s]: %v", username, err)
+	}
+}
+
+// HashPassword hashes the password of a new user.
+func (s *JWTAuthService) HashPassword(password string) (string, error) {
+	return s.hasher.Hash(password)
+}
+
 // parseAndValidateToken parses and validates a JWT token.
 func (s *JWTAuthService) parseAndValidateToken(tokenString string, key []byte) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		if s.keyset != nil {
+			return s.verificationKey(token)
+		}
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, ErrInvalidSignature
 		}
@@ -144,17 +223,39 @@ func (s *JWTAuthService) parseAndValidateToken(tokenString string, key []byte) (
 	return nil, ErrInvalidToken
 }
 
+// verificationKey returns the public key matching the kid header of the token.
+func (s *JWTAuthService) verificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	signingKey, err := s.keyset.Lookup(kid)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method.Alg() != signingKey.Method().Alg() {
+		return nil, ErrInvalidSignature
+	}
+	return signingKey.Private.Public(), nil
+}
+
 func (s *JWTAuthService) ValidateAccessToken(accessToken string) (string, error) {
-	claims, err := s.parseAndValidateToken(accessToken, s.accessTokenKey)
+	claims, err := s.ParseAccessToken(accessToken)
 	if err != nil {
 		return "", err
 	}
+	return claims.Username, nil
+}
+
+// ParseAccessToken validates the access token and returns its claims.
+func (s *JWTAuthService) ParseAccessToken(accessToken string) (*Claims, error) {
+	claims, err := s.parseAndValidateToken(accessToken, s.accessTokenKey)
+	if err != nil {
+		return nil, err
+	}
 
 	if claims.Type != AccessToken {
-		return "", ErrInvalidToken
+		return nil, ErrInvalidToken
 	}
 
-	return claims.Username, nil
+	return claims, nil
 }
 
 func (s *JWTAuthService) RefreshTokens(refreshToken string) (*TokenPair, error) {
@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,11 +14,13 @@ import (
 	"github.com/testcontainers/testcontainers-go/wait"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/itallix/gophkeeper/db/migrations"
+	"github.com/itallix/gophkeeper/internal/common/srp"
 	"github.com/itallix/gophkeeper/internal/server"
+	"github.com/itallix/gophkeeper/internal/server/models"
 	"github.com/itallix/gophkeeper/internal/server/service"
 	"github.com/itallix/gophkeeper/internal/server/storage"
-
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	mocks "github.com/itallix/gophkeeper/mocks/internal_/server/service"
 )
 
 const (
@@ -60,7 +63,7 @@ func (suite *JWTAuthTestSuite) TestJWTAuth() {
 	suite.Require().NoError(pgErr)
 	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", postgresUser, postgresPassword,
 		postgresEndpoint, postgresDatabase)
-	suite.Require().NoError(server.ApplyMigrations(dsn, "../../../db/migrations"))
+	suite.Require().NoError(server.ApplyMigrations(dsn, migrations.FS))
 	pool, pgErr := pgxpool.New(ctx, dsn)
 	suite.Require().NoError(pgErr)
 	userRepo := storage.NewUserRepo(pool)
@@ -96,6 +99,18 @@ func (suite *JWTAuthTestSuite) TestJWTAuth() {
 		suite.Equal(givenUsername, actual)
 	})
 
+	suite.Run("scoped token carries the scope", func() {
+		scope := models.Scope{ReadOnly: true, PathPrefixes: []string{"deploy/"}}
+		token, err := authService.GetScopedToken("deployer", scope)
+		suite.Require().NoError(err)
+
+		claims, err := authService.ParseAccessToken(token)
+		suite.Require().NoError(err)
+		suite.Equal("deployer", claims.Username)
+		suite.Require().NotNil(claims.Scope)
+		suite.Equal(scope, *claims.Scope)
+	})
+
 	suite.Run("successful authentication", func() {
 		tokens, err := authService.Authenticate(ctx, givenUsername, givenPassword)
 
@@ -108,6 +123,15 @@ func (suite *JWTAuthTestSuite) TestJWTAuth() {
 		suite.Equal(givenUsername, actual)
 	})
 
+	suite.Run("bcrypt hash is upgraded after login", func() {
+		hash, err := userRepo.GetPasswordHash(ctx, givenUsername)
+		suite.Require().NoError(err)
+		suite.True(strings.HasPrefix(hash, "$argon2id$"))
+
+		_, err = authService.Authenticate(ctx, givenUsername, givenPassword)
+		suite.Require().NoError(err)
+	})
+
 	suite.Run("invalid password", func() {
 		_, err := authService.Authenticate(ctx, givenUsername, "geheim")
 
@@ -121,6 +145,105 @@ func (suite *JWTAuthTestSuite) TestJWTAuth() {
 		suite.Require().Error(err)
 		suite.ErrorIs(err, service.ErrUserNotFound)
 	})
+
+	srpService := service.NewSRPAuthService(userRepo, authService)
+	srpLogin := func(login, password string) (*service.TokenPair, error) {
+		client, err := srp.NewClient(login, password)
+		suite.Require().NoError(err)
+		challenge, err := srpService.Begin(ctx, login, client.Public())
+		if err != nil {
+			return nil, err
+		}
+		proof, err := client.Proof(challenge.Salt, challenge.ServerPublic)
+		suite.Require().NoError(err)
+		serverProof, pair, err := srpService.Finish(ctx, challenge.SessionID, login, proof)
+		if err != nil {
+			return nil, err
+		}
+		suite.Require().NoError(client.VerifyServer(serverProof))
+		return pair, nil
+	}
+
+	suite.Run("srp login of a legacy user after enrollment", func() {
+		_, err := srpLogin(givenUsername, givenPassword)
+		suite.Require().ErrorIs(err, service.ErrSRPNotEnrolled)
+
+		salt, err := srp.NewSalt()
+		suite.Require().NoError(err)
+		suite.Require().NoError(srpService.Enroll(ctx, givenUsername, salt, srp.Verifier(givenUsername, givenPassword, salt)))
+
+		tokens, err := srpLogin(givenUsername, givenPassword)
+		suite.Require().NoError(err)
+		actual, err := authService.ValidateAccessToken(tokens.AccessToken)
+		suite.Require().NoError(err)
+		suite.Equal(givenUsername, actual)
+
+		_, err = srpLogin(givenUsername, "geheim")
+		suite.ErrorIs(err, service.ErrInvalidCreds)
+	})
+
+	suite.Run("srp registration", func() {
+		salt, err := srp.NewSalt()
+		suite.Require().NoError(err)
+		_, err = srpService.Register(ctx, "lucius", salt, srp.Verifier("lucius", "fox", salt))
+		suite.Require().NoError(err)
+
+		_, err = srpService.Register(ctx, "lucius", salt, srp.Verifier("lucius", "fox", salt))
+		suite.ErrorIs(err, service.ErrUserExists)
+
+		_, err = srpLogin("lucius", "fox")
+		suite.Require().NoError(err)
+		_, err = authService.Authenticate(ctx, "lucius", "fox")
+		suite.ErrorIs(err, service.ErrInvalidCreds, "srp users have no password hash")
+
+		_, err = srpLogin("steve", "geheim")
+		suite.ErrorIs(err, service.ErrUserNotFound)
+	})
+
+	suite.Run("srp session is single use", func() {
+		client, err := srp.NewClient("lucius", "fox")
+		suite.Require().NoError(err)
+		challenge, err := srpService.Begin(ctx, "lucius", client.Public())
+		suite.Require().NoError(err)
+		proof, err := client.Proof(challenge.Salt, challenge.ServerPublic)
+		suite.Require().NoError(err)
+
+		_, _, err = srpService.Finish(ctx, challenge.SessionID, "mark", proof)
+		suite.Require().ErrorIs(err, service.ErrSRPSession)
+		_, _, err = srpService.Finish(ctx, challenge.SessionID, "lucius", proof)
+		suite.ErrorIs(err, service.ErrSRPSession)
+	})
+
+	suite.Run("keyset is shared through the database", func() {
+		kms := mocks.NewKMS(suite.T())
+		dataKey := make([]byte, 32)
+		kms.EXPECT().GenerateDataKey().Return(dataKey, []byte("encrypted-key"), nil).Maybe()
+		kms.EXPECT().DecryptDataKey([]byte("encrypted-key")).Return(dataKey, nil).Maybe()
+		enc := service.NewStandardEncryptionService(kms)
+		keyRepo := storage.NewSigningKeyRepo(pool)
+
+		first, err := service.NewKeyset(ctx, keyRepo, enc, service.AlgEdDSA)
+		suite.Require().NoError(err)
+		second, err := service.NewKeyset(ctx, keyRepo, enc, service.AlgEdDSA)
+		suite.Require().NoError(err)
+		suite.Equal(first.Current().ID, second.Current().ID)
+
+		signer := service.NewJWTAuthService(userRepo, nil, nil, time.Hour, time.Hour, service.WithKeyset(first))
+		verifier := service.NewJWTAuthService(userRepo, nil, nil, time.Hour, time.Hour, service.WithKeyset(second))
+		for range 3 {
+			_, err = first.Rotate()
+			suite.Require().NoError(err)
+		}
+		tokens, err := signer.GetTokenPair("bruce")
+		suite.Require().NoError(err)
+		username, err := verifier.ValidateAccessToken(tokens.AccessToken)
+		suite.Require().NoError(err, "unknown kid reloads the keys")
+		suite.Equal("bruce", username)
+
+		stored, err := keyRepo.List(ctx, 10)
+		suite.Require().NoError(err)
+		suite.Len(stored, service.KeysetSize, "old keys are retired")
+	})
 }
 
 func TestJWTAuthTestSuite(t *testing.T) {
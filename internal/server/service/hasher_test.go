@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/itallix/gophkeeper/internal/server/service"
+)
+
+var testArgon2Params = service.Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1}
+
+func TestArgon2Hasher(t *testing.T) {
+	hasher := service.NewArgon2Hasher(testArgon2Params)
+
+	hash, err := hasher.Hash("secret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	t.Run("matching password", func(t *testing.T) {
+		ok, rehash, err := hasher.Verify(hash, "secret")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.False(t, rehash)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		ok, _, err := hasher.Verify(hash, "geheim")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("salted", func(t *testing.T) {
+		other, err := hasher.Hash("secret")
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, other)
+	})
+
+	t.Run("changed parameters require rehash", func(t *testing.T) {
+		stronger := service.NewArgon2Hasher(service.Argon2Params{Memory: 2048, Iterations: 2, Parallelism: 1})
+		ok, rehash, err := stronger.Verify(hash, "secret")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, rehash)
+	})
+
+	t.Run("long passwords are not truncated", func(t *testing.T) {
+		long := strings.Repeat("a", 100)
+		longHash, err := hasher.Hash(long)
+		require.NoError(t, err)
+		ok, _, err := hasher.Verify(longHash, long[:72])
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("malformed hash", func(t *testing.T) {
+		_, _, err := hasher.Verify("$argon2id$v=19$m=1024$broken", "secret")
+		require.Error(t, err)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, _, err := hasher.Verify("plaintext", "secret")
+		require.ErrorIs(t, err, service.ErrUnknownHash)
+	})
+}
+
+func TestArgon2HasherLegacyBcrypt(t *testing.T) {
+	hasher := service.NewArgon2Hasher(testArgon2Params)
+	legacy, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	ok, rehash, err := hasher.Verify(string(legacy), "secret")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, rehash)
+
+	ok, _, err = hasher.Verify(string(legacy), "geheim")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
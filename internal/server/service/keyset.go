@@ -0,0 +1,325 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// Supported asymmetric signing algorithms.
+const (
+	AlgEdDSA = "EdDSA"
+	AlgES256 = "ES256"
+)
+
+const (
+	// KeysetSize is the number of keys accepted for verification: the current one and the previous one,
+	// so tokens issued before a rotation stay valid.
+	KeysetSize = 2
+	// KeysetRefresh is how often keys rotated by other server instances are picked up.
+	KeysetRefresh = time.Minute
+
+	kidBytes = 8
+)
+
+// KeysetForcedRefresh limits how often a token with an unknown kid reloads the keys, so that forged tokens
+// can't make every request query the store and decrypt the keys.
+var KeysetForcedRefresh = 10 * time.Second
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrUnknownKey           = errors.New("unknown signing key")
+
+	errNoKeys = errors.New("no signing keys stored")
+)
+
+// SigningKey is a private key used to sign JWTs, identified by the kid header.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	CreatedAt time.Time
+}
+
+// Method returns the JWT signing method of the key.
+func (k SigningKey) Method() jwt.SigningMethod {
+	if k.Algorithm == AlgES256 {
+		return jwt.SigningMethodES256
+	}
+	return jwt.SigningMethodEdDSA
+}
+
+// JWK is the public part of a signing key in the JSON Web Key format (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y,omitempty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+}
+
+// JWK returns the public key in the JWK format.
+func (k SigningKey) JWK() JWK {
+	jwk := JWK{KeyID: k.ID, Algorithm: k.Algorithm, Use: "sig"}
+	switch pub := k.Private.Public().(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType, jwk.Curve = "OKP", "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8 //nolint:mnd // bits to bytes
+		jwk.KeyType, jwk.Curve = "EC", pub.Curve.Params().Name
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+		jwk.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+	}
+	return jwk
+}
+
+// GenerateSigningKey creates a new key for the algorithm with a random key ID.
+func GenerateSigningKey(algorithm string) (SigningKey, error) {
+	var (
+		private crypto.Signer
+		err     error
+	)
+	switch algorithm {
+	case AlgEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	case AlgES256:
+		private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return SigningKey{}, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	id := make([]byte, kidBytes)
+	if _, err = rand.Read(id); err != nil {
+		return SigningKey{}, fmt.Errorf("failed to generate key id: %w", err)
+	}
+	return SigningKey{
+		ID:        hex.EncodeToString(id),
+		Algorithm: algorithm,
+		Private:   private,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// KeyStore persists the signing keys, so that every server instance signs with the same key.
+type KeyStore interface {
+	List(ctx context.Context, limit int) ([]models.EncryptedSigningKey, error)
+	Add(ctx context.Context, key models.EncryptedSigningKey, keep int) error
+}
+
+// SigningKeys publishes the verification keys and rotates the signing key.
+type SigningKeys interface {
+	JWKS() []JWK
+	Rotate() (SigningKey, error)
+}
+
+// Keyset holds the current signing key and the previous one. Private keys are stored encrypted
+// with the data keys of the KMS.
+type Keyset struct {
+	ctx       context.Context
+	store     KeyStore
+	enc       EncryptionService
+	algorithm string
+
+	mu         sync.RWMutex
+	keys       []SigningKey // the newest first
+	reloadedAt time.Time
+}
+
+// NewKeyset loads the stored keys and creates the first one if there are none yet.
+func NewKeyset(ctx context.Context, store KeyStore, enc EncryptionService, algorithm string) (*Keyset, error) {
+	if algorithm != AlgEdDSA && algorithm != AlgES256 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	ks := &Keyset{
+		ctx:       ctx,
+		store:     store,
+		enc:       enc,
+		algorithm: algorithm,
+	}
+	if err := ks.load(); err != nil && !errors.Is(err, errNoKeys) {
+		return nil, err
+	}
+	if len(ks.keys) == 0 || ks.keys[0].Algorithm != algorithm {
+		if _, err := ks.Rotate(); err != nil {
+			return nil, err
+		}
+	}
+	return ks, nil
+}
+
+func (ks *Keyset) load() error {
+	stored, err := ks.store.List(ks.ctx, KeysetSize)
+	if err != nil {
+		return err
+	}
+	keys := make([]SigningKey, 0, len(stored))
+	for _, s := range stored {
+		key, decErr := ks.decrypt(s)
+		if decErr != nil {
+			return decErr
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return errNoKeys
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.keys = keys
+	ks.reloadedAt = time.Now()
+	return nil
+}
+
+func (ks *Keyset) decrypt(stored models.EncryptedSigningKey) (SigningKey, error) {
+	var der bytes.Buffer
+	if err := ks.enc.Decrypt(stored.PrivateKey, &der, stored.DataKey); err != nil {
+		return SigningKey{}, fmt.Errorf("failed to decrypt signing key %s: %w", stored.ID, err)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der.Bytes())
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("failed to parse signing key %s: %w", stored.ID, err)
+	}
+	private, ok := parsed.(crypto.Signer)
+	if !ok {
+		return SigningKey{}, fmt.Errorf("%w: %T", ErrUnsupportedAlgorithm, parsed)
+	}
+	return SigningKey{
+		ID:        stored.ID,
+		Algorithm: stored.Algorithm,
+		Private:   private,
+		CreatedAt: stored.CreatedAt,
+	}, nil
+}
+
+// snapshot returns the keys, reloading them first if they are stale or force is set and they
+// haven't been reloaded within KeysetForcedRefresh.
+func (ks *Keyset) snapshot(force bool) []SigningKey {
+	ks.mu.RLock()
+	keys, due := ks.keys, ks.reloadDue(force)
+	ks.mu.RUnlock()
+	if !due || !ks.claimReload(force) {
+		return keys
+	}
+	if err := ks.load(); err != nil {
+		// Keep using the keys at hand, the store is asked again once the reload is due.
+		logger.Log().Errorf("failed to reload signing keys: %v", err)
+		return keys
+	}
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return ks.keys
+}
+
+func (ks *Keyset) reloadDue(force bool) bool {
+	age := time.Since(ks.reloadedAt)
+	return age > KeysetRefresh || (force && age > KeysetForcedRefresh)
+}
+
+// claimReload records the reload attempt unless another caller did so first, so that concurrent
+// callers and failing reloads don't query the store more often.
+func (ks *Keyset) claimReload(force bool) bool {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	if !ks.reloadDue(force) {
+		return false
+	}
+	ks.reloadedAt = time.Now()
+	return true
+}
+
+// Current returns the key new tokens are signed with.
+func (ks *Keyset) Current() SigningKey {
+	return ks.snapshot(false)[0]
+}
+
+// Lookup returns the key with the ID. An unknown ID triggers a reload, since the key
+// might have been rotated by another server instance.
+func (ks *Keyset) Lookup(kid string) (SigningKey, error) {
+	for _, force := range []bool{false, true} {
+		for _, key := range ks.snapshot(force) {
+			if key.ID == kid {
+				return key, nil
+			}
+		}
+	}
+	return SigningKey{}, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+}
+
+// Rotate creates a new signing key. The previous key is still accepted for verification,
+// older keys are retired.
+func (ks *Keyset) Rotate() (SigningKey, error) {
+	key, err := GenerateSigningKey(ks.algorithm)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key.Private)
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("failed to marshal signing key: %w", err)
+	}
+	var encrypted bytes.Buffer
+	dataKey, err := ks.enc.Encrypt(der, &encrypted)
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("failed to encrypt signing key: %w", err)
+	}
+	if err = ks.store.Add(ks.ctx, models.EncryptedSigningKey{
+		ID:         key.ID,
+		Algorithm:  key.Algorithm,
+		PrivateKey: encrypted.Bytes(),
+		DataKey:    dataKey,
+		CreatedAt:  key.CreatedAt,
+	}, KeysetSize); err != nil {
+		return SigningKey{}, err
+	}
+	if err = ks.load(); err != nil {
+		return SigningKey{}, err
+	}
+	return key, nil
+}
+
+// JWKS returns the public keys accepted for verification.
+func (ks *Keyset) JWKS() []JWK {
+	keys := ks.snapshot(false)
+	jwks := make([]JWK, 0, len(keys))
+	for _, key := range keys {
+		jwks = append(jwks, key.JWK())
+	}
+	return jwks
+}
+
+// NewJWKSHandler serves the verification keys as a JSON Web Key Set.
+func NewJWKSHandler(keys SigningKeys) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(KeysetRefresh.Seconds())))
+		if err := json.NewEncoder(w).Encode(struct {
+			Keys []JWK `json:"keys"`
+		}{Keys: keys.JWKS()}); err != nil {
+			logger.Log().Errorf("failed to write jwks: %v", err)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/service"
+	mocks "github.com/itallix/gophkeeper/mocks/internal_/server/service"
+)
+
+type memKeyStore struct {
+	mu    sync.Mutex
+	keys  []models.EncryptedSigningKey
+	lists int
+}
+
+func (m *memKeyStore) List(_ context.Context, limit int) ([]models.EncryptedSigningKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lists++
+	keys := append([]models.EncryptedSigningKey(nil), m.keys...)
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].CreatedAt.After(keys[j].CreatedAt) })
+	return keys[:min(limit, len(keys))], nil
+}
+
+func (m *memKeyStore) Add(ctx context.Context, key models.EncryptedSigningKey, keep int) error {
+	m.mu.Lock()
+	m.keys = append(m.keys, key)
+	m.mu.Unlock()
+	kept, _ := m.List(ctx, keep)
+	m.mu.Lock()
+	m.keys = kept
+	m.mu.Unlock()
+	return nil
+}
+
+func newEncryption(t *testing.T) service.EncryptionService {
+	kms := mocks.NewKMS(t)
+	dataKey := make([]byte, 32)
+	kms.EXPECT().GenerateDataKey().Return(dataKey, []byte("encrypted-key"), nil).Maybe()
+	kms.EXPECT().DecryptDataKey([]byte("encrypted-key")).Return(dataKey, nil).Maybe()
+	return service.NewStandardEncryptionService(kms)
+}
+
+func TestKeyset(t *testing.T) {
+	ctx := context.Background()
+
+	for _, alg := range []string{service.AlgEdDSA, service.AlgES256} {
+		t.Run(alg+" tokens carry the kid", func(t *testing.T) {
+			keyset, err := service.NewKeyset(ctx, &memKeyStore{}, newEncryption(t), alg)
+			require.NoError(t, err)
+			auth := service.NewJWTAuthService(nil, nil, nil, time.Hour, time.Hour, service.WithKeyset(keyset))
+
+			tokens, err := auth.GetTokenPair("bruce")
+			require.NoError(t, err)
+			token, _, err := jwt.NewParser().ParseUnverified(tokens.AccessToken, &service.Claims{})
+			require.NoError(t, err)
+			assert.Equal(t, alg, token.Method.Alg())
+			assert.Equal(t, keyset.Current().ID, token.Header["kid"])
+
+			username, err := auth.ValidateAccessToken(tokens.AccessToken)
+			require.NoError(t, err)
+			assert.Equal(t, "bruce", username)
+			_, err = auth.RefreshTokens(tokens.RefreshToken)
+			require.NoError(t, err)
+			_, err = auth.ValidateAccessToken(tokens.RefreshToken)
+			require.ErrorIs(t, err, service.ErrInvalidToken)
+		})
+	}
+
+	t.Run("previous key is accepted after rotation", func(t *testing.T) {
+		keyset, err := service.NewKeyset(ctx, &memKeyStore{}, newEncryption(t), service.AlgEdDSA)
+		require.NoError(t, err)
+		auth := service.NewJWTAuthService(nil, nil, nil, time.Hour, time.Hour, service.WithKeyset(keyset))
+		tokens, err := auth.GetTokenPair("bruce")
+		require.NoError(t, err)
+		previous := keyset.Current()
+
+		rotated, err := keyset.Rotate()
+		require.NoError(t, err)
+		assert.NotEqual(t, previous.ID, rotated.ID)
+		assert.Equal(t, rotated.ID, keyset.Current().ID)
+		_, err = auth.ValidateAccessToken(tokens.AccessToken)
+		require.NoError(t, err)
+
+		_, err = keyset.Rotate()
+		require.NoError(t, err)
+		_, err = auth.ValidateAccessToken(tokens.AccessToken)
+		require.ErrorIs(t, err, service.ErrUnknownKey)
+	})
+
+	t.Run("stored keys are encrypted and reused", func(t *testing.T) {
+		store := &memKeyStore{}
+		enc := newEncryption(t)
+		first, err := service.NewKeyset(ctx, store, enc, service.AlgES256)
+		require.NoError(t, err)
+		require.Len(t, store.keys, 1)
+		assert.NotContains(t, string(store.keys[0].PrivateKey), "PRIVATE")
+
+		second, err := service.NewKeyset(ctx, store, enc, service.AlgES256)
+		require.NoError(t, err)
+		assert.Equal(t, first.Current().ID, second.Current().ID)
+		assert.True(t, first.Current().Private.(*ecdsa.PrivateKey).Equal(second.Current().Private))
+	})
+
+	t.Run("algorithm change rotates the key", func(t *testing.T) {
+		store := &memKeyStore{}
+		enc := newEncryption(t)
+		_, err := service.NewKeyset(ctx, store, enc, service.AlgES256)
+		require.NoError(t, err)
+		keyset, err := service.NewKeyset(ctx, store, enc, service.AlgEdDSA)
+		require.NoError(t, err)
+		assert.Equal(t, service.AlgEdDSA, keyset.Current().Algorithm)
+		assert.Len(t, keyset.JWKS(), service.KeysetSize)
+	})
+
+	t.Run("token with mismatching algorithm is rejected", func(t *testing.T) {
+		keyset, err := service.NewKeyset(ctx, &memKeyStore{}, newEncryption(t), service.AlgEdDSA)
+		require.NoError(t, err)
+		auth := service.NewJWTAuthService(nil, nil, nil, time.Hour, time.Hour, service.WithKeyset(keyset))
+
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, service.Claims{Username: "bruce", Type: service.AccessToken})
+		token.Header["kid"] = keyset.Current().ID
+		public := keyset.Current().Private.Public().(ed25519.PublicKey)
+		forged, err := token.SignedString([]byte(public))
+		require.NoError(t, err)
+		_, err = auth.ValidateAccessToken(forged)
+		require.ErrorIs(t, err, service.ErrInvalidSignature)
+	})
+
+	t.Run("unknown kids reload the keys once per interval", func(t *testing.T) {
+		refresh := service.KeysetForcedRefresh
+		service.KeysetForcedRefresh = 200 * time.Millisecond
+		defer func() { service.KeysetForcedRefresh = refresh }()
+
+		store := &memKeyStore{}
+		keyset, err := service.NewKeyset(ctx, store, newEncryption(t), service.AlgEdDSA)
+		require.NoError(t, err)
+		lookup := func() {
+			var wg sync.WaitGroup
+			for range 100 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, lookupErr := keyset.Lookup("forged")
+					assert.ErrorIs(t, lookupErr, service.ErrUnknownKey)
+				}()
+			}
+			wg.Wait()
+		}
+		lists := func() int {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			return store.lists
+		}
+
+		loaded := lists()
+		lookup()
+		assert.Equal(t, loaded, lists(), "the keys were just loaded")
+
+		time.Sleep(service.KeysetForcedRefresh)
+		lookup()
+		assert.Equal(t, loaded+1, lists())
+		lookup()
+		assert.Equal(t, loaded+1, lists())
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := service.NewKeyset(ctx, &memKeyStore{}, newEncryption(t), "RS256")
+		require.ErrorIs(t, err, service.ErrUnsupportedAlgorithm)
+	})
+}
+
+func TestJWKSHandler(t *testing.T) {
+	keyset, err := service.NewKeyset(context.Background(), &memKeyStore{}, newEncryption(t), service.AlgES256)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	service.NewJWKSHandler(keyset).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var body struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Keys, 1)
+	key := body.Keys[0]
+	assert.Equal(t, keyset.Current().ID, key["kid"])
+	assert.Equal(t, "EC", key["kty"])
+	assert.Equal(t, "P-256", key["crv"])
+	assert.Equal(t, "ES256", key["alg"])
+	assert.Len(t, key["x"], 43)
+	assert.Len(t, key["y"], 43)
+	assert.NotContains(t, key, "d")
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/common/oidc"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+const (
+	// OIDCKeysRefresh limits how often the keys of the issuer are fetched for an unknown kid.
+	OIDCKeysRefresh = 10 * time.Second
+	// OIDCLeeway tolerates clock skew between the server and the issuer.
+	OIDCLeeway = time.Minute
+	// OIDCTimeout bounds the requests to the issuer.
+	OIDCTimeout = 10 * time.Second
+)
+
+var (
+	ErrInvalidIDToken    = errors.New("invalid id token")
+	ErrIssuerUnavailable = errors.New("identity provider is unavailable")
+)
+
+// OIDCConfig describes the OpenID Connect issuer users sign in with.
+type OIDCConfig struct {
+	Issuer   string
+	ClientID string
+	// Scopes are requested by the clients, openid is always included.
+	Scopes []string
+	// UsernameClaim names the claim the login of new users is taken from,
+	// falling back to the email and the subject.
+	UsernameClaim string
+	GroupsClaim   string
+}
+
+// OIDCVerifier verifies ID tokens issued for the client against the key set of the issuer.
+type OIDCVerifier struct {
+	cfg    OIDCConfig
+	client *http.Client
+
+	mu        sync.Mutex
+	provider  *oidc.Provider
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewOIDCVerifier creates a verifier, the issuer is only contacted when the first token is verified.
+func NewOIDCVerifier(cfg OIDCConfig, client *http.Client) *OIDCVerifier {
+	if client == nil {
+		client = &http.Client{Timeout: OIDCTimeout}
+	}
+	return &OIDCVerifier{cfg: cfg, client: client}
+}
+
+// Config returns the configuration of the issuer.
+func (v *OIDCVerifier) Config() OIDCConfig {
+	return v.cfg
+}
+
+// publicKey returns the key with the ID, discovering the provider and refreshing
+// the key set as needed.
+func (v *OIDCVerifier) publicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(v.fetchedAt) < OIDCKeysRefresh {
+		return nil, fmt.Errorf("%w: unknown key %s", ErrInvalidIDToken, kid)
+	}
+
+	if v.provider == nil {
+		provider, err := oidc.Discover(ctx, v.client, v.cfg.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrIssuerUnavailable, err)
+		}
+		v.provider = &provider
+	}
+	set, err := oidc.FetchKeys(ctx, v.client, *v.provider)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrIssuerUnavailable, err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, keyErr := jwk.PublicKey()
+		if keyErr != nil {
+			logger.Log().Warnf("skipping key %s of issuer %s: %v", jwk.KeyID, v.cfg.Issuer, keyErr)
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+	v.keys, v.fetchedAt = keys, time.Now()
+
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key %s", ErrInvalidIDToken, kid)
+}
+
+// Verify checks the signature, issuer, audience and lifetime of the ID token and, unless
+// it is empty, the nonce. It returns the identity with the login suggested by the claims.
+func (v *OIDCVerifier) Verify(ctx context.Context, idToken, nonce string) (models.ExternalIdentity, error) {
+	c, cancel := context.WithTimeout(ctx, OIDCTimeout)
+	defer cancel()
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.publicKey(c, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "ES256", "ES384", "EdDSA"}),
+		jwt.WithIssuer(v.cfg.Issuer),
+		jwt.WithAudience(v.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(OIDCLeeway),
+	)
+	if errors.Is(err, ErrIssuerUnavailable) {
+		return models.ExternalIdentity{}, err
+	}
+	if err != nil {
+		return models.ExternalIdentity{}, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return models.ExternalIdentity{}, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+	if aud, _ := claims.GetAudience(); len(aud) > 1 {
+		if azp, _ := claims["azp"].(string); azp != v.cfg.ClientID {
+			return models.ExternalIdentity{}, fmt.Errorf("%w: token was issued for %s", ErrInvalidIDToken, azp)
+		}
+	}
+	if nonce != "" {
+		if got, _ := claims["nonce"].(string); got != nonce {
+			return models.ExternalIdentity{}, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+		}
+	}
+
+	return models.ExternalIdentity{
+		Issuer:      v.cfg.Issuer,
+		Subject:     subject,
+		Login:       v.login(claims, subject),
+		Groups:      stringsClaim(claims[v.cfg.GroupsClaim]),
+		LastLoginAt: time.Now().UTC(),
+	}, nil
+}
+
+func (v *OIDCVerifier) login(claims jwt.MapClaims, subject string) string {
+	for _, name := range []string{v.cfg.UsernameClaim, "email"} {
+		if login, _ := claims[name].(string); login != "" {
+			return login
+		}
+	}
+	return subject
+}
+
+// stringsClaim accepts both a list of strings and a single string.
+func stringsClaim(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/common/oidc/oidctest"
+	"github.com/itallix/gophkeeper/internal/server/service"
+)
+
+func TestOIDCVerifier(t *testing.T) {
+	issuer := oidctest.NewIssuer("gophkeeper")
+	defer issuer.Close()
+	verifier := service.NewOIDCVerifier(service.OIDCConfig{
+		Issuer:        issuer.URL,
+		ClientID:      "gophkeeper",
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+	}, issuer.Client())
+	ctx := context.Background()
+
+	t.Run("valid token", func(t *testing.T) {
+		identity, err := verifier.Verify(ctx, issuer.IDToken(map[string]any{
+			"sub":                "00u1",
+			"preferred_username": "alice",
+			"email":              "alice@example.com",
+			"groups":             []string{"eng", "ops"},
+		}), "")
+		require.NoError(t, err)
+		assert.Equal(t, issuer.URL, identity.Issuer)
+		assert.Equal(t, "00u1", identity.Subject)
+		assert.Equal(t, "alice", identity.Login)
+		assert.Equal(t, []string{"eng", "ops"}, identity.Groups)
+	})
+
+	t.Run("login falls back to email and subject", func(t *testing.T) {
+		identity, err := verifier.Verify(ctx, issuer.IDToken(map[string]any{
+			"sub": "00u2", "email": "bob@example.com", "groups": "eng",
+		}), "")
+		require.NoError(t, err)
+		assert.Equal(t, "bob@example.com", identity.Login)
+		assert.Equal(t, []string{"eng"}, identity.Groups)
+
+		identity, err = verifier.Verify(ctx, issuer.IDToken(map[string]any{"sub": "00u3"}), "")
+		require.NoError(t, err)
+		assert.Equal(t, "00u3", identity.Login)
+		assert.Empty(t, identity.Groups)
+	})
+
+	t.Run("nonce", func(t *testing.T) {
+		token := issuer.IDToken(map[string]any{"sub": "00u1", "nonce": "n-1"})
+		_, err := verifier.Verify(ctx, token, "n-1")
+		require.NoError(t, err)
+		_, err = verifier.Verify(ctx, token, "n-2")
+		require.ErrorIs(t, err, service.ErrInvalidIDToken)
+	})
+
+	t.Run("multiple audiences require azp", func(t *testing.T) {
+		_, err := verifier.Verify(ctx, issuer.IDToken(map[string]any{
+			"sub": "00u1", "aud": []string{"other", "gophkeeper"},
+		}), "")
+		require.ErrorIs(t, err, service.ErrInvalidIDToken)
+
+		_, err = verifier.Verify(ctx, issuer.IDToken(map[string]any{
+			"sub": "00u1", "aud": []string{"other", "gophkeeper"}, "azp": "gophkeeper",
+		}), "")
+		require.NoError(t, err)
+	})
+
+	invalid := []struct {
+		name   string
+		claims map[string]any
+	}{
+		{name: "wrong audience", claims: map[string]any{"sub": "00u1", "aud": "other"}},
+		{name: "wrong issuer", claims: map[string]any{"sub": "00u1", "iss": "https://evil.example.com"}},
+		{name: "expired", claims: map[string]any{"sub": "00u1", "exp": time.Now().Add(-time.Hour).Unix()}},
+		{name: "without expiration", claims: map[string]any{"sub": "00u1", "exp": nil}},
+		{name: "without subject", claims: map[string]any{}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := map[string]any{"sub": nil}
+			for name, value := range tt.claims {
+				claims[name] = value
+			}
+			_, err := verifier.Verify(ctx, issuer.IDToken(claims), "")
+			require.ErrorIs(t, err, service.ErrInvalidIDToken)
+		})
+	}
+
+	t.Run("tampered token", func(t *testing.T) {
+		token := issuer.IDToken(map[string]any{"sub": "00u1"})
+		_, err := verifier.Verify(ctx, token[:len(token)-4]+"AAAA", "")
+		require.ErrorIs(t, err, service.ErrInvalidIDToken)
+	})
+
+	t.Run("unknown key is not refetched right away", func(t *testing.T) {
+		issuer.RotateKey()
+		_, err := verifier.Verify(ctx, issuer.IDToken(map[string]any{"sub": "00u1"}), "")
+		require.ErrorIs(t, err, service.ErrInvalidIDToken)
+	})
+
+	t.Run("issuer is down", func(t *testing.T) {
+		down := oidctest.NewIssuer("gophkeeper")
+		token := down.IDToken(map[string]any{"sub": "00u1"})
+		down.Close()
+		downVerifier := service.NewOIDCVerifier(service.OIDCConfig{Issuer: down.URL, ClientID: "gophkeeper"}, nil)
+
+		_, err := downVerifier.Verify(ctx, token, "")
+		require.ErrorIs(t, err, service.ErrIssuerUnavailable)
+	})
+}
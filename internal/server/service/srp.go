@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/itallix/gophkeeper/internal/common/srp"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+)
+
+const (
+	// DefaultSRPSessionTTL is how long a started SRP login waits for the client proof.
+	DefaultSRPSessionTTL = time.Minute
+	srpSessionIDLen      = 16
+	// srpVerifierMaxLen limits the verifier size, it is never longer than the 2048-bit group.
+	srpVerifierMaxLen = 256
+)
+
+var (
+	ErrSRPNotEnrolled  = errors.New("user has no srp verifier")
+	ErrSRPSession      = errors.New("srp session not found or expired")
+	ErrInvalidVerifier = errors.New("invalid srp salt or verifier")
+	ErrUserExists      = errors.New("user already exists")
+)
+
+// SRPAuthenticator logs users in with SRP-6a, so the server never sees their passwords.
+type SRPAuthenticator interface {
+	Register(ctx context.Context, login string, salt, verifier []byte) (*TokenPair, error)
+	// Enroll stores the verifier of a user who has just authenticated with the password.
+	Enroll(ctx context.Context, login string, salt, verifier []byte) error
+	// Begin answers the client public value with a challenge.
+	Begin(ctx context.Context, login string, clientPublic []byte) (*SRPChallenge, error)
+	// Finish checks the client proof and returns the server proof together with the tokens.
+	Finish(ctx context.Context, sessionID, login string, clientProof []byte) ([]byte, *TokenPair, error)
+}
+
+// SRPChallenge is the server response to a started login.
+type SRPChallenge struct {
+	SessionID    string
+	Salt         []byte
+	ServerPublic []byte
+}
+
+type srpSession struct {
+	login   string
+	server  *srp.Server
+	expires time.Time
+}
+
+// SRPAuthService keeps the started logins in memory, the client must finish a login on
+// the same server instance it was started on.
+type SRPAuthService struct {
+	userRepo    *storage.UserRepo
+	authService AuthenticationService
+	ttl         time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]srpSession
+}
+
+func NewSRPAuthService(userRepo *storage.UserRepo, authService AuthenticationService) *SRPAuthService {
+	return &SRPAuthService{
+		userRepo:    userRepo,
+		authService: authService,
+		ttl:         DefaultSRPSessionTTL,
+		now:         time.Now,
+		sessions:    make(map[string]srpSession),
+	}
+}
+
+func validVerifier(salt, verifier []byte) bool {
+	return len(salt) >= srp.SaltLen && len(verifier) > 0 && len(verifier) <= srpVerifierMaxLen
+}
+
+func (s *SRPAuthService) Register(ctx context.Context, login string, salt, verifier []byte) (*TokenPair, error) {
+	if !validVerifier(salt, verifier) {
+		return nil, ErrInvalidVerifier
+	}
+	exists, err := s.userRepo.Exists(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrUserExists
+	}
+	if err = s.userRepo.CreateSRPUser(ctx, login, salt, verifier); err != nil {
+		return nil, err
+	}
+	return s.authService.GetTokenPair(login)
+}
+
+func (s *SRPAuthService) Enroll(ctx context.Context, login string, salt, verifier []byte) error {
+	if !validVerifier(salt, verifier) {
+		return ErrInvalidVerifier
+	}
+	return s.userRepo.SetSRPVerifier(ctx, login, salt, verifier)
+}
+
+func (s *SRPAuthService) Begin(ctx context.Context, login string, clientPublic []byte) (*SRPChallenge, error) {
+	salt, verifier, err := s.userRepo.GetSRPVerifier(ctx, login)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if verifier == nil {
+		return nil, ErrSRPNotEnrolled
+	}
+	server, err := srp.NewServer(login, salt, verifier, clientPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, srpSessionIDLen)
+	if _, err = rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate session id: %w", err)
+	}
+	challenge := &SRPChallenge{
+		SessionID:    hex.EncodeToString(buf),
+		Salt:         server.Salt(),
+		ServerPublic: server.Public(),
+	}
+
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, session := range s.sessions {
+		if now.After(session.expires) {
+			delete(s.sessions, id)
+		}
+	}
+	s.sessions[challenge.SessionID] = srpSession{login: login, server: server, expires: now.Add(s.ttl)}
+
+	return challenge, nil
+}
+
+// Finish consumes the session, a failed proof can't be retried against the same challenge.
+func (s *SRPAuthService) Finish(_ context.Context, sessionID, login string,
+	clientProof []byte) ([]byte, *TokenPair, error) {
+	s.mu.Lock()
+	session, ok := s.sessions[sessionID]
+	delete(s.sessions, sessionID)
+	s.mu.Unlock()
+
+	if !ok || session.login != login || s.now().After(session.expires) {
+		return nil, nil, ErrSRPSession
+	}
+	serverProof, _, err := session.server.Verify(clientProof)
+	if err != nil {
+		return nil, nil, ErrInvalidCreds
+	}
+	pair, err := s.authService.GetTokenPair(login)
+	if err != nil {
+		return nil, nil, err
+	}
+	return serverProof, pair, nil
+}
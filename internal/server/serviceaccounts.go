@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+)
+
+const (
+	// APIKeyPrefix starts every API key, so leaked keys are easy to recognize.
+	APIKeyPrefix = "gk"
+	apiKeyIDLen  = 8
+	apiKeyLen    = 32
+)
+
+var (
+	ErrInvalidAPIKey = errors.New("invalid api key")
+	ErrAPIKeyExpired = errors.New("api key expired")
+)
+
+// ServiceAccounts defines the management of service accounts and their API keys.
+// Accounts and keys are managed by their owner, passed as the first argument.
+type ServiceAccounts interface {
+	CreateServiceAccount(owner, name string) error
+	ListServiceAccounts(owner string) ([]models.ServiceAccount, error)
+	DeleteServiceAccount(owner, name string) error
+	// CreateAPIKey returns the new key, which is not stored and can't be shown again. A zero ttl never expires.
+	CreateAPIKey(owner, account string, scope models.Scope, ttl time.Duration) (string, models.APIKey, error)
+	ListAPIKeys(owner, account string) ([]models.APIKey, error)
+	RevokeAPIKey(owner, account, keyID string) error
+	// AuthenticateAPIKey checks the key and returns its account and scope.
+	AuthenticateAPIKey(key string) (models.APIKey, error)
+}
+
+// ServiceAccountManager implements ServiceAccounts. Keys are random, so they are
+// hashed with SHA-256 rather than a slow password hash.
+type ServiceAccountManager struct {
+	ctx  context.Context
+	repo *storage.ServiceAccountRepo
+	now  func() time.Time
+}
+
+func NewServiceAccountManager(ctx context.Context, pool *pgxpool.Pool) *ServiceAccountManager {
+	return &ServiceAccountManager{
+		ctx:  ctx,
+		repo: storage.NewServiceAccountRepo(pool),
+		now:  time.Now,
+	}
+}
+
+func hashAPIKey(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// parseAPIKey splits a key of the form gk_<id>_<secret>.
+func parseAPIKey(key string) (string, string, error) {
+	parts := strings.SplitN(key, "_", 3) //nolint:mnd // prefix, id and secret
+	if len(parts) != 3 || parts[0] != APIKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", ErrInvalidAPIKey
+	}
+	return parts[1], parts[2], nil
+}
+
+func (m *ServiceAccountManager) CreateServiceAccount(owner, name string) error {
+	return m.repo.CreateAccount(m.ctx, owner, name)
+}
+
+func (m *ServiceAccountManager) ListServiceAccounts(owner string) ([]models.ServiceAccount, error) {
+	return m.repo.ListAccounts(m.ctx, owner)
+}
+
+func (m *ServiceAccountManager) DeleteServiceAccount(owner, name string) error {
+	return m.repo.DeleteAccount(m.ctx, owner, name)
+}
+
+func (m *ServiceAccountManager) CreateAPIKey(owner, account string, scope models.Scope,
+	ttl time.Duration) (string, models.APIKey, error) {
+	id := make([]byte, apiKeyIDLen)
+	secret := make([]byte, apiKeyLen)
+	if _, err := rand.Read(id); err != nil {
+		return "", models.APIKey{}, fmt.Errorf("failed to generate api key: %w", err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", models.APIKey{}, fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	now := m.now()
+	key := models.APIKey{
+		ID:        hex.EncodeToString(id),
+		Account:   account,
+		Scope:     scope,
+		CreatedAt: now,
+	}
+	if ttl > 0 {
+		key.ExpiresAt = now.Add(ttl)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(secret)
+	if err := m.repo.CreateKey(m.ctx, owner, key, hashAPIKey(encoded)); err != nil {
+		return "", models.APIKey{}, err
+	}
+
+	return strings.Join([]string{APIKeyPrefix, key.ID, encoded}, "_"), key, nil
+}
+
+func (m *ServiceAccountManager) ListAPIKeys(owner, account string) ([]models.APIKey, error) {
+	return m.repo.ListKeys(m.ctx, owner, account)
+}
+
+func (m *ServiceAccountManager) RevokeAPIKey(owner, account, keyID string) error {
+	return m.repo.RevokeKey(m.ctx, owner, account, keyID)
+}
+
+func (m *ServiceAccountManager) AuthenticateAPIKey(key string) (models.APIKey, error) {
+	id, secret, err := parseAPIKey(key)
+	if err != nil {
+		return models.APIKey{}, err
+	}
+	apiKey, hash, err := m.repo.GetKey(m.ctx, id)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return models.APIKey{}, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return models.APIKey{}, err
+	}
+	if subtle.ConstantTimeCompare(hash, hashAPIKey(secret)) != 1 {
+		return models.APIKey{}, ErrInvalidAPIKey
+	}
+	now := m.now()
+	if !apiKey.ExpiresAt.IsZero() && now.After(apiKey.ExpiresAt) {
+		return models.APIKey{}, ErrAPIKeyExpired
+	}
+	if err = m.repo.TouchKey(m.ctx, id, now); err != nil {
+		logger.Log().Errorf("failed to record the use of api key=[%s]: %v", id, err)
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/service"
+	"github.com/itallix/gophkeeper/internal/server/storage"
+)
+
+// roleAdmin maps a group to the server administrator role.
+const roleAdmin = "admin"
+
+var ErrInvalidGroupRole = errors.New("invalid group role mapping")
+
+// SSO defines single sign-on through an OpenID Connect issuer.
+type SSO interface {
+	// Config returns the issuer and the client the CLI signs in with.
+	Config() service.OIDCConfig
+	// Login verifies the ID token, provisions the user on the first login and applies
+	// the roles of their groups. It returns the login of the user.
+	Login(idToken, nonce string) (string, error)
+	// IsAdmin reports whether the groups of the last login made the user an administrator.
+	IsAdmin(login string) bool
+}
+
+// ParseGroupRoles parses mappings of the form group=admin, granting the server administrator
+// role, and group=org:role, granting the role within the organization.
+func ParseGroupRoles(entries []string) ([]models.GroupRole, error) {
+	mappings := make([]models.GroupRole, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		sep := strings.LastIndex(entry, "=")
+		if sep <= 0 {
+			return nil, fmt.Errorf("%w: %q, expected group=admin or group=org:role", ErrInvalidGroupRole, entry)
+		}
+		mapping := models.GroupRole{Group: entry[:sep]}
+		target := entry[sep+1:]
+		if target == roleAdmin {
+			mapping.Admin = true
+			mappings = append(mappings, mapping)
+			continue
+		}
+		sep = strings.LastIndex(target, ":")
+		if sep <= 0 {
+			return nil, fmt.Errorf("%w: %q, expected group=admin or group=org:role", ErrInvalidGroupRole, entry)
+		}
+		mapping.Org, mapping.Role = target[:sep], models.Role(target[sep+1:])
+		if mapping.Role.Rank() == 0 {
+			return nil, fmt.Errorf("%w: %q, unknown role %s", ErrInvalidGroupRole, entry, mapping.Role)
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings, nil
+}
+
+// rolesFor returns whether the groups grant the administrator role and the highest role they
+// grant in every mapped organization, an empty role if they grant none.
+func rolesFor(mappings []models.GroupRole, groups []string) (bool, map[string]models.Role) {
+	member := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		member[group] = true
+	}
+	admin := false
+	roles := make(map[string]models.Role)
+	for _, mapping := range mappings {
+		if mapping.Admin {
+			admin = admin || member[mapping.Group]
+			continue
+		}
+		current := roles[mapping.Org]
+		if member[mapping.Group] && mapping.Role.Rank() > current.Rank() {
+			current = mapping.Role
+		}
+		roles[mapping.Org] = current
+	}
+	return admin, roles
+}
+
+// SSOManager implements SSO. Users are keyed by the issuer and the subject, the login is
+// taken from the claims on the first sign-on and suffixed if it is already taken.
+// Memberships of the organizations named in the mappings follow the groups of the user.
+type SSOManager struct {
+	ctx        context.Context
+	verifier   *service.OIDCVerifier
+	identities *storage.IdentityRepo
+	orgs       *storage.OrgRepo
+	mappings   []models.GroupRole
+}
+
+func NewSSOManager(ctx context.Context, pool *pgxpool.Pool, verifier *service.OIDCVerifier,
+	mappings []models.GroupRole) *SSOManager {
+	return &SSOManager{
+		ctx:        ctx,
+		verifier:   verifier,
+		identities: storage.NewIdentityRepo(pool),
+		orgs:       storage.NewOrgRepo(pool),
+		mappings:   mappings,
+	}
+}
+
+func (m *SSOManager) Config() service.OIDCConfig {
+	return m.verifier.Config()
+}
+
+func (m *SSOManager) Login(idToken, nonce string) (string, error) {
+	identity, err := m.verifier.Verify(m.ctx, idToken, nonce)
+	if err != nil {
+		return "", err
+	}
+	admin, roles := rolesFor(m.mappings, identity.Groups)
+	identity.Admin = admin
+
+	login, err := m.identities.FindLogin(m.ctx, identity.Issuer, identity.Subject)
+	switch {
+	case errors.Is(err, storage.ErrIdentityNotFound):
+		if login, err = m.provision(identity); err != nil {
+			return "", err
+		}
+	case err != nil:
+		return "", err
+	default:
+		if err = m.identities.Update(m.ctx, identity); err != nil {
+			return "", err
+		}
+	}
+
+	m.syncRoles(login, roles)
+	return login, nil
+}
+
+func (m *SSOManager) provision(identity models.ExternalIdentity) (string, error) {
+	sum := sha256.Sum256([]byte(identity.Issuer + "|" + identity.Subject))
+	candidates := []string{identity.Login, identity.Login + "-" + hex.EncodeToString(sum[:4])}
+	var err error
+	for _, login := range candidates {
+		identity.Login = login
+		if err = m.identities.Create(m.ctx, identity); !errors.Is(err, storage.ErrLoginTaken) {
+			break
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return identity.Login, nil
+}
+
+// syncRoles applies the organization roles, failures are logged and retried on the next login.
+func (m *SSOManager) syncRoles(login string, roles map[string]models.Role) {
+	for org, role := range roles {
+		var err error
+		if role == "" {
+			err = m.orgs.RemoveMember(m.ctx, org, login)
+			if errors.Is(err, storage.ErrMemberNotFound) {
+				err = nil
+			}
+		} else {
+			err = m.orgs.SetMember(m.ctx, org, login, role)
+		}
+		if err != nil {
+			logger.Log().Warnf("failed to apply role %q of user=[%s] in organization [%s]: %v", role, login, org, err)
+		}
+	}
+}
+
+func (m *SSOManager) IsAdmin(login string) bool {
+	admin, err := m.identities.IsAdmin(m.ctx, login)
+	if err != nil {
+		logger.Log().Errorf("failed to check if user=[%s] is an administrator: %v", login, err)
+	}
+	return admin
+}
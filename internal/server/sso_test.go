@@ -0,0 +1,42 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+func TestParseGroupRoles(t *testing.T) {
+	mappings, err := ParseGroupRoles([]string{"platform=admin", " /eng/backend=acme:editor", "", "a=b=c:viewer"})
+	require.NoError(t, err)
+	assert.Equal(t, []models.GroupRole{
+		{Group: "platform", Admin: true},
+		{Group: "/eng/backend", Org: "acme", Role: models.RoleEditor},
+		{Group: "a=b", Org: "c", Role: models.RoleViewer},
+	}, mappings)
+
+	for _, entry := range []string{"platform", "=admin", "eng=acme", "eng=acme:boss", "eng=:viewer"} {
+		_, err = ParseGroupRoles([]string{entry})
+		require.ErrorIs(t, err, ErrInvalidGroupRole, entry)
+	}
+}
+
+func TestRolesFor(t *testing.T) {
+	mappings := []models.GroupRole{
+		{Group: "platform", Admin: true},
+		{Group: "eng", Org: "acme", Role: models.RoleEditor},
+		{Group: "leads", Org: "acme", Role: models.RoleOwner},
+		{Group: "eng", Org: "initech", Role: models.RoleViewer},
+	}
+
+	admin, roles := rolesFor(mappings, []string{"leads", "eng"})
+	assert.False(t, admin)
+	assert.Equal(t, map[string]models.Role{"acme": models.RoleOwner, "initech": models.RoleViewer}, roles)
+
+	admin, roles = rolesFor(mappings, []string{"platform"})
+	assert.True(t, admin)
+	assert.Equal(t, map[string]models.Role{"acme": "", "initech": ""}, roles)
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/server/authz"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+var (
+	// ErrSecretNotFound is returned when the secret does not exist or is not visible to the user.
+	ErrSecretNotFound = errors.New("secret not found")
+	// ErrAccessDenied is returned when the user can see the resource but is not allowed to perform the action.
+	ErrAccessDenied = errors.New("access denied")
+	// ErrSecretExists is returned when a secret is written to a path which is already taken.
+	ErrSecretExists = errors.New("secret already exists")
+)
+
+// secretFacts collects what authz.Decide needs to know about the access of the user
+// to the secret stored at the path: its owner, the direct share and the roles the user
+// has in the organization whose collection contains the secret.
+func secretFacts(ctx context.Context, pool *pgxpool.Pool, path, user string) (authz.Resource, error) {
+	selectSQL := `
+	SELECT
+		COALESCE(s.created_by, ''),
+		COALESCE(sh.permission, ''),
+		COALESCE(m.role, ''),
+		ARRAY(
+			SELECT ct.role FROM collection_teams ct
+			INNER JOIN team_members tm ON tm.team_id = ct.team_id AND tm.login = $2
+			WHERE ct.collection_id = cs.collection_id
+		)
+	FROM secrets s
+	LEFT JOIN secret_shares sh ON sh.secret_id = s.secret_id AND sh.grantee = $2
+	LEFT JOIN collection_secrets cs ON cs.secret_id = s.secret_id
+	LEFT JOIN collections c ON c.collection_id = cs.collection_id
+	LEFT JOIN org_members m ON m.org_id = c.org_id AND m.login = $2
+	WHERE s.path = $1
+	`
+
+	var (
+		owner, shared, orgRole string
+		collectionRoles        []string
+	)
+	err := pool.QueryRow(ctx, selectSQL, path, user).Scan(&owner, &shared, &orgRole, &collectionRoles)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return authz.Resource{}, ErrSecretNotFound
+	}
+	if err != nil {
+		return authz.Resource{}, fmt.Errorf("failed to query secret access: %w", err)
+	}
+	return authz.Resource{
+		Owner:           owner,
+		Shared:          models.Permission(shared),
+		OrgRole:         models.Role(orgRole),
+		CollectionRoles: toRoles(collectionRoles),
+	}, nil
+}
+
+// decide translates the authorization decision into storage errors. Resources the user
+// can't even read are reported as not found, so their existence is not disclosed.
+func decide(user string, action, visibility authz.Action, res authz.Resource, notFound error) error {
+	err := authz.Decide(user, action, res)
+	if err == nil {
+		return nil
+	}
+	if authz.Decide(user, visibility, res) != nil {
+		return notFound
+	}
+	return fmt.Errorf("%w: %w", ErrAccessDenied, err)
+}
+
+// checkAccess verifies that the user is allowed to perform the action on the secret at the path.
+func checkAccess(ctx context.Context, pool *pgxpool.Pool, path, user string, action authz.Action) error {
+	if user == "" {
+		return ErrAccessDenied
+	}
+	res, err := secretFacts(ctx, pool, path, user)
+	if err != nil {
+		return err
+	}
+	return decide(user, action, authz.ActionRead, res, ErrSecretNotFound)
+}
+
+// checkPathAvailable verifies that no secret is stored at the path yet.
+func checkPathAvailable(ctx context.Context, pool *pgxpool.Pool, path, user string) error {
+	if user == "" {
+		return ErrAccessDenied
+	}
+	_, err := secretFacts(ctx, pool, path, user)
+	if errors.Is(err, ErrSecretNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return ErrSecretExists
+}
+
+// AccessRepo exposes the access checks of the storage visitors, so the authorization
+// layer can reject requests before they reach the vault.
+type AccessRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewAccessRepo(pool *pgxpool.Pool) *AccessRepo {
+	return &AccessRepo{
+		pool: pool,
+	}
+}
+
+// CheckSecret verifies that the user is allowed to perform the action on the secret at the path.
+func (r *AccessRepo) CheckSecret(ctx context.Context, path, user string, action authz.Action) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	return checkAccess(c, r.pool, path, user, action)
+}
@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+const (
+	// auditLockID serializes appends to the audit log, so every event is chained to the latest one.
+	auditLockID = 7_305_001
+	// DefaultAuditLimit is the number of events returned when the filter doesn't set a limit.
+	DefaultAuditLimit = 100
+	// MaxAuditLimit caps the number of events returned at once.
+	MaxAuditLimit = 1000
+)
+
+// AuditRepo appends events to the hash-chained audit log and verifies its integrity.
+type AuditRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewAuditRepo(pool *pgxpool.Pool) *AuditRepo {
+	return &AuditRepo{
+		pool: pool,
+	}
+}
+
+// Record appends the event to the audit log. The owner of the secret is looked up
+// when the event doesn't have one, falling back to the actor for secrets which no longer exist.
+func (r *AuditRepo) Record(ctx context.Context, event models.AuditEvent) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[RECORD AUDIT EVENT]"
+	tx, err := r.pool.Begin(c)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(c)
+	}()
+
+	if _, err = tx.Exec(c, "SELECT pg_advisory_xact_lock($1)", auditLockID); err != nil {
+		return fmt.Errorf("%s failed to lock audit log: %w", errPrefix, err)
+	}
+	if event.Owner == "" && event.Path != "" {
+		ownerSQL := "SELECT COALESCE((SELECT created_by FROM secrets WHERE path = $1), '')"
+		if err = tx.QueryRow(c, ownerSQL, event.Path).Scan(&event.Owner); err != nil {
+			return fmt.Errorf("%s failed to query secret owner: %w", errPrefix, err)
+		}
+	}
+	if event.Owner == "" {
+		event.Owner = event.Actor
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	// the database keeps microseconds, the hash must be computed over what is stored
+	event.CreatedAt = event.CreatedAt.UTC().Truncate(time.Microsecond)
+
+	event.PrevHash = []byte{}
+	lastSQL := "SELECT hash FROM audit_events ORDER BY event_id DESC LIMIT 1"
+	if err = tx.QueryRow(c, lastSQL).Scan(&event.PrevHash); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%s failed to query last event: %w", errPrefix, err)
+	}
+	event.Hash = event.ComputeHash(event.PrevHash)
+
+	insertSQL := `
+	INSERT INTO audit_events
+		(actor, action, path, secret_type, owner, result, client_ip, user_agent, created_at, prev_hash, hash)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	if _, err = tx.Exec(c, insertSQL, event.Actor, event.Action, event.Path, event.Type, event.Owner, event.Result,
+		event.ClientIP, event.UserAgent, event.CreatedAt, event.PrevHash, event.Hash); err != nil {
+		return fmt.Errorf("%s failed to insert event: %w", errPrefix, err)
+	}
+	if err = tx.Commit(c); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+	return nil
+}
+
+func scanAuditEvent(row pgx.CollectableRow) (models.AuditEvent, error) {
+	var (
+		event      models.AuditEvent
+		secretType string
+	)
+	err := row.Scan(&event.EventID, &event.Actor, &event.Action, &event.Path, &secretType, &event.Owner,
+		&event.Result, &event.ClientIP, &event.UserAgent, &event.CreatedAt, &event.PrevHash, &event.Hash)
+	event.Type = models.VaultItemType(secretType)
+	return event, err
+}
+
+const auditColumns = `event_id, actor, action, path, secret_type, owner, result, client_ip, user_agent, created_at,
+	prev_hash, hash`
+
+// List returns the events matching the filter, the most recent first.
+func (r *AuditRepo) List(ctx context.Context, filter models.AuditFilter) ([]models.AuditEvent, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	var (
+		conditions []string
+		args       []any
+	)
+	where := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, strings.ReplaceAll(condition, "?", fmt.Sprintf("$%d", len(args))))
+	}
+	if filter.Participant != "" {
+		where("(actor = ? OR owner = ?)", filter.Participant)
+	}
+	if filter.Actor != "" {
+		where("actor = ?", filter.Actor)
+	}
+	if filter.Action != "" {
+		where("action = ?", filter.Action)
+	}
+	if filter.PathPrefix != "" {
+		where("starts_with(path, ?)", filter.PathPrefix)
+	}
+	if !filter.Since.IsZero() {
+		where("created_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		where("created_at < ?", filter.Until)
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultAuditLimit
+	}
+	limit = min(limit, MaxAuditLimit)
+
+	selectSQL := "SELECT " + auditColumns + " FROM audit_events"
+	if len(conditions) > 0 {
+		selectSQL += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	selectSQL += fmt.Sprintf(" ORDER BY event_id DESC LIMIT %d", limit)
+
+	rows, err := r.pool.Query(c, selectSQL, args...)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST AUDIT EVENTS] failed to query events: %w", err)
+	}
+	events, err := pgx.CollectRows(rows, scanAuditEvent)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST AUDIT EVENTS] failed to scan event: %w", err)
+	}
+	return events, nil
+}
+
+// Verify walks the whole audit log checking that every event is chained to the previous
+// one and that its hash matches its content.
+func (r *AuditRepo) Verify(ctx context.Context) (models.AuditVerification, error) {
+	var result models.AuditVerification
+
+	rows, err := r.pool.Query(ctx, "SELECT "+auditColumns+" FROM audit_events ORDER BY event_id")
+	if err != nil {
+		return result, fmt.Errorf("[VERIFY AUDIT LOG] failed to query events: %w", err)
+	}
+	defer rows.Close()
+
+	prevHash := []byte{}
+	for rows.Next() {
+		event, scanErr := scanAuditEvent(rows)
+		if scanErr != nil {
+			return result, fmt.Errorf("[VERIFY AUDIT LOG] failed to scan event: %w", scanErr)
+		}
+		result.Events++
+		switch {
+		case !bytes.Equal(event.PrevHash, prevHash):
+			result.BrokenAt = event.EventID
+			result.Reason = "previous event has been modified or deleted"
+		case !bytes.Equal(event.Hash, event.ComputeHash(prevHash)):
+			result.BrokenAt = event.EventID
+			result.Reason = "event has been modified"
+		}
+		if result.BrokenAt != 0 {
+			return result, nil
+		}
+		prevHash = event.Hash
+	}
+	if err = rows.Err(); err != nil {
+		return result, fmt.Errorf("[VERIFY AUDIT LOG] failed to read events: %w", err)
+	}
+	return result, nil
+}
@@ -79,8 +79,10 @@ func (s *Creator) VisitLogin(login *models.Login) error {
         INSERT INTO logins (
             secret_id,
             login,
-            password
-        ) VALUES ($1, $2, $3)
+            password,
+            totp,
+            notes
+        ) VALUES ($1, $2, $3, $4, $5)
 		RETURNING login_id`
 
 	var loginID int64
@@ -88,10 +90,32 @@ func (s *Creator) VisitLogin(login *models.Login) error {
 		secretID,
 		login.Login,
 		login.Password,
+		login.TOTP,
+		login.Notes,
 	).Scan(&loginID); err != nil {
 		return fmt.Errorf("%s failed to insert login: %w", errPrefix, err)
 	}
 
+	for i, uri := range login.URIs {
+		if _, err = tx.Exec(ctx, "INSERT INTO login_uris (login_id, position, uri, match) VALUES ($1, $2, $3, $4)",
+			loginID, i, uri.URI, uri.Match); err != nil {
+			return fmt.Errorf("%s failed to insert uri %s: %w", errPrefix, uri.URI, err)
+		}
+	}
+	insertFieldSQL := `
+        INSERT INTO login_fields (
+            login_id,
+            position,
+            name,
+            value,
+            sensitive
+        ) VALUES ($1, $2, $3, $4, $5)`
+	for i, field := range login.Fields {
+		if _, err = tx.Exec(ctx, insertFieldSQL, loginID, i, field.Name, field.Value, field.Sensitive); err != nil {
+			return fmt.Errorf("%s failed to insert field %s: %w", errPrefix, field.Name, err)
+		}
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
 	}
@@ -129,8 +153,11 @@ func (s *Creator) VisitCard(card *models.Card) error {
             number,
 			expiry_month,
 			expiry_year,
-			cvc
-        ) VALUES ($1, $2, $3, $4, $5, $6)
+			cvc,
+			brand,
+			billing_address,
+			pin
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING card_id`
 
 	var cardID int64
@@ -141,6 +168,9 @@ func (s *Creator) VisitCard(card *models.Card) error {
 		card.ExpiryMonth,
 		card.ExpiryYear,
 		card.CVC,
+		card.Brand,
+		card.BillingAddress,
+		card.PIN,
 	).Scan(&cardID); err != nil {
 		return fmt.Errorf("%s failed to insert card: %w", errPrefix, err)
 	}
@@ -201,6 +231,155 @@ func (s *Creator) VisitNote(note *models.Note) error {
 	return nil
 }
 
+func (s *Creator) VisitSSHKey(key *models.SSHKey) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[CREATE SSH KEY]"
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	secretID, err := createSecret(ctx, tx, key.SecretMetadata)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	insertSQL := `
+        INSERT INTO ssh_keys (
+            secret_id,
+            private_key,
+            public_key,
+            passphrase,
+            comment,
+            fingerprint
+        ) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ssh_key_id`
+
+	var keyID int64
+	if err = tx.QueryRow(ctx, insertSQL,
+		secretID,
+		key.PrivateKey,
+		key.PublicKey,
+		key.Passphrase,
+		key.Comment,
+		key.Fingerprint,
+	).Scan(&keyID); err != nil {
+		return fmt.Errorf("%s failed to insert ssh key: %w", errPrefix, err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	key.SecretID = secretID
+	key.SSHKeyID = keyID
+
+	logger.Log().Infof("SSH key with path=[%s] has been successfully created.", key.Path)
+
+	return nil
+}
+
+func (s *Creator) VisitTOTP(secret *models.TOTP) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[CREATE TOTP]"
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	secretID, err := createSecret(ctx, tx, secret.SecretMetadata)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	insertSQL := `
+        INSERT INTO totps (
+            secret_id,
+            uri
+        ) VALUES ($1, $2)
+		RETURNING totp_id`
+
+	var totpID int64
+	if err = tx.QueryRow(ctx, insertSQL,
+		secretID,
+		secret.URI,
+	).Scan(&totpID); err != nil {
+		return fmt.Errorf("%s failed to insert totp: %w", errPrefix, err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	secret.SecretID = secretID
+	secret.TOTPID = totpID
+
+	logger.Log().Infof("TOTP with path=[%s] has been successfully created.", secret.Path)
+
+	return nil
+}
+
+func (s *Creator) VisitKV(kv *models.KV) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[CREATE KV]"
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	secretID, err := createSecret(ctx, tx, kv.SecretMetadata)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	var kvID int64
+	if err = tx.QueryRow(ctx, "INSERT INTO kvs (secret_id, type_name) VALUES ($1, NULLIF($2, '')) RETURNING kv_id",
+		secretID, kv.Type).Scan(&kvID); err != nil {
+		return fmt.Errorf("%s failed to insert kv: %w", errPrefix, err)
+	}
+
+	insertSQL := `
+        INSERT INTO kv_fields (
+            kv_id,
+            position,
+            name,
+            value,
+            sensitive
+        ) VALUES ($1, $2, $3, $4, $5)`
+
+	for i, field := range kv.Fields {
+		if _, err = tx.Exec(ctx, insertSQL, kvID, i, field.Name, field.Value, field.Sensitive); err != nil {
+			return fmt.Errorf("%s failed to insert field %s: %w", errPrefix, field.Name, err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	kv.SecretID = secretID
+	kv.KVID = kvID
+
+	logger.Log().Infof("KV with path=[%s] has been successfully created.", kv.Path)
+
+	return nil
+}
+
 func (s *Creator) VisitBinary(binary *models.Binary) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
@@ -246,6 +425,11 @@ func (s *Creator) VisitBinary(binary *models.Binary) error {
 		return nil
 	}
 
+	// make sure the chunks of an existing binary are not overwritten
+	if err := checkPathAvailable(ctx, s.pool, binary.Path, binary.RequestedBy); err != nil {
+		return fmt.Errorf("[CREATE BINARY] %w", err)
+	}
+
 	// write the chunk data to object storage
 	chunkName := fmt.Sprintf("%s/%d", binary.Path, binary.ChunkID)
 	if _, err := s.objectStorage.Upload(ctx, BucketBinaries, chunkName, int64(len(binary.Data)),
@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/authz"
 	"github.com/itallix/gophkeeper/internal/server/models"
 	"github.com/itallix/gophkeeper/internal/server/s3"
 )
@@ -40,6 +41,9 @@ func (s *Deleter) VisitLogin(login *models.Login) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
+	if err := checkAccess(ctx, s.pool, login.Path, login.RequestedBy, authz.ActionWrite); err != nil {
+		return fmt.Errorf("[DELETE LOGIN]: %w", err)
+	}
 	if err := deleteSecret(ctx, s.pool, login.Path); err != nil {
 		return fmt.Errorf("[DELETE LOGIN]: %w", err)
 	}
@@ -53,6 +57,9 @@ func (s *Deleter) VisitCard(card *models.Card) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
+	if err := checkAccess(ctx, s.pool, card.Path, card.RequestedBy, authz.ActionWrite); err != nil {
+		return fmt.Errorf("[DELETE CARD]: %w", err)
+	}
 	if err := deleteSecret(ctx, s.pool, card.Path); err != nil {
 		return fmt.Errorf("[DELETE CARD]: %w", err)
 	}
@@ -66,6 +73,9 @@ func (s *Deleter) VisitNote(note *models.Note) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
+	if err := checkAccess(ctx, s.pool, note.Path, note.RequestedBy, authz.ActionWrite); err != nil {
+		return fmt.Errorf("[DELETE NOTE]: %w", err)
+	}
 	if err := deleteSecret(ctx, s.pool, note.Path); err != nil {
 		return fmt.Errorf("[DELETE NOTE]: %w", err)
 	}
@@ -79,6 +89,9 @@ func (s *Deleter) VisitBinary(binary *models.Binary) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
+	if err := checkAccess(ctx, s.pool, binary.Path, binary.RequestedBy, authz.ActionWrite); err != nil {
+		return fmt.Errorf("[DELETE BINARY]: %w", err)
+	}
 	if err := s.objectStorage.DeleteChunks(ctx, BucketBinaries, binary.Path); err != nil {
 		return err
 	}
@@ -92,6 +105,54 @@ func (s *Deleter) VisitBinary(binary *models.Binary) error {
 	return nil
 }
 
+func (s *Deleter) VisitSSHKey(key *models.SSHKey) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	if err := checkAccess(ctx, s.pool, key.Path, key.RequestedBy, authz.ActionWrite); err != nil {
+		return fmt.Errorf("[DELETE SSH KEY]: %w", err)
+	}
+	if err := deleteSecret(ctx, s.pool, key.Path); err != nil {
+		return fmt.Errorf("[DELETE SSH KEY]: %w", err)
+	}
+
+	logger.Log().Infof("SSH key with path=[%s] has been successfully deleted.", key.Path)
+
+	return nil
+}
+
+func (s *Deleter) VisitTOTP(secret *models.TOTP) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	if err := checkAccess(ctx, s.pool, secret.Path, secret.RequestedBy, authz.ActionWrite); err != nil {
+		return fmt.Errorf("[DELETE TOTP]: %w", err)
+	}
+	if err := deleteSecret(ctx, s.pool, secret.Path); err != nil {
+		return fmt.Errorf("[DELETE TOTP]: %w", err)
+	}
+
+	logger.Log().Infof("TOTP with path=[%s] has been successfully deleted.", secret.Path)
+
+	return nil
+}
+
+func (s *Deleter) VisitKV(kv *models.KV) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	if err := checkAccess(ctx, s.pool, kv.Path, kv.RequestedBy, authz.ActionWrite); err != nil {
+		return fmt.Errorf("[DELETE KV]: %w", err)
+	}
+	if err := deleteSecret(ctx, s.pool, kv.Path); err != nil {
+		return fmt.Errorf("[DELETE KV]: %w", err)
+	}
+
+	logger.Log().Infof("KV with path=[%s] has been successfully deleted.", kv.Path)
+
+	return nil
+}
+
 func (s *Deleter) GetResult() any {
 	return nil
 }
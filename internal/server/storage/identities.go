@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+var (
+	ErrIdentityNotFound = errors.New("external identity not found")
+	ErrLoginTaken       = errors.New("login is already taken")
+)
+
+// IdentityRepo stores the users signing in through an OpenID Connect issuer.
+type IdentityRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewIdentityRepo(pool *pgxpool.Pool) *IdentityRepo {
+	return &IdentityRepo{
+		pool: pool,
+	}
+}
+
+// FindLogin returns the user linked to the subject of the issuer.
+func (r *IdentityRepo) FindLogin(ctx context.Context, issuer, subject string) (string, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	var login string
+	selectSQL := "SELECT login FROM external_identities WHERE issuer = $1 AND subject = $2"
+	err := r.pool.QueryRow(c, selectSQL, issuer, subject).Scan(&login)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("[FIND IDENTITY] %w", ErrIdentityNotFound)
+	}
+	if err != nil {
+		return "", fmt.Errorf("[FIND IDENTITY] failed to query identity: %w", err)
+	}
+	return login, nil
+}
+
+// Create provisions a user without a password linked to the identity.
+func (r *IdentityRepo) Create(ctx context.Context, identity models.ExternalIdentity) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[CREATE IDENTITY]"
+	tx, err := r.pool.Begin(c)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(c)
+	}()
+
+	if _, err = tx.Exec(c, "INSERT INTO users (login) VALUES ($1)", identity.Login); err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s %w", errPrefix, ErrLoginTaken)
+		}
+		return fmt.Errorf("%s failed to insert user: %w", errPrefix, err)
+	}
+	insertSQL := `
+	INSERT INTO external_identities (issuer, subject, login, groups, admin, last_login_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	if _, err = tx.Exec(c, insertSQL, identity.Issuer, identity.Subject, identity.Login, groupsOf(identity),
+		identity.Admin, identity.LastLoginAt); err != nil {
+		return fmt.Errorf("%s failed to insert identity: %w", errPrefix, err)
+	}
+	if err = tx.Commit(c); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("User=[%s] has been provisioned for subject [%s] of issuer [%s].",
+		identity.Login, identity.Subject, identity.Issuer)
+
+	return nil
+}
+
+// Update records the groups and the administrator role of the latest login.
+func (r *IdentityRepo) Update(ctx context.Context, identity models.ExternalIdentity) error {
+	updateSQL := `
+	UPDATE external_identities SET groups = $3, admin = $4, last_login_at = $5
+	WHERE issuer = $1 AND subject = $2
+	`
+	return execOne(ctx, r.pool, "[UPDATE IDENTITY]", ErrIdentityNotFound, updateSQL,
+		identity.Issuer, identity.Subject, groupsOf(identity), identity.Admin, identity.LastLoginAt)
+}
+
+// IsAdmin reports whether the identity provider made the user an administrator.
+func (r *IdentityRepo) IsAdmin(ctx context.Context, login string) (bool, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	var admin bool
+	selectSQL := "SELECT EXISTS(SELECT 1 FROM external_identities WHERE login = $1 AND admin)"
+	if err := r.pool.QueryRow(c, selectSQL, login).Scan(&admin); err != nil {
+		return false, fmt.Errorf("[IDENTITY ADMIN] failed to query identity: %w", err)
+	}
+	return admin, nil
+}
+
+func groupsOf(identity models.ExternalIdentity) []string {
+	if identity.Groups == nil {
+		return []string{}
+	}
+	return identity.Groups
+}
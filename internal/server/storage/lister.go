@@ -24,8 +24,8 @@ func NewLister(ctx context.Context, pool *pgxpool.Pool) *Lister {
 	}
 }
 
-func listSecrets(ctx context.Context, pool *pgxpool.Pool, query, errMsgPrexix string) ([]string, error) {
-	rows, err := pool.Query(ctx, query)
+func listSecrets(ctx context.Context, pool *pgxpool.Pool, query, errMsgPrexix string, args ...any) ([]string, error) {
+	rows, err := pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s failed to query logins: %w", errMsgPrexix, err)
 	}
@@ -48,12 +48,16 @@ func listSecrets(ctx context.Context, pool *pgxpool.Pool, query, errMsgPrexix st
 	return secrets, nil
 }
 
-func (s *Lister) VisitLogin(_ *models.Login) error {
+func (s *Lister) VisitLogin(login *models.Login) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
-	selectSQL := "SELECT path FROM logins l INNER JOIN secrets s ON l.secret_id = s.secret_id"
-	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST LOGINS]")
+	selectSQL := `
+	SELECT path FROM logins l
+	INNER JOIN secrets s ON l.secret_id = s.secret_id
+	WHERE s.created_by = $1
+	`
+	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST LOGINS]", login.RequestedBy)
 	if err != nil {
 		return err
 	}
@@ -62,12 +66,16 @@ func (s *Lister) VisitLogin(_ *models.Login) error {
 	return nil
 }
 
-func (s *Lister) VisitCard(_ *models.Card) error {
+func (s *Lister) VisitCard(card *models.Card) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
-	selectSQL := "SELECT path FROM cards l INNER JOIN secrets s ON l.secret_id = s.secret_id"
-	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST CARDS]")
+	selectSQL := `
+	SELECT path FROM cards l
+	INNER JOIN secrets s ON l.secret_id = s.secret_id
+	WHERE s.created_by = $1
+	`
+	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST CARDS]", card.RequestedBy)
 	if err != nil {
 		return err
 	}
@@ -76,12 +84,16 @@ func (s *Lister) VisitCard(_ *models.Card) error {
 	return nil
 }
 
-func (s *Lister) VisitNote(_ *models.Note) error {
+func (s *Lister) VisitNote(note *models.Note) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
-	selectSQL := "SELECT path FROM notes n INNER JOIN secrets s ON n.secret_id = s.secret_id"
-	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST NOTES]")
+	selectSQL := `
+	SELECT path FROM notes n
+	INNER JOIN secrets s ON n.secret_id = s.secret_id
+	WHERE s.created_by = $1
+	`
+	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST NOTES]", note.RequestedBy)
 	if err != nil {
 		return err
 	}
@@ -90,12 +102,70 @@ func (s *Lister) VisitNote(_ *models.Note) error {
 	return nil
 }
 
-func (s *Lister) VisitBinary(_ *models.Binary) error {
+func (s *Lister) VisitBinary(binary *models.Binary) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
-	selectSQL := "SELECT path FROM binaries b INNER JOIN secrets s ON b.secret_id = s.secret_id"
-	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST BINARIES]")
+	selectSQL := `
+	SELECT path FROM binaries b
+	INNER JOIN secrets s ON b.secret_id = s.secret_id
+	WHERE s.created_by = $1
+	`
+	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST BINARIES]", binary.RequestedBy)
+	if err != nil {
+		return err
+	}
+
+	s.result = secrets
+	return nil
+}
+
+func (s *Lister) VisitSSHKey(key *models.SSHKey) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT path FROM ssh_keys k
+	INNER JOIN secrets s ON k.secret_id = s.secret_id
+	WHERE s.created_by = $1
+	`
+	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST SSH KEYS]", key.RequestedBy)
+	if err != nil {
+		return err
+	}
+
+	s.result = secrets
+	return nil
+}
+
+func (s *Lister) VisitTOTP(secret *models.TOTP) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT path FROM totps t
+	INNER JOIN secrets s ON t.secret_id = s.secret_id
+	WHERE s.created_by = $1
+	`
+	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST TOTPS]", secret.RequestedBy)
+	if err != nil {
+		return err
+	}
+
+	s.result = secrets
+	return nil
+}
+
+func (s *Lister) VisitKV(kv *models.KV) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT path FROM kvs k
+	INNER JOIN secrets s ON k.secret_id = s.secret_id
+	WHERE s.created_by = $1 AND ($2 = '' OR k.type_name = $2)
+	`
+	secrets, err := listSecrets(ctx, s.pool, selectSQL, "[LIST KVS]", kv.RequestedBy, kv.Type)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// LockoutRepo keeps the failed login attempts in the database, so lockouts survive
+// restarts and apply to every server instance.
+type LockoutRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewLockoutRepo(pool *pgxpool.Pool) *LockoutRepo {
+	return &LockoutRepo{
+		pool: pool,
+	}
+}
+
+func scanAttempts(row pgx.Row) (models.LoginAttempts, error) {
+	var (
+		attempts                 models.LoginAttempts
+		lastFailure, lockedUntil *time.Time
+	)
+	if err := row.Scan(&attempts.Failures, &lastFailure, &lockedUntil); err != nil {
+		return attempts, err
+	}
+	if lastFailure != nil {
+		attempts.LastFailure = *lastFailure
+	}
+	if lockedUntil != nil {
+		attempts.LockedUntil = *lockedUntil
+	}
+	return attempts, nil
+}
+
+func (r *LockoutRepo) GetAttempts(ctx context.Context, login string) (models.LoginAttempts, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := "SELECT failures, last_failure, locked_until FROM login_attempts WHERE login = $1"
+	attempts, err := scanAttempts(r.pool.QueryRow(c, selectSQL, login))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.LoginAttempts{}, nil
+	}
+	if err != nil {
+		return attempts, fmt.Errorf("failed to get login attempts: %w", err)
+	}
+	return attempts, nil
+}
+
+func (r *LockoutRepo) RecordFailure(ctx context.Context, login string, now, since time.Time) (models.LoginAttempts,
+	error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	upsertSQL := `
+	INSERT INTO login_attempts (login, failures, last_failure) VALUES ($1, 1, $2)
+	ON CONFLICT (login) DO UPDATE SET
+		failures = CASE
+			WHEN login_attempts.last_failure IS NULL OR login_attempts.last_failure < $3 THEN 1
+			ELSE login_attempts.failures + 1
+		END,
+		last_failure = $2
+	RETURNING failures, last_failure, locked_until
+	`
+	attempts, err := scanAttempts(r.pool.QueryRow(c, upsertSQL, login, now, since))
+	if err != nil {
+		return attempts, fmt.Errorf("failed to record login failure: %w", err)
+	}
+	return attempts, nil
+}
+
+func (r *LockoutRepo) Lock(ctx context.Context, login string, until time.Time) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	updateSQL := "UPDATE login_attempts SET failures = 0, locked_until = $2 WHERE login = $1"
+	if _, err := r.pool.Exec(c, updateSQL, login, until); err != nil {
+		return fmt.Errorf("failed to lock account: %w", err)
+	}
+
+	logger.Log().Warnf("Account with login=[%s] is locked until %s after too many failed logins.", login,
+		until.Format(time.RFC3339))
+
+	return nil
+}
+
+func (r *LockoutRepo) Reset(ctx context.Context, login string) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	if _, err := r.pool.Exec(c, "DELETE FROM login_attempts WHERE login = $1", login); err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/server/authz"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// ListLoginURIs returns the URIs of the logins the user is allowed to read, one entry per URI ordered by path,
+// so that they can be matched against a URL. The candidates are the logins the user owns, that are shared
+// with them or that are in a collection of an organization they belong to, the access is then decided on the
+// same facts as for retrieving the login.
+func ListLoginURIs(ctx context.Context, pool *pgxpool.Pool, user string) ([]models.LoginMatch, error) {
+	ctx, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[LIST LOGIN URIS]"
+	selectSQL := `
+	SELECT
+		s.path, l.login, u.uri, u.match,
+		COALESCE(s.created_by, ''),
+		COALESCE(sh.permission, ''),
+		COALESCE(m.role, ''),
+		ARRAY(
+			SELECT ct.role FROM collection_teams ct
+			INNER JOIN team_members tm ON tm.team_id = ct.team_id AND tm.login = $1
+			WHERE ct.collection_id = cs.collection_id
+		)
+	FROM login_uris u
+	INNER JOIN logins l ON u.login_id = l.login_id
+	INNER JOIN secrets s ON l.secret_id = s.secret_id
+	LEFT JOIN secret_shares sh ON sh.secret_id = s.secret_id AND sh.grantee = $1
+	LEFT JOIN collection_secrets cs ON cs.secret_id = s.secret_id
+	LEFT JOIN collections c ON c.collection_id = cs.collection_id
+	LEFT JOIN org_members m ON m.org_id = c.org_id AND m.login = $1
+	WHERE s.created_by = $1 OR sh.grantee IS NOT NULL OR m.login IS NOT NULL
+	ORDER BY s.path, u.position
+	`
+	rows, err := pool.Query(ctx, selectSQL, user)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed to query uris: %w", errPrefix, err)
+	}
+	type candidate struct {
+		match    models.LoginMatch
+		resource authz.Resource
+	}
+	candidates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (candidate, error) {
+		var (
+			c                      candidate
+			owner, shared, orgRole string
+			collectionRoles        []string
+		)
+		err := row.Scan(&c.match.Path, &c.match.Login, &c.match.URI.URI, &c.match.URI.Match,
+			&owner, &shared, &orgRole, &collectionRoles)
+		c.resource = authz.Resource{
+			Owner:           owner,
+			Shared:          models.Permission(shared),
+			OrgRole:         models.Role(orgRole),
+			CollectionRoles: toRoles(collectionRoles),
+		}
+		return c, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s failed to scan uri: %w", errPrefix, err)
+	}
+
+	var matches []models.LoginMatch
+	for _, c := range candidates {
+		if authz.Decide(user, authz.ActionRead, c.resource) == nil {
+			matches = append(matches, c.match)
+		}
+	}
+	return matches, nil
+}
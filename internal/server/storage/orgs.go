@@ -0,0 +1,541 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/authz"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+const uniqueViolation = "23505"
+
+var (
+	ErrOrgNotFound        = errors.New("organization not found")
+	ErrOrgExists          = errors.New("organization already exists")
+	ErrMemberNotFound     = errors.New("member not found")
+	ErrLastOwner          = errors.New("organization must have at least one owner")
+	ErrTeamNotFound       = errors.New("team not found")
+	ErrTeamExists         = errors.New("team already exists")
+	ErrCollectionNotFound = errors.New("collection not found")
+	ErrCollectionExists   = errors.New("collection already exists")
+)
+
+// OrgRepo stores organizations, their members, teams and collections. It doesn't
+// authorize the operations, callers are expected to check them with the facts it provides.
+type OrgRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewOrgRepo(pool *pgxpool.Pool) *OrgRepo {
+	return &OrgRepo{
+		pool: pool,
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+func toRoles(roles []string) []models.Role {
+	result := make([]models.Role, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, models.Role(role))
+	}
+	return result
+}
+
+// execOne runs the statement and returns notFound if it didn't affect any row.
+func execOne(ctx context.Context, pool *pgxpool.Pool, errPrefix string, notFound error, query string,
+	args ...any) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	tag, err := pool.Exec(c, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s failed to execute statement: %w", errPrefix, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s %w", errPrefix, notFound)
+	}
+	return nil
+}
+
+func (r *OrgRepo) exec(ctx context.Context, errPrefix string, notFound error, query string, args ...any) error {
+	return execOne(ctx, r.pool, errPrefix, notFound, query, args...)
+}
+
+// OrgFacts returns the role of the user in the organization and the current role of the member.
+func (r *OrgRepo) OrgFacts(ctx context.Context, org, user, member string) (authz.Resource, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT
+		COALESCE((SELECT role FROM org_members WHERE org_id = o.org_id AND login = $2), ''),
+		COALESCE((SELECT role FROM org_members WHERE org_id = o.org_id AND login = $3), '')
+	FROM organizations o
+	WHERE o.name = $1
+	`
+
+	var orgRole, memberRole string
+	err := r.pool.QueryRow(c, selectSQL, org, user, member).Scan(&orgRole, &memberRole)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return authz.Resource{}, ErrOrgNotFound
+	}
+	if err != nil {
+		return authz.Resource{}, fmt.Errorf("failed to query organization access: %w", err)
+	}
+	return authz.Resource{OrgRole: models.Role(orgRole), MemberRole: models.Role(memberRole)}, nil
+}
+
+// CollectionFacts returns the role of the user in the organization and the roles of their teams on the collection.
+func (r *OrgRepo) CollectionFacts(ctx context.Context, org, collection, user string) (authz.Resource, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT
+		COALESCE((SELECT role FROM org_members WHERE org_id = o.org_id AND login = $3), ''),
+		ARRAY(
+			SELECT ct.role FROM collection_teams ct
+			INNER JOIN team_members tm ON tm.team_id = ct.team_id AND tm.login = $3
+			WHERE ct.collection_id = c.collection_id
+		)
+	FROM organizations o
+	INNER JOIN collections c ON c.org_id = o.org_id AND c.name = $2
+	WHERE o.name = $1
+	`
+
+	var (
+		orgRole         string
+		collectionRoles []string
+	)
+	err := r.pool.QueryRow(c, selectSQL, org, collection, user).Scan(&orgRole, &collectionRoles)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return authz.Resource{}, ErrCollectionNotFound
+	}
+	if err != nil {
+		return authz.Resource{}, fmt.Errorf("failed to query collection access: %w", err)
+	}
+	return authz.Resource{OrgRole: models.Role(orgRole), CollectionRoles: toRoles(collectionRoles)}, nil
+}
+
+// CreateOrg creates a new organization with the user as its owner.
+func (r *OrgRepo) CreateOrg(ctx context.Context, name, owner string) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[CREATE ORG]"
+	tx, err := r.pool.Begin(c)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(c)
+	}()
+
+	var orgID int64
+	if err = tx.QueryRow(c, "INSERT INTO organizations (name, created_by) VALUES ($1, $2) RETURNING org_id",
+		name, owner).Scan(&orgID); err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s %w", errPrefix, ErrOrgExists)
+		}
+		return fmt.Errorf("%s failed to insert organization: %w", errPrefix, err)
+	}
+	if _, err = tx.Exec(c, "INSERT INTO org_members (org_id, login, role) VALUES ($1, $2, $3)",
+		orgID, owner, models.RoleOwner); err != nil {
+		return fmt.Errorf("%s failed to insert owner: %w", errPrefix, err)
+	}
+	if err = tx.Commit(c); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("Organization [%s] has been successfully created by user=[%s].", name, owner)
+
+	return nil
+}
+
+// ListOrgs returns the organizations the user is a member of.
+func (r *OrgRepo) ListOrgs(ctx context.Context, user string) ([]models.Organization, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT o.name, m.role FROM organizations o
+	INNER JOIN org_members m ON m.org_id = o.org_id
+	WHERE m.login = $1
+	ORDER BY o.name
+	`
+
+	rows, err := r.pool.Query(c, selectSQL, user)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST ORGS] failed to query organizations: %w", err)
+	}
+	orgs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Organization, error) {
+		var (
+			org  models.Organization
+			role string
+		)
+		scanErr := row.Scan(&org.Name, &role)
+		org.Role = models.Role(role)
+		return org, scanErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[LIST ORGS] failed to scan organization: %w", err)
+	}
+	return orgs, nil
+}
+
+// countOwners returns the number of owners of the organization other than the member.
+func countOwners(ctx context.Context, tx pgx.Tx, org, member string) (int, error) {
+	selectSQL := `
+	SELECT COUNT(*) FROM org_members m
+	INNER JOIN organizations o ON o.org_id = m.org_id
+	WHERE o.name = $1 AND m.role = 'owner' AND m.login <> $2
+	`
+
+	var count int
+	if err := tx.QueryRow(ctx, selectSQL, org, member).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count owners: %w", err)
+	}
+	return count, nil
+}
+
+// SetMember adds the user to the organization or changes their role.
+func (r *OrgRepo) SetMember(ctx context.Context, org, member string, role models.Role) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[SET MEMBER]"
+	tx, err := r.pool.Begin(c)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(c)
+	}()
+
+	var count int
+	if err = tx.QueryRow(c, "SELECT COUNT(*) FROM users WHERE login = $1", member).Scan(&count); err != nil {
+		return fmt.Errorf("%s failed to check if user exists: %w", errPrefix, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%s %w", errPrefix, ErrUserNotFound)
+	}
+	if role != models.RoleOwner {
+		if count, err = countOwners(c, tx, org, member); err != nil {
+			return fmt.Errorf("%s %w", errPrefix, err)
+		}
+		if count == 0 {
+			return fmt.Errorf("%s %w", errPrefix, ErrLastOwner)
+		}
+	}
+
+	upsertSQL := `
+	INSERT INTO org_members (org_id, login, role)
+	SELECT org_id, $2, $3 FROM organizations WHERE name = $1
+	ON CONFLICT (org_id, login) DO UPDATE SET role = EXCLUDED.role
+	`
+	if _, err = tx.Exec(c, upsertSQL, org, member, role); err != nil {
+		return fmt.Errorf("%s failed to upsert member: %w", errPrefix, err)
+	}
+	if err = tx.Commit(c); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("User=[%s] is now %s of organization [%s].", member, role, org)
+
+	return nil
+}
+
+// RemoveMember removes the user from the organization and all its teams.
+func (r *OrgRepo) RemoveMember(ctx context.Context, org, member string) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[REMOVE MEMBER]"
+	tx, err := r.pool.Begin(c)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(c)
+	}()
+
+	count, err := countOwners(c, tx, org, member)
+	if err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%s %w", errPrefix, ErrLastOwner)
+	}
+
+	deleteTeamsSQL := `
+	DELETE FROM team_members tm USING teams t, organizations o
+	WHERE tm.team_id = t.team_id AND t.org_id = o.org_id AND o.name = $1 AND tm.login = $2
+	`
+	if _, err = tx.Exec(c, deleteTeamsSQL, org, member); err != nil {
+		return fmt.Errorf("%s failed to delete team memberships: %w", errPrefix, err)
+	}
+	deleteSQL := `
+	DELETE FROM org_members m USING organizations o
+	WHERE m.org_id = o.org_id AND o.name = $1 AND m.login = $2
+	`
+	tag, err := tx.Exec(c, deleteSQL, org, member)
+	if err != nil {
+		return fmt.Errorf("%s failed to delete member: %w", errPrefix, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s %w", errPrefix, ErrMemberNotFound)
+	}
+	if err = tx.Commit(c); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("User=[%s] has been removed from organization [%s].", member, org)
+
+	return nil
+}
+
+// ListMembers returns the members of the organization with their teams.
+func (r *OrgRepo) ListMembers(ctx context.Context, org string) ([]models.Member, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT m.login, m.role, ARRAY(
+		SELECT t.name FROM teams t
+		INNER JOIN team_members tm ON tm.team_id = t.team_id
+		WHERE t.org_id = o.org_id AND tm.login = m.login
+		ORDER BY t.name
+	)
+	FROM org_members m
+	INNER JOIN organizations o ON o.org_id = m.org_id
+	WHERE o.name = $1
+	ORDER BY m.login
+	`
+
+	rows, err := r.pool.Query(c, selectSQL, org)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST MEMBERS] failed to query members: %w", err)
+	}
+	members, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Member, error) {
+		var (
+			member models.Member
+			role   string
+		)
+		scanErr := row.Scan(&member.Login, &role, &member.Teams)
+		member.Role = models.Role(role)
+		return member, scanErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[LIST MEMBERS] failed to scan member: %w", err)
+	}
+	return members, nil
+}
+
+// CreateTeam creates a new team in the organization.
+func (r *OrgRepo) CreateTeam(ctx context.Context, org, team string) error {
+	insertSQL := "INSERT INTO teams (org_id, name) SELECT org_id, $2 FROM organizations WHERE name = $1"
+	err := r.exec(ctx, "[CREATE TEAM]", ErrOrgNotFound, insertSQL, org, team)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("[CREATE TEAM] %w", ErrTeamExists)
+	}
+	return err
+}
+
+// AddTeamMember adds a member of the organization to the team.
+func (r *OrgRepo) AddTeamMember(ctx context.Context, org, team, member string) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[ADD TEAM MEMBER]"
+	selectSQL := `
+	SELECT
+		COALESCE((SELECT t.team_id FROM teams t WHERE t.org_id = o.org_id AND t.name = $2), 0),
+		EXISTS (SELECT 1 FROM org_members m WHERE m.org_id = o.org_id AND m.login = $3)
+	FROM organizations o
+	WHERE o.name = $1
+	`
+
+	var (
+		teamID   int64
+		isMember bool
+	)
+	if err := r.pool.QueryRow(c, selectSQL, org, team, member).Scan(&teamID, &isMember); err != nil {
+		return fmt.Errorf("%s failed to query team: %w", errPrefix, err)
+	}
+	if teamID == 0 {
+		return fmt.Errorf("%s %w", errPrefix, ErrTeamNotFound)
+	}
+	if !isMember {
+		return fmt.Errorf("%s %w", errPrefix, ErrMemberNotFound)
+	}
+
+	insertSQL := "INSERT INTO team_members (team_id, login) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	if _, err := r.pool.Exec(c, insertSQL, teamID, member); err != nil {
+		return fmt.Errorf("%s failed to insert team member: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("User=[%s] has been added to team [%s/%s].", member, org, team)
+
+	return nil
+}
+
+// RemoveTeamMember removes the user from the team.
+func (r *OrgRepo) RemoveTeamMember(ctx context.Context, org, team, member string) error {
+	deleteSQL := `
+	DELETE FROM team_members tm USING teams t, organizations o
+	WHERE tm.team_id = t.team_id AND t.org_id = o.org_id AND o.name = $1 AND t.name = $2 AND tm.login = $3
+	`
+	return r.exec(ctx, "[REMOVE TEAM MEMBER]", ErrMemberNotFound, deleteSQL, org, team, member)
+}
+
+// ListTeams returns the teams of the organization with their members.
+func (r *OrgRepo) ListTeams(ctx context.Context, org string) ([]models.Team, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT t.name, ARRAY(SELECT login FROM team_members WHERE team_id = t.team_id ORDER BY login)
+	FROM teams t
+	INNER JOIN organizations o ON o.org_id = t.org_id
+	WHERE o.name = $1
+	ORDER BY t.name
+	`
+
+	rows, err := r.pool.Query(c, selectSQL, org)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST TEAMS] failed to query teams: %w", err)
+	}
+	teams, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Team, error) {
+		var team models.Team
+		scanErr := row.Scan(&team.Name, &team.Members)
+		return team, scanErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[LIST TEAMS] failed to scan team: %w", err)
+	}
+	return teams, nil
+}
+
+// CreateCollection creates a new collection in the organization.
+func (r *OrgRepo) CreateCollection(ctx context.Context, org, collection string) error {
+	insertSQL := "INSERT INTO collections (org_id, name) SELECT org_id, $2 FROM organizations WHERE name = $1"
+	err := r.exec(ctx, "[CREATE COLLECTION]", ErrOrgNotFound, insertSQL, org, collection)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("[CREATE COLLECTION] %w", ErrCollectionExists)
+	}
+	return err
+}
+
+// AddToCollection moves the secret into the collection, a secret belongs to at most one collection.
+func (r *OrgRepo) AddToCollection(ctx context.Context, org, collection, path string) error {
+	upsertSQL := `
+	INSERT INTO collection_secrets (collection_id, secret_id)
+	SELECT c.collection_id, s.secret_id FROM collections c
+	INNER JOIN organizations o ON o.org_id = c.org_id
+	CROSS JOIN secrets s
+	WHERE o.name = $1 AND c.name = $2 AND s.path = $3
+	ON CONFLICT (secret_id) DO UPDATE SET collection_id = EXCLUDED.collection_id
+	`
+	return r.exec(ctx, "[ADD TO COLLECTION]", ErrCollectionNotFound, upsertSQL, org, collection, path)
+}
+
+// RemoveFromCollection takes the secret out of the collection.
+func (r *OrgRepo) RemoveFromCollection(ctx context.Context, org, collection, path string) error {
+	deleteSQL := `
+	DELETE FROM collection_secrets cs USING collections c, organizations o, secrets s
+	WHERE cs.collection_id = c.collection_id AND c.org_id = o.org_id AND cs.secret_id = s.secret_id
+		AND o.name = $1 AND c.name = $2 AND s.path = $3
+	`
+	return r.exec(ctx, "[REMOVE FROM COLLECTION]", ErrSecretNotFound, deleteSQL, org, collection, path)
+}
+
+// AssignCollection gives the team access to the collection with the role, which is either editor or viewer.
+func (r *OrgRepo) AssignCollection(ctx context.Context, org, collection, team string, role models.Role) error {
+	upsertSQL := `
+	INSERT INTO collection_teams (collection_id, team_id, role)
+	SELECT c.collection_id, t.team_id, $4 FROM collections c
+	INNER JOIN organizations o ON o.org_id = c.org_id
+	INNER JOIN teams t ON t.org_id = o.org_id AND t.name = $3
+	WHERE o.name = $1 AND c.name = $2
+	ON CONFLICT (collection_id, team_id) DO UPDATE SET role = EXCLUDED.role
+	`
+	return r.exec(ctx, "[ASSIGN COLLECTION]", ErrTeamNotFound, upsertSQL, org, collection, team, role)
+}
+
+// UnassignCollection revokes the access of the team to the collection.
+func (r *OrgRepo) UnassignCollection(ctx context.Context, org, collection, team string) error {
+	deleteSQL := `
+	DELETE FROM collection_teams ct USING collections c, teams t, organizations o
+	WHERE ct.collection_id = c.collection_id AND ct.team_id = t.team_id AND c.org_id = o.org_id
+		AND o.name = $1 AND c.name = $2 AND t.name = $3
+	`
+	return r.exec(ctx, "[UNASSIGN COLLECTION]", ErrTeamNotFound, deleteSQL, org, collection, team)
+}
+
+// ListCollections returns the collections of the organization with their secrets and teams.
+func (r *OrgRepo) ListCollections(ctx context.Context, org string) ([]models.Collection, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT
+		c.name,
+		ARRAY(
+			SELECT s.path FROM collection_secrets cs
+			INNER JOIN secrets s ON s.secret_id = cs.secret_id
+			WHERE cs.collection_id = c.collection_id
+			ORDER BY s.path
+		),
+		ARRAY(
+			SELECT t.name FROM collection_teams ct
+			INNER JOIN teams t ON t.team_id = ct.team_id
+			WHERE ct.collection_id = c.collection_id
+			ORDER BY t.name
+		),
+		ARRAY(
+			SELECT ct.role FROM collection_teams ct
+			INNER JOIN teams t ON t.team_id = ct.team_id
+			WHERE ct.collection_id = c.collection_id
+			ORDER BY t.name
+		)
+	FROM collections c
+	INNER JOIN organizations o ON o.org_id = c.org_id
+	WHERE o.name = $1
+	ORDER BY c.name
+	`
+
+	rows, err := r.pool.Query(c, selectSQL, org)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST COLLECTIONS] failed to query collections: %w", err)
+	}
+	collections, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Collection, error) {
+		var (
+			collection models.Collection
+			teams      []string
+			roles      []string
+		)
+		if scanErr := row.Scan(&collection.Name, &collection.Secrets, &teams, &roles); scanErr != nil {
+			return collection, scanErr
+		}
+		for i, team := range teams {
+			collection.Teams = append(collection.Teams, models.CollectionTeam{Team: team, Role: models.Role(roles[i])})
+		}
+		return collection, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[LIST COLLECTIONS] failed to scan collection: %w", err)
+	}
+	return collections, nil
+}
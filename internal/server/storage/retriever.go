@@ -6,9 +6,11 @@ import (
 	"io"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/authz"
 	"github.com/itallix/gophkeeper/internal/server/models"
 	"github.com/itallix/gophkeeper/internal/server/s3"
 )
@@ -32,24 +34,50 @@ func (s *Retriever) VisitLogin(login *models.Login) error {
 	defer cancel()
 
 	errPrefix := "[RETRIEVE LOGIN]"
+	if err := checkAccess(ctx, s.pool, login.Path, login.RequestedBy, authz.ActionRead); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
 	selectSQL := `
-	SELECT encrypted_data_key, created_at, created_by, login, password FROM logins l 
+	SELECT login_id, encrypted_data_key, created_at, created_by, login, password, totp, notes FROM logins l 
 	INNER JOIN secrets s ON l.secret_id = s.secret_id
 	WHERE s.path = $1
 	`
 
 	err := s.pool.QueryRow(ctx, selectSQL, login.Path).
 		Scan(
+			&login.LoginID,
 			&login.EncryptedDataKey,
 			&login.CreatedAt,
 			&login.CreatedBy,
 			&login.Login,
 			&login.Password,
+			&login.TOTP,
+			&login.Notes,
 		)
 	if err != nil {
 		return fmt.Errorf("%s failed to query logins: %w", errPrefix, err)
 	}
 
+	rows, err := s.pool.Query(ctx,
+		"SELECT uri, match FROM login_uris WHERE login_id = $1 ORDER BY position", login.LoginID)
+	if err != nil {
+		return fmt.Errorf("%s failed to query uris: %w", errPrefix, err)
+	}
+	login.URIs, err = pgx.CollectRows(rows, pgx.RowToStructByPos[models.LoginURI])
+	if err != nil {
+		return fmt.Errorf("%s failed to scan uris: %w", errPrefix, err)
+	}
+
+	rows, err = s.pool.Query(ctx,
+		"SELECT name, value, sensitive FROM login_fields WHERE login_id = $1 ORDER BY position", login.LoginID)
+	if err != nil {
+		return fmt.Errorf("%s failed to query fields: %w", errPrefix, err)
+	}
+	login.Fields, err = pgx.CollectRows(rows, pgx.RowToStructByPos[models.KVField])
+	if err != nil {
+		return fmt.Errorf("%s failed to scan fields: %w", errPrefix, err)
+	}
+
 	return nil
 }
 
@@ -58,8 +86,12 @@ func (s *Retriever) VisitCard(card *models.Card) error {
 	defer cancel()
 
 	errPrefix := "[RETRIEVE CARD]"
+	if err := checkAccess(ctx, s.pool, card.Path, card.RequestedBy, authz.ActionRead); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
 	selectSQL := `
-	SELECT encrypted_data_key, created_at, created_by, cardholder_name, number, expiry_month, expiry_year, cvc 
+	SELECT encrypted_data_key, created_at, created_by, cardholder_name, number, expiry_month, expiry_year, cvc,
+	brand, billing_address, pin
 	FROM cards c 
 	INNER JOIN secrets s ON c.secret_id = s.secret_id
 	WHERE s.path = $1
@@ -75,6 +107,9 @@ func (s *Retriever) VisitCard(card *models.Card) error {
 			&card.ExpiryMonth,
 			&card.ExpiryYear,
 			&card.CVC,
+			&card.Brand,
+			&card.BillingAddress,
+			&card.PIN,
 		)
 	if err != nil {
 		return fmt.Errorf("%s failed to query logins: %w", errPrefix, err)
@@ -88,6 +123,9 @@ func (s *Retriever) VisitNote(note *models.Note) error {
 	defer cancel()
 
 	errPrefix := "[RETRIEVE NOTE]"
+	if err := checkAccess(ctx, s.pool, note.Path, note.RequestedBy, authz.ActionRead); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
 	selectSQL := `
 	SELECT encrypted_data_key, created_at, created_by, text FROM notes n 
 	INNER JOIN secrets s ON n.secret_id = s.secret_id
@@ -103,12 +141,116 @@ func (s *Retriever) VisitNote(note *models.Note) error {
 	return nil
 }
 
+func (s *Retriever) VisitSSHKey(key *models.SSHKey) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[RETRIEVE SSH KEY]"
+	if err := checkAccess(ctx, s.pool, key.Path, key.RequestedBy, authz.ActionRead); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+	selectSQL := `
+	SELECT encrypted_data_key, created_at, created_by, private_key, public_key, passphrase, comment, fingerprint
+	FROM ssh_keys k
+	INNER JOIN secrets s ON k.secret_id = s.secret_id
+	WHERE s.path = $1
+	`
+
+	err := s.pool.QueryRow(ctx, selectSQL, key.Path).
+		Scan(
+			&key.EncryptedDataKey,
+			&key.CreatedAt,
+			&key.CreatedBy,
+			&key.PrivateKey,
+			&key.PublicKey,
+			&key.Passphrase,
+			&key.Comment,
+			&key.Fingerprint,
+		)
+	if err != nil {
+		return fmt.Errorf("%s failed to query ssh keys: %w", errPrefix, err)
+	}
+
+	return nil
+}
+
+func (s *Retriever) VisitTOTP(secret *models.TOTP) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[RETRIEVE TOTP]"
+	if err := checkAccess(ctx, s.pool, secret.Path, secret.RequestedBy, authz.ActionRead); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+	selectSQL := `
+	SELECT encrypted_data_key, created_at, created_by, uri FROM totps t
+	INNER JOIN secrets s ON t.secret_id = s.secret_id
+	WHERE s.path = $1
+	`
+
+	err := s.pool.QueryRow(ctx, selectSQL, secret.Path).
+		Scan(
+			&secret.EncryptedDataKey,
+			&secret.CreatedAt,
+			&secret.CreatedBy,
+			&secret.URI,
+		)
+	if err != nil {
+		return fmt.Errorf("%s failed to query totps: %w", errPrefix, err)
+	}
+
+	return nil
+}
+
+func (s *Retriever) VisitKV(kv *models.KV) error {
+	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[RETRIEVE KV]"
+	if err := checkAccess(ctx, s.pool, kv.Path, kv.RequestedBy, authz.ActionRead); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+	selectSQL := `
+	SELECT kv_id, COALESCE(type_name, ''), encrypted_data_key, created_at, created_by FROM kvs k
+	INNER JOIN secrets s ON k.secret_id = s.secret_id
+	WHERE s.path = $1
+	`
+
+	err := s.pool.QueryRow(ctx, selectSQL, kv.Path).
+		Scan(
+			&kv.KVID,
+			&kv.Type,
+			&kv.EncryptedDataKey,
+			&kv.CreatedAt,
+			&kv.CreatedBy,
+		)
+	if err != nil {
+		return fmt.Errorf("%s failed to query kvs: %w", errPrefix, err)
+	}
+
+	rows, err := s.pool.Query(ctx,
+		"SELECT name, value, sensitive FROM kv_fields WHERE kv_id = $1 ORDER BY position", kv.KVID)
+	if err != nil {
+		return fmt.Errorf("%s failed to query fields: %w", errPrefix, err)
+	}
+	kv.Fields, err = pgx.CollectRows(rows, pgx.RowToStructByPos[models.KVField])
+	if err != nil {
+		return fmt.Errorf("%s failed to scan fields: %w", errPrefix, err)
+	}
+
+	return nil
+}
+
 func (s *Retriever) VisitBinary(binary *models.Binary) error {
 	ctx, cancel := context.WithTimeout(s.context, TimeoutInSeconds*time.Second)
 	defer cancel()
 
+	errPrefix := "[RETRIEVE BINARY]"
+	if err := checkAccess(ctx, s.pool, binary.Path, binary.RequestedBy, authz.ActionRead); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+
 	if binary.Chunks == 0 {
-		errPrefix := "[RETRIEVE BINARY]"
 		selectSQL := `
 		SELECT encrypted_data_key, created_at, created_by, chunks, hash FROM binaries b
 		INNER JOIN secrets s ON b.secret_id = s.secret_id
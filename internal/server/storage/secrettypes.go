@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+var ErrSecretTypeNotFound = errors.New("secret type not found")
+
+// SecretTypeRepo stores the definitions of user-defined secret types, the fields of a type are kept as JSON.
+type SecretTypeRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewSecretTypeRepo(pool *pgxpool.Pool) *SecretTypeRepo {
+	return &SecretTypeRepo{
+		pool: pool,
+	}
+}
+
+// Save registers the secret type, the definition of an already registered type is replaced.
+func (r *SecretTypeRepo) Save(ctx context.Context, secretType *models.SecretType) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	insertSQL := `
+	INSERT INTO secret_types (name, description, fields, created_by, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (name) DO UPDATE SET description = EXCLUDED.description, fields = EXCLUDED.fields
+	`
+	if _, err := r.pool.Exec(c, insertSQL, secretType.Name, secretType.Description, secretType.Fields,
+		secretType.CreatedBy, secretType.CreatedAt); err != nil {
+		return fmt.Errorf("[SAVE SECRET TYPE] failed to insert secret type: %w", err)
+	}
+
+	logger.Log().Infof("Secret type [%s] has been successfully registered by user=[%s].",
+		secretType.Name, secretType.CreatedBy)
+
+	return nil
+}
+
+func scanSecretType(row pgx.Row) (models.SecretType, error) {
+	var secretType models.SecretType
+	err := row.Scan(&secretType.Name, &secretType.Description, &secretType.Fields,
+		&secretType.CreatedBy, &secretType.CreatedAt)
+	return secretType, err
+}
+
+// Get returns the secret type or ErrSecretTypeNotFound.
+func (r *SecretTypeRepo) Get(ctx context.Context, name string) (*models.SecretType, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := "SELECT name, description, fields, created_by, created_at FROM secret_types WHERE name = $1"
+	secretType, err := scanSecretType(r.pool.QueryRow(c, selectSQL, name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("[GET SECRET TYPE] %w", ErrSecretTypeNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[GET SECRET TYPE] failed to query secret type: %w", err)
+	}
+	return &secretType, nil
+}
+
+// List returns every registered secret type ordered by name.
+func (r *SecretTypeRepo) List(ctx context.Context) ([]models.SecretType, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	rows, err := r.pool.Query(c,
+		"SELECT name, description, fields, created_by, created_at FROM secret_types ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("[LIST SECRET TYPES] failed to query secret types: %w", err)
+	}
+	secretTypes, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.SecretType, error) {
+		return scanSecretType(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[LIST SECRET TYPES] failed to scan secret type: %w", err)
+	}
+	return secretTypes, nil
+}
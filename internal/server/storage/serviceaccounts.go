@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+var (
+	ErrAccountNotFound = errors.New("service account not found")
+	ErrAccountExists   = errors.New("service account or user already exists")
+	ErrKeyNotFound     = errors.New("api key not found")
+)
+
+// ServiceAccountRepo stores service accounts and their API keys. Every service account
+// is a user without credentials of its own, it can only authenticate with its keys.
+type ServiceAccountRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewServiceAccountRepo(pool *pgxpool.Pool) *ServiceAccountRepo {
+	return &ServiceAccountRepo{
+		pool: pool,
+	}
+}
+
+// CreateAccount creates a service account owned by the user.
+func (r *ServiceAccountRepo) CreateAccount(ctx context.Context, owner, name string) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[CREATE SERVICE ACCOUNT]"
+	tx, err := r.pool.Begin(c)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(c)
+	}()
+
+	if _, err = tx.Exec(c, "INSERT INTO users (login) VALUES ($1)", name); err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s %w", errPrefix, ErrAccountExists)
+		}
+		return fmt.Errorf("%s failed to insert user: %w", errPrefix, err)
+	}
+	if _, err = tx.Exec(c, "INSERT INTO service_accounts (name, owner) VALUES ($1, $2)", name, owner); err != nil {
+		return fmt.Errorf("%s failed to insert service account: %w", errPrefix, err)
+	}
+	if err = tx.Commit(c); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("Service account [%s] has been successfully created by user=[%s].", name, owner)
+
+	return nil
+}
+
+// ListAccounts returns the service accounts owned by the user.
+func (r *ServiceAccountRepo) ListAccounts(ctx context.Context, owner string) ([]models.ServiceAccount, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := "SELECT name, owner, created_at FROM service_accounts WHERE owner = $1 ORDER BY name"
+	rows, err := r.pool.Query(c, selectSQL, owner)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST SERVICE ACCOUNTS] failed to query service accounts: %w", err)
+	}
+	accounts, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ServiceAccount, error) {
+		var account models.ServiceAccount
+		scanErr := row.Scan(&account.Name, &account.Owner, &account.CreatedAt)
+		return account, scanErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[LIST SERVICE ACCOUNTS] failed to scan service account: %w", err)
+	}
+	return accounts, nil
+}
+
+// DeleteAccount deletes the service account together with its keys and the secrets it created.
+func (r *ServiceAccountRepo) DeleteAccount(ctx context.Context, owner, name string) error {
+	deleteSQL := `
+	DELETE FROM users
+	WHERE login = (SELECT name FROM service_accounts WHERE name = $1 AND owner = $2)
+	`
+	return execOne(ctx, r.pool, "[DELETE SERVICE ACCOUNT]", ErrAccountNotFound, deleteSQL, name, owner)
+}
+
+// CreateKey stores the hash of a new key of the service account owned by the user.
+func (r *ServiceAccountRepo) CreateKey(ctx context.Context, owner string, key models.APIKey, hash []byte) error {
+	var expiresAt *time.Time
+	if !key.ExpiresAt.IsZero() {
+		expiresAt = &key.ExpiresAt
+	}
+	prefixes := key.Scope.PathPrefixes
+	if prefixes == nil {
+		prefixes = []string{}
+	}
+
+	insertSQL := `
+	INSERT INTO api_keys (key_id, account, key_hash, read_only, path_prefixes, expires_at)
+	SELECT $1, name, $3, $4, $5, $6 FROM service_accounts WHERE name = $2 AND owner = $7
+	`
+	return execOne(ctx, r.pool, "[CREATE API KEY]", ErrAccountNotFound, insertSQL,
+		key.ID, key.Account, hash, key.Scope.ReadOnly, prefixes, expiresAt, owner)
+}
+
+func scanKey(row pgx.Row, dest ...any) (models.APIKey, error) {
+	var (
+		key                   models.APIKey
+		expiresAt, lastUsedAt *time.Time
+	)
+	dest = append([]any{&key.ID, &key.Account, &key.Scope.ReadOnly, &key.Scope.PathPrefixes, &key.CreatedAt,
+		&expiresAt, &lastUsedAt}, dest...)
+	if err := row.Scan(dest...); err != nil {
+		return key, err
+	}
+	if len(key.Scope.PathPrefixes) == 0 {
+		key.Scope.PathPrefixes = nil
+	}
+	if expiresAt != nil {
+		key.ExpiresAt = *expiresAt
+	}
+	if lastUsedAt != nil {
+		key.LastUsedAt = *lastUsedAt
+	}
+	return key, nil
+}
+
+// ListKeys returns the keys of the service account owned by the user.
+func (r *ServiceAccountRepo) ListKeys(ctx context.Context, owner, account string) ([]models.APIKey, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	var found bool
+	if err := r.pool.QueryRow(c, "SELECT EXISTS(SELECT 1 FROM service_accounts WHERE name = $1 AND owner = $2)",
+		account, owner).Scan(&found); err != nil {
+		return nil, fmt.Errorf("[LIST API KEYS] failed to query service account: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("[LIST API KEYS] %w", ErrAccountNotFound)
+	}
+
+	selectSQL := `
+	SELECT key_id, account, read_only, path_prefixes, created_at, expires_at, last_used_at
+	FROM api_keys WHERE account = $1
+	ORDER BY created_at
+	`
+	rows, err := r.pool.Query(c, selectSQL, account)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST API KEYS] failed to query api keys: %w", err)
+	}
+	keys, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.APIKey, error) {
+		return scanKey(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[LIST API KEYS] failed to scan api key: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeKey deletes the key of the service account owned by the user.
+func (r *ServiceAccountRepo) RevokeKey(ctx context.Context, owner, account, keyID string) error {
+	deleteSQL := `
+	DELETE FROM api_keys k USING service_accounts a
+	WHERE k.account = a.name AND a.owner = $1 AND a.name = $2 AND k.key_id = $3
+	`
+	return execOne(ctx, r.pool, "[REVOKE API KEY]", ErrKeyNotFound, deleteSQL, owner, account, keyID)
+}
+
+// GetKey returns the key and its hash.
+func (r *ServiceAccountRepo) GetKey(ctx context.Context, keyID string) (models.APIKey, []byte, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT key_id, account, read_only, path_prefixes, created_at, expires_at, last_used_at, key_hash
+	FROM api_keys WHERE key_id = $1
+	`
+	var hash []byte
+	key, err := scanKey(r.pool.QueryRow(c, selectSQL, keyID), &hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return key, nil, fmt.Errorf("[GET API KEY] %w", ErrKeyNotFound)
+	}
+	if err != nil {
+		return key, nil, fmt.Errorf("[GET API KEY] failed to query api key: %w", err)
+	}
+	return key, hash, nil
+}
+
+// TouchKey records the last use of the key.
+func (r *ServiceAccountRepo) TouchKey(ctx context.Context, keyID string, usedAt time.Time) error {
+	return execOne(ctx, r.pool, "[TOUCH API KEY]", ErrKeyNotFound,
+		"UPDATE api_keys SET last_used_at = $2 WHERE key_id = $1", keyID, usedAt)
+}
@@ -0,0 +1,166 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/authz"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrShareNotFound = errors.New("share not found")
+	ErrShareWithSelf = errors.New("secret can not be shared with its owner")
+)
+
+// secretTypeSQL resolves the type of the secret joined as s.
+const secretTypeSQL = `
+	CASE
+		WHEN EXISTS (SELECT 1 FROM logins WHERE secret_id = s.secret_id) THEN 'login'
+		WHEN EXISTS (SELECT 1 FROM cards WHERE secret_id = s.secret_id) THEN 'card'
+		WHEN EXISTS (SELECT 1 FROM notes WHERE secret_id = s.secret_id) THEN 'note'
+		WHEN EXISTS (SELECT 1 FROM ssh_keys WHERE secret_id = s.secret_id) THEN 'ssh_key'
+		WHEN EXISTS (SELECT 1 FROM totps WHERE secret_id = s.secret_id) THEN 'totp'
+		WHEN EXISTS (SELECT 1 FROM kvs WHERE secret_id = s.secret_id) THEN 'kv'
+		ELSE 'binary'
+	END`
+
+// ShareRepo manages the access control list of secrets. Only the owner of a secret,
+// or an admin of the organization it belongs to, can grant, revoke and list access to it.
+type ShareRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewShareRepo(pool *pgxpool.Pool) *ShareRepo {
+	return &ShareRepo{
+		pool: pool,
+	}
+}
+
+// Share grants the grantee access to the secret or updates the permission of an existing share.
+func (r *ShareRepo) Share(ctx context.Context, share models.Share) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[SHARE SECRET]"
+	if share.Grantee == share.Owner {
+		return fmt.Errorf("%s %w", errPrefix, ErrShareWithSelf)
+	}
+	if err := checkAccess(c, r.pool, share.Path, share.Owner, authz.ActionShare); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	var count int
+	if err := r.pool.QueryRow(c, "SELECT COUNT(*) FROM users WHERE login = $1", share.Grantee).
+		Scan(&count); err != nil {
+		return fmt.Errorf("%s failed to check if user exists: %w", errPrefix, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%s %w", errPrefix, ErrUserNotFound)
+	}
+
+	insertSQL := `
+	INSERT INTO secret_shares (secret_id, grantee, permission)
+	SELECT secret_id, $2, $3 FROM secrets WHERE path = $1
+	ON CONFLICT (secret_id, grantee) DO UPDATE SET permission = EXCLUDED.permission`
+
+	if _, err := r.pool.Exec(c, insertSQL, share.Path, share.Grantee, share.Permission); err != nil {
+		return fmt.Errorf("%s failed to insert share: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("Secret with path=[%s] has been shared with user=[%s] with permission=[%s].",
+		share.Path, share.Grantee, share.Permission)
+
+	return nil
+}
+
+// Unshare revokes the access of the grantee to the secret.
+func (r *ShareRepo) Unshare(ctx context.Context, share models.Share) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[UNSHARE SECRET]"
+	if err := checkAccess(c, r.pool, share.Path, share.Owner, authz.ActionShare); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	deleteSQL := `
+	DELETE FROM secret_shares sh USING secrets s
+	WHERE sh.secret_id = s.secret_id AND s.path = $1 AND sh.grantee = $2`
+
+	tag, err := r.pool.Exec(c, deleteSQL, share.Path, share.Grantee)
+	if err != nil {
+		return fmt.Errorf("%s failed to delete share: %w", errPrefix, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s %w", errPrefix, ErrShareNotFound)
+	}
+
+	logger.Log().Infof("Access of user=[%s] to secret with path=[%s] has been revoked.", share.Grantee, share.Path)
+
+	return nil
+}
+
+func (r *ShareRepo) queryShares(ctx context.Context, errPrefix, query string, args ...any) ([]models.Share, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed to query shares: %w", errPrefix, err)
+	}
+	defer rows.Close()
+
+	var shares []models.Share
+	for rows.Next() {
+		var share models.Share
+		if err = rows.Scan(&share.Path, &share.Type, &share.Owner, &share.Grantee, &share.Permission,
+			&share.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%s failed to scan share: %w", errPrefix, err)
+		}
+		shares = append(shares, share)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s error during iteration: %w", errPrefix, err)
+	}
+
+	return shares, nil
+}
+
+// ListShares returns the users the secret owned by the user has been shared with.
+func (r *ShareRepo) ListShares(ctx context.Context, owner, path string) ([]models.Share, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[LIST SHARES]"
+	if err := checkAccess(c, r.pool, path, owner, authz.ActionShare); err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	selectSQL := `
+	SELECT s.path, ` + secretTypeSQL + `, COALESCE(s.created_by, ''), sh.grantee, sh.permission, sh.created_at
+	FROM secret_shares sh
+	INNER JOIN secrets s ON sh.secret_id = s.secret_id
+	WHERE s.path = $1
+	ORDER BY sh.grantee`
+
+	return r.queryShares(c, errPrefix, selectSQL, path)
+}
+
+// ListSharedWith returns the secrets other users have shared with the grantee.
+func (r *ShareRepo) ListSharedWith(ctx context.Context, grantee string) ([]models.Share, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT s.path, ` + secretTypeSQL + `, COALESCE(s.created_by, ''), sh.grantee, sh.permission, sh.created_at
+	FROM secret_shares sh
+	INNER JOIN secrets s ON sh.secret_id = s.secret_id
+	WHERE sh.grantee = $1
+	ORDER BY s.path`
+
+	return r.queryShares(c, "[LIST SHARED WITH]", selectSQL, grantee)
+}
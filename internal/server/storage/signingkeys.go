@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// SigningKeyRepo stores the keys used to sign JWTs.
+type SigningKeyRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewSigningKeyRepo(pool *pgxpool.Pool) *SigningKeyRepo {
+	return &SigningKeyRepo{
+		pool: pool,
+	}
+}
+
+// List returns up to limit signing keys, the newest first.
+func (r *SigningKeyRepo) List(ctx context.Context, limit int) ([]models.EncryptedSigningKey, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	selectSQL := `
+	SELECT kid, algorithm, private_key, data_key, created_at
+	FROM signing_keys
+	ORDER BY created_at DESC, kid
+	LIMIT $1
+	`
+	rows, err := r.pool.Query(c, selectSQL, limit)
+	if err != nil {
+		return nil, fmt.Errorf("[LIST SIGNING KEYS] failed to query signing keys: %w", err)
+	}
+	keys, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.EncryptedSigningKey, error) {
+		var key models.EncryptedSigningKey
+		scanErr := row.Scan(&key.ID, &key.Algorithm, &key.PrivateKey, &key.DataKey, &key.CreatedAt)
+		return key, scanErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[LIST SIGNING KEYS] failed to scan signing key: %w", err)
+	}
+	return keys, nil
+}
+
+// Add stores the key and deletes all but the keep newest keys.
+func (r *SigningKeyRepo) Add(ctx context.Context, key models.EncryptedSigningKey, keep int) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[ADD SIGNING KEY]"
+	tx, err := r.pool.Begin(c)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(c)
+	}()
+
+	insertSQL := `
+	INSERT INTO signing_keys (kid, algorithm, private_key, data_key, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err = tx.Exec(c, insertSQL, key.ID, key.Algorithm, key.PrivateKey, key.DataKey,
+		key.CreatedAt); err != nil {
+		return fmt.Errorf("%s failed to insert signing key: %w", errPrefix, err)
+	}
+	deleteSQL := `
+	DELETE FROM signing_keys
+	WHERE kid NOT IN (SELECT kid FROM signing_keys ORDER BY created_at DESC, kid LIMIT $1)
+	`
+	tag, err := tx.Exec(c, deleteSQL, keep)
+	if err != nil {
+		return fmt.Errorf("%s failed to delete old signing keys: %w", errPrefix, err)
+	}
+	if err = tx.Commit(c); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("Signing key [%s] has been added, %d old key(s) retired.", key.ID, tag.RowsAffected())
+
+	return nil
+}
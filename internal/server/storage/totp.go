@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/itallix/gophkeeper/internal/common/logger"
+	"github.com/itallix/gophkeeper/internal/server/authz"
+	"github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// AttachTOTP replaces the second factor of an existing login with the already encrypted login.TOTP.
+func AttachTOTP(ctx context.Context, pool *pgxpool.Pool, login *models.Login) error {
+	ctx, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	errPrefix := "[ATTACH TOTP]"
+	if err := checkAccess(ctx, pool, login.Path, login.RequestedBy, authz.ActionWrite); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s failed to begin transaction: %w", errPrefix, err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	updateSQL := `
+	UPDATE logins l SET totp = $1
+	FROM secrets s
+	WHERE l.secret_id = s.secret_id AND s.path = $2
+	`
+	tag, err := tx.Exec(ctx, updateSQL, login.TOTP, login.Path)
+	if err != nil {
+		return fmt.Errorf("%s failed to update login: %w", errPrefix, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s %w", errPrefix, ErrSecretNotFound)
+	}
+	if _, err = tx.Exec(ctx, "UPDATE secrets SET modified_at = $1, modified_by = $2 WHERE path = $3",
+		login.ModifiedAt, login.ModifiedBy, login.Path); err != nil {
+		return fmt.Errorf("%s failed to update secret: %w", errPrefix, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s failed to commit transaction: %w", errPrefix, err)
+	}
+
+	logger.Log().Infof("TOTP has been attached to login with path=[%s].", login.Path)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/itallix/gophkeeper/internal/common/tracing"
+)
+
+// QueryTracer traces every query run by pgx as a span of the context the query is run with.
+// Set it as the Tracer of the pool's connection config.
+type QueryTracer struct {
+	tracer trace.Tracer
+}
+
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{tracer: tracing.Tracer("github.com/itallix/gophkeeper/internal/server/storage")}
+}
+
+// operationName is the first keyword of the statement, e.g. SELECT or BEGIN.
+func operationName(sql string) string {
+	operation, _, _ := strings.Cut(strings.TrimSpace(sql), " ")
+	return strings.ToUpper(operation)
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn,
+	data pgx.TraceQueryStartData) context.Context {
+	operation := operationName(data.SQL)
+	ctx, _ = t.tracer.Start(ctx, "postgres "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+	return ctx
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	tracing.End(trace.SpanFromContext(ctx), data.Err)
+}
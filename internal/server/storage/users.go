@@ -47,7 +47,7 @@ func (r *UserRepo) GetPasswordHash(ctx context.Context, login string) (string, e
 	defer cancel()
 
 	var hash string
-	selectSQL := "SELECT password_hash FROM users WHERE login = $1"
+	selectSQL := "SELECT COALESCE(password_hash, '') FROM users WHERE login = $1"
 
 	if err := r.pool.QueryRow(c, selectSQL, login).Scan(&hash); err != nil {
 		return "", fmt.Errorf("failed to get user password hash: %w", err)
@@ -56,6 +56,70 @@ func (r *UserRepo) GetPasswordHash(ctx context.Context, login string) (string, e
 	return hash, nil
 }
 
+func (r *UserRepo) UpdatePasswordHash(ctx context.Context, login, passwordHash string) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	updateSQL := "UPDATE users SET password_hash = $2 WHERE login = $1"
+	if _, err := r.pool.Exec(c, updateSQL, login, passwordHash); err != nil {
+		return fmt.Errorf("failed to update user password hash: %w", err)
+	}
+
+	logger.Log().Infof("Password hash of user with login=[%s] has been upgraded.", login)
+
+	return nil
+}
+
+// CreateSRPUser creates a user who authenticates with SRP and has no password hash.
+func (r *UserRepo) CreateSRPUser(ctx context.Context, login string, salt, verifier []byte) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	insertSQL := `
+	INSERT INTO users(
+		login,
+		srp_salt,
+		srp_verifier
+	) VALUES($1, $2, $3)`
+
+	if _, err := r.pool.Exec(c, insertSQL, login, salt, verifier); err != nil {
+		return fmt.Errorf("[CREATE USER] failed to insert users: %w", err)
+	}
+
+	logger.Log().Infof("User with login=[%s] has been successfully created.", login)
+
+	return nil
+}
+
+// GetSRPVerifier returns the SRP salt and verifier of the user, both are nil if the user has none yet.
+func (r *UserRepo) GetSRPVerifier(ctx context.Context, login string) ([]byte, []byte, error) {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	var salt, verifier []byte
+	selectSQL := "SELECT srp_salt, srp_verifier FROM users WHERE login = $1"
+
+	if err := r.pool.QueryRow(c, selectSQL, login).Scan(&salt, &verifier); err != nil {
+		return nil, nil, fmt.Errorf("failed to get user srp verifier: %w", err)
+	}
+
+	return salt, verifier, nil
+}
+
+func (r *UserRepo) SetSRPVerifier(ctx context.Context, login string, salt, verifier []byte) error {
+	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
+	defer cancel()
+
+	updateSQL := "UPDATE users SET srp_salt = $2, srp_verifier = $3, modified_at = now() WHERE login = $1"
+	if _, err := r.pool.Exec(c, updateSQL, login, salt, verifier); err != nil {
+		return fmt.Errorf("failed to update user srp verifier: %w", err)
+	}
+
+	logger.Log().Infof("SRP verifier of user with login=[%s] has been set.", login)
+
+	return nil
+}
+
 func (r *UserRepo) Exists(ctx context.Context, login string) (bool, error) {
 	c, cancel := context.WithTimeout(ctx, TimeoutInSeconds*time.Second)
 	defer cancel()
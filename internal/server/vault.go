@@ -4,9 +4,14 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap/buffer"
 
+	"github.com/itallix/gophkeeper/internal/common/password"
 	"github.com/itallix/gophkeeper/internal/server/models"
 	"github.com/itallix/gophkeeper/internal/server/operation"
 	"github.com/itallix/gophkeeper/internal/server/s3"
@@ -17,11 +22,18 @@ import (
 // Vault defines the interface for secure secret management operations.
 // It provides methods for storing, retrieving, and managing different types of secrets
 // while handling encryption and secure storage automatically.
+// The secret operations take the context of the request, so their work is traced as a part of it.
 type Vault interface {
-	StoreSecret(secret models.Secret) error
-	RetrieveSecret(secret models.Secret) error
-	DeleteSecret(secret models.Secret) error
-	ListSecrets(secret models.Secret) ([]string, error)
+	StoreSecret(ctx context.Context, secret models.Secret) error
+	RetrieveSecret(ctx context.Context, secret models.Secret) error
+	DeleteSecret(ctx context.Context, secret models.Secret) error
+	ListSecrets(ctx context.Context, secret models.Secret) ([]string, error)
+	AttachTOTP(ctx context.Context, path, uri, requestedBy string) error
+	FindLogins(ctx context.Context, rawURL, requestedBy string) ([]models.LoginMatch, error)
+	ShareSecret(share models.Share) error
+	UnshareSecret(share models.Share) error
+	ListShares(owner, path string) ([]models.Share, error)
+	ListSharedWith(grantee string) ([]models.Share, error)
 }
 
 // VaultImpl implements the Vault interface using a combination of database storage
@@ -32,6 +44,20 @@ type VaultImpl struct {
 	pool              *pgxpool.Pool
 	objectStorage     *s3.ObjectStorage
 	encryptionService service.EncryptionService
+	shareRepo         *storage.ShareRepo
+	auditRepo         *storage.AuditRepo
+	secretTypeRepo    *storage.SecretTypeRepo
+	validatorOpts     []operation.ValidatorOption
+}
+
+// VaultOption configures optional VaultImpl behaviour.
+type VaultOption func(*VaultImpl)
+
+// WithPasswordPolicy makes the vault reject logins whose password violates the policy.
+func WithPasswordPolicy(policy password.Policy) VaultOption {
+	return func(v *VaultImpl) {
+		v.validatorOpts = append(v.validatorOpts, operation.WithPasswordPolicy(policy))
+	}
 }
 
 // NewVault creates and initializes a new Vault instance with the provided dependencies.
@@ -41,35 +67,54 @@ type VaultImpl struct {
 //   - pool: PostgreSQL connection pool for database operations
 //   - objectStorage: S3-compatible storage for binary data
 //   - encryptionService: Service for encrypting and decrypting sensitive data
+//   - opts: Optional settings such as the password policy
 //
 // Returns:
 //   - *Vault: A new instance of Vault initialized with the provided dependencies
 func NewVaultImpl(ctx context.Context, pool *pgxpool.Pool, objectStorage *s3.ObjectStorage,
-	encryptionService service.EncryptionService) *VaultImpl {
-	return &VaultImpl{
+	encryptionService service.EncryptionService, opts ...VaultOption) *VaultImpl {
+	v := &VaultImpl{
 		ctx:               ctx,
 		pool:              pool,
 		objectStorage:     objectStorage,
 		encryptionService: encryptionService,
+		shareRepo:         storage.NewShareRepo(pool),
+		auditRepo:         storage.NewAuditRepo(pool),
+		secretTypeRepo:    storage.NewSecretTypeRepo(pool),
 	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
 }
 
 // StoreSecret securely stores a secret in the vault. The secret is validated,
 // encrypted, and then stored using the appropriate storage mechanism based on its type.
+// Key-value secrets of a user-defined type are validated against the schema of the type.
 //
 // Parameters:
+//   - ctx: Context of the request
 //   - secret: The secret to be stored, implementing the models.Secret interface
 //
 // Returns:
 //   - error: nil if successful, otherwise an error describing what went wrong
-func (v *VaultImpl) StoreSecret(secret models.Secret) error {
+func (v *VaultImpl) StoreSecret(ctx context.Context, secret models.Secret) error {
+	if kv, ok := secret.(*models.KV); ok && kv.Type != "" {
+		// an unknown type is reported by the validator
+		schema, err := v.secretTypeRepo.Get(ctx, kv.Type)
+		if err != nil && !errors.Is(err, storage.ErrSecretTypeNotFound) {
+			return err
+		}
+		kv.Schema = schema
+	}
+
 	op := operation.NewProcessorBuilder().
-		WithValidation().
+		WithValidation(v.validatorOpts...).
 		WithEncryption(v.encryptionService).
-		WithStorageCreator(v.ctx, v.pool, v.objectStorage).
+		WithStorageCreator(ctx, v.pool, v.objectStorage).
 		Build()
 
-	if err := op.Process(secret); err != nil {
+	if err := op.Process(ctx, secret); err != nil {
 		return err
 	}
 	return nil
@@ -79,17 +124,18 @@ func (v *VaultImpl) StoreSecret(secret models.Secret) error {
 // The secret is retrieved from storage and decrypted using the encryption service.
 //
 // Parameters:
+//   - ctx: Context of the request
 //   - secret: A secret object containing the necessary metadata for retrieval
 //
 // Returns:
 //   - error: nil if successful, otherwise an error describing what went wrong
-func (v *VaultImpl) RetrieveSecret(secret models.Secret) error {
+func (v *VaultImpl) RetrieveSecret(ctx context.Context, secret models.Secret) error {
 	op := operation.NewProcessorBuilder().
-		WithStorageRetriever(v.ctx, v.pool, v.objectStorage).
+		WithStorageRetriever(ctx, v.pool, v.objectStorage).
 		WithDecryption(v.encryptionService).
 		Build()
 
-	if err := op.Process(secret); err != nil {
+	if err := op.Process(ctx, secret); err != nil {
 		return err
 	}
 	return nil
@@ -99,12 +145,13 @@ func (v *VaultImpl) RetrieveSecret(secret models.Secret) error {
 // and object storage records as appropriate.
 //
 // Parameters:
+//   - ctx: Context of the request
 //   - secret: The secret to be deleted, containing necessary metadata
 //
 // Returns:
 //   - error: nil if successful, otherwise an error describing what went wrong
-func (v *VaultImpl) DeleteSecret(secret models.Secret) error {
-	deleter := storage.NewDeleter(v.ctx, v.pool, v.objectStorage)
+func (v *VaultImpl) DeleteSecret(ctx context.Context, secret models.Secret) error {
+	deleter := storage.NewDeleter(ctx, v.pool, v.objectStorage)
 
 	if err := secret.Accept(deleter); err != nil {
 		return err
@@ -116,13 +163,14 @@ func (v *VaultImpl) DeleteSecret(secret models.Secret) error {
 // stored in the vault.
 //
 // Parameters:
+//   - ctx: Context of the request
 //   - secret: A secret object indicating the type of secrets to list
 //
 // Returns:
 //   - []string: A slice of secret identifiers
 //   - error: nil if successful, otherwise an error describing what went wrong
-func (v *VaultImpl) ListSecrets(secret models.Secret) ([]string, error) {
-	lister := storage.NewLister(v.ctx, v.pool)
+func (v *VaultImpl) ListSecrets(ctx context.Context, secret models.Secret) ([]string, error) {
+	lister := storage.NewLister(ctx, v.pool)
 
 	if err := secret.Accept(lister); err != nil {
 		return nil, err
@@ -130,3 +178,121 @@ func (v *VaultImpl) ListSecrets(secret models.Secret) ([]string, error) {
 
 	return lister.GetResult().([]string), nil
 }
+
+// AttachTOTP adds a second factor to an existing login, replacing the one it had. The otpauth URI
+// is validated like a TOTP secret and encrypted with the data key of the login.
+//
+// Parameters:
+//   - ctx: Context of the request
+//   - path: The path of the login
+//   - uri: The otpauth://totp URI
+//   - requestedBy: The user attaching the TOTP, they need write access to the login
+//
+// Returns:
+//   - error: nil if successful, otherwise an error describing what went wrong
+func (v *VaultImpl) AttachTOTP(ctx context.Context, path, uri, requestedBy string) error {
+	secret := models.NewTOTP(nil, []models.TOTPOption{models.WithOTPAuthURI(uri)})
+	if err := operation.NewValidator(v.validatorOpts...).VisitTOTP(secret); err != nil {
+		return err
+	}
+
+	login := models.NewLogin([]models.SecretOption{
+		models.WithPath(path),
+		models.WithModifiedBy(requestedBy),
+		models.WithRequestedBy(requestedBy),
+	}, nil)
+	if err := v.RetrieveSecret(ctx, login); err != nil {
+		return err
+	}
+	var buf buffer.Buffer
+	if err := v.encryptionService.EncryptWithKey(secret.URI, &buf, login.EncryptedDataKey); err != nil {
+		return fmt.Errorf("cannot encrypt totp: %w", err)
+	}
+	login.TOTP = buf.Bytes()
+	login.ModifiedAt = time.Now()
+
+	return storage.AttachTOTP(ctx, v.pool, login)
+}
+
+// FindLogins returns the logins offered for the URL according to the match rules of their URIs,
+// every login at most once with the first of its URIs that matched.
+//
+// Parameters:
+//   - ctx: Context of the request
+//   - rawURL: The URL of the website, a missing scheme defaults to https
+//   - requestedBy: The user looking for logins, only those they are allowed to read are matched
+//
+// Returns:
+//   - []models.LoginMatch: The matching logins ordered by path
+//   - error: nil if successful, otherwise an error describing what went wrong
+func (v *VaultImpl) FindLogins(ctx context.Context, rawURL, requestedBy string) ([]models.LoginMatch, error) {
+	target, err := models.ParseURI(rawURL)
+	if err != nil || target.Hostname() == "" {
+		return nil, &operation.ValidationError{Violations: []operation.FieldViolation{
+			{Field: "url", Description: fmt.Sprintf("%q is not a valid URL", rawURL)},
+		}}
+	}
+
+	candidates, err := storage.ListLoginURIs(ctx, v.pool, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+	var matches []models.LoginMatch
+	for _, candidate := range candidates {
+		if len(matches) > 0 && matches[len(matches)-1].Path == candidate.Path {
+			continue
+		}
+		if candidate.URI.Matches(target) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches, nil
+}
+
+// ShareSecret grants another user access to a secret. Only the owner of the secret
+// can share it, sharing it again with the same user updates the permission.
+//
+// Parameters:
+//   - share: The path of the secret, its owner, the grantee and the granted permission
+//
+// Returns:
+//   - error: nil if successful, otherwise an error describing what went wrong
+func (v *VaultImpl) ShareSecret(share models.Share) error {
+	return v.shareRepo.Share(v.ctx, share)
+}
+
+// UnshareSecret revokes the access of a user to a secret previously shared with them.
+//
+// Parameters:
+//   - share: The path of the secret, its owner and the grantee
+//
+// Returns:
+//   - error: nil if successful, otherwise an error describing what went wrong
+func (v *VaultImpl) UnshareSecret(share models.Share) error {
+	return v.shareRepo.Unshare(v.ctx, share)
+}
+
+// ListShares returns the access control list of a secret.
+//
+// Parameters:
+//   - owner: The user requesting the list, who must own the secret
+//   - path: The path of the secret
+//
+// Returns:
+//   - []models.Share: The users the secret is shared with
+//   - error: nil if successful, otherwise an error describing what went wrong
+func (v *VaultImpl) ListShares(owner, path string) ([]models.Share, error) {
+	return v.shareRepo.ListShares(v.ctx, owner, path)
+}
+
+// ListSharedWith returns the secrets other users have shared with the grantee.
+//
+// Parameters:
+//   - grantee: The user the secrets are shared with
+//
+// Returns:
+//   - []models.Share: The shared secrets with their owners and permissions
+//   - error: nil if successful, otherwise an error describing what went wrong
+func (v *VaultImpl) ListSharedWith(grantee string) ([]models.Share, error) {
+	return v.shareRepo.ListSharedWith(v.ctx, grantee)
+}
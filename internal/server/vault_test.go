@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,13 +18,15 @@ import (
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
 
+	"github.com/itallix/gophkeeper/db/migrations"
+	"github.com/itallix/gophkeeper/internal/common/oidc/oidctest"
+	"github.com/itallix/gophkeeper/internal/common/sshkey"
 	"github.com/itallix/gophkeeper/internal/server"
 	"github.com/itallix/gophkeeper/internal/server/models"
+	"github.com/itallix/gophkeeper/internal/server/operation"
 	"github.com/itallix/gophkeeper/internal/server/s3"
 	"github.com/itallix/gophkeeper/internal/server/service"
 	"github.com/itallix/gophkeeper/internal/server/storage"
-
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
 const (
@@ -86,19 +89,68 @@ func (suite *VaultTestSuite) TearDownSuite() {
 	suite.Require().NoError(suite.postgresContainer.Terminate(ctx))
 }
 
-func (suite *VaultTestSuite) TestVaultAPI() {
-	ctx := context.Background()
-	postgresEndpoint, err := suite.postgresContainer.Endpoint(ctx, "")
+func (suite *VaultTestSuite) dsn() string {
+	postgresEndpoint, err := suite.postgresContainer.Endpoint(context.Background(), "")
 	suite.Require().NoError(err)
-	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", postgresUser, postgresPassword,
+	return fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", postgresUser, postgresPassword,
 		postgresEndpoint, postgresDatabase)
-	suite.Require().NoError(server.ApplyMigrations(dsn, "../../db/migrations"))
+}
+
+func (suite *VaultTestSuite) TestMigrations() {
+	migrator, err := server.NewMigrator(suite.dsn(), migrations.FS)
+	suite.Require().NoError(err)
+	defer migrator.Close()
+
+	status, err := migrator.Status()
+	suite.Require().NoError(err)
+	latest := status.Latest()
+	previous := status.Migrations[len(status.Migrations)-2].Version
+	suite.Require().NoError(migrator.To(0))
+	suite.Require().ErrorIs(migrator.Check(), server.ErrSchemaVersion, "the server refuses an empty database")
+
+	// replicas starting together apply the migrations once
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = server.ApplyMigrations(suite.dsn(), migrations.FS)
+		}()
+	}
+	wg.Wait()
+	for _, err = range errs {
+		suite.Require().NoError(err)
+	}
+	suite.Require().NoError(migrator.Check())
+
+	suite.Require().NoError(migrator.Down())
+	status, err = migrator.Status()
+	suite.Require().NoError(err)
+	suite.Equal(previous, status.Version)
+	suite.Require().ErrorIs(migrator.Check(), server.ErrSchemaVersion)
+
+	suite.Require().NoError(migrator.To(latest))
+	suite.Require().NoError(migrator.Force(latest + 1))
+	suite.Require().ErrorIs(migrator.Check(), server.ErrSchemaVersion, "the server refuses a newer schema")
+	suite.Require().NoError(migrator.Force(latest))
+	suite.Require().NoError(migrator.Up())
+	suite.Require().NoError(migrator.Check())
+}
+
+func (suite *VaultTestSuite) TestVaultAPI() {
+	ctx := context.Background()
+	dsn := suite.dsn()
+	suite.Require().NoError(server.ApplyMigrations(dsn, migrations.FS))
 	pool, err := pgxpool.New(ctx, dsn)
 	suite.Require().NoError(err)
 	minioEndpoint, err := suite.minioContainer.Endpoint(ctx, "")
 	suite.Require().NoError(err)
-	suite.T().Setenv("ENDPOINT", minioEndpoint)
-	objectStorage, err := s3.NewObjectStorage()
+	objectStorage, err := s3.NewObjectStorage(s3.Config{
+		Endpoint:  minioEndpoint,
+		AccessKey: minioAccessKey,
+		SecretKey: minioSecretKey,
+	})
 	suite.Require().NoError(err)
 	kms, err := service.NewRSAKMS("../../testdata/private.pem", "../../testdata/encrypted_key.bin")
 	suite.Require().NoError(err)
@@ -108,74 +160,127 @@ func (suite *VaultTestSuite) TestVaultAPI() {
 	userRepo := storage.NewUserRepo(pool)
 	username := "mark"
 	suite.Require().NoError(userRepo.CreateUser(ctx, username, "aurelius"))
+	owner := []models.SecretOption{models.WithRequestedBy(username)}
 
 	suite.Run("logins", func() {
 		secret := models.NewLogin([]models.SecretOption{
 			models.WithPath("login0"),
 			models.WithCreatedBy(username),
 			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
 		}, []models.LoginOption{
 			models.WithLogin("leo"),
 			models.WithPassword("secret"),
 		})
-		suite.Require().NoError(vault.StoreSecret(secret))
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
 
 		retrieved := models.NewLogin([]models.SecretOption{
 			models.WithPath("login0"),
+			models.WithRequestedBy(username),
 		}, nil)
-		suite.Require().NoError(vault.RetrieveSecret(retrieved))
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
 		suite.Equal("leo", retrieved.Login)
 		suite.Equal("secret", string(retrieved.Password))
 
 		var secrets []string
-		secrets, err = vault.ListSecrets(models.NewLogin(nil, nil))
+		secrets, err = vault.ListSecrets(ctx, models.NewLogin(owner, nil))
 		suite.Require().NoError(err)
 		suite.Len(secrets, 1)
 
 		deleted := models.NewLogin([]models.SecretOption{
 			models.WithPath("login0"),
+			models.WithRequestedBy(username),
 		}, nil)
-		suite.Require().NoError(vault.DeleteSecret(deleted))
+		suite.Require().NoError(vault.DeleteSecret(ctx, deleted))
 
-		secrets, err = vault.ListSecrets(models.NewLogin(nil, nil))
+		secrets, err = vault.ListSecrets(ctx, models.NewLogin(owner, nil))
 		suite.Require().NoError(err)
 		suite.Empty(secrets)
 	})
 
+	suite.Run("login uris", func() {
+		secret := models.NewLogin([]models.SecretOption{
+			models.WithPath("github"),
+			models.WithCreatedBy(username),
+			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.LoginOption{
+			models.WithLogin("leo"),
+			models.WithPassword("secret"),
+			models.WithURI("github.com", ""),
+			models.WithURI("https://gist.github.com", models.URIMatchHost),
+			models.WithNotes("recovery codes in the safe"),
+			models.WithLoginField("pin", "1234", true),
+		})
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
+
+		retrieved := models.NewLogin([]models.SecretOption{
+			models.WithPath("github"),
+			models.WithRequestedBy(username),
+		}, nil)
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
+		suite.Len(retrieved.URIs, 2)
+		suite.Equal(models.URIMatchHost, retrieved.URIs[1].Match)
+		suite.Equal("recovery codes in the safe", string(retrieved.Notes))
+		suite.Equal("1234", string(retrieved.Fields[0].Value))
+
+		var matches []models.LoginMatch
+		matches, err = vault.FindLogins(ctx, "https://gist.github.com/leo", username)
+		suite.Require().NoError(err)
+		suite.Require().Len(matches, 1)
+		suite.Equal("github.com", matches[0].URI.URI)
+
+		matches, err = vault.FindLogins(ctx, "https://gitlab.com", username)
+		suite.Require().NoError(err)
+		suite.Empty(matches)
+
+		suite.Require().NoError(vault.DeleteSecret(ctx, models.NewLogin([]models.SecretOption{
+			models.WithPath("github"),
+			models.WithRequestedBy(username),
+		}, nil)))
+	})
+
 	suite.Run("cards", func() {
 		secret := models.NewCard([]models.SecretOption{
 			models.WithPath("card0"),
 			models.WithCreatedBy(username),
 			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
 		}, []models.CardOption{
-			models.WithCardNumber("1122334455667788"),
+			models.WithCardNumber("378282246310005"),
 			models.WithCardHolder("Mark Aurelius"),
-			models.WithCVC("247"),
+			models.WithCVC("2470"),
 			models.WithExpiry(8, int64(time.Now().Year()+2)),
+			models.WithBillingAddress("1 Palatine Hill, Rome"),
 		})
-		suite.Require().NoError(vault.StoreSecret(secret))
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
 
 		retrieved := models.NewCard([]models.SecretOption{
 			models.WithPath("card0"),
+			models.WithRequestedBy(username),
 		}, nil)
-		suite.Require().NoError(vault.RetrieveSecret(retrieved))
-		suite.Equal("1122334455667788", string(retrieved.Number))
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
+		suite.Equal("378282246310005", string(retrieved.Number))
 		suite.Equal("Mark Aurelius", retrieved.CardholderName)
-		suite.Equal("247", string(retrieved.CVC))
+		suite.Equal("2470", string(retrieved.CVC))
+		suite.Equal("Amex", retrieved.Brand)
+		suite.Equal("1 Palatine Hill, Rome", string(retrieved.BillingAddress))
+		suite.Empty(retrieved.PIN)
 		suite.Equal(int64(8), retrieved.ExpiryMonth)
 		suite.Equal(int64(time.Now().Year()+2), retrieved.ExpiryYear)
 
 		var secrets []string
-		secrets, err = vault.ListSecrets(models.NewCard(nil, nil))
+		secrets, err = vault.ListSecrets(ctx, models.NewCard(owner, nil))
 		suite.Require().NoError(err)
 		suite.Len(secrets, 1)
 
 		deleted := models.NewCard([]models.SecretOption{
 			models.WithPath("card0"),
+			models.WithRequestedBy(username),
 		}, nil)
-		suite.Require().NoError(vault.DeleteSecret(deleted))
+		suite.Require().NoError(vault.DeleteSecret(ctx, deleted))
 
-		secrets, err = vault.ListSecrets(models.NewCard(nil, nil))
+		secrets, err = vault.ListSecrets(ctx, models.NewCard(owner, nil))
 		suite.Require().NoError(err)
 		suite.Empty(secrets)
 	})
@@ -185,32 +290,221 @@ func (suite *VaultTestSuite) TestVaultAPI() {
 			models.WithPath("note0"),
 			models.WithCreatedBy(username),
 			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
 		}, []models.NoteOption{
 			models.WithText("lorem ipsum"),
 		})
-		suite.Require().NoError(vault.StoreSecret(secret))
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
 
 		retrieved := models.NewNote([]models.SecretOption{
 			models.WithPath("note0"),
+			models.WithRequestedBy(username),
 		}, nil)
-		suite.Require().NoError(vault.RetrieveSecret(retrieved))
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
 		suite.Equal("lorem ipsum", string(retrieved.Text))
 
 		var secrets []string
-		secrets, err = vault.ListSecrets(models.NewNote(nil, nil))
+		secrets, err = vault.ListSecrets(ctx, models.NewNote(owner, nil))
 		suite.Require().NoError(err)
 		suite.Len(secrets, 1)
 
 		deleted := models.NewNote([]models.SecretOption{
 			models.WithPath("note0"),
+			models.WithRequestedBy(username),
+		}, nil)
+		suite.Require().NoError(vault.DeleteSecret(ctx, deleted))
+
+		secrets, err = vault.ListSecrets(ctx, models.NewNote(owner, nil))
+		suite.Require().NoError(err)
+		suite.Empty(secrets)
+	})
+
+	suite.Run("ssh keys", func() {
+		privateKey, publicKey, genErr := sshkey.Generate(sshkey.TypeEd25519, 0, "mark@laptop")
+		suite.Require().NoError(genErr)
+		secret := models.NewSSHKey([]models.SecretOption{
+			models.WithPath("ssh0"),
+			models.WithCreatedBy(username),
+			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.SSHKeyOption{
+			models.WithPrivateKey(string(privateKey)),
+			models.WithPublicKey(publicKey),
+		})
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
+
+		retrieved := models.NewSSHKey([]models.SecretOption{
+			models.WithPath("ssh0"),
+			models.WithRequestedBy(username),
+		}, nil)
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
+		suite.Equal(privateKey, retrieved.PrivateKey)
+		suite.Equal(publicKey, retrieved.PublicKey)
+		suite.Equal("mark@laptop", retrieved.Comment)
+		suite.NotEmpty(retrieved.Fingerprint)
+
+		var secrets []string
+		secrets, err = vault.ListSecrets(ctx, models.NewSSHKey(owner, nil))
+		suite.Require().NoError(err)
+		suite.Len(secrets, 1)
+
+		deleted := models.NewSSHKey([]models.SecretOption{
+			models.WithPath("ssh0"),
+			models.WithRequestedBy(username),
+		}, nil)
+		suite.Require().NoError(vault.DeleteSecret(ctx, deleted))
+
+		secrets, err = vault.ListSecrets(ctx, models.NewSSHKey(owner, nil))
+		suite.Require().NoError(err)
+		suite.Empty(secrets)
+	})
+
+	suite.Run("totps", func() {
+		const uri = "otpauth://totp/GitHub:mark?secret=JBSWY3DPEHPK3PXP"
+		secret := models.NewTOTP([]models.SecretOption{
+			models.WithPath("totp0"),
+			models.WithCreatedBy(username),
+			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.TOTPOption{
+			models.WithOTPAuthURI(uri),
+		})
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
+
+		retrieved := models.NewTOTP([]models.SecretOption{
+			models.WithPath("totp0"),
+			models.WithRequestedBy(username),
+		}, nil)
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
+		suite.Contains(string(retrieved.URI), "secret=JBSWY3DPEHPK3PXP")
+
+		login := models.NewLogin([]models.SecretOption{
+			models.WithPath("login-2fa"),
+			models.WithCreatedBy(username),
+			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.LoginOption{
+			models.WithLogin("mark"),
+			models.WithPassword("meditations"),
+		})
+		suite.Require().NoError(vault.StoreSecret(ctx, login))
+		suite.Require().NoError(vault.AttachTOTP(ctx, "login-2fa", uri, username))
+		var validationErr *operation.ValidationError
+		suite.Require().ErrorAs(vault.AttachTOTP(ctx, "login-2fa", "otpauth://totp/x", username), &validationErr)
+
+		retrievedLogin := models.NewLogin([]models.SecretOption{
+			models.WithPath("login-2fa"),
+			models.WithRequestedBy(username),
+		}, nil)
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrievedLogin))
+		suite.Equal("meditations", string(retrievedLogin.Password))
+		suite.Contains(string(retrievedLogin.TOTP), "secret=JBSWY3DPEHPK3PXP")
+
+		for _, deleted := range []models.Secret{
+			models.NewTOTP([]models.SecretOption{models.WithPath("totp0"), models.WithRequestedBy(username)}, nil),
+			models.NewLogin([]models.SecretOption{models.WithPath("login-2fa"), models.WithRequestedBy(username)}, nil),
+		} {
+			suite.Require().NoError(vault.DeleteSecret(ctx, deleted))
+		}
+		var secrets []string
+		secrets, err = vault.ListSecrets(ctx, models.NewTOTP(owner, nil))
+		suite.Require().NoError(err)
+		suite.Empty(secrets)
+	})
+
+	suite.Run("key-value secrets", func() {
+		secret := models.NewKV([]models.SecretOption{
+			models.WithPath("kv0"),
+			models.WithCreatedBy(username),
+			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.KVOption{
+			models.WithField("url", "postgres://db.internal:5432/app", false),
+			models.WithField("password", "s3cr3t", true),
+		})
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
+
+		retrieved := models.NewKV([]models.SecretOption{
+			models.WithPath("kv0"),
+			models.WithRequestedBy(username),
+		}, nil)
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
+		suite.Equal([]models.KVField{
+			{Name: "url", Value: []byte("postgres://db.internal:5432/app")},
+			{Name: "password", Value: []byte("s3cr3t"), Sensitive: true},
+		}, retrieved.Fields)
+
+		var secrets []string
+		secrets, err = vault.ListSecrets(ctx, models.NewKV(owner, nil))
+		suite.Require().NoError(err)
+		suite.Len(secrets, 1)
+
+		deleted := models.NewKV([]models.SecretOption{
+			models.WithPath("kv0"),
+			models.WithRequestedBy(username),
 		}, nil)
-		suite.Require().NoError(vault.DeleteSecret(deleted))
+		suite.Require().NoError(vault.DeleteSecret(ctx, deleted))
 
-		secrets, err = vault.ListSecrets(models.NewNote(nil, nil))
+		secrets, err = vault.ListSecrets(ctx, models.NewKV(owner, nil))
 		suite.Require().NoError(err)
 		suite.Empty(secrets)
 	})
 
+	suite.Run("secret types", func() {
+		registry := server.NewSecretTypeRegistry(pool)
+		suite.Require().NoError(registry.RegisterSecretType(ctx, &models.SecretType{
+			Name: "database",
+			Fields: []models.SchemaField{
+				{Name: "host", Type: models.FieldTypeString, Required: true},
+				{Name: "port", Type: models.FieldTypeInteger},
+				{Name: "password", Type: models.FieldTypeString, Required: true, Sensitive: true},
+			},
+			CreatedBy: username,
+		}))
+
+		invalid := models.NewKV([]models.SecretOption{
+			models.WithPath("db0"),
+			models.WithCreatedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.KVOption{
+			models.WithSecretType("database"),
+			models.WithField("host", "db.internal", false),
+			models.WithField("port", "five", false),
+		})
+		var validationErr *operation.ValidationError
+		suite.Require().ErrorAs(vault.StoreSecret(ctx, invalid), &validationErr)
+
+		secret := models.NewKV([]models.SecretOption{
+			models.WithPath("db0"),
+			models.WithCreatedBy(username),
+			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.KVOption{
+			models.WithSecretType("database"),
+			models.WithField("host", "db.internal", false),
+			models.WithField("password", "s3cr3t", false),
+		})
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
+
+		retrieved := models.NewKV([]models.SecretOption{
+			models.WithPath("db0"),
+			models.WithRequestedBy(username),
+		}, nil)
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
+		suite.Equal("database", retrieved.Type)
+		suite.True(retrieved.Fields[1].Sensitive)
+
+		var secrets []string
+		secrets, err = vault.ListSecrets(ctx, models.NewKV(owner, []models.KVOption{models.WithSecretType("database")}))
+		suite.Require().NoError(err)
+		suite.Equal([]string{"db0"}, secrets)
+
+		suite.Require().NoError(vault.DeleteSecret(ctx, models.NewKV([]models.SecretOption{
+			models.WithPath("db0"),
+			models.WithRequestedBy(username),
+		}, nil)))
+	})
+
 	suite.Run("binaries", func() {
 		calcHash := func(data []byte) string {
 			dataHash := sha256.Sum256(data)
@@ -221,51 +515,337 @@ func (suite *VaultTestSuite) TestVaultAPI() {
 			models.WithPath("binary0"),
 			models.WithCreatedBy(username),
 			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
 		}, []models.BinaryOption{
 			models.WithChunkID(0),
 			models.WithData([]byte("test data")),
 			models.WithHash(calcHash([]byte("test data"))),
 		})
-		suite.Require().NoError(vault.StoreSecret(chunk))
+		suite.Require().NoError(vault.StoreSecret(ctx, chunk))
 		chunk = models.NewBinary([]models.SecretOption{
 			models.WithPath("binary0"),
 			models.WithCreatedBy(username),
 			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
 			models.WithEncryptedDataKey(chunk.EncryptedDataKey),
 		}, []models.BinaryOption{
 			models.WithChunks(1),
 			models.WithHash(calcHash([]byte("test data"))),
 		})
-		suite.Require().NoError(vault.StoreSecret(chunk))
+		suite.Require().NoError(vault.StoreSecret(ctx, chunk))
 
 		retrieved := models.NewBinary([]models.SecretOption{
 			models.WithPath("binary0"),
+			models.WithRequestedBy(username),
 		}, nil)
-		suite.Require().NoError(vault.RetrieveSecret(retrieved))
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
 		suite.Equal(int64(1), retrieved.Chunks)
 		retrieved = models.NewBinary([]models.SecretOption{
 			models.WithPath("binary0"),
+			models.WithRequestedBy(username),
 			models.WithEncryptedDataKey(retrieved.EncryptedDataKey),
 		}, []models.BinaryOption{
 			models.WithChunks(retrieved.Chunks),
 		})
-		suite.Require().NoError(vault.RetrieveSecret(retrieved))
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
 		suite.Equal(retrieved.Data, []byte("test data"))
 
 		var secrets []string
-		secrets, err = vault.ListSecrets(models.NewBinary(nil, nil))
+		secrets, err = vault.ListSecrets(ctx, models.NewBinary(owner, nil))
 		suite.Require().NoError(err)
 		suite.Len(secrets, 1)
 
 		deleted := models.NewBinary([]models.SecretOption{
 			models.WithPath("binary0"),
+			models.WithRequestedBy(username),
 		}, nil)
-		suite.Require().NoError(vault.DeleteSecret(deleted))
+		suite.Require().NoError(vault.DeleteSecret(ctx, deleted))
 
-		secrets, err = vault.ListSecrets(models.NewBinary(nil, nil))
+		secrets, err = vault.ListSecrets(ctx, models.NewBinary(owner, nil))
 		suite.Require().NoError(err)
 		suite.Empty(secrets)
 	})
+
+	suite.Run("sharing", func() {
+		colleague := "lucius"
+		suite.Require().NoError(userRepo.CreateUser(ctx, colleague, "verus"))
+		secret := models.NewLogin([]models.SecretOption{
+			models.WithPath("shared0"),
+			models.WithCreatedBy(username),
+			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.LoginOption{
+			models.WithLogin("leo"),
+			models.WithPassword("secret"),
+		})
+		suite.Require().NoError(vault.StoreSecret(ctx, secret))
+
+		asColleague := func() *models.Login {
+			return models.NewLogin([]models.SecretOption{
+				models.WithPath("shared0"),
+				models.WithRequestedBy(colleague),
+			}, nil)
+		}
+		suite.Require().ErrorIs(vault.RetrieveSecret(ctx, asColleague()), storage.ErrSecretNotFound)
+
+		share := models.Share{Path: "shared0", Owner: username, Grantee: colleague, Permission: models.PermissionRead}
+		suite.Require().NoError(vault.ShareSecret(share))
+		retrieved := asColleague()
+		suite.Require().NoError(vault.RetrieveSecret(ctx, retrieved))
+		suite.Equal("secret", string(retrieved.Password))
+		suite.Require().ErrorIs(vault.DeleteSecret(ctx, asColleague()), storage.ErrAccessDenied)
+		suite.Require().ErrorIs(vault.ShareSecret(models.Share{
+			Path: "shared0", Owner: colleague, Grantee: username, Permission: models.PermissionRead,
+		}), storage.ErrAccessDenied)
+
+		shares, listErr := vault.ListShares(username, "shared0")
+		suite.Require().NoError(listErr)
+		suite.Require().Len(shares, 1)
+		suite.Equal(models.LoginType, shares[0].Type)
+		shares, listErr = vault.ListSharedWith(colleague)
+		suite.Require().NoError(listErr)
+		suite.Require().Len(shares, 1)
+		suite.Equal(username, shares[0].Owner)
+
+		var secrets []string
+		secrets, err = vault.ListSecrets(ctx, models.NewLogin([]models.SecretOption{models.WithRequestedBy(colleague)}, nil))
+		suite.Require().NoError(err)
+		suite.Empty(secrets)
+
+		suite.Require().NoError(vault.UnshareSecret(share))
+		suite.Require().ErrorIs(vault.RetrieveSecret(ctx, asColleague()), storage.ErrSecretNotFound)
+		suite.Require().NoError(vault.DeleteSecret(ctx, models.NewLogin([]models.SecretOption{
+			models.WithPath("shared0"),
+			models.WithRequestedBy(username),
+		}, nil)))
+	})
+
+	suite.Run("organizations", func() {
+		authorizer := server.NewAuthorizer(ctx, pool, vault)
+		editor, viewer := "faustina", "commodus"
+		suite.Require().NoError(userRepo.CreateUser(ctx, editor, "pia"))
+		suite.Require().NoError(userRepo.CreateUser(ctx, viewer, "gladiator"))
+		secret := models.NewLogin([]models.SecretOption{
+			models.WithPath("org0"),
+			models.WithCreatedBy(username),
+			models.WithModifiedBy(username),
+			models.WithRequestedBy(username),
+		}, []models.LoginOption{
+			models.WithLogin("leo"),
+			models.WithPassword("secret"),
+			models.WithURI("https://treasury.rome.example", models.URIMatchHost),
+		})
+		suite.Require().NoError(authorizer.StoreSecret(ctx, secret))
+
+		suite.Require().NoError(authorizer.CreateOrganization(username, "rome"))
+		suite.Require().ErrorIs(authorizer.CreateOrganization(editor, "rome"), storage.ErrOrgExists)
+		suite.Require().NoError(authorizer.SetMember(username, "rome", editor, models.RoleEditor))
+		suite.Require().NoError(authorizer.SetMember(username, "rome", viewer, models.RoleViewer))
+		suite.Require().ErrorIs(authorizer.SetMember(editor, "rome", viewer, models.RoleEditor), storage.ErrAccessDenied)
+		suite.Require().ErrorIs(authorizer.SetMember(username, "rome", username, models.RoleAdmin), storage.ErrLastOwner)
+		suite.Require().NoError(authorizer.CreateTeam(username, "rome", "senate"))
+		suite.Require().NoError(authorizer.AddTeamMember(username, "rome", "senate", editor))
+		suite.Require().NoError(authorizer.AddTeamMember(username, "rome", "senate", viewer))
+		suite.Require().NoError(authorizer.CreateCollection(username, "rome", "treasury"))
+		suite.Require().NoError(authorizer.AssignCollection(username, "rome", "treasury", "senate", models.RoleEditor))
+		suite.Require().NoError(authorizer.AddToCollection(username, "rome", "treasury", "org0"))
+
+		as := func(user string) *models.Login {
+			return models.NewLogin([]models.SecretOption{
+				models.WithPath("org0"),
+				models.WithRequestedBy(user),
+			}, nil)
+		}
+		retrieved := as(viewer)
+		suite.Require().NoError(authorizer.RetrieveSecret(ctx, retrieved))
+		suite.Equal("secret", string(retrieved.Password))
+		// the team is editor of the collection, but the role of the viewer in the organization caps it
+		suite.Require().ErrorIs(authorizer.DeleteSecret(ctx, as(viewer)), storage.ErrAccessDenied)
+		suite.Require().ErrorIs(authorizer.ShareSecret(models.Share{
+			Path: "org0", Owner: editor, Grantee: viewer, Permission: models.PermissionRead,
+		}), storage.ErrAccessDenied)
+
+		collections, listErr := authorizer.ListCollections(viewer, "rome")
+		suite.Require().NoError(listErr)
+		suite.Require().Len(collections, 1)
+		suite.Equal([]string{"org0"}, collections[0].Secrets)
+		members, listErr := authorizer.ListMembers(viewer, "rome")
+		suite.Require().NoError(listErr)
+		suite.Len(members, 3)
+		_, listErr = authorizer.ListTeams("lucius", "rome")
+		suite.Require().ErrorIs(listErr, storage.ErrOrgNotFound)
+
+		// the login is reached through the collection only
+		matches, findErr := authorizer.FindLogins(ctx, "https://treasury.rome.example/login", viewer)
+		suite.Require().NoError(findErr)
+		suite.Require().Len(matches, 1)
+		suite.Equal("org0", matches[0].Path)
+
+		suite.Require().NoError(authorizer.RemoveMember(username, "rome", viewer))
+		suite.Require().ErrorIs(authorizer.RetrieveSecret(ctx, as(viewer)), storage.ErrSecretNotFound)
+		matches, findErr = authorizer.FindLogins(ctx, "https://treasury.rome.example/login", viewer)
+		suite.Require().NoError(findErr)
+		suite.Empty(matches)
+		suite.Require().NoError(authorizer.DeleteSecret(ctx, as(editor)))
+	})
+
+	suite.Run("audit", func() {
+		for _, event := range []models.AuditEvent{
+			{Actor: username, Action: "Create", Path: "audit0", Type: models.NoteType, Result: "OK"},
+			{Actor: "lucius", Action: "Get", Path: "audit0", Type: models.NoteType, Owner: username,
+				Result: "NotFound"},
+			{Actor: "lucius", Action: "List", Type: models.LoginType, Result: "OK"},
+		} {
+			suite.Require().NoError(vault.RecordEvent(event))
+		}
+
+		events, listErr := vault.ListAuditEvents(models.AuditFilter{Participant: username})
+		suite.Require().NoError(listErr)
+		suite.Require().Len(events, 2)
+		suite.Equal("Get", events[0].Action)
+		suite.Equal(username, events[1].Owner, "owner falls back to the actor")
+		events, listErr = vault.ListAuditEvents(models.AuditFilter{Actor: "lucius", PathPrefix: "audit"})
+		suite.Require().NoError(listErr)
+		suite.Len(events, 1)
+
+		result, verifyErr := vault.VerifyAuditLog()
+		suite.Require().NoError(verifyErr)
+		suite.Equal(int64(3), result.Events)
+		suite.Zero(result.BrokenAt)
+
+		_, execErr := pool.Exec(ctx, "DELETE FROM audit_events")
+		suite.Require().Error(execErr, "audit log is append-only")
+		_, execErr = pool.Exec(ctx, "ALTER TABLE audit_events DISABLE TRIGGER audit_events_no_change")
+		suite.Require().NoError(execErr)
+		_, execErr = pool.Exec(ctx, "UPDATE audit_events SET result = 'OK' WHERE action = 'Get'")
+		suite.Require().NoError(execErr)
+
+		result, verifyErr = vault.VerifyAuditLog()
+		suite.Require().NoError(verifyErr)
+		suite.Equal(events[0].EventID, result.BrokenAt)
+		suite.Equal("event has been modified", result.Reason)
+	})
+
+	suite.Run("lockout", func() {
+		repo := storage.NewLockoutRepo(pool)
+		now := time.Now().Truncate(time.Microsecond)
+
+		attempts, getErr := repo.GetAttempts(ctx, "lucius")
+		suite.Require().NoError(getErr)
+		suite.Zero(attempts.Failures)
+
+		attempts, getErr = repo.RecordFailure(ctx, "lucius", now, now.Add(-time.Hour))
+		suite.Require().NoError(getErr)
+		suite.Equal(1, attempts.Failures)
+		attempts, getErr = repo.RecordFailure(ctx, "lucius", now.Add(time.Second), now.Add(-time.Hour))
+		suite.Require().NoError(getErr)
+		suite.Equal(2, attempts.Failures)
+		attempts, getErr = repo.RecordFailure(ctx, "lucius", now.Add(2*time.Hour), now.Add(time.Hour))
+		suite.Require().NoError(getErr)
+		suite.Equal(1, attempts.Failures, "old failures are forgotten")
+
+		suite.Require().NoError(repo.Lock(ctx, "lucius", now.Add(time.Hour)))
+		attempts, getErr = repo.GetAttempts(ctx, "lucius")
+		suite.Require().NoError(getErr)
+		suite.True(attempts.LockedUntil.Equal(now.Add(time.Hour)))
+
+		suite.Require().NoError(repo.Reset(ctx, "lucius"))
+		attempts, getErr = repo.GetAttempts(ctx, "lucius")
+		suite.Require().NoError(getErr)
+		suite.True(attempts.LockedUntil.IsZero())
+	})
+
+	suite.Run("service accounts", func() {
+		accounts := server.NewServiceAccountManager(ctx, pool)
+		suite.Require().NoError(accounts.CreateServiceAccount(username, "deployer"))
+		suite.Require().ErrorIs(accounts.CreateServiceAccount(username, "lucius"), storage.ErrAccountExists)
+
+		list, listErr := accounts.ListServiceAccounts(username)
+		suite.Require().NoError(listErr)
+		suite.Require().Len(list, 1)
+		suite.Equal("deployer", list[0].Name)
+
+		scope := models.Scope{ReadOnly: true, PathPrefixes: []string{"deploy/"}}
+		apiKey, key, createErr := accounts.CreateAPIKey(username, "deployer", scope, time.Hour)
+		suite.Require().NoError(createErr)
+		_, _, createErr = accounts.CreateAPIKey("lucius", "deployer", scope, 0)
+		suite.Require().ErrorIs(createErr, storage.ErrAccountNotFound, "only the owner manages keys")
+
+		authenticated, authErr := accounts.AuthenticateAPIKey(apiKey)
+		suite.Require().NoError(authErr)
+		suite.Equal("deployer", authenticated.Account)
+		suite.Equal(scope, authenticated.Scope)
+		_, authErr = accounts.AuthenticateAPIKey(apiKey + "x")
+		suite.Require().ErrorIs(authErr, server.ErrInvalidAPIKey)
+
+		keys, listErr := accounts.ListAPIKeys(username, "deployer")
+		suite.Require().NoError(listErr)
+		suite.Require().Len(keys, 1)
+		suite.Equal(key.ID, keys[0].ID)
+		suite.False(keys[0].LastUsedAt.IsZero())
+
+		suite.Require().NoError(accounts.RevokeAPIKey(username, "deployer", key.ID))
+		_, authErr = accounts.AuthenticateAPIKey(apiKey)
+		suite.Require().ErrorIs(authErr, server.ErrInvalidAPIKey)
+
+		suite.Require().NoError(accounts.DeleteServiceAccount(username, "deployer"))
+		exists, existsErr := userRepo.Exists(ctx, "deployer")
+		suite.Require().NoError(existsErr)
+		suite.False(exists)
+	})
+
+	suite.Run("single sign-on", func() {
+		issuer := oidctest.NewIssuer("gophkeeper")
+		defer issuer.Close()
+		verifier := service.NewOIDCVerifier(service.OIDCConfig{
+			Issuer:        issuer.URL,
+			ClientID:      "gophkeeper",
+			UsernameClaim: "preferred_username",
+			GroupsClaim:   "groups",
+		}, issuer.Client())
+		mappings, parseErr := server.ParseGroupRoles([]string{"platform=admin", "eng=rome:editor", "leads=rome:admin"})
+		suite.Require().NoError(parseErr)
+		sso := server.NewSSOManager(ctx, pool, verifier, mappings)
+		authorizer := server.NewAuthorizer(ctx, pool, vault)
+		roleIn := func(login string) models.Role {
+			members, listErr := authorizer.ListMembers(username, "rome")
+			suite.Require().NoError(listErr)
+			for _, member := range members {
+				if member.Login == login {
+					return member.Role
+				}
+			}
+			return ""
+		}
+
+		login, loginErr := sso.Login(issuer.IDToken(map[string]any{
+			"sub": "00u1", "preferred_username": "portia", "groups": []string{"eng", "leads", "platform"},
+		}), "")
+		suite.Require().NoError(loginErr)
+		suite.Equal("portia", login)
+		suite.True(sso.IsAdmin("portia"))
+		suite.Equal(models.RoleAdmin, roleIn("portia"), "the highest mapped role wins")
+
+		login, loginErr = sso.Login(issuer.IDToken(map[string]any{
+			"sub": "00u1", "preferred_username": "renamed", "groups": []string{"eng"},
+		}), "")
+		suite.Require().NoError(loginErr)
+		suite.Equal("portia", login, "users are keyed by the subject")
+		suite.False(sso.IsAdmin("portia"))
+		suite.Equal(models.RoleEditor, roleIn("portia"))
+
+		_, loginErr = sso.Login(issuer.IDToken(map[string]any{"sub": "00u1"}), "")
+		suite.Require().NoError(loginErr)
+		suite.Empty(roleIn("portia"), "membership follows the groups")
+
+		login, loginErr = sso.Login(issuer.IDToken(map[string]any{"sub": "00u2", "preferred_username": username}), "")
+		suite.Require().NoError(loginErr)
+		suite.NotEqual(username, login, "existing users are not taken over")
+		suite.Contains(login, username+"-")
+
+		_, loginErr = sso.Login(issuer.IDToken(map[string]any{"sub": "00u1", "aud": "other"}), "")
+		suite.Require().ErrorIs(loginErr, service.ErrInvalidIDToken)
+	})
 }
 
 func TestVaultTestSuite(t *testing.T) {
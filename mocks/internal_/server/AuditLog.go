@@ -0,0 +1,195 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package server
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// AuditLog is an autogenerated mock type for the AuditLog type
+type AuditLog struct {
+	mock.Mock
+}
+
+type AuditLog_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditLog) EXPECT() *AuditLog_Expecter {
+	return &AuditLog_Expecter{mock: &_m.Mock}
+}
+
+// ListAuditEvents provides a mock function with given fields: filter
+func (_m *AuditLog) ListAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 []models.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.AuditFilter) ([]models.AuditEvent, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.AuditFilter) []models.AuditEvent); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.AuditFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditLog_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type AuditLog_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - filter models.AuditFilter
+func (_e *AuditLog_Expecter) ListAuditEvents(filter interface{}) *AuditLog_ListAuditEvents_Call {
+	return &AuditLog_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents", filter)}
+}
+
+func (_c *AuditLog_ListAuditEvents_Call) Run(run func(filter models.AuditFilter)) *AuditLog_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.AuditFilter))
+	})
+	return _c
+}
+
+func (_c *AuditLog_ListAuditEvents_Call) Return(_a0 []models.AuditEvent, _a1 error) *AuditLog_ListAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditLog_ListAuditEvents_Call) RunAndReturn(run func(models.AuditFilter) ([]models.AuditEvent, error)) *AuditLog_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordEvent provides a mock function with given fields: event
+func (_m *AuditLog) RecordEvent(event models.AuditEvent) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.AuditEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditLog_RecordEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordEvent'
+type AuditLog_RecordEvent_Call struct {
+	*mock.Call
+}
+
+// RecordEvent is a helper method to define mock.On call
+//   - event models.AuditEvent
+func (_e *AuditLog_Expecter) RecordEvent(event interface{}) *AuditLog_RecordEvent_Call {
+	return &AuditLog_RecordEvent_Call{Call: _e.mock.On("RecordEvent", event)}
+}
+
+func (_c *AuditLog_RecordEvent_Call) Run(run func(event models.AuditEvent)) *AuditLog_RecordEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *AuditLog_RecordEvent_Call) Return(_a0 error) *AuditLog_RecordEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditLog_RecordEvent_Call) RunAndReturn(run func(models.AuditEvent) error) *AuditLog_RecordEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyAuditLog provides a mock function with given fields:
+func (_m *AuditLog) VerifyAuditLog() (models.AuditVerification, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyAuditLog")
+	}
+
+	var r0 models.AuditVerification
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (models.AuditVerification, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() models.AuditVerification); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.AuditVerification)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditLog_VerifyAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyAuditLog'
+type AuditLog_VerifyAuditLog_Call struct {
+	*mock.Call
+}
+
+// VerifyAuditLog is a helper method to define mock.On call
+func (_e *AuditLog_Expecter) VerifyAuditLog() *AuditLog_VerifyAuditLog_Call {
+	return &AuditLog_VerifyAuditLog_Call{Call: _e.mock.On("VerifyAuditLog")}
+}
+
+func (_c *AuditLog_VerifyAuditLog_Call) Run(run func()) *AuditLog_VerifyAuditLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AuditLog_VerifyAuditLog_Call) Return(_a0 models.AuditVerification, _a1 error) *AuditLog_VerifyAuditLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditLog_VerifyAuditLog_Call) RunAndReturn(run func() (models.AuditVerification, error)) *AuditLog_VerifyAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditLog creates a new instance of AuditLog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditLog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditLog {
+	mock := &AuditLog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
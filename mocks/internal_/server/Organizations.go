@@ -0,0 +1,806 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package server
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// Organizations is an autogenerated mock type for the Organizations type
+type Organizations struct {
+	mock.Mock
+}
+
+type Organizations_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Organizations) EXPECT() *Organizations_Expecter {
+	return &Organizations_Expecter{mock: &_m.Mock}
+}
+
+// AddTeamMember provides a mock function with given fields: user, org, team, member
+func (_m *Organizations) AddTeamMember(user string, org string, team string, member string) error {
+	ret := _m.Called(user, org, team, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTeamMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) error); ok {
+		r0 = rf(user, org, team, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_AddTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMember'
+type Organizations_AddTeamMember_Call struct {
+	*mock.Call
+}
+
+// AddTeamMember is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - team string
+//   - member string
+func (_e *Organizations_Expecter) AddTeamMember(user interface{}, org interface{}, team interface{}, member interface{}) *Organizations_AddTeamMember_Call {
+	return &Organizations_AddTeamMember_Call{Call: _e.mock.On("AddTeamMember", user, org, team, member)}
+}
+
+func (_c *Organizations_AddTeamMember_Call) Run(run func(user string, org string, team string, member string)) *Organizations_AddTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_AddTeamMember_Call) Return(_a0 error) *Organizations_AddTeamMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_AddTeamMember_Call) RunAndReturn(run func(string, string, string, string) error) *Organizations_AddTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddToCollection provides a mock function with given fields: user, org, collection, path
+func (_m *Organizations) AddToCollection(user string, org string, collection string, path string) error {
+	ret := _m.Called(user, org, collection, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) error); ok {
+		r0 = rf(user, org, collection, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_AddToCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToCollection'
+type Organizations_AddToCollection_Call struct {
+	*mock.Call
+}
+
+// AddToCollection is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - collection string
+//   - path string
+func (_e *Organizations_Expecter) AddToCollection(user interface{}, org interface{}, collection interface{}, path interface{}) *Organizations_AddToCollection_Call {
+	return &Organizations_AddToCollection_Call{Call: _e.mock.On("AddToCollection", user, org, collection, path)}
+}
+
+func (_c *Organizations_AddToCollection_Call) Run(run func(user string, org string, collection string, path string)) *Organizations_AddToCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_AddToCollection_Call) Return(_a0 error) *Organizations_AddToCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_AddToCollection_Call) RunAndReturn(run func(string, string, string, string) error) *Organizations_AddToCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignCollection provides a mock function with given fields: user, org, collection, team, role
+func (_m *Organizations) AssignCollection(user string, org string, collection string, team string, role models.Role) error {
+	ret := _m.Called(user, org, collection, team, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, models.Role) error); ok {
+		r0 = rf(user, org, collection, team, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_AssignCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignCollection'
+type Organizations_AssignCollection_Call struct {
+	*mock.Call
+}
+
+// AssignCollection is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - collection string
+//   - team string
+//   - role models.Role
+func (_e *Organizations_Expecter) AssignCollection(user interface{}, org interface{}, collection interface{}, team interface{}, role interface{}) *Organizations_AssignCollection_Call {
+	return &Organizations_AssignCollection_Call{Call: _e.mock.On("AssignCollection", user, org, collection, team, role)}
+}
+
+func (_c *Organizations_AssignCollection_Call) Run(run func(user string, org string, collection string, team string, role models.Role)) *Organizations_AssignCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(models.Role))
+	})
+	return _c
+}
+
+func (_c *Organizations_AssignCollection_Call) Return(_a0 error) *Organizations_AssignCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_AssignCollection_Call) RunAndReturn(run func(string, string, string, string, models.Role) error) *Organizations_AssignCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCollection provides a mock function with given fields: user, org, collection
+func (_m *Organizations) CreateCollection(user string, org string, collection string) error {
+	ret := _m.Called(user, org, collection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(user, org, collection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
+type Organizations_CreateCollection_Call struct {
+	*mock.Call
+}
+
+// CreateCollection is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - collection string
+func (_e *Organizations_Expecter) CreateCollection(user interface{}, org interface{}, collection interface{}) *Organizations_CreateCollection_Call {
+	return &Organizations_CreateCollection_Call{Call: _e.mock.On("CreateCollection", user, org, collection)}
+}
+
+func (_c *Organizations_CreateCollection_Call) Run(run func(user string, org string, collection string)) *Organizations_CreateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_CreateCollection_Call) Return(_a0 error) *Organizations_CreateCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_CreateCollection_Call) RunAndReturn(run func(string, string, string) error) *Organizations_CreateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function with given fields: user, org
+func (_m *Organizations) CreateOrganization(user string, org string) error {
+	ret := _m.Called(user, org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(user, org)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type Organizations_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - user string
+//   - org string
+func (_e *Organizations_Expecter) CreateOrganization(user interface{}, org interface{}) *Organizations_CreateOrganization_Call {
+	return &Organizations_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", user, org)}
+}
+
+func (_c *Organizations_CreateOrganization_Call) Run(run func(user string, org string)) *Organizations_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_CreateOrganization_Call) Return(_a0 error) *Organizations_CreateOrganization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_CreateOrganization_Call) RunAndReturn(run func(string, string) error) *Organizations_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTeam provides a mock function with given fields: user, org, team
+func (_m *Organizations) CreateTeam(user string, org string, team string) error {
+	ret := _m.Called(user, org, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(user, org, team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
+type Organizations_CreateTeam_Call struct {
+	*mock.Call
+}
+
+// CreateTeam is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - team string
+func (_e *Organizations_Expecter) CreateTeam(user interface{}, org interface{}, team interface{}) *Organizations_CreateTeam_Call {
+	return &Organizations_CreateTeam_Call{Call: _e.mock.On("CreateTeam", user, org, team)}
+}
+
+func (_c *Organizations_CreateTeam_Call) Run(run func(user string, org string, team string)) *Organizations_CreateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_CreateTeam_Call) Return(_a0 error) *Organizations_CreateTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_CreateTeam_Call) RunAndReturn(run func(string, string, string) error) *Organizations_CreateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCollections provides a mock function with given fields: user, org
+func (_m *Organizations) ListCollections(user string, org string) ([]models.Collection, error) {
+	ret := _m.Called(user, org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollections")
+	}
+
+	var r0 []models.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.Collection, error)); ok {
+		return rf(user, org)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.Collection); ok {
+		r0 = rf(user, org)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(user, org)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Organizations_ListCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollections'
+type Organizations_ListCollections_Call struct {
+	*mock.Call
+}
+
+// ListCollections is a helper method to define mock.On call
+//   - user string
+//   - org string
+func (_e *Organizations_Expecter) ListCollections(user interface{}, org interface{}) *Organizations_ListCollections_Call {
+	return &Organizations_ListCollections_Call{Call: _e.mock.On("ListCollections", user, org)}
+}
+
+func (_c *Organizations_ListCollections_Call) Run(run func(user string, org string)) *Organizations_ListCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_ListCollections_Call) Return(_a0 []models.Collection, _a1 error) *Organizations_ListCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Organizations_ListCollections_Call) RunAndReturn(run func(string, string) ([]models.Collection, error)) *Organizations_ListCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: user, org
+func (_m *Organizations) ListMembers(user string, org string) ([]models.Member, error) {
+	ret := _m.Called(user, org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 []models.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.Member, error)); ok {
+		return rf(user, org)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.Member); ok {
+		r0 = rf(user, org)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(user, org)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Organizations_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type Organizations_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - user string
+//   - org string
+func (_e *Organizations_Expecter) ListMembers(user interface{}, org interface{}) *Organizations_ListMembers_Call {
+	return &Organizations_ListMembers_Call{Call: _e.mock.On("ListMembers", user, org)}
+}
+
+func (_c *Organizations_ListMembers_Call) Run(run func(user string, org string)) *Organizations_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_ListMembers_Call) Return(_a0 []models.Member, _a1 error) *Organizations_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Organizations_ListMembers_Call) RunAndReturn(run func(string, string) ([]models.Member, error)) *Organizations_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizations provides a mock function with given fields: user
+func (_m *Organizations) ListOrganizations(user string) ([]models.Organization, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizations")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Organization, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Organization); ok {
+		r0 = rf(user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Organizations_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
+type Organizations_ListOrganizations_Call struct {
+	*mock.Call
+}
+
+// ListOrganizations is a helper method to define mock.On call
+//   - user string
+func (_e *Organizations_Expecter) ListOrganizations(user interface{}) *Organizations_ListOrganizations_Call {
+	return &Organizations_ListOrganizations_Call{Call: _e.mock.On("ListOrganizations", user)}
+}
+
+func (_c *Organizations_ListOrganizations_Call) Run(run func(user string)) *Organizations_ListOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_ListOrganizations_Call) Return(_a0 []models.Organization, _a1 error) *Organizations_ListOrganizations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Organizations_ListOrganizations_Call) RunAndReturn(run func(string) ([]models.Organization, error)) *Organizations_ListOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTeams provides a mock function with given fields: user, org
+func (_m *Organizations) ListTeams(user string, org string) ([]models.Team, error) {
+	ret := _m.Called(user, org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeams")
+	}
+
+	var r0 []models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.Team, error)); ok {
+		return rf(user, org)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.Team); ok {
+		r0 = rf(user, org)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(user, org)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Organizations_ListTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeams'
+type Organizations_ListTeams_Call struct {
+	*mock.Call
+}
+
+// ListTeams is a helper method to define mock.On call
+//   - user string
+//   - org string
+func (_e *Organizations_Expecter) ListTeams(user interface{}, org interface{}) *Organizations_ListTeams_Call {
+	return &Organizations_ListTeams_Call{Call: _e.mock.On("ListTeams", user, org)}
+}
+
+func (_c *Organizations_ListTeams_Call) Run(run func(user string, org string)) *Organizations_ListTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_ListTeams_Call) Return(_a0 []models.Team, _a1 error) *Organizations_ListTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Organizations_ListTeams_Call) RunAndReturn(run func(string, string) ([]models.Team, error)) *Organizations_ListTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromCollection provides a mock function with given fields: user, org, collection, path
+func (_m *Organizations) RemoveFromCollection(user string, org string, collection string, path string) error {
+	ret := _m.Called(user, org, collection, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) error); ok {
+		r0 = rf(user, org, collection, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_RemoveFromCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromCollection'
+type Organizations_RemoveFromCollection_Call struct {
+	*mock.Call
+}
+
+// RemoveFromCollection is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - collection string
+//   - path string
+func (_e *Organizations_Expecter) RemoveFromCollection(user interface{}, org interface{}, collection interface{}, path interface{}) *Organizations_RemoveFromCollection_Call {
+	return &Organizations_RemoveFromCollection_Call{Call: _e.mock.On("RemoveFromCollection", user, org, collection, path)}
+}
+
+func (_c *Organizations_RemoveFromCollection_Call) Run(run func(user string, org string, collection string, path string)) *Organizations_RemoveFromCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_RemoveFromCollection_Call) Return(_a0 error) *Organizations_RemoveFromCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_RemoveFromCollection_Call) RunAndReturn(run func(string, string, string, string) error) *Organizations_RemoveFromCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: user, org, member
+func (_m *Organizations) RemoveMember(user string, org string, member string) error {
+	ret := _m.Called(user, org, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(user, org, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type Organizations_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - member string
+func (_e *Organizations_Expecter) RemoveMember(user interface{}, org interface{}, member interface{}) *Organizations_RemoveMember_Call {
+	return &Organizations_RemoveMember_Call{Call: _e.mock.On("RemoveMember", user, org, member)}
+}
+
+func (_c *Organizations_RemoveMember_Call) Run(run func(user string, org string, member string)) *Organizations_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_RemoveMember_Call) Return(_a0 error) *Organizations_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_RemoveMember_Call) RunAndReturn(run func(string, string, string) error) *Organizations_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMember provides a mock function with given fields: user, org, team, member
+func (_m *Organizations) RemoveTeamMember(user string, org string, team string, member string) error {
+	ret := _m.Called(user, org, team, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) error); ok {
+		r0 = rf(user, org, team, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
+type Organizations_RemoveTeamMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMember is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - team string
+//   - member string
+func (_e *Organizations_Expecter) RemoveTeamMember(user interface{}, org interface{}, team interface{}, member interface{}) *Organizations_RemoveTeamMember_Call {
+	return &Organizations_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember", user, org, team, member)}
+}
+
+func (_c *Organizations_RemoveTeamMember_Call) Run(run func(user string, org string, team string, member string)) *Organizations_RemoveTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_RemoveTeamMember_Call) Return(_a0 error) *Organizations_RemoveTeamMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_RemoveTeamMember_Call) RunAndReturn(run func(string, string, string, string) error) *Organizations_RemoveTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMember provides a mock function with given fields: user, org, member, role
+func (_m *Organizations) SetMember(user string, org string, member string, role models.Role) error {
+	ret := _m.Called(user, org, member, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, models.Role) error); ok {
+		r0 = rf(user, org, member, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_SetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMember'
+type Organizations_SetMember_Call struct {
+	*mock.Call
+}
+
+// SetMember is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - member string
+//   - role models.Role
+func (_e *Organizations_Expecter) SetMember(user interface{}, org interface{}, member interface{}, role interface{}) *Organizations_SetMember_Call {
+	return &Organizations_SetMember_Call{Call: _e.mock.On("SetMember", user, org, member, role)}
+}
+
+func (_c *Organizations_SetMember_Call) Run(run func(user string, org string, member string, role models.Role)) *Organizations_SetMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(models.Role))
+	})
+	return _c
+}
+
+func (_c *Organizations_SetMember_Call) Return(_a0 error) *Organizations_SetMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_SetMember_Call) RunAndReturn(run func(string, string, string, models.Role) error) *Organizations_SetMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignCollection provides a mock function with given fields: user, org, collection, team
+func (_m *Organizations) UnassignCollection(user string, org string, collection string, team string) error {
+	ret := _m.Called(user, org, collection, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) error); ok {
+		r0 = rf(user, org, collection, team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Organizations_UnassignCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignCollection'
+type Organizations_UnassignCollection_Call struct {
+	*mock.Call
+}
+
+// UnassignCollection is a helper method to define mock.On call
+//   - user string
+//   - org string
+//   - collection string
+//   - team string
+func (_e *Organizations_Expecter) UnassignCollection(user interface{}, org interface{}, collection interface{}, team interface{}) *Organizations_UnassignCollection_Call {
+	return &Organizations_UnassignCollection_Call{Call: _e.mock.On("UnassignCollection", user, org, collection, team)}
+}
+
+func (_c *Organizations_UnassignCollection_Call) Run(run func(user string, org string, collection string, team string)) *Organizations_UnassignCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Organizations_UnassignCollection_Call) Return(_a0 error) *Organizations_UnassignCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Organizations_UnassignCollection_Call) RunAndReturn(run func(string, string, string, string) error) *Organizations_UnassignCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrganizations creates a new instance of Organizations. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrganizations(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Organizations {
+	mock := &Organizations{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,184 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package server
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/itallix/gophkeeper/internal/server/service"
+)
+
+// SSO is an autogenerated mock type for the SSO type
+type SSO struct {
+	mock.Mock
+}
+
+type SSO_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SSO) EXPECT() *SSO_Expecter {
+	return &SSO_Expecter{mock: &_m.Mock}
+}
+
+// Config provides a mock function with given fields:
+func (_m *SSO) Config() service.OIDCConfig {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 service.OIDCConfig
+	if rf, ok := ret.Get(0).(func() service.OIDCConfig); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(service.OIDCConfig)
+	}
+
+	return r0
+}
+
+// SSO_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type SSO_Config_Call struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *SSO_Expecter) Config() *SSO_Config_Call {
+	return &SSO_Config_Call{Call: _e.mock.On("Config")}
+}
+
+func (_c *SSO_Config_Call) Run(run func()) *SSO_Config_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SSO_Config_Call) Return(_a0 service.OIDCConfig) *SSO_Config_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SSO_Config_Call) RunAndReturn(run func() service.OIDCConfig) *SSO_Config_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAdmin provides a mock function with given fields: login
+func (_m *SSO) IsAdmin(login string) bool {
+	ret := _m.Called(login)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAdmin")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(login)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// SSO_IsAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAdmin'
+type SSO_IsAdmin_Call struct {
+	*mock.Call
+}
+
+// IsAdmin is a helper method to define mock.On call
+//   - login string
+func (_e *SSO_Expecter) IsAdmin(login interface{}) *SSO_IsAdmin_Call {
+	return &SSO_IsAdmin_Call{Call: _e.mock.On("IsAdmin", login)}
+}
+
+func (_c *SSO_IsAdmin_Call) Run(run func(login string)) *SSO_IsAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SSO_IsAdmin_Call) Return(_a0 bool) *SSO_IsAdmin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SSO_IsAdmin_Call) RunAndReturn(run func(string) bool) *SSO_IsAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: idToken, nonce
+func (_m *SSO) Login(idToken string, nonce string) (string, error) {
+	ret := _m.Called(idToken, nonce)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(idToken, nonce)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(idToken, nonce)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(idToken, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SSO_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type SSO_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - idToken string
+//   - nonce string
+func (_e *SSO_Expecter) Login(idToken interface{}, nonce interface{}) *SSO_Login_Call {
+	return &SSO_Login_Call{Call: _e.mock.On("Login", idToken, nonce)}
+}
+
+func (_c *SSO_Login_Call) Run(run func(idToken string, nonce string)) *SSO_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SSO_Login_Call) Return(_a0 string, _a1 error) *SSO_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SSO_Login_Call) RunAndReturn(run func(string, string) (string, error)) *SSO_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSSO creates a new instance of SSO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSSO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SSO {
+	mock := &SSO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,202 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package server
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// SecretTypes is an autogenerated mock type for the SecretTypes type
+type SecretTypes struct {
+	mock.Mock
+}
+
+type SecretTypes_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecretTypes) EXPECT() *SecretTypes_Expecter {
+	return &SecretTypes_Expecter{mock: &_m.Mock}
+}
+
+// GetSecretType provides a mock function with given fields: ctx, name
+func (_m *SecretTypes) GetSecretType(ctx context.Context, name string) (*models.SecretType, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecretType")
+	}
+
+	var r0 *models.SecretType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.SecretType, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.SecretType); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SecretType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretTypes_GetSecretType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecretType'
+type SecretTypes_GetSecretType_Call struct {
+	*mock.Call
+}
+
+// GetSecretType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *SecretTypes_Expecter) GetSecretType(ctx interface{}, name interface{}) *SecretTypes_GetSecretType_Call {
+	return &SecretTypes_GetSecretType_Call{Call: _e.mock.On("GetSecretType", ctx, name)}
+}
+
+func (_c *SecretTypes_GetSecretType_Call) Run(run func(ctx context.Context, name string)) *SecretTypes_GetSecretType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SecretTypes_GetSecretType_Call) Return(_a0 *models.SecretType, _a1 error) *SecretTypes_GetSecretType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretTypes_GetSecretType_Call) RunAndReturn(run func(context.Context, string) (*models.SecretType, error)) *SecretTypes_GetSecretType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecretTypes provides a mock function with given fields: ctx
+func (_m *SecretTypes) ListSecretTypes(ctx context.Context) ([]models.SecretType, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecretTypes")
+	}
+
+	var r0 []models.SecretType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.SecretType, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.SecretType); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SecretType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretTypes_ListSecretTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretTypes'
+type SecretTypes_ListSecretTypes_Call struct {
+	*mock.Call
+}
+
+// ListSecretTypes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SecretTypes_Expecter) ListSecretTypes(ctx interface{}) *SecretTypes_ListSecretTypes_Call {
+	return &SecretTypes_ListSecretTypes_Call{Call: _e.mock.On("ListSecretTypes", ctx)}
+}
+
+func (_c *SecretTypes_ListSecretTypes_Call) Run(run func(ctx context.Context)) *SecretTypes_ListSecretTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SecretTypes_ListSecretTypes_Call) Return(_a0 []models.SecretType, _a1 error) *SecretTypes_ListSecretTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretTypes_ListSecretTypes_Call) RunAndReturn(run func(context.Context) ([]models.SecretType, error)) *SecretTypes_ListSecretTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterSecretType provides a mock function with given fields: ctx, secretType
+func (_m *SecretTypes) RegisterSecretType(ctx context.Context, secretType *models.SecretType) error {
+	ret := _m.Called(ctx, secretType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterSecretType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SecretType) error); ok {
+		r0 = rf(ctx, secretType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecretTypes_RegisterSecretType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterSecretType'
+type SecretTypes_RegisterSecretType_Call struct {
+	*mock.Call
+}
+
+// RegisterSecretType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - secretType *models.SecretType
+func (_e *SecretTypes_Expecter) RegisterSecretType(ctx interface{}, secretType interface{}) *SecretTypes_RegisterSecretType_Call {
+	return &SecretTypes_RegisterSecretType_Call{Call: _e.mock.On("RegisterSecretType", ctx, secretType)}
+}
+
+func (_c *SecretTypes_RegisterSecretType_Call) Run(run func(ctx context.Context, secretType *models.SecretType)) *SecretTypes_RegisterSecretType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SecretType))
+	})
+	return _c
+}
+
+func (_c *SecretTypes_RegisterSecretType_Call) Return(_a0 error) *SecretTypes_RegisterSecretType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretTypes_RegisterSecretType_Call) RunAndReturn(run func(context.Context, *models.SecretType) error) *SecretTypes_RegisterSecretType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecretTypes creates a new instance of SecretTypes. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretTypes(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretTypes {
+	mock := &SecretTypes{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
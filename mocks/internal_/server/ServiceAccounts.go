@@ -0,0 +1,419 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package server
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+
+	time "time"
+)
+
+// ServiceAccounts is an autogenerated mock type for the ServiceAccounts type
+type ServiceAccounts struct {
+	mock.Mock
+}
+
+type ServiceAccounts_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAccounts) EXPECT() *ServiceAccounts_Expecter {
+	return &ServiceAccounts_Expecter{mock: &_m.Mock}
+}
+
+// AuthenticateAPIKey provides a mock function with given fields: key
+func (_m *ServiceAccounts) AuthenticateAPIKey(key string) (models.APIKey, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateAPIKey")
+	}
+
+	var r0 models.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.APIKey, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.APIKey); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(models.APIKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccounts_AuthenticateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticateAPIKey'
+type ServiceAccounts_AuthenticateAPIKey_Call struct {
+	*mock.Call
+}
+
+// AuthenticateAPIKey is a helper method to define mock.On call
+//   - key string
+func (_e *ServiceAccounts_Expecter) AuthenticateAPIKey(key interface{}) *ServiceAccounts_AuthenticateAPIKey_Call {
+	return &ServiceAccounts_AuthenticateAPIKey_Call{Call: _e.mock.On("AuthenticateAPIKey", key)}
+}
+
+func (_c *ServiceAccounts_AuthenticateAPIKey_Call) Run(run func(key string)) *ServiceAccounts_AuthenticateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccounts_AuthenticateAPIKey_Call) Return(_a0 models.APIKey, _a1 error) *ServiceAccounts_AuthenticateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccounts_AuthenticateAPIKey_Call) RunAndReturn(run func(string) (models.APIKey, error)) *ServiceAccounts_AuthenticateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function with given fields: owner, account, scope, ttl
+func (_m *ServiceAccounts) CreateAPIKey(owner string, account string, scope models.Scope, ttl time.Duration) (string, models.APIKey, error) {
+	ret := _m.Called(owner, account, scope, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 string
+	var r1 models.APIKey
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, models.Scope, time.Duration) (string, models.APIKey, error)); ok {
+		return rf(owner, account, scope, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, models.Scope, time.Duration) string); ok {
+		r0 = rf(owner, account, scope, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, models.Scope, time.Duration) models.APIKey); ok {
+		r1 = rf(owner, account, scope, ttl)
+	} else {
+		r1 = ret.Get(1).(models.APIKey)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, models.Scope, time.Duration) error); ok {
+		r2 = rf(owner, account, scope, ttl)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ServiceAccounts_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type ServiceAccounts_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - owner string
+//   - account string
+//   - scope models.Scope
+//   - ttl time.Duration
+func (_e *ServiceAccounts_Expecter) CreateAPIKey(owner interface{}, account interface{}, scope interface{}, ttl interface{}) *ServiceAccounts_CreateAPIKey_Call {
+	return &ServiceAccounts_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", owner, account, scope, ttl)}
+}
+
+func (_c *ServiceAccounts_CreateAPIKey_Call) Run(run func(owner string, account string, scope models.Scope, ttl time.Duration)) *ServiceAccounts_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(models.Scope), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ServiceAccounts_CreateAPIKey_Call) Return(_a0 string, _a1 models.APIKey, _a2 error) *ServiceAccounts_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ServiceAccounts_CreateAPIKey_Call) RunAndReturn(run func(string, string, models.Scope, time.Duration) (string, models.APIKey, error)) *ServiceAccounts_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServiceAccount provides a mock function with given fields: owner, name
+func (_m *ServiceAccounts) CreateServiceAccount(owner string, name string) error {
+	ret := _m.Called(owner, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServiceAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(owner, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccounts_CreateServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServiceAccount'
+type ServiceAccounts_CreateServiceAccount_Call struct {
+	*mock.Call
+}
+
+// CreateServiceAccount is a helper method to define mock.On call
+//   - owner string
+//   - name string
+func (_e *ServiceAccounts_Expecter) CreateServiceAccount(owner interface{}, name interface{}) *ServiceAccounts_CreateServiceAccount_Call {
+	return &ServiceAccounts_CreateServiceAccount_Call{Call: _e.mock.On("CreateServiceAccount", owner, name)}
+}
+
+func (_c *ServiceAccounts_CreateServiceAccount_Call) Run(run func(owner string, name string)) *ServiceAccounts_CreateServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccounts_CreateServiceAccount_Call) Return(_a0 error) *ServiceAccounts_CreateServiceAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccounts_CreateServiceAccount_Call) RunAndReturn(run func(string, string) error) *ServiceAccounts_CreateServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServiceAccount provides a mock function with given fields: owner, name
+func (_m *ServiceAccounts) DeleteServiceAccount(owner string, name string) error {
+	ret := _m.Called(owner, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServiceAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(owner, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccounts_DeleteServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccount'
+type ServiceAccounts_DeleteServiceAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteServiceAccount is a helper method to define mock.On call
+//   - owner string
+//   - name string
+func (_e *ServiceAccounts_Expecter) DeleteServiceAccount(owner interface{}, name interface{}) *ServiceAccounts_DeleteServiceAccount_Call {
+	return &ServiceAccounts_DeleteServiceAccount_Call{Call: _e.mock.On("DeleteServiceAccount", owner, name)}
+}
+
+func (_c *ServiceAccounts_DeleteServiceAccount_Call) Run(run func(owner string, name string)) *ServiceAccounts_DeleteServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccounts_DeleteServiceAccount_Call) Return(_a0 error) *ServiceAccounts_DeleteServiceAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccounts_DeleteServiceAccount_Call) RunAndReturn(run func(string, string) error) *ServiceAccounts_DeleteServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function with given fields: owner, account
+func (_m *ServiceAccounts) ListAPIKeys(owner string, account string) ([]models.APIKey, error) {
+	ret := _m.Called(owner, account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 []models.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.APIKey, error)); ok {
+		return rf(owner, account)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.APIKey); ok {
+		r0 = rf(owner, account)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(owner, account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccounts_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type ServiceAccounts_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - owner string
+//   - account string
+func (_e *ServiceAccounts_Expecter) ListAPIKeys(owner interface{}, account interface{}) *ServiceAccounts_ListAPIKeys_Call {
+	return &ServiceAccounts_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", owner, account)}
+}
+
+func (_c *ServiceAccounts_ListAPIKeys_Call) Run(run func(owner string, account string)) *ServiceAccounts_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccounts_ListAPIKeys_Call) Return(_a0 []models.APIKey, _a1 error) *ServiceAccounts_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccounts_ListAPIKeys_Call) RunAndReturn(run func(string, string) ([]models.APIKey, error)) *ServiceAccounts_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListServiceAccounts provides a mock function with given fields: owner
+func (_m *ServiceAccounts) ListServiceAccounts(owner string) ([]models.ServiceAccount, error) {
+	ret := _m.Called(owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServiceAccounts")
+	}
+
+	var r0 []models.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.ServiceAccount, error)); ok {
+		return rf(owner)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.ServiceAccount); ok {
+		r0 = rf(owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccounts_ListServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServiceAccounts'
+type ServiceAccounts_ListServiceAccounts_Call struct {
+	*mock.Call
+}
+
+// ListServiceAccounts is a helper method to define mock.On call
+//   - owner string
+func (_e *ServiceAccounts_Expecter) ListServiceAccounts(owner interface{}) *ServiceAccounts_ListServiceAccounts_Call {
+	return &ServiceAccounts_ListServiceAccounts_Call{Call: _e.mock.On("ListServiceAccounts", owner)}
+}
+
+func (_c *ServiceAccounts_ListServiceAccounts_Call) Run(run func(owner string)) *ServiceAccounts_ListServiceAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccounts_ListServiceAccounts_Call) Return(_a0 []models.ServiceAccount, _a1 error) *ServiceAccounts_ListServiceAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccounts_ListServiceAccounts_Call) RunAndReturn(run func(string) ([]models.ServiceAccount, error)) *ServiceAccounts_ListServiceAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: owner, account, keyID
+func (_m *ServiceAccounts) RevokeAPIKey(owner string, account string, keyID string) error {
+	ret := _m.Called(owner, account, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(owner, account, keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccounts_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type ServiceAccounts_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - owner string
+//   - account string
+//   - keyID string
+func (_e *ServiceAccounts_Expecter) RevokeAPIKey(owner interface{}, account interface{}, keyID interface{}) *ServiceAccounts_RevokeAPIKey_Call {
+	return &ServiceAccounts_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", owner, account, keyID)}
+}
+
+func (_c *ServiceAccounts_RevokeAPIKey_Call) Run(run func(owner string, account string, keyID string)) *ServiceAccounts_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccounts_RevokeAPIKey_Call) Return(_a0 error) *ServiceAccounts_RevokeAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccounts_RevokeAPIKey_Call) RunAndReturn(run func(string, string, string) error) *ServiceAccounts_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAccounts creates a new instance of ServiceAccounts. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAccounts(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAccounts {
+	mock := &ServiceAccounts{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,8 @@
 package server
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/itallix/gophkeeper/internal/server/models"
@@ -21,17 +23,66 @@ func (_m *Vault) EXPECT() *Vault_Expecter {
 	return &Vault_Expecter{mock: &_m.Mock}
 }
 
-// DeleteSecret provides a mock function with given fields: secret
-func (_m *Vault) DeleteSecret(secret models.Secret) error {
-	ret := _m.Called(secret)
+// AttachTOTP provides a mock function with given fields: ctx, path, uri, requestedBy
+func (_m *Vault) AttachTOTP(ctx context.Context, path string, uri string, requestedBy string) error {
+	ret := _m.Called(ctx, path, uri, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachTOTP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, path, uri, requestedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Vault_AttachTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachTOTP'
+type Vault_AttachTOTP_Call struct {
+	*mock.Call
+}
+
+// AttachTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - uri string
+//   - requestedBy string
+func (_e *Vault_Expecter) AttachTOTP(ctx interface{}, path interface{}, uri interface{}, requestedBy interface{}) *Vault_AttachTOTP_Call {
+	return &Vault_AttachTOTP_Call{Call: _e.mock.On("AttachTOTP", ctx, path, uri, requestedBy)}
+}
+
+func (_c *Vault_AttachTOTP_Call) Run(run func(ctx context.Context, path string, uri string, requestedBy string)) *Vault_AttachTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Vault_AttachTOTP_Call) Return(_a0 error) *Vault_AttachTOTP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Vault_AttachTOTP_Call) RunAndReturn(run func(context.Context, string, string, string) error) *Vault_AttachTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSecret provides a mock function with given fields: ctx, secret
+func (_m *Vault) DeleteSecret(ctx context.Context, secret models.Secret) error {
+	ret := _m.Called(ctx, secret)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteSecret")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(models.Secret) error); ok {
-		r0 = rf(secret)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Secret) error); ok {
+		r0 = rf(ctx, secret)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -45,14 +96,15 @@ type Vault_DeleteSecret_Call struct {
 }
 
 // DeleteSecret is a helper method to define mock.On call
+//   - ctx context.Context
 //   - secret models.Secret
-func (_e *Vault_Expecter) DeleteSecret(secret interface{}) *Vault_DeleteSecret_Call {
-	return &Vault_DeleteSecret_Call{Call: _e.mock.On("DeleteSecret", secret)}
+func (_e *Vault_Expecter) DeleteSecret(ctx interface{}, secret interface{}) *Vault_DeleteSecret_Call {
+	return &Vault_DeleteSecret_Call{Call: _e.mock.On("DeleteSecret", ctx, secret)}
 }
 
-func (_c *Vault_DeleteSecret_Call) Run(run func(secret models.Secret)) *Vault_DeleteSecret_Call {
+func (_c *Vault_DeleteSecret_Call) Run(run func(ctx context.Context, secret models.Secret)) *Vault_DeleteSecret_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Secret))
+		run(args[0].(context.Context), args[1].(models.Secret))
 	})
 	return _c
 }
@@ -62,14 +114,74 @@ func (_c *Vault_DeleteSecret_Call) Return(_a0 error) *Vault_DeleteSecret_Call {
 	return _c
 }
 
-func (_c *Vault_DeleteSecret_Call) RunAndReturn(run func(models.Secret) error) *Vault_DeleteSecret_Call {
+func (_c *Vault_DeleteSecret_Call) RunAndReturn(run func(context.Context, models.Secret) error) *Vault_DeleteSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLogins provides a mock function with given fields: ctx, rawURL, requestedBy
+func (_m *Vault) FindLogins(ctx context.Context, rawURL string, requestedBy string) ([]models.LoginMatch, error) {
+	ret := _m.Called(ctx, rawURL, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLogins")
+	}
+
+	var r0 []models.LoginMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.LoginMatch, error)); ok {
+		return rf(ctx, rawURL, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.LoginMatch); ok {
+		r0 = rf(ctx, rawURL, requestedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LoginMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, rawURL, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Vault_FindLogins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLogins'
+type Vault_FindLogins_Call struct {
+	*mock.Call
+}
+
+// FindLogins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rawURL string
+//   - requestedBy string
+func (_e *Vault_Expecter) FindLogins(ctx interface{}, rawURL interface{}, requestedBy interface{}) *Vault_FindLogins_Call {
+	return &Vault_FindLogins_Call{Call: _e.mock.On("FindLogins", ctx, rawURL, requestedBy)}
+}
+
+func (_c *Vault_FindLogins_Call) Run(run func(ctx context.Context, rawURL string, requestedBy string)) *Vault_FindLogins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Vault_FindLogins_Call) Return(_a0 []models.LoginMatch, _a1 error) *Vault_FindLogins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Vault_FindLogins_Call) RunAndReturn(run func(context.Context, string, string) ([]models.LoginMatch, error)) *Vault_FindLogins_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSecrets provides a mock function with given fields: secret
-func (_m *Vault) ListSecrets(secret models.Secret) ([]string, error) {
-	ret := _m.Called(secret)
+// ListSecrets provides a mock function with given fields: ctx, secret
+func (_m *Vault) ListSecrets(ctx context.Context, secret models.Secret) ([]string, error) {
+	ret := _m.Called(ctx, secret)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListSecrets")
@@ -77,19 +189,19 @@ func (_m *Vault) ListSecrets(secret models.Secret) ([]string, error) {
 
 	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Secret) ([]string, error)); ok {
-		return rf(secret)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Secret) ([]string, error)); ok {
+		return rf(ctx, secret)
 	}
-	if rf, ok := ret.Get(0).(func(models.Secret) []string); ok {
-		r0 = rf(secret)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Secret) []string); ok {
+		r0 = rf(ctx, secret)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Secret) error); ok {
-		r1 = rf(secret)
+	if rf, ok := ret.Get(1).(func(context.Context, models.Secret) error); ok {
+		r1 = rf(ctx, secret)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -103,14 +215,15 @@ type Vault_ListSecrets_Call struct {
 }
 
 // ListSecrets is a helper method to define mock.On call
+//   - ctx context.Context
 //   - secret models.Secret
-func (_e *Vault_Expecter) ListSecrets(secret interface{}) *Vault_ListSecrets_Call {
-	return &Vault_ListSecrets_Call{Call: _e.mock.On("ListSecrets", secret)}
+func (_e *Vault_Expecter) ListSecrets(ctx interface{}, secret interface{}) *Vault_ListSecrets_Call {
+	return &Vault_ListSecrets_Call{Call: _e.mock.On("ListSecrets", ctx, secret)}
 }
 
-func (_c *Vault_ListSecrets_Call) Run(run func(secret models.Secret)) *Vault_ListSecrets_Call {
+func (_c *Vault_ListSecrets_Call) Run(run func(ctx context.Context, secret models.Secret)) *Vault_ListSecrets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Secret))
+		run(args[0].(context.Context), args[1].(models.Secret))
 	})
 	return _c
 }
@@ -120,22 +233,139 @@ func (_c *Vault_ListSecrets_Call) Return(_a0 []string, _a1 error) *Vault_ListSec
 	return _c
 }
 
-func (_c *Vault_ListSecrets_Call) RunAndReturn(run func(models.Secret) ([]string, error)) *Vault_ListSecrets_Call {
+func (_c *Vault_ListSecrets_Call) RunAndReturn(run func(context.Context, models.Secret) ([]string, error)) *Vault_ListSecrets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RetrieveSecret provides a mock function with given fields: secret
-func (_m *Vault) RetrieveSecret(secret models.Secret) error {
-	ret := _m.Called(secret)
+// ListSharedWith provides a mock function with given fields: grantee
+func (_m *Vault) ListSharedWith(grantee string) ([]models.Share, error) {
+	ret := _m.Called(grantee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSharedWith")
+	}
+
+	var r0 []models.Share
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Share, error)); ok {
+		return rf(grantee)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Share); ok {
+		r0 = rf(grantee)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Share)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(grantee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Vault_ListSharedWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSharedWith'
+type Vault_ListSharedWith_Call struct {
+	*mock.Call
+}
+
+// ListSharedWith is a helper method to define mock.On call
+//   - grantee string
+func (_e *Vault_Expecter) ListSharedWith(grantee interface{}) *Vault_ListSharedWith_Call {
+	return &Vault_ListSharedWith_Call{Call: _e.mock.On("ListSharedWith", grantee)}
+}
+
+func (_c *Vault_ListSharedWith_Call) Run(run func(grantee string)) *Vault_ListSharedWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Vault_ListSharedWith_Call) Return(_a0 []models.Share, _a1 error) *Vault_ListSharedWith_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Vault_ListSharedWith_Call) RunAndReturn(run func(string) ([]models.Share, error)) *Vault_ListSharedWith_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListShares provides a mock function with given fields: owner, path
+func (_m *Vault) ListShares(owner string, path string) ([]models.Share, error) {
+	ret := _m.Called(owner, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListShares")
+	}
+
+	var r0 []models.Share
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.Share, error)); ok {
+		return rf(owner, path)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.Share); ok {
+		r0 = rf(owner, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Share)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(owner, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Vault_ListShares_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListShares'
+type Vault_ListShares_Call struct {
+	*mock.Call
+}
+
+// ListShares is a helper method to define mock.On call
+//   - owner string
+//   - path string
+func (_e *Vault_Expecter) ListShares(owner interface{}, path interface{}) *Vault_ListShares_Call {
+	return &Vault_ListShares_Call{Call: _e.mock.On("ListShares", owner, path)}
+}
+
+func (_c *Vault_ListShares_Call) Run(run func(owner string, path string)) *Vault_ListShares_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Vault_ListShares_Call) Return(_a0 []models.Share, _a1 error) *Vault_ListShares_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Vault_ListShares_Call) RunAndReturn(run func(string, string) ([]models.Share, error)) *Vault_ListShares_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveSecret provides a mock function with given fields: ctx, secret
+func (_m *Vault) RetrieveSecret(ctx context.Context, secret models.Secret) error {
+	ret := _m.Called(ctx, secret)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RetrieveSecret")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(models.Secret) error); ok {
-		r0 = rf(secret)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Secret) error); ok {
+		r0 = rf(ctx, secret)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -149,14 +379,15 @@ type Vault_RetrieveSecret_Call struct {
 }
 
 // RetrieveSecret is a helper method to define mock.On call
+//   - ctx context.Context
 //   - secret models.Secret
-func (_e *Vault_Expecter) RetrieveSecret(secret interface{}) *Vault_RetrieveSecret_Call {
-	return &Vault_RetrieveSecret_Call{Call: _e.mock.On("RetrieveSecret", secret)}
+func (_e *Vault_Expecter) RetrieveSecret(ctx interface{}, secret interface{}) *Vault_RetrieveSecret_Call {
+	return &Vault_RetrieveSecret_Call{Call: _e.mock.On("RetrieveSecret", ctx, secret)}
 }
 
-func (_c *Vault_RetrieveSecret_Call) Run(run func(secret models.Secret)) *Vault_RetrieveSecret_Call {
+func (_c *Vault_RetrieveSecret_Call) Run(run func(ctx context.Context, secret models.Secret)) *Vault_RetrieveSecret_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Secret))
+		run(args[0].(context.Context), args[1].(models.Secret))
 	})
 	return _c
 }
@@ -166,22 +397,68 @@ func (_c *Vault_RetrieveSecret_Call) Return(_a0 error) *Vault_RetrieveSecret_Cal
 	return _c
 }
 
-func (_c *Vault_RetrieveSecret_Call) RunAndReturn(run func(models.Secret) error) *Vault_RetrieveSecret_Call {
+func (_c *Vault_RetrieveSecret_Call) RunAndReturn(run func(context.Context, models.Secret) error) *Vault_RetrieveSecret_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StoreSecret provides a mock function with given fields: secret
-func (_m *Vault) StoreSecret(secret models.Secret) error {
-	ret := _m.Called(secret)
+// ShareSecret provides a mock function with given fields: share
+func (_m *Vault) ShareSecret(share models.Share) error {
+	ret := _m.Called(share)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShareSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Share) error); ok {
+		r0 = rf(share)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Vault_ShareSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShareSecret'
+type Vault_ShareSecret_Call struct {
+	*mock.Call
+}
+
+// ShareSecret is a helper method to define mock.On call
+//   - share models.Share
+func (_e *Vault_Expecter) ShareSecret(share interface{}) *Vault_ShareSecret_Call {
+	return &Vault_ShareSecret_Call{Call: _e.mock.On("ShareSecret", share)}
+}
+
+func (_c *Vault_ShareSecret_Call) Run(run func(share models.Share)) *Vault_ShareSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Share))
+	})
+	return _c
+}
+
+func (_c *Vault_ShareSecret_Call) Return(_a0 error) *Vault_ShareSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Vault_ShareSecret_Call) RunAndReturn(run func(models.Share) error) *Vault_ShareSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreSecret provides a mock function with given fields: ctx, secret
+func (_m *Vault) StoreSecret(ctx context.Context, secret models.Secret) error {
+	ret := _m.Called(ctx, secret)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StoreSecret")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(models.Secret) error); ok {
-		r0 = rf(secret)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Secret) error); ok {
+		r0 = rf(ctx, secret)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -195,14 +472,15 @@ type Vault_StoreSecret_Call struct {
 }
 
 // StoreSecret is a helper method to define mock.On call
+//   - ctx context.Context
 //   - secret models.Secret
-func (_e *Vault_Expecter) StoreSecret(secret interface{}) *Vault_StoreSecret_Call {
-	return &Vault_StoreSecret_Call{Call: _e.mock.On("StoreSecret", secret)}
+func (_e *Vault_Expecter) StoreSecret(ctx interface{}, secret interface{}) *Vault_StoreSecret_Call {
+	return &Vault_StoreSecret_Call{Call: _e.mock.On("StoreSecret", ctx, secret)}
 }
 
-func (_c *Vault_StoreSecret_Call) Run(run func(secret models.Secret)) *Vault_StoreSecret_Call {
+func (_c *Vault_StoreSecret_Call) Run(run func(ctx context.Context, secret models.Secret)) *Vault_StoreSecret_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Secret))
+		run(args[0].(context.Context), args[1].(models.Secret))
 	})
 	return _c
 }
@@ -212,7 +490,53 @@ func (_c *Vault_StoreSecret_Call) Return(_a0 error) *Vault_StoreSecret_Call {
 	return _c
 }
 
-func (_c *Vault_StoreSecret_Call) RunAndReturn(run func(models.Secret) error) *Vault_StoreSecret_Call {
+func (_c *Vault_StoreSecret_Call) RunAndReturn(run func(context.Context, models.Secret) error) *Vault_StoreSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnshareSecret provides a mock function with given fields: share
+func (_m *Vault) UnshareSecret(share models.Share) error {
+	ret := _m.Called(share)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnshareSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Share) error); ok {
+		r0 = rf(share)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Vault_UnshareSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnshareSecret'
+type Vault_UnshareSecret_Call struct {
+	*mock.Call
+}
+
+// UnshareSecret is a helper method to define mock.On call
+//   - share models.Share
+func (_e *Vault_Expecter) UnshareSecret(share interface{}) *Vault_UnshareSecret_Call {
+	return &Vault_UnshareSecret_Call{Call: _e.mock.On("UnshareSecret", share)}
+}
+
+func (_c *Vault_UnshareSecret_Call) Run(run func(share models.Share)) *Vault_UnshareSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Share))
+	})
+	return _c
+}
+
+func (_c *Vault_UnshareSecret_Call) Return(_a0 error) *Vault_UnshareSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Vault_UnshareSecret_Call) RunAndReturn(run func(models.Share) error) *Vault_UnshareSecret_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,69 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package server
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	server "github.com/itallix/gophkeeper/internal/server"
+)
+
+// VaultOption is an autogenerated mock type for the VaultOption type
+type VaultOption struct {
+	mock.Mock
+}
+
+type VaultOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VaultOption) EXPECT() *VaultOption_Expecter {
+	return &VaultOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *VaultOption) Execute(_a0 *server.VaultImpl) {
+	_m.Called(_a0)
+}
+
+// VaultOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type VaultOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *server.VaultImpl
+func (_e *VaultOption_Expecter) Execute(_a0 interface{}) *VaultOption_Execute_Call {
+	return &VaultOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *VaultOption_Execute_Call) Run(run func(_a0 *server.VaultImpl)) *VaultOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*server.VaultImpl))
+	})
+	return _c
+}
+
+func (_c *VaultOption_Execute_Call) Return() *VaultOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *VaultOption_Execute_Call) RunAndReturn(run func(*server.VaultImpl)) *VaultOption_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVaultOption creates a new instance of VaultOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVaultOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VaultOption {
+	mock := &VaultOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
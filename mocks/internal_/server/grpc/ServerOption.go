@@ -0,0 +1,69 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package grpc
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	grpc "github.com/itallix/gophkeeper/internal/server/grpc"
+)
+
+// ServerOption is an autogenerated mock type for the ServerOption type
+type ServerOption struct {
+	mock.Mock
+}
+
+type ServerOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServerOption) EXPECT() *ServerOption_Expecter {
+	return &ServerOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *ServerOption) Execute(_a0 *grpc.GophkeeperServer) {
+	_m.Called(_a0)
+}
+
+// ServerOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ServerOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *grpc.GophkeeperServer
+func (_e *ServerOption_Expecter) Execute(_a0 interface{}) *ServerOption_Execute_Call {
+	return &ServerOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *ServerOption_Execute_Call) Run(run func(_a0 *grpc.GophkeeperServer)) *ServerOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*grpc.GophkeeperServer))
+	})
+	return _c
+}
+
+func (_c *ServerOption_Execute_Call) Return() *ServerOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ServerOption_Execute_Call) RunAndReturn(run func(*grpc.GophkeeperServer)) *ServerOption_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServerOption creates a new instance of ServerOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerOption {
+	mock := &ServerOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,69 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package models
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// KVOption is an autogenerated mock type for the KVOption type
+type KVOption struct {
+	mock.Mock
+}
+
+type KVOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KVOption) EXPECT() *KVOption_Expecter {
+	return &KVOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *KVOption) Execute(_a0 *models.KVOptions) {
+	_m.Called(_a0)
+}
+
+// KVOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type KVOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *models.KVOptions
+func (_e *KVOption_Expecter) Execute(_a0 interface{}) *KVOption_Execute_Call {
+	return &KVOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *KVOption_Execute_Call) Run(run func(_a0 *models.KVOptions)) *KVOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.KVOptions))
+	})
+	return _c
+}
+
+func (_c *KVOption_Execute_Call) Return() *KVOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *KVOption_Execute_Call) RunAndReturn(run func(*models.KVOptions)) *KVOption_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKVOption creates a new instance of KVOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKVOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KVOption {
+	mock := &KVOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
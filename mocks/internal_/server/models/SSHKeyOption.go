@@ -0,0 +1,69 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package models
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// SSHKeyOption is an autogenerated mock type for the SSHKeyOption type
+type SSHKeyOption struct {
+	mock.Mock
+}
+
+type SSHKeyOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SSHKeyOption) EXPECT() *SSHKeyOption_Expecter {
+	return &SSHKeyOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *SSHKeyOption) Execute(_a0 *models.SSHKeyOptions) {
+	_m.Called(_a0)
+}
+
+// SSHKeyOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type SSHKeyOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *models.SSHKeyOptions
+func (_e *SSHKeyOption_Expecter) Execute(_a0 interface{}) *SSHKeyOption_Execute_Call {
+	return &SSHKeyOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *SSHKeyOption_Execute_Call) Run(run func(_a0 *models.SSHKeyOptions)) *SSHKeyOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.SSHKeyOptions))
+	})
+	return _c
+}
+
+func (_c *SSHKeyOption_Execute_Call) Return() *SSHKeyOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SSHKeyOption_Execute_Call) RunAndReturn(run func(*models.SSHKeyOptions)) *SSHKeyOption_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSSHKeyOption creates a new instance of SSHKeyOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSSHKeyOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SSHKeyOption {
+	mock := &SSHKeyOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -67,6 +67,53 @@ func (_c *Secret_Accept_Call) RunAndReturn(run func(models.SecretVisitor) error)
 	return _c
 }
 
+// Meta provides a mock function with given fields:
+func (_m *Secret) Meta() *models.SecretMetadata {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Meta")
+	}
+
+	var r0 *models.SecretMetadata
+	if rf, ok := ret.Get(0).(func() *models.SecretMetadata); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SecretMetadata)
+		}
+	}
+
+	return r0
+}
+
+// Secret_Meta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Meta'
+type Secret_Meta_Call struct {
+	*mock.Call
+}
+
+// Meta is a helper method to define mock.On call
+func (_e *Secret_Expecter) Meta() *Secret_Meta_Call {
+	return &Secret_Meta_Call{Call: _e.mock.On("Meta")}
+}
+
+func (_c *Secret_Meta_Call) Run(run func()) *Secret_Meta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Secret_Meta_Call) Return(_a0 *models.SecretMetadata) *Secret_Meta_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Secret_Meta_Call) RunAndReturn(run func() *models.SecretMetadata) *Secret_Meta_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSecret creates a new instance of Secret. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSecret(t interface {
@@ -160,6 +160,52 @@ func (_c *SecretVisitor_VisitCard_Call) RunAndReturn(run func(*models.Card) erro
 	return _c
 }
 
+// VisitKV provides a mock function with given fields: kv
+func (_m *SecretVisitor) VisitKV(kv *models.KV) error {
+	ret := _m.Called(kv)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitKV")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.KV) error); ok {
+		r0 = rf(kv)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecretVisitor_VisitKV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitKV'
+type SecretVisitor_VisitKV_Call struct {
+	*mock.Call
+}
+
+// VisitKV is a helper method to define mock.On call
+//   - kv *models.KV
+func (_e *SecretVisitor_Expecter) VisitKV(kv interface{}) *SecretVisitor_VisitKV_Call {
+	return &SecretVisitor_VisitKV_Call{Call: _e.mock.On("VisitKV", kv)}
+}
+
+func (_c *SecretVisitor_VisitKV_Call) Run(run func(kv *models.KV)) *SecretVisitor_VisitKV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.KV))
+	})
+	return _c
+}
+
+func (_c *SecretVisitor_VisitKV_Call) Return(_a0 error) *SecretVisitor_VisitKV_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretVisitor_VisitKV_Call) RunAndReturn(run func(*models.KV) error) *SecretVisitor_VisitKV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VisitLogin provides a mock function with given fields: login
 func (_m *SecretVisitor) VisitLogin(login *models.Login) error {
 	ret := _m.Called(login)
@@ -252,6 +298,98 @@ func (_c *SecretVisitor_VisitNote_Call) RunAndReturn(run func(*models.Note) erro
 	return _c
 }
 
+// VisitSSHKey provides a mock function with given fields: key
+func (_m *SecretVisitor) VisitSSHKey(key *models.SSHKey) error {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitSSHKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SSHKey) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecretVisitor_VisitSSHKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitSSHKey'
+type SecretVisitor_VisitSSHKey_Call struct {
+	*mock.Call
+}
+
+// VisitSSHKey is a helper method to define mock.On call
+//   - key *models.SSHKey
+func (_e *SecretVisitor_Expecter) VisitSSHKey(key interface{}) *SecretVisitor_VisitSSHKey_Call {
+	return &SecretVisitor_VisitSSHKey_Call{Call: _e.mock.On("VisitSSHKey", key)}
+}
+
+func (_c *SecretVisitor_VisitSSHKey_Call) Run(run func(key *models.SSHKey)) *SecretVisitor_VisitSSHKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.SSHKey))
+	})
+	return _c
+}
+
+func (_c *SecretVisitor_VisitSSHKey_Call) Return(_a0 error) *SecretVisitor_VisitSSHKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretVisitor_VisitSSHKey_Call) RunAndReturn(run func(*models.SSHKey) error) *SecretVisitor_VisitSSHKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VisitTOTP provides a mock function with given fields: totp
+func (_m *SecretVisitor) VisitTOTP(totp *models.TOTP) error {
+	ret := _m.Called(totp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitTOTP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.TOTP) error); ok {
+		r0 = rf(totp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecretVisitor_VisitTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitTOTP'
+type SecretVisitor_VisitTOTP_Call struct {
+	*mock.Call
+}
+
+// VisitTOTP is a helper method to define mock.On call
+//   - totp *models.TOTP
+func (_e *SecretVisitor_Expecter) VisitTOTP(totp interface{}) *SecretVisitor_VisitTOTP_Call {
+	return &SecretVisitor_VisitTOTP_Call{Call: _e.mock.On("VisitTOTP", totp)}
+}
+
+func (_c *SecretVisitor_VisitTOTP_Call) Run(run func(totp *models.TOTP)) *SecretVisitor_VisitTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.TOTP))
+	})
+	return _c
+}
+
+func (_c *SecretVisitor_VisitTOTP_Call) Return(_a0 error) *SecretVisitor_VisitTOTP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretVisitor_VisitTOTP_Call) RunAndReturn(run func(*models.TOTP) error) *SecretVisitor_VisitTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSecretVisitor creates a new instance of SecretVisitor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSecretVisitor(t interface {
@@ -0,0 +1,69 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package models
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// TOTPOption is an autogenerated mock type for the TOTPOption type
+type TOTPOption struct {
+	mock.Mock
+}
+
+type TOTPOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TOTPOption) EXPECT() *TOTPOption_Expecter {
+	return &TOTPOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *TOTPOption) Execute(_a0 *models.TOTPOptions) {
+	_m.Called(_a0)
+}
+
+// TOTPOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type TOTPOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *models.TOTPOptions
+func (_e *TOTPOption_Expecter) Execute(_a0 interface{}) *TOTPOption_Execute_Call {
+	return &TOTPOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *TOTPOption_Execute_Call) Run(run func(_a0 *models.TOTPOptions)) *TOTPOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.TOTPOptions))
+	})
+	return _c
+}
+
+func (_c *TOTPOption_Execute_Call) Return() *TOTPOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TOTPOption_Execute_Call) RunAndReturn(run func(*models.TOTPOptions)) *TOTPOption_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTOTPOption creates a new instance of TOTPOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTOTPOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TOTPOption {
+	mock := &TOTPOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,69 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package operation
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	operation "github.com/itallix/gophkeeper/internal/server/operation"
+)
+
+// ValidatorOption is an autogenerated mock type for the ValidatorOption type
+type ValidatorOption struct {
+	mock.Mock
+}
+
+type ValidatorOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ValidatorOption) EXPECT() *ValidatorOption_Expecter {
+	return &ValidatorOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *ValidatorOption) Execute(_a0 *operation.Validator) {
+	_m.Called(_a0)
+}
+
+// ValidatorOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ValidatorOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *operation.Validator
+func (_e *ValidatorOption_Expecter) Execute(_a0 interface{}) *ValidatorOption_Execute_Call {
+	return &ValidatorOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *ValidatorOption_Execute_Call) Run(run func(_a0 *operation.Validator)) *ValidatorOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*operation.Validator))
+	})
+	return _c
+}
+
+func (_c *ValidatorOption_Execute_Call) Return() *ValidatorOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ValidatorOption_Execute_Call) RunAndReturn(run func(*operation.Validator)) *ValidatorOption_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewValidatorOption creates a new instance of ValidatorOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewValidatorOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ValidatorOption {
+	mock := &ValidatorOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
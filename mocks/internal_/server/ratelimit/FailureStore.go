@@ -0,0 +1,251 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package ratelimit
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+
+	time "time"
+)
+
+// FailureStore is an autogenerated mock type for the FailureStore type
+type FailureStore struct {
+	mock.Mock
+}
+
+type FailureStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FailureStore) EXPECT() *FailureStore_Expecter {
+	return &FailureStore_Expecter{mock: &_m.Mock}
+}
+
+// GetAttempts provides a mock function with given fields: ctx, login
+func (_m *FailureStore) GetAttempts(ctx context.Context, login string) (models.LoginAttempts, error) {
+	ret := _m.Called(ctx, login)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttempts")
+	}
+
+	var r0 models.LoginAttempts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.LoginAttempts, error)); ok {
+		return rf(ctx, login)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.LoginAttempts); ok {
+		r0 = rf(ctx, login)
+	} else {
+		r0 = ret.Get(0).(models.LoginAttempts)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, login)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailureStore_GetAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttempts'
+type FailureStore_GetAttempts_Call struct {
+	*mock.Call
+}
+
+// GetAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+func (_e *FailureStore_Expecter) GetAttempts(ctx interface{}, login interface{}) *FailureStore_GetAttempts_Call {
+	return &FailureStore_GetAttempts_Call{Call: _e.mock.On("GetAttempts", ctx, login)}
+}
+
+func (_c *FailureStore_GetAttempts_Call) Run(run func(ctx context.Context, login string)) *FailureStore_GetAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FailureStore_GetAttempts_Call) Return(_a0 models.LoginAttempts, _a1 error) *FailureStore_GetAttempts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FailureStore_GetAttempts_Call) RunAndReturn(run func(context.Context, string) (models.LoginAttempts, error)) *FailureStore_GetAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lock provides a mock function with given fields: ctx, login, until
+func (_m *FailureStore) Lock(ctx context.Context, login string, until time.Time) error {
+	ret := _m.Called(ctx, login, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, login, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FailureStore_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type FailureStore_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+//   - until time.Time
+func (_e *FailureStore_Expecter) Lock(ctx interface{}, login interface{}, until interface{}) *FailureStore_Lock_Call {
+	return &FailureStore_Lock_Call{Call: _e.mock.On("Lock", ctx, login, until)}
+}
+
+func (_c *FailureStore_Lock_Call) Run(run func(ctx context.Context, login string, until time.Time)) *FailureStore_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FailureStore_Lock_Call) Return(_a0 error) *FailureStore_Lock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FailureStore_Lock_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *FailureStore_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailure provides a mock function with given fields: ctx, login, now, since
+func (_m *FailureStore) RecordFailure(ctx context.Context, login string, now time.Time, since time.Time) (models.LoginAttempts, error) {
+	ret := _m.Called(ctx, login, now, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 models.LoginAttempts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (models.LoginAttempts, error)); ok {
+		return rf(ctx, login, now, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) models.LoginAttempts); ok {
+		r0 = rf(ctx, login, now, since)
+	} else {
+		r0 = ret.Get(0).(models.LoginAttempts)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, login, now, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailureStore_RecordFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailure'
+type FailureStore_RecordFailure_Call struct {
+	*mock.Call
+}
+
+// RecordFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+//   - now time.Time
+//   - since time.Time
+func (_e *FailureStore_Expecter) RecordFailure(ctx interface{}, login interface{}, now interface{}, since interface{}) *FailureStore_RecordFailure_Call {
+	return &FailureStore_RecordFailure_Call{Call: _e.mock.On("RecordFailure", ctx, login, now, since)}
+}
+
+func (_c *FailureStore_RecordFailure_Call) Run(run func(ctx context.Context, login string, now time.Time, since time.Time)) *FailureStore_RecordFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FailureStore_RecordFailure_Call) Return(_a0 models.LoginAttempts, _a1 error) *FailureStore_RecordFailure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FailureStore_RecordFailure_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time) (models.LoginAttempts, error)) *FailureStore_RecordFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: ctx, login
+func (_m *FailureStore) Reset(ctx context.Context, login string) error {
+	ret := _m.Called(ctx, login)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, login)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FailureStore_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type FailureStore_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+func (_e *FailureStore_Expecter) Reset(ctx interface{}, login interface{}) *FailureStore_Reset_Call {
+	return &FailureStore_Reset_Call{Call: _e.mock.On("Reset", ctx, login)}
+}
+
+func (_c *FailureStore_Reset_Call) Run(run func(ctx context.Context, login string)) *FailureStore_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FailureStore_Reset_Call) Return(_a0 error) *FailureStore_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FailureStore_Reset_Call) RunAndReturn(run func(context.Context, string) error) *FailureStore_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFailureStore creates a new instance of FailureStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFailureStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FailureStore {
+	mock := &FailureStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,69 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package service
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/itallix/gophkeeper/internal/server/service"
+)
+
+// AuthOption is an autogenerated mock type for the AuthOption type
+type AuthOption struct {
+	mock.Mock
+}
+
+type AuthOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuthOption) EXPECT() *AuthOption_Expecter {
+	return &AuthOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *AuthOption) Execute(_a0 *service.JWTAuthService) {
+	_m.Called(_a0)
+}
+
+// AuthOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type AuthOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *service.JWTAuthService
+func (_e *AuthOption_Expecter) Execute(_a0 interface{}) *AuthOption_Execute_Call {
+	return &AuthOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *AuthOption_Execute_Call) Run(run func(_a0 *service.JWTAuthService)) *AuthOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*service.JWTAuthService))
+	})
+	return _c
+}
+
+func (_c *AuthOption_Execute_Call) Return() *AuthOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuthOption_Execute_Call) RunAndReturn(run func(*service.JWTAuthService)) *AuthOption_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuthOption creates a new instance of AuthOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuthOption {
+	mock := &AuthOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	models "github.com/itallix/gophkeeper/internal/server/models"
+
 	service "github.com/itallix/gophkeeper/internal/server/service"
 )
 
@@ -83,6 +85,63 @@ func (_c *AuthenticationService_Authenticate_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetScopedToken provides a mock function with given fields: username, scope
+func (_m *AuthenticationService) GetScopedToken(username string, scope models.Scope) (string, error) {
+	ret := _m.Called(username, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScopedToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, models.Scope) (string, error)); ok {
+		return rf(username, scope)
+	}
+	if rf, ok := ret.Get(0).(func(string, models.Scope) string); ok {
+		r0 = rf(username, scope)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, models.Scope) error); ok {
+		r1 = rf(username, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationService_GetScopedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScopedToken'
+type AuthenticationService_GetScopedToken_Call struct {
+	*mock.Call
+}
+
+// GetScopedToken is a helper method to define mock.On call
+//   - username string
+//   - scope models.Scope
+func (_e *AuthenticationService_Expecter) GetScopedToken(username interface{}, scope interface{}) *AuthenticationService_GetScopedToken_Call {
+	return &AuthenticationService_GetScopedToken_Call{Call: _e.mock.On("GetScopedToken", username, scope)}
+}
+
+func (_c *AuthenticationService_GetScopedToken_Call) Run(run func(username string, scope models.Scope)) *AuthenticationService_GetScopedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(models.Scope))
+	})
+	return _c
+}
+
+func (_c *AuthenticationService_GetScopedToken_Call) Return(_a0 string, _a1 error) *AuthenticationService_GetScopedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationService_GetScopedToken_Call) RunAndReturn(run func(string, models.Scope) (string, error)) *AuthenticationService_GetScopedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTokenPair provides a mock function with given fields: username
 func (_m *AuthenticationService) GetTokenPair(username string) (*service.TokenPair, error) {
 	ret := _m.Called(username)
@@ -141,6 +200,120 @@ func (_c *AuthenticationService_GetTokenPair_Call) RunAndReturn(run func(string)
 	return _c
 }
 
+// HashPassword provides a mock function with given fields: password
+func (_m *AuthenticationService) HashPassword(password string) (string, error) {
+	ret := _m.Called(password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HashPassword")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(password)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(password)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationService_HashPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HashPassword'
+type AuthenticationService_HashPassword_Call struct {
+	*mock.Call
+}
+
+// HashPassword is a helper method to define mock.On call
+//   - password string
+func (_e *AuthenticationService_Expecter) HashPassword(password interface{}) *AuthenticationService_HashPassword_Call {
+	return &AuthenticationService_HashPassword_Call{Call: _e.mock.On("HashPassword", password)}
+}
+
+func (_c *AuthenticationService_HashPassword_Call) Run(run func(password string)) *AuthenticationService_HashPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationService_HashPassword_Call) Return(_a0 string, _a1 error) *AuthenticationService_HashPassword_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationService_HashPassword_Call) RunAndReturn(run func(string) (string, error)) *AuthenticationService_HashPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParseAccessToken provides a mock function with given fields: accessToken
+func (_m *AuthenticationService) ParseAccessToken(accessToken string) (*service.Claims, error) {
+	ret := _m.Called(accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseAccessToken")
+	}
+
+	var r0 *service.Claims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*service.Claims, error)); ok {
+		return rf(accessToken)
+	}
+	if rf, ok := ret.Get(0).(func(string) *service.Claims); ok {
+		r0 = rf(accessToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.Claims)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationService_ParseAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseAccessToken'
+type AuthenticationService_ParseAccessToken_Call struct {
+	*mock.Call
+}
+
+// ParseAccessToken is a helper method to define mock.On call
+//   - accessToken string
+func (_e *AuthenticationService_Expecter) ParseAccessToken(accessToken interface{}) *AuthenticationService_ParseAccessToken_Call {
+	return &AuthenticationService_ParseAccessToken_Call{Call: _e.mock.On("ParseAccessToken", accessToken)}
+}
+
+func (_c *AuthenticationService_ParseAccessToken_Call) Run(run func(accessToken string)) *AuthenticationService_ParseAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationService_ParseAccessToken_Call) Return(_a0 *service.Claims, _a1 error) *AuthenticationService_ParseAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationService_ParseAccessToken_Call) RunAndReturn(run func(string) (*service.Claims, error)) *AuthenticationService_ParseAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshTokens provides a mock function with given fields: refreshToken
 func (_m *AuthenticationService) RefreshTokens(refreshToken string) (*service.TokenPair, error) {
 	ret := _m.Called(refreshToken)
@@ -0,0 +1,145 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/itallix/gophkeeper/internal/server/models"
+)
+
+// KeyStore is an autogenerated mock type for the KeyStore type
+type KeyStore struct {
+	mock.Mock
+}
+
+type KeyStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KeyStore) EXPECT() *KeyStore_Expecter {
+	return &KeyStore_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, key, keep
+func (_m *KeyStore) Add(ctx context.Context, key models.EncryptedSigningKey, keep int) error {
+	ret := _m.Called(ctx, key, keep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.EncryptedSigningKey, int) error); ok {
+		r0 = rf(ctx, key, keep)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KeyStore_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type KeyStore_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key models.EncryptedSigningKey
+//   - keep int
+func (_e *KeyStore_Expecter) Add(ctx interface{}, key interface{}, keep interface{}) *KeyStore_Add_Call {
+	return &KeyStore_Add_Call{Call: _e.mock.On("Add", ctx, key, keep)}
+}
+
+func (_c *KeyStore_Add_Call) Run(run func(ctx context.Context, key models.EncryptedSigningKey, keep int)) *KeyStore_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.EncryptedSigningKey), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *KeyStore_Add_Call) Return(_a0 error) *KeyStore_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KeyStore_Add_Call) RunAndReturn(run func(context.Context, models.EncryptedSigningKey, int) error) *KeyStore_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, limit
+func (_m *KeyStore) List(ctx context.Context, limit int) ([]models.EncryptedSigningKey, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.EncryptedSigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.EncryptedSigningKey, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.EncryptedSigningKey); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EncryptedSigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeyStore_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type KeyStore_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *KeyStore_Expecter) List(ctx interface{}, limit interface{}) *KeyStore_List_Call {
+	return &KeyStore_List_Call{Call: _e.mock.On("List", ctx, limit)}
+}
+
+func (_c *KeyStore_List_Call) Run(run func(ctx context.Context, limit int)) *KeyStore_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *KeyStore_List_Call) Return(_a0 []models.EncryptedSigningKey, _a1 error) *KeyStore_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeyStore_List_Call) RunAndReturn(run func(context.Context, int) ([]models.EncryptedSigningKey, error)) *KeyStore_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKeyStore creates a new instance of KeyStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKeyStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KeyStore {
+	mock := &KeyStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,278 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/itallix/gophkeeper/internal/server/service"
+)
+
+// SRPAuthenticator is an autogenerated mock type for the SRPAuthenticator type
+type SRPAuthenticator struct {
+	mock.Mock
+}
+
+type SRPAuthenticator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SRPAuthenticator) EXPECT() *SRPAuthenticator_Expecter {
+	return &SRPAuthenticator_Expecter{mock: &_m.Mock}
+}
+
+// Begin provides a mock function with given fields: ctx, login, clientPublic
+func (_m *SRPAuthenticator) Begin(ctx context.Context, login string, clientPublic []byte) (*service.SRPChallenge, error) {
+	ret := _m.Called(ctx, login, clientPublic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 *service.SRPChallenge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) (*service.SRPChallenge, error)); ok {
+		return rf(ctx, login, clientPublic)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) *service.SRPChallenge); ok {
+		r0 = rf(ctx, login, clientPublic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.SRPChallenge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, login, clientPublic)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SRPAuthenticator_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type SRPAuthenticator_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+//   - clientPublic []byte
+func (_e *SRPAuthenticator_Expecter) Begin(ctx interface{}, login interface{}, clientPublic interface{}) *SRPAuthenticator_Begin_Call {
+	return &SRPAuthenticator_Begin_Call{Call: _e.mock.On("Begin", ctx, login, clientPublic)}
+}
+
+func (_c *SRPAuthenticator_Begin_Call) Run(run func(ctx context.Context, login string, clientPublic []byte)) *SRPAuthenticator_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *SRPAuthenticator_Begin_Call) Return(_a0 *service.SRPChallenge, _a1 error) *SRPAuthenticator_Begin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SRPAuthenticator_Begin_Call) RunAndReturn(run func(context.Context, string, []byte) (*service.SRPChallenge, error)) *SRPAuthenticator_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enroll provides a mock function with given fields: ctx, login, salt, verifier
+func (_m *SRPAuthenticator) Enroll(ctx context.Context, login string, salt []byte, verifier []byte) error {
+	ret := _m.Called(ctx, login, salt, verifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enroll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, []byte) error); ok {
+		r0 = rf(ctx, login, salt, verifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SRPAuthenticator_Enroll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enroll'
+type SRPAuthenticator_Enroll_Call struct {
+	*mock.Call
+}
+
+// Enroll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+//   - salt []byte
+//   - verifier []byte
+func (_e *SRPAuthenticator_Expecter) Enroll(ctx interface{}, login interface{}, salt interface{}, verifier interface{}) *SRPAuthenticator_Enroll_Call {
+	return &SRPAuthenticator_Enroll_Call{Call: _e.mock.On("Enroll", ctx, login, salt, verifier)}
+}
+
+func (_c *SRPAuthenticator_Enroll_Call) Run(run func(ctx context.Context, login string, salt []byte, verifier []byte)) *SRPAuthenticator_Enroll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *SRPAuthenticator_Enroll_Call) Return(_a0 error) *SRPAuthenticator_Enroll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SRPAuthenticator_Enroll_Call) RunAndReturn(run func(context.Context, string, []byte, []byte) error) *SRPAuthenticator_Enroll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Finish provides a mock function with given fields: ctx, sessionID, login, clientProof
+func (_m *SRPAuthenticator) Finish(ctx context.Context, sessionID string, login string, clientProof []byte) ([]byte, *service.TokenPair, error) {
+	ret := _m.Called(ctx, sessionID, login, clientProof)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finish")
+	}
+
+	var r0 []byte
+	var r1 *service.TokenPair
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) ([]byte, *service.TokenPair, error)); ok {
+		return rf(ctx, sessionID, login, clientProof)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) []byte); ok {
+		r0 = rf(ctx, sessionID, login, clientProof)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte) *service.TokenPair); ok {
+		r1 = rf(ctx, sessionID, login, clientProof)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*service.TokenPair)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, []byte) error); ok {
+		r2 = rf(ctx, sessionID, login, clientProof)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SRPAuthenticator_Finish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finish'
+type SRPAuthenticator_Finish_Call struct {
+	*mock.Call
+}
+
+// Finish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+//   - login string
+//   - clientProof []byte
+func (_e *SRPAuthenticator_Expecter) Finish(ctx interface{}, sessionID interface{}, login interface{}, clientProof interface{}) *SRPAuthenticator_Finish_Call {
+	return &SRPAuthenticator_Finish_Call{Call: _e.mock.On("Finish", ctx, sessionID, login, clientProof)}
+}
+
+func (_c *SRPAuthenticator_Finish_Call) Run(run func(ctx context.Context, sessionID string, login string, clientProof []byte)) *SRPAuthenticator_Finish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *SRPAuthenticator_Finish_Call) Return(_a0 []byte, _a1 *service.TokenPair, _a2 error) *SRPAuthenticator_Finish_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SRPAuthenticator_Finish_Call) RunAndReturn(run func(context.Context, string, string, []byte) ([]byte, *service.TokenPair, error)) *SRPAuthenticator_Finish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: ctx, login, salt, verifier
+func (_m *SRPAuthenticator) Register(ctx context.Context, login string, salt []byte, verifier []byte) (*service.TokenPair, error) {
+	ret := _m.Called(ctx, login, salt, verifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 *service.TokenPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, []byte) (*service.TokenPair, error)); ok {
+		return rf(ctx, login, salt, verifier)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, []byte) *service.TokenPair); ok {
+		r0 = rf(ctx, login, salt, verifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.TokenPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte, []byte) error); ok {
+		r1 = rf(ctx, login, salt, verifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SRPAuthenticator_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type SRPAuthenticator_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+//   - salt []byte
+//   - verifier []byte
+func (_e *SRPAuthenticator_Expecter) Register(ctx interface{}, login interface{}, salt interface{}, verifier interface{}) *SRPAuthenticator_Register_Call {
+	return &SRPAuthenticator_Register_Call{Call: _e.mock.On("Register", ctx, login, salt, verifier)}
+}
+
+func (_c *SRPAuthenticator_Register_Call) Run(run func(ctx context.Context, login string, salt []byte, verifier []byte)) *SRPAuthenticator_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *SRPAuthenticator_Register_Call) Return(_a0 *service.TokenPair, _a1 error) *SRPAuthenticator_Register_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SRPAuthenticator_Register_Call) RunAndReturn(run func(context.Context, string, []byte, []byte) (*service.TokenPair, error)) *SRPAuthenticator_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSRPAuthenticator creates a new instance of SRPAuthenticator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSRPAuthenticator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SRPAuthenticator {
+	mock := &SRPAuthenticator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
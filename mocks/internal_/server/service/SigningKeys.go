@@ -0,0 +1,138 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package service
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/itallix/gophkeeper/internal/server/service"
+)
+
+// SigningKeys is an autogenerated mock type for the SigningKeys type
+type SigningKeys struct {
+	mock.Mock
+}
+
+type SigningKeys_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SigningKeys) EXPECT() *SigningKeys_Expecter {
+	return &SigningKeys_Expecter{mock: &_m.Mock}
+}
+
+// JWKS provides a mock function with given fields:
+func (_m *SigningKeys) JWKS() []service.JWK {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JWKS")
+	}
+
+	var r0 []service.JWK
+	if rf, ok := ret.Get(0).(func() []service.JWK); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.JWK)
+		}
+	}
+
+	return r0
+}
+
+// SigningKeys_JWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JWKS'
+type SigningKeys_JWKS_Call struct {
+	*mock.Call
+}
+
+// JWKS is a helper method to define mock.On call
+func (_e *SigningKeys_Expecter) JWKS() *SigningKeys_JWKS_Call {
+	return &SigningKeys_JWKS_Call{Call: _e.mock.On("JWKS")}
+}
+
+func (_c *SigningKeys_JWKS_Call) Run(run func()) *SigningKeys_JWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SigningKeys_JWKS_Call) Return(_a0 []service.JWK) *SigningKeys_JWKS_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SigningKeys_JWKS_Call) RunAndReturn(run func() []service.JWK) *SigningKeys_JWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function with given fields:
+func (_m *SigningKeys) Rotate() (service.SigningKey, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 service.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (service.SigningKey, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() service.SigningKey); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(service.SigningKey)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SigningKeys_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type SigningKeys_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+func (_e *SigningKeys_Expecter) Rotate() *SigningKeys_Rotate_Call {
+	return &SigningKeys_Rotate_Call{Call: _e.mock.On("Rotate")}
+}
+
+func (_c *SigningKeys_Rotate_Call) Run(run func()) *SigningKeys_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SigningKeys_Rotate_Call) Return(_a0 service.SigningKey, _a1 error) *SigningKeys_Rotate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SigningKeys_Rotate_Call) RunAndReturn(run func() (service.SigningKey, error)) *SigningKeys_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSigningKeys creates a new instance of SigningKeys. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSigningKeys(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SigningKeys {
+	mock := &SigningKeys{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,6 +24,302 @@ func (_m *GophkeeperServiceClient) EXPECT() *GophkeeperServiceClient_Expecter {
 	return &GophkeeperServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// AddTeamMember provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) AddTeamMember(ctx context.Context, in *v1.AddTeamMemberRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTeamMember")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AddTeamMemberRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AddTeamMemberRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.AddTeamMemberRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_AddTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMember'
+type GophkeeperServiceClient_AddTeamMember_Call struct {
+	*mock.Call
+}
+
+// AddTeamMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.AddTeamMemberRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) AddTeamMember(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_AddTeamMember_Call {
+	return &GophkeeperServiceClient_AddTeamMember_Call{Call: _e.mock.On("AddTeamMember",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_AddTeamMember_Call) Run(run func(ctx context.Context, in *v1.AddTeamMemberRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_AddTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.AddTeamMemberRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_AddTeamMember_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_AddTeamMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_AddTeamMember_Call) RunAndReturn(run func(context.Context, *v1.AddTeamMemberRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_AddTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddToCollection provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) AddToCollection(ctx context.Context, in *v1.AddToCollectionRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToCollection")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AddToCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AddToCollectionRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.AddToCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_AddToCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToCollection'
+type GophkeeperServiceClient_AddToCollection_Call struct {
+	*mock.Call
+}
+
+// AddToCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.AddToCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) AddToCollection(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_AddToCollection_Call {
+	return &GophkeeperServiceClient_AddToCollection_Call{Call: _e.mock.On("AddToCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_AddToCollection_Call) Run(run func(ctx context.Context, in *v1.AddToCollectionRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_AddToCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.AddToCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_AddToCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_AddToCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_AddToCollection_Call) RunAndReturn(run func(context.Context, *v1.AddToCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_AddToCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignCollection provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) AssignCollection(ctx context.Context, in *v1.AssignCollectionRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCollection")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AssignCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AssignCollectionRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.AssignCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_AssignCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignCollection'
+type GophkeeperServiceClient_AssignCollection_Call struct {
+	*mock.Call
+}
+
+// AssignCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.AssignCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) AssignCollection(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_AssignCollection_Call {
+	return &GophkeeperServiceClient_AssignCollection_Call{Call: _e.mock.On("AssignCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_AssignCollection_Call) Run(run func(ctx context.Context, in *v1.AssignCollectionRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_AssignCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.AssignCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_AssignCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_AssignCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_AssignCollection_Call) RunAndReturn(run func(context.Context, *v1.AssignCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_AssignCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttachTOTP provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) AttachTOTP(ctx context.Context, in *v1.AttachTOTPRequest, opts ...grpc.CallOption) (*v1.AttachTOTPResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachTOTP")
+	}
+
+	var r0 *v1.AttachTOTPResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AttachTOTPRequest, ...grpc.CallOption) (*v1.AttachTOTPResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AttachTOTPRequest, ...grpc.CallOption) *v1.AttachTOTPResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AttachTOTPResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.AttachTOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_AttachTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachTOTP'
+type GophkeeperServiceClient_AttachTOTP_Call struct {
+	*mock.Call
+}
+
+// AttachTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.AttachTOTPRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) AttachTOTP(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_AttachTOTP_Call {
+	return &GophkeeperServiceClient_AttachTOTP_Call{Call: _e.mock.On("AttachTOTP",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_AttachTOTP_Call) Run(run func(ctx context.Context, in *v1.AttachTOTPRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_AttachTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.AttachTOTPRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_AttachTOTP_Call) Return(_a0 *v1.AttachTOTPResponse, _a1 error) *GophkeeperServiceClient_AttachTOTP_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_AttachTOTP_Call) RunAndReturn(run func(context.Context, *v1.AttachTOTPRequest, ...grpc.CallOption) (*v1.AttachTOTPResponse, error)) *GophkeeperServiceClient_AttachTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, in, opts
 func (_m *GophkeeperServiceClient) Create(ctx context.Context, in *v1.CreateRequest, opts ...grpc.CallOption) (*v1.CreateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -36,23 +332,2539 @@ func (_m *GophkeeperServiceClient) Create(ctx context.Context, in *v1.CreateRequ
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Create")
+	}
+
+	var r0 *v1.CreateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateRequest, ...grpc.CallOption) (*v1.CreateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateRequest, ...grpc.CallOption) *v1.CreateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.CreateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type GophkeeperServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.CreateRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Create_Call {
+	return &GophkeeperServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_Create_Call) Run(run func(ctx context.Context, in *v1.CreateRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.CreateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Create_Call) Return(_a0 *v1.CreateResponse, _a1 error) *GophkeeperServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Create_Call) RunAndReturn(run func(context.Context, *v1.CreateRequest, ...grpc.CallOption) (*v1.CreateResponse, error)) *GophkeeperServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) CreateAPIKey(ctx context.Context, in *v1.CreateAPIKeyRequest, opts ...grpc.CallOption) (*v1.CreateAPIKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *v1.CreateAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateAPIKeyRequest, ...grpc.CallOption) (*v1.CreateAPIKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateAPIKeyRequest, ...grpc.CallOption) *v1.CreateAPIKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.CreateAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateAPIKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type GophkeeperServiceClient_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.CreateAPIKeyRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) CreateAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_CreateAPIKey_Call {
+	return &GophkeeperServiceClient_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_CreateAPIKey_Call) Run(run func(ctx context.Context, in *v1.CreateAPIKeyRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.CreateAPIKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateAPIKey_Call) Return(_a0 *v1.CreateAPIKeyResponse, _a1 error) *GophkeeperServiceClient_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *v1.CreateAPIKeyRequest, ...grpc.CallOption) (*v1.CreateAPIKeyResponse, error)) *GophkeeperServiceClient_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCollection provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) CreateCollection(ctx context.Context, in *v1.CreateCollectionRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollection")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateCollectionRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
+type GophkeeperServiceClient_CreateCollection_Call struct {
+	*mock.Call
+}
+
+// CreateCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.CreateCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) CreateCollection(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_CreateCollection_Call {
+	return &GophkeeperServiceClient_CreateCollection_Call{Call: _e.mock.On("CreateCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_CreateCollection_Call) Run(run func(ctx context.Context, in *v1.CreateCollectionRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_CreateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.CreateCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_CreateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateCollection_Call) RunAndReturn(run func(context.Context, *v1.CreateCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_CreateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) CreateOrganization(ctx context.Context, in *v1.CreateOrganizationRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateOrganizationRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateOrganizationRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateOrganizationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type GophkeeperServiceClient_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.CreateOrganizationRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) CreateOrganization(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_CreateOrganization_Call {
+	return &GophkeeperServiceClient_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_CreateOrganization_Call) Run(run func(ctx context.Context, in *v1.CreateOrganizationRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.CreateOrganizationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateOrganization_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_CreateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateOrganization_Call) RunAndReturn(run func(context.Context, *v1.CreateOrganizationRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServiceAccount provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) CreateServiceAccount(ctx context.Context, in *v1.CreateServiceAccountRequest, opts ...grpc.CallOption) (*v1.ServiceAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServiceAccount")
+	}
+
+	var r0 *v1.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateServiceAccountRequest, ...grpc.CallOption) (*v1.ServiceAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateServiceAccountRequest, ...grpc.CallOption) *v1.ServiceAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateServiceAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_CreateServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServiceAccount'
+type GophkeeperServiceClient_CreateServiceAccount_Call struct {
+	*mock.Call
+}
+
+// CreateServiceAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.CreateServiceAccountRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) CreateServiceAccount(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_CreateServiceAccount_Call {
+	return &GophkeeperServiceClient_CreateServiceAccount_Call{Call: _e.mock.On("CreateServiceAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_CreateServiceAccount_Call) Run(run func(ctx context.Context, in *v1.CreateServiceAccountRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_CreateServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.CreateServiceAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateServiceAccount_Call) Return(_a0 *v1.ServiceAccountResponse, _a1 error) *GophkeeperServiceClient_CreateServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateServiceAccount_Call) RunAndReturn(run func(context.Context, *v1.CreateServiceAccountRequest, ...grpc.CallOption) (*v1.ServiceAccountResponse, error)) *GophkeeperServiceClient_CreateServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTeam provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) CreateTeam(ctx context.Context, in *v1.CreateTeamRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeam")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateTeamRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateTeamRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateTeamRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
+type GophkeeperServiceClient_CreateTeam_Call struct {
+	*mock.Call
+}
+
+// CreateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.CreateTeamRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) CreateTeam(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_CreateTeam_Call {
+	return &GophkeeperServiceClient_CreateTeam_Call{Call: _e.mock.On("CreateTeam",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_CreateTeam_Call) Run(run func(ctx context.Context, in *v1.CreateTeamRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_CreateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.CreateTeamRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateTeam_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_CreateTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_CreateTeam_Call) RunAndReturn(run func(context.Context, *v1.CreateTeamRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_CreateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) Delete(ctx context.Context, in *v1.DeleteRequest, opts ...grpc.CallOption) (*v1.DeleteResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *v1.DeleteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteRequest, ...grpc.CallOption) (*v1.DeleteResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteRequest, ...grpc.CallOption) *v1.DeleteResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.DeleteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.DeleteRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type GophkeeperServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.DeleteRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Delete_Call {
+	return &GophkeeperServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_Delete_Call) Run(run func(ctx context.Context, in *v1.DeleteRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.DeleteRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Delete_Call) Return(_a0 *v1.DeleteResponse, _a1 error) *GophkeeperServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *v1.DeleteRequest, ...grpc.CallOption) (*v1.DeleteResponse, error)) *GophkeeperServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServiceAccount provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) DeleteServiceAccount(ctx context.Context, in *v1.DeleteServiceAccountRequest, opts ...grpc.CallOption) (*v1.ServiceAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServiceAccount")
+	}
+
+	var r0 *v1.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteServiceAccountRequest, ...grpc.CallOption) (*v1.ServiceAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteServiceAccountRequest, ...grpc.CallOption) *v1.ServiceAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.DeleteServiceAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_DeleteServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccount'
+type GophkeeperServiceClient_DeleteServiceAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteServiceAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.DeleteServiceAccountRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) DeleteServiceAccount(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_DeleteServiceAccount_Call {
+	return &GophkeeperServiceClient_DeleteServiceAccount_Call{Call: _e.mock.On("DeleteServiceAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_DeleteServiceAccount_Call) Run(run func(ctx context.Context, in *v1.DeleteServiceAccountRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_DeleteServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.DeleteServiceAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_DeleteServiceAccount_Call) Return(_a0 *v1.ServiceAccountResponse, _a1 error) *GophkeeperServiceClient_DeleteServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_DeleteServiceAccount_Call) RunAndReturn(run func(context.Context, *v1.DeleteServiceAccountRequest, ...grpc.CallOption) (*v1.ServiceAccountResponse, error)) *GophkeeperServiceClient_DeleteServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Download provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) Download(ctx context.Context, in *v1.DownloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.Chunk], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Download")
+	}
+
+	var r0 grpc.ServerStreamingClient[v1.Chunk]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DownloadRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1.Chunk], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DownloadRequest, ...grpc.CallOption) grpc.ServerStreamingClient[v1.Chunk]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[v1.Chunk])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.DownloadRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
+type GophkeeperServiceClient_Download_Call struct {
+	*mock.Call
+}
+
+// Download is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.DownloadRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) Download(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Download_Call {
+	return &GophkeeperServiceClient_Download_Call{Call: _e.mock.On("Download",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_Download_Call) Run(run func(ctx context.Context, in *v1.DownloadRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Download_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.DownloadRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Download_Call) Return(_a0 grpc.ServerStreamingClient[v1.Chunk], _a1 error) *GophkeeperServiceClient_Download_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Download_Call) RunAndReturn(run func(context.Context, *v1.DownloadRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1.Chunk], error)) *GophkeeperServiceClient_Download_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ExchangeAPIKey(ctx context.Context, in *v1.ExchangeAPIKeyRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeAPIKey")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ExchangeAPIKeyRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ExchangeAPIKeyRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ExchangeAPIKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ExchangeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeAPIKey'
+type GophkeeperServiceClient_ExchangeAPIKey_Call struct {
+	*mock.Call
+}
+
+// ExchangeAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ExchangeAPIKeyRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ExchangeAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ExchangeAPIKey_Call {
+	return &GophkeeperServiceClient_ExchangeAPIKey_Call{Call: _e.mock.On("ExchangeAPIKey",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ExchangeAPIKey_Call) Run(run func(ctx context.Context, in *v1.ExchangeAPIKeyRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ExchangeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ExchangeAPIKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ExchangeAPIKey_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_ExchangeAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ExchangeAPIKey_Call) RunAndReturn(run func(context.Context, *v1.ExchangeAPIKeyRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_ExchangeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) Export(ctx context.Context, in *v1.ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.ExportItem], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 grpc.ServerStreamingClient[v1.ExportItem]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ExportRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1.ExportItem], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ExportRequest, ...grpc.CallOption) grpc.ServerStreamingClient[v1.ExportItem]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[v1.ExportItem])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type GophkeeperServiceClient_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ExportRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) Export(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Export_Call {
+	return &GophkeeperServiceClient_Export_Call{Call: _e.mock.On("Export",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_Export_Call) Run(run func(ctx context.Context, in *v1.ExportRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Export_Call) Return(_a0 grpc.ServerStreamingClient[v1.ExportItem], _a1 error) *GophkeeperServiceClient_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Export_Call) RunAndReturn(run func(context.Context, *v1.ExportRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1.ExportItem], error)) *GophkeeperServiceClient_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByURL provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) FindByURL(ctx context.Context, in *v1.FindByURLRequest, opts ...grpc.CallOption) (*v1.FindByURLResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByURL")
+	}
+
+	var r0 *v1.FindByURLResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.FindByURLRequest, ...grpc.CallOption) (*v1.FindByURLResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.FindByURLRequest, ...grpc.CallOption) *v1.FindByURLResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.FindByURLResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.FindByURLRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_FindByURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByURL'
+type GophkeeperServiceClient_FindByURL_Call struct {
+	*mock.Call
+}
+
+// FindByURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.FindByURLRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) FindByURL(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_FindByURL_Call {
+	return &GophkeeperServiceClient_FindByURL_Call{Call: _e.mock.On("FindByURL",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_FindByURL_Call) Run(run func(ctx context.Context, in *v1.FindByURLRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_FindByURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.FindByURLRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_FindByURL_Call) Return(_a0 *v1.FindByURLResponse, _a1 error) *GophkeeperServiceClient_FindByURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_FindByURL_Call) RunAndReturn(run func(context.Context, *v1.FindByURLRequest, ...grpc.CallOption) (*v1.FindByURLResponse, error)) *GophkeeperServiceClient_FindByURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) Get(ctx context.Context, in *v1.GetRequest, opts ...grpc.CallOption) (*v1.GetResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *v1.GetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetRequest, ...grpc.CallOption) (*v1.GetResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetRequest, ...grpc.CallOption) *v1.GetResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.GetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type GophkeeperServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.GetRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Get_Call {
+	return &GophkeeperServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_Get_Call) Run(run func(ctx context.Context, in *v1.GetRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.GetRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Get_Call) Return(_a0 *v1.GetResponse, _a1 error) *GophkeeperServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Get_Call) RunAndReturn(run func(context.Context, *v1.GetRequest, ...grpc.CallOption) (*v1.GetResponse, error)) *GophkeeperServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJWKS provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) GetJWKS(ctx context.Context, in *v1.GetJWKSRequest, opts ...grpc.CallOption) (*v1.GetJWKSResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJWKS")
+	}
+
+	var r0 *v1.GetJWKSResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetJWKSRequest, ...grpc.CallOption) (*v1.GetJWKSResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetJWKSRequest, ...grpc.CallOption) *v1.GetJWKSResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.GetJWKSResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetJWKSRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_GetJWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJWKS'
+type GophkeeperServiceClient_GetJWKS_Call struct {
+	*mock.Call
+}
+
+// GetJWKS is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.GetJWKSRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) GetJWKS(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_GetJWKS_Call {
+	return &GophkeeperServiceClient_GetJWKS_Call{Call: _e.mock.On("GetJWKS",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_GetJWKS_Call) Run(run func(ctx context.Context, in *v1.GetJWKSRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_GetJWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.GetJWKSRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_GetJWKS_Call) Return(_a0 *v1.GetJWKSResponse, _a1 error) *GophkeeperServiceClient_GetJWKS_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_GetJWKS_Call) RunAndReturn(run func(context.Context, *v1.GetJWKSRequest, ...grpc.CallOption) (*v1.GetJWKSResponse, error)) *GophkeeperServiceClient_GetJWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOIDCConfig provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) GetOIDCConfig(ctx context.Context, in *v1.GetOIDCConfigRequest, opts ...grpc.CallOption) (*v1.GetOIDCConfigResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOIDCConfig")
+	}
+
+	var r0 *v1.GetOIDCConfigResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetOIDCConfigRequest, ...grpc.CallOption) (*v1.GetOIDCConfigResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetOIDCConfigRequest, ...grpc.CallOption) *v1.GetOIDCConfigResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.GetOIDCConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetOIDCConfigRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_GetOIDCConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOIDCConfig'
+type GophkeeperServiceClient_GetOIDCConfig_Call struct {
+	*mock.Call
+}
+
+// GetOIDCConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.GetOIDCConfigRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) GetOIDCConfig(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_GetOIDCConfig_Call {
+	return &GophkeeperServiceClient_GetOIDCConfig_Call{Call: _e.mock.On("GetOIDCConfig",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_GetOIDCConfig_Call) Run(run func(ctx context.Context, in *v1.GetOIDCConfigRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_GetOIDCConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.GetOIDCConfigRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_GetOIDCConfig_Call) Return(_a0 *v1.GetOIDCConfigResponse, _a1 error) *GophkeeperServiceClient_GetOIDCConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_GetOIDCConfig_Call) RunAndReturn(run func(context.Context, *v1.GetOIDCConfigRequest, ...grpc.CallOption) (*v1.GetOIDCConfigResponse, error)) *GophkeeperServiceClient_GetOIDCConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecretType provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) GetSecretType(ctx context.Context, in *v1.GetSecretTypeRequest, opts ...grpc.CallOption) (*v1.GetSecretTypeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecretType")
+	}
+
+	var r0 *v1.GetSecretTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetSecretTypeRequest, ...grpc.CallOption) (*v1.GetSecretTypeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetSecretTypeRequest, ...grpc.CallOption) *v1.GetSecretTypeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.GetSecretTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetSecretTypeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_GetSecretType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecretType'
+type GophkeeperServiceClient_GetSecretType_Call struct {
+	*mock.Call
+}
+
+// GetSecretType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.GetSecretTypeRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) GetSecretType(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_GetSecretType_Call {
+	return &GophkeeperServiceClient_GetSecretType_Call{Call: _e.mock.On("GetSecretType",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_GetSecretType_Call) Run(run func(ctx context.Context, in *v1.GetSecretTypeRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_GetSecretType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.GetSecretTypeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_GetSecretType_Call) Return(_a0 *v1.GetSecretTypeResponse, _a1 error) *GophkeeperServiceClient_GetSecretType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_GetSecretType_Call) RunAndReturn(run func(context.Context, *v1.GetSecretTypeRequest, ...grpc.CallOption) (*v1.GetSecretTypeResponse, error)) *GophkeeperServiceClient_GetSecretType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) List(ctx context.Context, in *v1.ListRequest, opts ...grpc.CallOption) (*v1.ListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *v1.ListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListRequest, ...grpc.CallOption) (*v1.ListResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListRequest, ...grpc.CallOption) *v1.ListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type GophkeeperServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_List_Call {
+	return &GophkeeperServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_List_Call) Run(run func(ctx context.Context, in *v1.ListRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_List_Call) Return(_a0 *v1.ListResponse, _a1 error) *GophkeeperServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_List_Call) RunAndReturn(run func(context.Context, *v1.ListRequest, ...grpc.CallOption) (*v1.ListResponse, error)) *GophkeeperServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListAPIKeys(ctx context.Context, in *v1.ListAPIKeysRequest, opts ...grpc.CallOption) (*v1.ListAPIKeysResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 *v1.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListAPIKeysRequest, ...grpc.CallOption) (*v1.ListAPIKeysResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListAPIKeysRequest, ...grpc.CallOption) *v1.ListAPIKeysResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListAPIKeysRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type GophkeeperServiceClient_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListAPIKeysRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListAPIKeys(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListAPIKeys_Call {
+	return &GophkeeperServiceClient_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListAPIKeys_Call) Run(run func(ctx context.Context, in *v1.ListAPIKeysRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListAPIKeysRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListAPIKeys_Call) Return(_a0 *v1.ListAPIKeysResponse, _a1 error) *GophkeeperServiceClient_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *v1.ListAPIKeysRequest, ...grpc.CallOption) (*v1.ListAPIKeysResponse, error)) *GophkeeperServiceClient_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListAuditEvents(ctx context.Context, in *v1.ListAuditEventsRequest, opts ...grpc.CallOption) (*v1.ListAuditEventsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 *v1.ListAuditEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListAuditEventsRequest, ...grpc.CallOption) (*v1.ListAuditEventsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListAuditEventsRequest, ...grpc.CallOption) *v1.ListAuditEventsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListAuditEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListAuditEventsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type GophkeeperServiceClient_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListAuditEventsRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListAuditEvents(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListAuditEvents_Call {
+	return &GophkeeperServiceClient_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListAuditEvents_Call) Run(run func(ctx context.Context, in *v1.ListAuditEventsRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListAuditEventsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListAuditEvents_Call) Return(_a0 *v1.ListAuditEventsResponse, _a1 error) *GophkeeperServiceClient_ListAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListAuditEvents_Call) RunAndReturn(run func(context.Context, *v1.ListAuditEventsRequest, ...grpc.CallOption) (*v1.ListAuditEventsResponse, error)) *GophkeeperServiceClient_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCollections provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListCollections(ctx context.Context, in *v1.ListCollectionsRequest, opts ...grpc.CallOption) (*v1.ListCollectionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollections")
+	}
+
+	var r0 *v1.ListCollectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListCollectionsRequest, ...grpc.CallOption) (*v1.ListCollectionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListCollectionsRequest, ...grpc.CallOption) *v1.ListCollectionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListCollectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListCollectionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollections'
+type GophkeeperServiceClient_ListCollections_Call struct {
+	*mock.Call
+}
+
+// ListCollections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListCollectionsRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListCollections(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListCollections_Call {
+	return &GophkeeperServiceClient_ListCollections_Call{Call: _e.mock.On("ListCollections",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListCollections_Call) Run(run func(ctx context.Context, in *v1.ListCollectionsRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListCollectionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListCollections_Call) Return(_a0 *v1.ListCollectionsResponse, _a1 error) *GophkeeperServiceClient_ListCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListCollections_Call) RunAndReturn(run func(context.Context, *v1.ListCollectionsRequest, ...grpc.CallOption) (*v1.ListCollectionsResponse, error)) *GophkeeperServiceClient_ListCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListMembers(ctx context.Context, in *v1.ListMembersRequest, opts ...grpc.CallOption) (*v1.ListMembersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 *v1.ListMembersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListMembersRequest, ...grpc.CallOption) (*v1.ListMembersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListMembersRequest, ...grpc.CallOption) *v1.ListMembersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListMembersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListMembersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type GophkeeperServiceClient_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListMembersRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListMembers(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListMembers_Call {
+	return &GophkeeperServiceClient_ListMembers_Call{Call: _e.mock.On("ListMembers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListMembers_Call) Run(run func(ctx context.Context, in *v1.ListMembersRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListMembersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListMembers_Call) Return(_a0 *v1.ListMembersResponse, _a1 error) *GophkeeperServiceClient_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListMembers_Call) RunAndReturn(run func(context.Context, *v1.ListMembersRequest, ...grpc.CallOption) (*v1.ListMembersResponse, error)) *GophkeeperServiceClient_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizations provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListOrganizations(ctx context.Context, in *v1.ListOrganizationsRequest, opts ...grpc.CallOption) (*v1.ListOrganizationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizations")
+	}
+
+	var r0 *v1.ListOrganizationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListOrganizationsRequest, ...grpc.CallOption) (*v1.ListOrganizationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListOrganizationsRequest, ...grpc.CallOption) *v1.ListOrganizationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListOrganizationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListOrganizationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
+type GophkeeperServiceClient_ListOrganizations_Call struct {
+	*mock.Call
+}
+
+// ListOrganizations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListOrganizationsRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListOrganizations(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListOrganizations_Call {
+	return &GophkeeperServiceClient_ListOrganizations_Call{Call: _e.mock.On("ListOrganizations",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListOrganizations_Call) Run(run func(ctx context.Context, in *v1.ListOrganizationsRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListOrganizationsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListOrganizations_Call) Return(_a0 *v1.ListOrganizationsResponse, _a1 error) *GophkeeperServiceClient_ListOrganizations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListOrganizations_Call) RunAndReturn(run func(context.Context, *v1.ListOrganizationsRequest, ...grpc.CallOption) (*v1.ListOrganizationsResponse, error)) *GophkeeperServiceClient_ListOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecretTypes provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListSecretTypes(ctx context.Context, in *v1.ListSecretTypesRequest, opts ...grpc.CallOption) (*v1.ListSecretTypesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecretTypes")
+	}
+
+	var r0 *v1.ListSecretTypesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListSecretTypesRequest, ...grpc.CallOption) (*v1.ListSecretTypesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListSecretTypesRequest, ...grpc.CallOption) *v1.ListSecretTypesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListSecretTypesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListSecretTypesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListSecretTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretTypes'
+type GophkeeperServiceClient_ListSecretTypes_Call struct {
+	*mock.Call
+}
+
+// ListSecretTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListSecretTypesRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListSecretTypes(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListSecretTypes_Call {
+	return &GophkeeperServiceClient_ListSecretTypes_Call{Call: _e.mock.On("ListSecretTypes",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListSecretTypes_Call) Run(run func(ctx context.Context, in *v1.ListSecretTypesRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListSecretTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListSecretTypesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListSecretTypes_Call) Return(_a0 *v1.ListSecretTypesResponse, _a1 error) *GophkeeperServiceClient_ListSecretTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListSecretTypes_Call) RunAndReturn(run func(context.Context, *v1.ListSecretTypesRequest, ...grpc.CallOption) (*v1.ListSecretTypesResponse, error)) *GophkeeperServiceClient_ListSecretTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListServiceAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListServiceAccounts(ctx context.Context, in *v1.ListServiceAccountsRequest, opts ...grpc.CallOption) (*v1.ListServiceAccountsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServiceAccounts")
+	}
+
+	var r0 *v1.ListServiceAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListServiceAccountsRequest, ...grpc.CallOption) (*v1.ListServiceAccountsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListServiceAccountsRequest, ...grpc.CallOption) *v1.ListServiceAccountsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListServiceAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListServiceAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServiceAccounts'
+type GophkeeperServiceClient_ListServiceAccounts_Call struct {
+	*mock.Call
+}
+
+// ListServiceAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListServiceAccountsRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListServiceAccounts(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListServiceAccounts_Call {
+	return &GophkeeperServiceClient_ListServiceAccounts_Call{Call: _e.mock.On("ListServiceAccounts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListServiceAccounts_Call) Run(run func(ctx context.Context, in *v1.ListServiceAccountsRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListServiceAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListServiceAccountsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListServiceAccounts_Call) Return(_a0 *v1.ListServiceAccountsResponse, _a1 error) *GophkeeperServiceClient_ListServiceAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListServiceAccounts_Call) RunAndReturn(run func(context.Context, *v1.ListServiceAccountsRequest, ...grpc.CallOption) (*v1.ListServiceAccountsResponse, error)) *GophkeeperServiceClient_ListServiceAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListShares provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListShares(ctx context.Context, in *v1.ListSharesRequest, opts ...grpc.CallOption) (*v1.ListSharesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListShares")
+	}
+
+	var r0 *v1.ListSharesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListSharesRequest, ...grpc.CallOption) (*v1.ListSharesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListSharesRequest, ...grpc.CallOption) *v1.ListSharesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListSharesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListSharesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListShares_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListShares'
+type GophkeeperServiceClient_ListShares_Call struct {
+	*mock.Call
+}
+
+// ListShares is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListSharesRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListShares(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListShares_Call {
+	return &GophkeeperServiceClient_ListShares_Call{Call: _e.mock.On("ListShares",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListShares_Call) Run(run func(ctx context.Context, in *v1.ListSharesRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListShares_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListSharesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListShares_Call) Return(_a0 *v1.ListSharesResponse, _a1 error) *GophkeeperServiceClient_ListShares_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListShares_Call) RunAndReturn(run func(context.Context, *v1.ListSharesRequest, ...grpc.CallOption) (*v1.ListSharesResponse, error)) *GophkeeperServiceClient_ListShares_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTeams provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) ListTeams(ctx context.Context, in *v1.ListTeamsRequest, opts ...grpc.CallOption) (*v1.ListTeamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeams")
+	}
+
+	var r0 *v1.ListTeamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListTeamsRequest, ...grpc.CallOption) (*v1.ListTeamsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListTeamsRequest, ...grpc.CallOption) *v1.ListTeamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListTeamsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListTeamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_ListTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeams'
+type GophkeeperServiceClient_ListTeams_Call struct {
+	*mock.Call
+}
+
+// ListTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListTeamsRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) ListTeams(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_ListTeams_Call {
+	return &GophkeeperServiceClient_ListTeams_Call{Call: _e.mock.On("ListTeams",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_ListTeams_Call) Run(run func(ctx context.Context, in *v1.ListTeamsRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_ListTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.ListTeamsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListTeams_Call) Return(_a0 *v1.ListTeamsResponse, _a1 error) *GophkeeperServiceClient_ListTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_ListTeams_Call) RunAndReturn(run func(context.Context, *v1.ListTeamsRequest, ...grpc.CallOption) (*v1.ListTeamsResponse, error)) *GophkeeperServiceClient_ListTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) Login(ctx context.Context, in *v1.LoginRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.LoginRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.LoginRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.LoginRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type GophkeeperServiceClient_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.LoginRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) Login(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Login_Call {
+	return &GophkeeperServiceClient_Login_Call{Call: _e.mock.On("Login",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_Login_Call) Run(run func(ctx context.Context, in *v1.LoginRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.LoginRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Login_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Login_Call) RunAndReturn(run func(context.Context, *v1.LoginRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OIDCLogin provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) OIDCLogin(ctx context.Context, in *v1.OIDCLoginRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OIDCLogin")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.OIDCLoginRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.OIDCLoginRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.OIDCLoginRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_OIDCLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OIDCLogin'
+type GophkeeperServiceClient_OIDCLogin_Call struct {
+	*mock.Call
+}
+
+// OIDCLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.OIDCLoginRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) OIDCLogin(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_OIDCLogin_Call {
+	return &GophkeeperServiceClient_OIDCLogin_Call{Call: _e.mock.On("OIDCLogin",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_OIDCLogin_Call) Run(run func(ctx context.Context, in *v1.OIDCLoginRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_OIDCLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.OIDCLoginRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_OIDCLogin_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_OIDCLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_OIDCLogin_Call) RunAndReturn(run func(context.Context, *v1.OIDCLoginRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_OIDCLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshToken provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) RefreshToken(ctx context.Context, in *v1.RefreshTokenRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshToken")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RefreshTokenRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RefreshTokenRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RefreshTokenRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
+type GophkeeperServiceClient_RefreshToken_Call struct {
+	*mock.Call
+}
+
+// RefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RefreshTokenRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) RefreshToken(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_RefreshToken_Call {
+	return &GophkeeperServiceClient_RefreshToken_Call{Call: _e.mock.On("RefreshToken",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_RefreshToken_Call) Run(run func(ctx context.Context, in *v1.RefreshTokenRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_RefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.RefreshTokenRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RefreshToken_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_RefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RefreshToken_Call) RunAndReturn(run func(context.Context, *v1.RefreshTokenRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_RefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) Register(ctx context.Context, in *v1.RegisterRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RegisterRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type GophkeeperServiceClient_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RegisterRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) Register(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Register_Call {
+	return &GophkeeperServiceClient_Register_Call{Call: _e.mock.On("Register",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_Register_Call) Run(run func(ctx context.Context, in *v1.RegisterRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.RegisterRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Register_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_Register_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Register_Call) RunAndReturn(run func(context.Context, *v1.RegisterRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterSecretType provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) RegisterSecretType(ctx context.Context, in *v1.RegisterSecretTypeRequest, opts ...grpc.CallOption) (*v1.RegisterSecretTypeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterSecretType")
+	}
+
+	var r0 *v1.RegisterSecretTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterSecretTypeRequest, ...grpc.CallOption) (*v1.RegisterSecretTypeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterSecretTypeRequest, ...grpc.CallOption) *v1.RegisterSecretTypeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RegisterSecretTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RegisterSecretTypeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_RegisterSecretType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterSecretType'
+type GophkeeperServiceClient_RegisterSecretType_Call struct {
+	*mock.Call
+}
+
+// RegisterSecretType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RegisterSecretTypeRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) RegisterSecretType(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_RegisterSecretType_Call {
+	return &GophkeeperServiceClient_RegisterSecretType_Call{Call: _e.mock.On("RegisterSecretType",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_RegisterSecretType_Call) Run(run func(ctx context.Context, in *v1.RegisterSecretTypeRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_RegisterSecretType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.RegisterSecretTypeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RegisterSecretType_Call) Return(_a0 *v1.RegisterSecretTypeResponse, _a1 error) *GophkeeperServiceClient_RegisterSecretType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RegisterSecretType_Call) RunAndReturn(run func(context.Context, *v1.RegisterSecretTypeRequest, ...grpc.CallOption) (*v1.RegisterSecretTypeResponse, error)) *GophkeeperServiceClient_RegisterSecretType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromCollection provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) RemoveFromCollection(ctx context.Context, in *v1.RemoveFromCollectionRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromCollection")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveFromCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveFromCollectionRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RemoveFromCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_RemoveFromCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromCollection'
+type GophkeeperServiceClient_RemoveFromCollection_Call struct {
+	*mock.Call
+}
+
+// RemoveFromCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RemoveFromCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) RemoveFromCollection(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_RemoveFromCollection_Call {
+	return &GophkeeperServiceClient_RemoveFromCollection_Call{Call: _e.mock.On("RemoveFromCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_RemoveFromCollection_Call) Run(run func(ctx context.Context, in *v1.RemoveFromCollectionRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_RemoveFromCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.RemoveFromCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RemoveFromCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_RemoveFromCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RemoveFromCollection_Call) RunAndReturn(run func(context.Context, *v1.RemoveFromCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_RemoveFromCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) RemoveMember(ctx context.Context, in *v1.RemoveMemberRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveMemberRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveMemberRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RemoveMemberRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type GophkeeperServiceClient_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RemoveMemberRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) RemoveMember(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_RemoveMember_Call {
+	return &GophkeeperServiceClient_RemoveMember_Call{Call: _e.mock.On("RemoveMember",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_RemoveMember_Call) Run(run func(ctx context.Context, in *v1.RemoveMemberRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.RemoveMemberRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RemoveMember_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_RemoveMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RemoveMember_Call) RunAndReturn(run func(context.Context, *v1.RemoveMemberRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMember provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) RemoveTeamMember(ctx context.Context, in *v1.RemoveTeamMemberRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMember")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveTeamMemberRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveTeamMemberRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RemoveTeamMemberRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
+type GophkeeperServiceClient_RemoveTeamMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RemoveTeamMemberRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) RemoveTeamMember(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_RemoveTeamMember_Call {
+	return &GophkeeperServiceClient_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_RemoveTeamMember_Call) Run(run func(ctx context.Context, in *v1.RemoveTeamMemberRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_RemoveTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.RemoveTeamMemberRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RemoveTeamMember_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_RemoveTeamMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_RemoveTeamMember_Call) RunAndReturn(run func(context.Context, *v1.RemoveTeamMemberRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_RemoveTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) RevokeAPIKey(ctx context.Context, in *v1.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*v1.ServiceAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
 	}
 
-	var r0 *v1.CreateResponse
+	var r0 *v1.ServiceAccountResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateRequest, ...grpc.CallOption) (*v1.CreateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RevokeAPIKeyRequest, ...grpc.CallOption) (*v1.ServiceAccountResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateRequest, ...grpc.CallOption) *v1.CreateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RevokeAPIKeyRequest, ...grpc.CallOption) *v1.ServiceAccountResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.CreateResponse)
+			r0 = ret.Get(0).(*v1.ServiceAccountResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RevokeAPIKeyRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -61,21 +2873,21 @@ func (_m *GophkeeperServiceClient) Create(ctx context.Context, in *v1.CreateRequ
 	return r0, r1
 }
 
-// GophkeeperServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type GophkeeperServiceClient_Create_Call struct {
+// GophkeeperServiceClient_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type GophkeeperServiceClient_RevokeAPIKey_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// RevokeAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.CreateRequest
+//   - in *v1.RevokeAPIKeyRequest
 //   - opts ...grpc.CallOption
-func (_e *GophkeeperServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Create_Call {
-	return &GophkeeperServiceClient_Create_Call{Call: _e.mock.On("Create",
+func (_e *GophkeeperServiceClient_Expecter) RevokeAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_RevokeAPIKey_Call {
+	return &GophkeeperServiceClient_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GophkeeperServiceClient_Create_Call) Run(run func(ctx context.Context, in *v1.CreateRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Create_Call {
+func (_c *GophkeeperServiceClient_RevokeAPIKey_Call) Run(run func(ctx context.Context, in *v1.RevokeAPIKeyRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_RevokeAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -83,23 +2895,23 @@ func (_c *GophkeeperServiceClient_Create_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1.CreateRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1.RevokeAPIKeyRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Create_Call) Return(_a0 *v1.CreateResponse, _a1 error) *GophkeeperServiceClient_Create_Call {
+func (_c *GophkeeperServiceClient_RevokeAPIKey_Call) Return(_a0 *v1.ServiceAccountResponse, _a1 error) *GophkeeperServiceClient_RevokeAPIKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Create_Call) RunAndReturn(run func(context.Context, *v1.CreateRequest, ...grpc.CallOption) (*v1.CreateResponse, error)) *GophkeeperServiceClient_Create_Call {
+func (_c *GophkeeperServiceClient_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *v1.RevokeAPIKeyRequest, ...grpc.CallOption) (*v1.ServiceAccountResponse, error)) *GophkeeperServiceClient_RevokeAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, in, opts
-func (_m *GophkeeperServiceClient) Delete(ctx context.Context, in *v1.DeleteRequest, opts ...grpc.CallOption) (*v1.DeleteResponse, error) {
+// RotateSigningKey provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) RotateSigningKey(ctx context.Context, in *v1.RotateSigningKeyRequest, opts ...grpc.CallOption) (*v1.RotateSigningKeyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -110,23 +2922,23 @@ func (_m *GophkeeperServiceClient) Delete(ctx context.Context, in *v1.DeleteRequ
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for RotateSigningKey")
 	}
 
-	var r0 *v1.DeleteResponse
+	var r0 *v1.RotateSigningKeyResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteRequest, ...grpc.CallOption) (*v1.DeleteResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RotateSigningKeyRequest, ...grpc.CallOption) (*v1.RotateSigningKeyResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteRequest, ...grpc.CallOption) *v1.DeleteResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RotateSigningKeyRequest, ...grpc.CallOption) *v1.RotateSigningKeyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.DeleteResponse)
+			r0 = ret.Get(0).(*v1.RotateSigningKeyResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.DeleteRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RotateSigningKeyRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -135,21 +2947,21 @@ func (_m *GophkeeperServiceClient) Delete(ctx context.Context, in *v1.DeleteRequ
 	return r0, r1
 }
 
-// GophkeeperServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type GophkeeperServiceClient_Delete_Call struct {
+// GophkeeperServiceClient_RotateSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSigningKey'
+type GophkeeperServiceClient_RotateSigningKey_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// RotateSigningKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.DeleteRequest
+//   - in *v1.RotateSigningKeyRequest
 //   - opts ...grpc.CallOption
-func (_e *GophkeeperServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Delete_Call {
-	return &GophkeeperServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+func (_e *GophkeeperServiceClient_Expecter) RotateSigningKey(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_RotateSigningKey_Call {
+	return &GophkeeperServiceClient_RotateSigningKey_Call{Call: _e.mock.On("RotateSigningKey",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GophkeeperServiceClient_Delete_Call) Run(run func(ctx context.Context, in *v1.DeleteRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Delete_Call {
+func (_c *GophkeeperServiceClient_RotateSigningKey_Call) Run(run func(ctx context.Context, in *v1.RotateSigningKeyRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_RotateSigningKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -157,23 +2969,23 @@ func (_c *GophkeeperServiceClient_Delete_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1.DeleteRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1.RotateSigningKeyRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Delete_Call) Return(_a0 *v1.DeleteResponse, _a1 error) *GophkeeperServiceClient_Delete_Call {
+func (_c *GophkeeperServiceClient_RotateSigningKey_Call) Return(_a0 *v1.RotateSigningKeyResponse, _a1 error) *GophkeeperServiceClient_RotateSigningKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *v1.DeleteRequest, ...grpc.CallOption) (*v1.DeleteResponse, error)) *GophkeeperServiceClient_Delete_Call {
+func (_c *GophkeeperServiceClient_RotateSigningKey_Call) RunAndReturn(run func(context.Context, *v1.RotateSigningKeyRequest, ...grpc.CallOption) (*v1.RotateSigningKeyResponse, error)) *GophkeeperServiceClient_RotateSigningKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Download provides a mock function with given fields: ctx, in, opts
-func (_m *GophkeeperServiceClient) Download(ctx context.Context, in *v1.DownloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.Chunk], error) {
+// SRPBegin provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) SRPBegin(ctx context.Context, in *v1.SRPBeginRequest, opts ...grpc.CallOption) (*v1.SRPBeginResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -184,23 +2996,23 @@ func (_m *GophkeeperServiceClient) Download(ctx context.Context, in *v1.Download
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Download")
+		panic("no return value specified for SRPBegin")
 	}
 
-	var r0 grpc.ServerStreamingClient[v1.Chunk]
+	var r0 *v1.SRPBeginResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.DownloadRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1.Chunk], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPBeginRequest, ...grpc.CallOption) (*v1.SRPBeginResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.DownloadRequest, ...grpc.CallOption) grpc.ServerStreamingClient[v1.Chunk]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPBeginRequest, ...grpc.CallOption) *v1.SRPBeginResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(grpc.ServerStreamingClient[v1.Chunk])
+			r0 = ret.Get(0).(*v1.SRPBeginResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.DownloadRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.SRPBeginRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -209,21 +3021,21 @@ func (_m *GophkeeperServiceClient) Download(ctx context.Context, in *v1.Download
 	return r0, r1
 }
 
-// GophkeeperServiceClient_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
-type GophkeeperServiceClient_Download_Call struct {
+// GophkeeperServiceClient_SRPBegin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SRPBegin'
+type GophkeeperServiceClient_SRPBegin_Call struct {
 	*mock.Call
 }
 
-// Download is a helper method to define mock.On call
+// SRPBegin is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.DownloadRequest
+//   - in *v1.SRPBeginRequest
 //   - opts ...grpc.CallOption
-func (_e *GophkeeperServiceClient_Expecter) Download(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Download_Call {
-	return &GophkeeperServiceClient_Download_Call{Call: _e.mock.On("Download",
+func (_e *GophkeeperServiceClient_Expecter) SRPBegin(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_SRPBegin_Call {
+	return &GophkeeperServiceClient_SRPBegin_Call{Call: _e.mock.On("SRPBegin",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GophkeeperServiceClient_Download_Call) Run(run func(ctx context.Context, in *v1.DownloadRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Download_Call {
+func (_c *GophkeeperServiceClient_SRPBegin_Call) Run(run func(ctx context.Context, in *v1.SRPBeginRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_SRPBegin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -231,23 +3043,23 @@ func (_c *GophkeeperServiceClient_Download_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1.DownloadRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1.SRPBeginRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Download_Call) Return(_a0 grpc.ServerStreamingClient[v1.Chunk], _a1 error) *GophkeeperServiceClient_Download_Call {
+func (_c *GophkeeperServiceClient_SRPBegin_Call) Return(_a0 *v1.SRPBeginResponse, _a1 error) *GophkeeperServiceClient_SRPBegin_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Download_Call) RunAndReturn(run func(context.Context, *v1.DownloadRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1.Chunk], error)) *GophkeeperServiceClient_Download_Call {
+func (_c *GophkeeperServiceClient_SRPBegin_Call) RunAndReturn(run func(context.Context, *v1.SRPBeginRequest, ...grpc.CallOption) (*v1.SRPBeginResponse, error)) *GophkeeperServiceClient_SRPBegin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function with given fields: ctx, in, opts
-func (_m *GophkeeperServiceClient) Get(ctx context.Context, in *v1.GetRequest, opts ...grpc.CallOption) (*v1.GetResponse, error) {
+// SRPFinish provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) SRPFinish(ctx context.Context, in *v1.SRPFinishRequest, opts ...grpc.CallOption) (*v1.SRPFinishResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -258,23 +3070,23 @@ func (_m *GophkeeperServiceClient) Get(ctx context.Context, in *v1.GetRequest, o
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for SRPFinish")
 	}
 
-	var r0 *v1.GetResponse
+	var r0 *v1.SRPFinishResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetRequest, ...grpc.CallOption) (*v1.GetResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPFinishRequest, ...grpc.CallOption) (*v1.SRPFinishResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetRequest, ...grpc.CallOption) *v1.GetResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPFinishRequest, ...grpc.CallOption) *v1.SRPFinishResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.GetResponse)
+			r0 = ret.Get(0).(*v1.SRPFinishResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.SRPFinishRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -283,21 +3095,21 @@ func (_m *GophkeeperServiceClient) Get(ctx context.Context, in *v1.GetRequest, o
 	return r0, r1
 }
 
-// GophkeeperServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type GophkeeperServiceClient_Get_Call struct {
+// GophkeeperServiceClient_SRPFinish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SRPFinish'
+type GophkeeperServiceClient_SRPFinish_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
+// SRPFinish is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.GetRequest
+//   - in *v1.SRPFinishRequest
 //   - opts ...grpc.CallOption
-func (_e *GophkeeperServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Get_Call {
-	return &GophkeeperServiceClient_Get_Call{Call: _e.mock.On("Get",
+func (_e *GophkeeperServiceClient_Expecter) SRPFinish(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_SRPFinish_Call {
+	return &GophkeeperServiceClient_SRPFinish_Call{Call: _e.mock.On("SRPFinish",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GophkeeperServiceClient_Get_Call) Run(run func(ctx context.Context, in *v1.GetRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Get_Call {
+func (_c *GophkeeperServiceClient_SRPFinish_Call) Run(run func(ctx context.Context, in *v1.SRPFinishRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_SRPFinish_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -305,23 +3117,23 @@ func (_c *GophkeeperServiceClient_Get_Call) Run(run func(ctx context.Context, in
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1.GetRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1.SRPFinishRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Get_Call) Return(_a0 *v1.GetResponse, _a1 error) *GophkeeperServiceClient_Get_Call {
+func (_c *GophkeeperServiceClient_SRPFinish_Call) Return(_a0 *v1.SRPFinishResponse, _a1 error) *GophkeeperServiceClient_SRPFinish_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Get_Call) RunAndReturn(run func(context.Context, *v1.GetRequest, ...grpc.CallOption) (*v1.GetResponse, error)) *GophkeeperServiceClient_Get_Call {
+func (_c *GophkeeperServiceClient_SRPFinish_Call) RunAndReturn(run func(context.Context, *v1.SRPFinishRequest, ...grpc.CallOption) (*v1.SRPFinishResponse, error)) *GophkeeperServiceClient_SRPFinish_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function with given fields: ctx, in, opts
-func (_m *GophkeeperServiceClient) List(ctx context.Context, in *v1.ListRequest, opts ...grpc.CallOption) (*v1.ListResponse, error) {
+// SRPRegister provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) SRPRegister(ctx context.Context, in *v1.SRPRegisterRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -332,23 +3144,23 @@ func (_m *GophkeeperServiceClient) List(ctx context.Context, in *v1.ListRequest,
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for SRPRegister")
 	}
 
-	var r0 *v1.ListResponse
+	var r0 *v1.AuthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListRequest, ...grpc.CallOption) (*v1.ListResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPRegisterRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListRequest, ...grpc.CallOption) *v1.ListResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPRegisterRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.ListResponse)
+			r0 = ret.Get(0).(*v1.AuthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.SRPRegisterRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -357,21 +3169,21 @@ func (_m *GophkeeperServiceClient) List(ctx context.Context, in *v1.ListRequest,
 	return r0, r1
 }
 
-// GophkeeperServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type GophkeeperServiceClient_List_Call struct {
+// GophkeeperServiceClient_SRPRegister_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SRPRegister'
+type GophkeeperServiceClient_SRPRegister_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// SRPRegister is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.ListRequest
+//   - in *v1.SRPRegisterRequest
 //   - opts ...grpc.CallOption
-func (_e *GophkeeperServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_List_Call {
-	return &GophkeeperServiceClient_List_Call{Call: _e.mock.On("List",
+func (_e *GophkeeperServiceClient_Expecter) SRPRegister(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_SRPRegister_Call {
+	return &GophkeeperServiceClient_SRPRegister_Call{Call: _e.mock.On("SRPRegister",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GophkeeperServiceClient_List_Call) Run(run func(ctx context.Context, in *v1.ListRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_List_Call {
+func (_c *GophkeeperServiceClient_SRPRegister_Call) Run(run func(ctx context.Context, in *v1.SRPRegisterRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_SRPRegister_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -379,23 +3191,23 @@ func (_c *GophkeeperServiceClient_List_Call) Run(run func(ctx context.Context, i
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1.ListRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1.SRPRegisterRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_List_Call) Return(_a0 *v1.ListResponse, _a1 error) *GophkeeperServiceClient_List_Call {
+func (_c *GophkeeperServiceClient_SRPRegister_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_SRPRegister_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_List_Call) RunAndReturn(run func(context.Context, *v1.ListRequest, ...grpc.CallOption) (*v1.ListResponse, error)) *GophkeeperServiceClient_List_Call {
+func (_c *GophkeeperServiceClient_SRPRegister_Call) RunAndReturn(run func(context.Context, *v1.SRPRegisterRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_SRPRegister_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function with given fields: ctx, in, opts
-func (_m *GophkeeperServiceClient) Login(ctx context.Context, in *v1.LoginRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
+// SetMember provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) SetMember(ctx context.Context, in *v1.SetMemberRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -406,23 +3218,23 @@ func (_m *GophkeeperServiceClient) Login(ctx context.Context, in *v1.LoginReques
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for SetMember")
 	}
 
-	var r0 *v1.AuthResponse
+	var r0 *v1.OrganizationResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.LoginRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SetMemberRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.LoginRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SetMemberRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.AuthResponse)
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.LoginRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.SetMemberRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -431,21 +3243,21 @@ func (_m *GophkeeperServiceClient) Login(ctx context.Context, in *v1.LoginReques
 	return r0, r1
 }
 
-// GophkeeperServiceClient_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type GophkeeperServiceClient_Login_Call struct {
+// GophkeeperServiceClient_SetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMember'
+type GophkeeperServiceClient_SetMember_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// SetMember is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.LoginRequest
+//   - in *v1.SetMemberRequest
 //   - opts ...grpc.CallOption
-func (_e *GophkeeperServiceClient_Expecter) Login(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Login_Call {
-	return &GophkeeperServiceClient_Login_Call{Call: _e.mock.On("Login",
+func (_e *GophkeeperServiceClient_Expecter) SetMember(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_SetMember_Call {
+	return &GophkeeperServiceClient_SetMember_Call{Call: _e.mock.On("SetMember",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GophkeeperServiceClient_Login_Call) Run(run func(ctx context.Context, in *v1.LoginRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Login_Call {
+func (_c *GophkeeperServiceClient_SetMember_Call) Run(run func(ctx context.Context, in *v1.SetMemberRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_SetMember_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -453,23 +3265,23 @@ func (_c *GophkeeperServiceClient_Login_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1.LoginRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1.SetMemberRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Login_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_Login_Call {
+func (_c *GophkeeperServiceClient_SetMember_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_SetMember_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Login_Call) RunAndReturn(run func(context.Context, *v1.LoginRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_Login_Call {
+func (_c *GophkeeperServiceClient_SetMember_Call) RunAndReturn(run func(context.Context, *v1.SetMemberRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_SetMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RefreshToken provides a mock function with given fields: ctx, in, opts
-func (_m *GophkeeperServiceClient) RefreshToken(ctx context.Context, in *v1.RefreshTokenRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
+// Share provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) Share(ctx context.Context, in *v1.ShareRequest, opts ...grpc.CallOption) (*v1.ShareResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -480,23 +3292,23 @@ func (_m *GophkeeperServiceClient) RefreshToken(ctx context.Context, in *v1.Refr
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RefreshToken")
+		panic("no return value specified for Share")
 	}
 
-	var r0 *v1.AuthResponse
+	var r0 *v1.ShareResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.RefreshTokenRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ShareRequest, ...grpc.CallOption) (*v1.ShareResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.RefreshTokenRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ShareRequest, ...grpc.CallOption) *v1.ShareResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.AuthResponse)
+			r0 = ret.Get(0).(*v1.ShareResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.RefreshTokenRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ShareRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -505,21 +3317,21 @@ func (_m *GophkeeperServiceClient) RefreshToken(ctx context.Context, in *v1.Refr
 	return r0, r1
 }
 
-// GophkeeperServiceClient_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
-type GophkeeperServiceClient_RefreshToken_Call struct {
+// GophkeeperServiceClient_Share_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Share'
+type GophkeeperServiceClient_Share_Call struct {
 	*mock.Call
 }
 
-// RefreshToken is a helper method to define mock.On call
+// Share is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.RefreshTokenRequest
+//   - in *v1.ShareRequest
 //   - opts ...grpc.CallOption
-func (_e *GophkeeperServiceClient_Expecter) RefreshToken(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_RefreshToken_Call {
-	return &GophkeeperServiceClient_RefreshToken_Call{Call: _e.mock.On("RefreshToken",
+func (_e *GophkeeperServiceClient_Expecter) Share(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Share_Call {
+	return &GophkeeperServiceClient_Share_Call{Call: _e.mock.On("Share",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GophkeeperServiceClient_RefreshToken_Call) Run(run func(ctx context.Context, in *v1.RefreshTokenRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_RefreshToken_Call {
+func (_c *GophkeeperServiceClient_Share_Call) Run(run func(ctx context.Context, in *v1.ShareRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Share_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -527,23 +3339,23 @@ func (_c *GophkeeperServiceClient_RefreshToken_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1.RefreshTokenRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1.ShareRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_RefreshToken_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_RefreshToken_Call {
+func (_c *GophkeeperServiceClient_Share_Call) Return(_a0 *v1.ShareResponse, _a1 error) *GophkeeperServiceClient_Share_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_RefreshToken_Call) RunAndReturn(run func(context.Context, *v1.RefreshTokenRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_RefreshToken_Call {
+func (_c *GophkeeperServiceClient_Share_Call) RunAndReturn(run func(context.Context, *v1.ShareRequest, ...grpc.CallOption) (*v1.ShareResponse, error)) *GophkeeperServiceClient_Share_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields: ctx, in, opts
-func (_m *GophkeeperServiceClient) Register(ctx context.Context, in *v1.RegisterRequest, opts ...grpc.CallOption) (*v1.AuthResponse, error) {
+// UnassignCollection provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) UnassignCollection(ctx context.Context, in *v1.UnassignCollectionRequest, opts ...grpc.CallOption) (*v1.OrganizationResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -554,23 +3366,23 @@ func (_m *GophkeeperServiceClient) Register(ctx context.Context, in *v1.Register
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Register")
+		panic("no return value specified for UnassignCollection")
 	}
 
-	var r0 *v1.AuthResponse
+	var r0 *v1.OrganizationResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterRequest, ...grpc.CallOption) (*v1.AuthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.UnassignCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterRequest, ...grpc.CallOption) *v1.AuthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.UnassignCollectionRequest, ...grpc.CallOption) *v1.OrganizationResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.AuthResponse)
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.RegisterRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.UnassignCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -579,21 +3391,21 @@ func (_m *GophkeeperServiceClient) Register(ctx context.Context, in *v1.Register
 	return r0, r1
 }
 
-// GophkeeperServiceClient_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
-type GophkeeperServiceClient_Register_Call struct {
+// GophkeeperServiceClient_UnassignCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignCollection'
+type GophkeeperServiceClient_UnassignCollection_Call struct {
 	*mock.Call
 }
 
-// Register is a helper method to define mock.On call
+// UnassignCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.RegisterRequest
+//   - in *v1.UnassignCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *GophkeeperServiceClient_Expecter) Register(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Register_Call {
-	return &GophkeeperServiceClient_Register_Call{Call: _e.mock.On("Register",
+func (_e *GophkeeperServiceClient_Expecter) UnassignCollection(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_UnassignCollection_Call {
+	return &GophkeeperServiceClient_UnassignCollection_Call{Call: _e.mock.On("UnassignCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GophkeeperServiceClient_Register_Call) Run(run func(ctx context.Context, in *v1.RegisterRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Register_Call {
+func (_c *GophkeeperServiceClient_UnassignCollection_Call) Run(run func(ctx context.Context, in *v1.UnassignCollectionRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_UnassignCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -601,17 +3413,91 @@ func (_c *GophkeeperServiceClient_Register_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1.RegisterRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1.UnassignCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Register_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceClient_Register_Call {
+func (_c *GophkeeperServiceClient_UnassignCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceClient_UnassignCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceClient_Register_Call) RunAndReturn(run func(context.Context, *v1.RegisterRequest, ...grpc.CallOption) (*v1.AuthResponse, error)) *GophkeeperServiceClient_Register_Call {
+func (_c *GophkeeperServiceClient_UnassignCollection_Call) RunAndReturn(run func(context.Context, *v1.UnassignCollectionRequest, ...grpc.CallOption) (*v1.OrganizationResponse, error)) *GophkeeperServiceClient_UnassignCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unshare provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) Unshare(ctx context.Context, in *v1.UnshareRequest, opts ...grpc.CallOption) (*v1.UnshareResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unshare")
+	}
+
+	var r0 *v1.UnshareResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.UnshareRequest, ...grpc.CallOption) (*v1.UnshareResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.UnshareRequest, ...grpc.CallOption) *v1.UnshareResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.UnshareResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.UnshareRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_Unshare_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unshare'
+type GophkeeperServiceClient_Unshare_Call struct {
+	*mock.Call
+}
+
+// Unshare is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.UnshareRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) Unshare(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_Unshare_Call {
+	return &GophkeeperServiceClient_Unshare_Call{Call: _e.mock.On("Unshare",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_Unshare_Call) Run(run func(ctx context.Context, in *v1.UnshareRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_Unshare_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.UnshareRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Unshare_Call) Return(_a0 *v1.UnshareResponse, _a1 error) *GophkeeperServiceClient_Unshare_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_Unshare_Call) RunAndReturn(run func(context.Context, *v1.UnshareRequest, ...grpc.CallOption) (*v1.UnshareResponse, error)) *GophkeeperServiceClient_Unshare_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -689,6 +3575,80 @@ func (_c *GophkeeperServiceClient_Upload_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// VerifyAuditLog provides a mock function with given fields: ctx, in, opts
+func (_m *GophkeeperServiceClient) VerifyAuditLog(ctx context.Context, in *v1.VerifyAuditLogRequest, opts ...grpc.CallOption) (*v1.VerifyAuditLogResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyAuditLog")
+	}
+
+	var r0 *v1.VerifyAuditLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.VerifyAuditLogRequest, ...grpc.CallOption) (*v1.VerifyAuditLogResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.VerifyAuditLogRequest, ...grpc.CallOption) *v1.VerifyAuditLogResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.VerifyAuditLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.VerifyAuditLogRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceClient_VerifyAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyAuditLog'
+type GophkeeperServiceClient_VerifyAuditLog_Call struct {
+	*mock.Call
+}
+
+// VerifyAuditLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.VerifyAuditLogRequest
+//   - opts ...grpc.CallOption
+func (_e *GophkeeperServiceClient_Expecter) VerifyAuditLog(ctx interface{}, in interface{}, opts ...interface{}) *GophkeeperServiceClient_VerifyAuditLog_Call {
+	return &GophkeeperServiceClient_VerifyAuditLog_Call{Call: _e.mock.On("VerifyAuditLog",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GophkeeperServiceClient_VerifyAuditLog_Call) Run(run func(ctx context.Context, in *v1.VerifyAuditLogRequest, opts ...grpc.CallOption)) *GophkeeperServiceClient_VerifyAuditLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1.VerifyAuditLogRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_VerifyAuditLog_Call) Return(_a0 *v1.VerifyAuditLogResponse, _a1 error) *GophkeeperServiceClient_VerifyAuditLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceClient_VerifyAuditLog_Call) RunAndReturn(run func(context.Context, *v1.VerifyAuditLogRequest, ...grpc.CallOption) (*v1.VerifyAuditLogResponse, error)) *GophkeeperServiceClient_VerifyAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewGophkeeperServiceClient creates a new instance of GophkeeperServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGophkeeperServiceClient(t interface {
@@ -24,28 +24,2246 @@ func (_m *GophkeeperServiceServer) EXPECT() *GophkeeperServiceServer_Expecter {
 	return &GophkeeperServiceServer_Expecter{mock: &_m.Mock}
 }
 
+// AddTeamMember provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) AddTeamMember(_a0 context.Context, _a1 *v1.AddTeamMemberRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTeamMember")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AddTeamMemberRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AddTeamMemberRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.AddTeamMemberRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_AddTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMember'
+type GophkeeperServiceServer_AddTeamMember_Call struct {
+	*mock.Call
+}
+
+// AddTeamMember is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.AddTeamMemberRequest
+func (_e *GophkeeperServiceServer_Expecter) AddTeamMember(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_AddTeamMember_Call {
+	return &GophkeeperServiceServer_AddTeamMember_Call{Call: _e.mock.On("AddTeamMember", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_AddTeamMember_Call) Run(run func(_a0 context.Context, _a1 *v1.AddTeamMemberRequest)) *GophkeeperServiceServer_AddTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.AddTeamMemberRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_AddTeamMember_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_AddTeamMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_AddTeamMember_Call) RunAndReturn(run func(context.Context, *v1.AddTeamMemberRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_AddTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddToCollection provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) AddToCollection(_a0 context.Context, _a1 *v1.AddToCollectionRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToCollection")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AddToCollectionRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AddToCollectionRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.AddToCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_AddToCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToCollection'
+type GophkeeperServiceServer_AddToCollection_Call struct {
+	*mock.Call
+}
+
+// AddToCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.AddToCollectionRequest
+func (_e *GophkeeperServiceServer_Expecter) AddToCollection(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_AddToCollection_Call {
+	return &GophkeeperServiceServer_AddToCollection_Call{Call: _e.mock.On("AddToCollection", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_AddToCollection_Call) Run(run func(_a0 context.Context, _a1 *v1.AddToCollectionRequest)) *GophkeeperServiceServer_AddToCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.AddToCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_AddToCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_AddToCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_AddToCollection_Call) RunAndReturn(run func(context.Context, *v1.AddToCollectionRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_AddToCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignCollection provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) AssignCollection(_a0 context.Context, _a1 *v1.AssignCollectionRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCollection")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AssignCollectionRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AssignCollectionRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.AssignCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_AssignCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignCollection'
+type GophkeeperServiceServer_AssignCollection_Call struct {
+	*mock.Call
+}
+
+// AssignCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.AssignCollectionRequest
+func (_e *GophkeeperServiceServer_Expecter) AssignCollection(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_AssignCollection_Call {
+	return &GophkeeperServiceServer_AssignCollection_Call{Call: _e.mock.On("AssignCollection", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_AssignCollection_Call) Run(run func(_a0 context.Context, _a1 *v1.AssignCollectionRequest)) *GophkeeperServiceServer_AssignCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.AssignCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_AssignCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_AssignCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_AssignCollection_Call) RunAndReturn(run func(context.Context, *v1.AssignCollectionRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_AssignCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttachTOTP provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) AttachTOTP(_a0 context.Context, _a1 *v1.AttachTOTPRequest) (*v1.AttachTOTPResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachTOTP")
+	}
+
+	var r0 *v1.AttachTOTPResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AttachTOTPRequest) (*v1.AttachTOTPResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.AttachTOTPRequest) *v1.AttachTOTPResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AttachTOTPResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.AttachTOTPRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_AttachTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachTOTP'
+type GophkeeperServiceServer_AttachTOTP_Call struct {
+	*mock.Call
+}
+
+// AttachTOTP is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.AttachTOTPRequest
+func (_e *GophkeeperServiceServer_Expecter) AttachTOTP(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_AttachTOTP_Call {
+	return &GophkeeperServiceServer_AttachTOTP_Call{Call: _e.mock.On("AttachTOTP", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_AttachTOTP_Call) Run(run func(_a0 context.Context, _a1 *v1.AttachTOTPRequest)) *GophkeeperServiceServer_AttachTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.AttachTOTPRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_AttachTOTP_Call) Return(_a0 *v1.AttachTOTPResponse, _a1 error) *GophkeeperServiceServer_AttachTOTP_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_AttachTOTP_Call) RunAndReturn(run func(context.Context, *v1.AttachTOTPRequest) (*v1.AttachTOTPResponse, error)) *GophkeeperServiceServer_AttachTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: _a0, _a1
 func (_m *GophkeeperServiceServer) Create(_a0 context.Context, _a1 *v1.CreateRequest) (*v1.CreateResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Create")
+	}
+
+	var r0 *v1.CreateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateRequest) (*v1.CreateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateRequest) *v1.CreateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.CreateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type GophkeeperServiceServer_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.CreateRequest
+func (_e *GophkeeperServiceServer_Expecter) Create(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Create_Call {
+	return &GophkeeperServiceServer_Create_Call{Call: _e.mock.On("Create", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_Create_Call) Run(run func(_a0 context.Context, _a1 *v1.CreateRequest)) *GophkeeperServiceServer_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.CreateRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Create_Call) Return(_a0 *v1.CreateResponse, _a1 error) *GophkeeperServiceServer_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Create_Call) RunAndReturn(run func(context.Context, *v1.CreateRequest) (*v1.CreateResponse, error)) *GophkeeperServiceServer_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) CreateAPIKey(_a0 context.Context, _a1 *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *v1.CreateAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateAPIKeyRequest) *v1.CreateAPIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.CreateAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type GophkeeperServiceServer_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.CreateAPIKeyRequest
+func (_e *GophkeeperServiceServer_Expecter) CreateAPIKey(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_CreateAPIKey_Call {
+	return &GophkeeperServiceServer_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_CreateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *v1.CreateAPIKeyRequest)) *GophkeeperServiceServer_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.CreateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateAPIKey_Call) Return(_a0 *v1.CreateAPIKeyResponse, _a1 error) *GophkeeperServiceServer_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error)) *GophkeeperServiceServer_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCollection provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) CreateCollection(_a0 context.Context, _a1 *v1.CreateCollectionRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollection")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateCollectionRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateCollectionRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
+type GophkeeperServiceServer_CreateCollection_Call struct {
+	*mock.Call
+}
+
+// CreateCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.CreateCollectionRequest
+func (_e *GophkeeperServiceServer_Expecter) CreateCollection(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_CreateCollection_Call {
+	return &GophkeeperServiceServer_CreateCollection_Call{Call: _e.mock.On("CreateCollection", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_CreateCollection_Call) Run(run func(_a0 context.Context, _a1 *v1.CreateCollectionRequest)) *GophkeeperServiceServer_CreateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.CreateCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_CreateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateCollection_Call) RunAndReturn(run func(context.Context, *v1.CreateCollectionRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_CreateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) CreateOrganization(_a0 context.Context, _a1 *v1.CreateOrganizationRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateOrganizationRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateOrganizationRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateOrganizationRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type GophkeeperServiceServer_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.CreateOrganizationRequest
+func (_e *GophkeeperServiceServer_Expecter) CreateOrganization(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_CreateOrganization_Call {
+	return &GophkeeperServiceServer_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_CreateOrganization_Call) Run(run func(_a0 context.Context, _a1 *v1.CreateOrganizationRequest)) *GophkeeperServiceServer_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.CreateOrganizationRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateOrganization_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_CreateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateOrganization_Call) RunAndReturn(run func(context.Context, *v1.CreateOrganizationRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServiceAccount provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) CreateServiceAccount(_a0 context.Context, _a1 *v1.CreateServiceAccountRequest) (*v1.ServiceAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServiceAccount")
+	}
+
+	var r0 *v1.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateServiceAccountRequest) (*v1.ServiceAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateServiceAccountRequest) *v1.ServiceAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateServiceAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_CreateServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServiceAccount'
+type GophkeeperServiceServer_CreateServiceAccount_Call struct {
+	*mock.Call
+}
+
+// CreateServiceAccount is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.CreateServiceAccountRequest
+func (_e *GophkeeperServiceServer_Expecter) CreateServiceAccount(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_CreateServiceAccount_Call {
+	return &GophkeeperServiceServer_CreateServiceAccount_Call{Call: _e.mock.On("CreateServiceAccount", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_CreateServiceAccount_Call) Run(run func(_a0 context.Context, _a1 *v1.CreateServiceAccountRequest)) *GophkeeperServiceServer_CreateServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.CreateServiceAccountRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateServiceAccount_Call) Return(_a0 *v1.ServiceAccountResponse, _a1 error) *GophkeeperServiceServer_CreateServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateServiceAccount_Call) RunAndReturn(run func(context.Context, *v1.CreateServiceAccountRequest) (*v1.ServiceAccountResponse, error)) *GophkeeperServiceServer_CreateServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTeam provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) CreateTeam(_a0 context.Context, _a1 *v1.CreateTeamRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeam")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateTeamRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateTeamRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateTeamRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
+type GophkeeperServiceServer_CreateTeam_Call struct {
+	*mock.Call
+}
+
+// CreateTeam is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.CreateTeamRequest
+func (_e *GophkeeperServiceServer_Expecter) CreateTeam(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_CreateTeam_Call {
+	return &GophkeeperServiceServer_CreateTeam_Call{Call: _e.mock.On("CreateTeam", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_CreateTeam_Call) Run(run func(_a0 context.Context, _a1 *v1.CreateTeamRequest)) *GophkeeperServiceServer_CreateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.CreateTeamRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateTeam_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_CreateTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_CreateTeam_Call) RunAndReturn(run func(context.Context, *v1.CreateTeamRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_CreateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) Delete(_a0 context.Context, _a1 *v1.DeleteRequest) (*v1.DeleteResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *v1.DeleteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteRequest) (*v1.DeleteResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteRequest) *v1.DeleteResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.DeleteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.DeleteRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type GophkeeperServiceServer_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.DeleteRequest
+func (_e *GophkeeperServiceServer_Expecter) Delete(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Delete_Call {
+	return &GophkeeperServiceServer_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_Delete_Call) Run(run func(_a0 context.Context, _a1 *v1.DeleteRequest)) *GophkeeperServiceServer_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.DeleteRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Delete_Call) Return(_a0 *v1.DeleteResponse, _a1 error) *GophkeeperServiceServer_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Delete_Call) RunAndReturn(run func(context.Context, *v1.DeleteRequest) (*v1.DeleteResponse, error)) *GophkeeperServiceServer_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServiceAccount provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) DeleteServiceAccount(_a0 context.Context, _a1 *v1.DeleteServiceAccountRequest) (*v1.ServiceAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServiceAccount")
+	}
+
+	var r0 *v1.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteServiceAccountRequest) (*v1.ServiceAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteServiceAccountRequest) *v1.ServiceAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.DeleteServiceAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_DeleteServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccount'
+type GophkeeperServiceServer_DeleteServiceAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteServiceAccount is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.DeleteServiceAccountRequest
+func (_e *GophkeeperServiceServer_Expecter) DeleteServiceAccount(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_DeleteServiceAccount_Call {
+	return &GophkeeperServiceServer_DeleteServiceAccount_Call{Call: _e.mock.On("DeleteServiceAccount", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_DeleteServiceAccount_Call) Run(run func(_a0 context.Context, _a1 *v1.DeleteServiceAccountRequest)) *GophkeeperServiceServer_DeleteServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.DeleteServiceAccountRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_DeleteServiceAccount_Call) Return(_a0 *v1.ServiceAccountResponse, _a1 error) *GophkeeperServiceServer_DeleteServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_DeleteServiceAccount_Call) RunAndReturn(run func(context.Context, *v1.DeleteServiceAccountRequest) (*v1.ServiceAccountResponse, error)) *GophkeeperServiceServer_DeleteServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Download provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) Download(_a0 *v1.DownloadRequest, _a1 grpc.ServerStreamingServer[v1.Chunk]) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Download")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*v1.DownloadRequest, grpc.ServerStreamingServer[v1.Chunk]) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GophkeeperServiceServer_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
+type GophkeeperServiceServer_Download_Call struct {
+	*mock.Call
+}
+
+// Download is a helper method to define mock.On call
+//   - _a0 *v1.DownloadRequest
+//   - _a1 grpc.ServerStreamingServer[v1.Chunk]
+func (_e *GophkeeperServiceServer_Expecter) Download(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Download_Call {
+	return &GophkeeperServiceServer_Download_Call{Call: _e.mock.On("Download", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_Download_Call) Run(run func(_a0 *v1.DownloadRequest, _a1 grpc.ServerStreamingServer[v1.Chunk])) *GophkeeperServiceServer_Download_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*v1.DownloadRequest), args[1].(grpc.ServerStreamingServer[v1.Chunk]))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Download_Call) Return(_a0 error) *GophkeeperServiceServer_Download_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Download_Call) RunAndReturn(run func(*v1.DownloadRequest, grpc.ServerStreamingServer[v1.Chunk]) error) *GophkeeperServiceServer_Download_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExchangeAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ExchangeAPIKey(_a0 context.Context, _a1 *v1.ExchangeAPIKeyRequest) (*v1.AuthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeAPIKey")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ExchangeAPIKeyRequest) (*v1.AuthResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ExchangeAPIKeyRequest) *v1.AuthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ExchangeAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ExchangeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeAPIKey'
+type GophkeeperServiceServer_ExchangeAPIKey_Call struct {
+	*mock.Call
+}
+
+// ExchangeAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ExchangeAPIKeyRequest
+func (_e *GophkeeperServiceServer_Expecter) ExchangeAPIKey(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ExchangeAPIKey_Call {
+	return &GophkeeperServiceServer_ExchangeAPIKey_Call{Call: _e.mock.On("ExchangeAPIKey", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ExchangeAPIKey_Call) Run(run func(_a0 context.Context, _a1 *v1.ExchangeAPIKeyRequest)) *GophkeeperServiceServer_ExchangeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ExchangeAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ExchangeAPIKey_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_ExchangeAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ExchangeAPIKey_Call) RunAndReturn(run func(context.Context, *v1.ExchangeAPIKeyRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_ExchangeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) Export(_a0 *v1.ExportRequest, _a1 grpc.ServerStreamingServer[v1.ExportItem]) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*v1.ExportRequest, grpc.ServerStreamingServer[v1.ExportItem]) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GophkeeperServiceServer_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type GophkeeperServiceServer_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - _a0 *v1.ExportRequest
+//   - _a1 grpc.ServerStreamingServer[v1.ExportItem]
+func (_e *GophkeeperServiceServer_Expecter) Export(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Export_Call {
+	return &GophkeeperServiceServer_Export_Call{Call: _e.mock.On("Export", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_Export_Call) Run(run func(_a0 *v1.ExportRequest, _a1 grpc.ServerStreamingServer[v1.ExportItem])) *GophkeeperServiceServer_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*v1.ExportRequest), args[1].(grpc.ServerStreamingServer[v1.ExportItem]))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Export_Call) Return(_a0 error) *GophkeeperServiceServer_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Export_Call) RunAndReturn(run func(*v1.ExportRequest, grpc.ServerStreamingServer[v1.ExportItem]) error) *GophkeeperServiceServer_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByURL provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) FindByURL(_a0 context.Context, _a1 *v1.FindByURLRequest) (*v1.FindByURLResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByURL")
+	}
+
+	var r0 *v1.FindByURLResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.FindByURLRequest) (*v1.FindByURLResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.FindByURLRequest) *v1.FindByURLResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.FindByURLResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.FindByURLRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_FindByURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByURL'
+type GophkeeperServiceServer_FindByURL_Call struct {
+	*mock.Call
+}
+
+// FindByURL is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.FindByURLRequest
+func (_e *GophkeeperServiceServer_Expecter) FindByURL(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_FindByURL_Call {
+	return &GophkeeperServiceServer_FindByURL_Call{Call: _e.mock.On("FindByURL", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_FindByURL_Call) Run(run func(_a0 context.Context, _a1 *v1.FindByURLRequest)) *GophkeeperServiceServer_FindByURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.FindByURLRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_FindByURL_Call) Return(_a0 *v1.FindByURLResponse, _a1 error) *GophkeeperServiceServer_FindByURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_FindByURL_Call) RunAndReturn(run func(context.Context, *v1.FindByURLRequest) (*v1.FindByURLResponse, error)) *GophkeeperServiceServer_FindByURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) Get(_a0 context.Context, _a1 *v1.GetRequest) (*v1.GetResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *v1.GetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetRequest) (*v1.GetResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetRequest) *v1.GetResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.GetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type GophkeeperServiceServer_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.GetRequest
+func (_e *GophkeeperServiceServer_Expecter) Get(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Get_Call {
+	return &GophkeeperServiceServer_Get_Call{Call: _e.mock.On("Get", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_Get_Call) Run(run func(_a0 context.Context, _a1 *v1.GetRequest)) *GophkeeperServiceServer_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.GetRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Get_Call) Return(_a0 *v1.GetResponse, _a1 error) *GophkeeperServiceServer_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Get_Call) RunAndReturn(run func(context.Context, *v1.GetRequest) (*v1.GetResponse, error)) *GophkeeperServiceServer_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJWKS provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) GetJWKS(_a0 context.Context, _a1 *v1.GetJWKSRequest) (*v1.GetJWKSResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJWKS")
+	}
+
+	var r0 *v1.GetJWKSResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetJWKSRequest) (*v1.GetJWKSResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetJWKSRequest) *v1.GetJWKSResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.GetJWKSResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetJWKSRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_GetJWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJWKS'
+type GophkeeperServiceServer_GetJWKS_Call struct {
+	*mock.Call
+}
+
+// GetJWKS is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.GetJWKSRequest
+func (_e *GophkeeperServiceServer_Expecter) GetJWKS(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_GetJWKS_Call {
+	return &GophkeeperServiceServer_GetJWKS_Call{Call: _e.mock.On("GetJWKS", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_GetJWKS_Call) Run(run func(_a0 context.Context, _a1 *v1.GetJWKSRequest)) *GophkeeperServiceServer_GetJWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.GetJWKSRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_GetJWKS_Call) Return(_a0 *v1.GetJWKSResponse, _a1 error) *GophkeeperServiceServer_GetJWKS_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_GetJWKS_Call) RunAndReturn(run func(context.Context, *v1.GetJWKSRequest) (*v1.GetJWKSResponse, error)) *GophkeeperServiceServer_GetJWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOIDCConfig provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) GetOIDCConfig(_a0 context.Context, _a1 *v1.GetOIDCConfigRequest) (*v1.GetOIDCConfigResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOIDCConfig")
+	}
+
+	var r0 *v1.GetOIDCConfigResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetOIDCConfigRequest) (*v1.GetOIDCConfigResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetOIDCConfigRequest) *v1.GetOIDCConfigResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.GetOIDCConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetOIDCConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_GetOIDCConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOIDCConfig'
+type GophkeeperServiceServer_GetOIDCConfig_Call struct {
+	*mock.Call
+}
+
+// GetOIDCConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.GetOIDCConfigRequest
+func (_e *GophkeeperServiceServer_Expecter) GetOIDCConfig(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_GetOIDCConfig_Call {
+	return &GophkeeperServiceServer_GetOIDCConfig_Call{Call: _e.mock.On("GetOIDCConfig", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_GetOIDCConfig_Call) Run(run func(_a0 context.Context, _a1 *v1.GetOIDCConfigRequest)) *GophkeeperServiceServer_GetOIDCConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.GetOIDCConfigRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_GetOIDCConfig_Call) Return(_a0 *v1.GetOIDCConfigResponse, _a1 error) *GophkeeperServiceServer_GetOIDCConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_GetOIDCConfig_Call) RunAndReturn(run func(context.Context, *v1.GetOIDCConfigRequest) (*v1.GetOIDCConfigResponse, error)) *GophkeeperServiceServer_GetOIDCConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecretType provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) GetSecretType(_a0 context.Context, _a1 *v1.GetSecretTypeRequest) (*v1.GetSecretTypeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecretType")
+	}
+
+	var r0 *v1.GetSecretTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetSecretTypeRequest) (*v1.GetSecretTypeResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetSecretTypeRequest) *v1.GetSecretTypeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.GetSecretTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetSecretTypeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_GetSecretType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecretType'
+type GophkeeperServiceServer_GetSecretType_Call struct {
+	*mock.Call
+}
+
+// GetSecretType is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.GetSecretTypeRequest
+func (_e *GophkeeperServiceServer_Expecter) GetSecretType(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_GetSecretType_Call {
+	return &GophkeeperServiceServer_GetSecretType_Call{Call: _e.mock.On("GetSecretType", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_GetSecretType_Call) Run(run func(_a0 context.Context, _a1 *v1.GetSecretTypeRequest)) *GophkeeperServiceServer_GetSecretType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.GetSecretTypeRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_GetSecretType_Call) Return(_a0 *v1.GetSecretTypeResponse, _a1 error) *GophkeeperServiceServer_GetSecretType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_GetSecretType_Call) RunAndReturn(run func(context.Context, *v1.GetSecretTypeRequest) (*v1.GetSecretTypeResponse, error)) *GophkeeperServiceServer_GetSecretType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) List(_a0 context.Context, _a1 *v1.ListRequest) (*v1.ListResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *v1.ListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListRequest) (*v1.ListResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListRequest) *v1.ListResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type GophkeeperServiceServer_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListRequest
+func (_e *GophkeeperServiceServer_Expecter) List(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_List_Call {
+	return &GophkeeperServiceServer_List_Call{Call: _e.mock.On("List", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_List_Call) Run(run func(_a0 context.Context, _a1 *v1.ListRequest)) *GophkeeperServiceServer_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_List_Call) Return(_a0 *v1.ListResponse, _a1 error) *GophkeeperServiceServer_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_List_Call) RunAndReturn(run func(context.Context, *v1.ListRequest) (*v1.ListResponse, error)) *GophkeeperServiceServer_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListAPIKeys(_a0 context.Context, _a1 *v1.ListAPIKeysRequest) (*v1.ListAPIKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 *v1.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListAPIKeysRequest) (*v1.ListAPIKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListAPIKeysRequest) *v1.ListAPIKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListAPIKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type GophkeeperServiceServer_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListAPIKeysRequest
+func (_e *GophkeeperServiceServer_Expecter) ListAPIKeys(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListAPIKeys_Call {
+	return &GophkeeperServiceServer_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListAPIKeys_Call) Run(run func(_a0 context.Context, _a1 *v1.ListAPIKeysRequest)) *GophkeeperServiceServer_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListAPIKeysRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListAPIKeys_Call) Return(_a0 *v1.ListAPIKeysResponse, _a1 error) *GophkeeperServiceServer_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *v1.ListAPIKeysRequest) (*v1.ListAPIKeysResponse, error)) *GophkeeperServiceServer_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuditEvents provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListAuditEvents(_a0 context.Context, _a1 *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 *v1.ListAuditEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListAuditEventsRequest) *v1.ListAuditEventsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListAuditEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListAuditEventsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type GophkeeperServiceServer_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListAuditEventsRequest
+func (_e *GophkeeperServiceServer_Expecter) ListAuditEvents(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListAuditEvents_Call {
+	return &GophkeeperServiceServer_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListAuditEvents_Call) Run(run func(_a0 context.Context, _a1 *v1.ListAuditEventsRequest)) *GophkeeperServiceServer_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListAuditEventsRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListAuditEvents_Call) Return(_a0 *v1.ListAuditEventsResponse, _a1 error) *GophkeeperServiceServer_ListAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListAuditEvents_Call) RunAndReturn(run func(context.Context, *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error)) *GophkeeperServiceServer_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCollections provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListCollections(_a0 context.Context, _a1 *v1.ListCollectionsRequest) (*v1.ListCollectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollections")
+	}
+
+	var r0 *v1.ListCollectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListCollectionsRequest) (*v1.ListCollectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListCollectionsRequest) *v1.ListCollectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListCollectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListCollectionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollections'
+type GophkeeperServiceServer_ListCollections_Call struct {
+	*mock.Call
+}
+
+// ListCollections is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListCollectionsRequest
+func (_e *GophkeeperServiceServer_Expecter) ListCollections(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListCollections_Call {
+	return &GophkeeperServiceServer_ListCollections_Call{Call: _e.mock.On("ListCollections", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListCollections_Call) Run(run func(_a0 context.Context, _a1 *v1.ListCollectionsRequest)) *GophkeeperServiceServer_ListCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListCollectionsRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListCollections_Call) Return(_a0 *v1.ListCollectionsResponse, _a1 error) *GophkeeperServiceServer_ListCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListCollections_Call) RunAndReturn(run func(context.Context, *v1.ListCollectionsRequest) (*v1.ListCollectionsResponse, error)) *GophkeeperServiceServer_ListCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListMembers(_a0 context.Context, _a1 *v1.ListMembersRequest) (*v1.ListMembersResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 *v1.ListMembersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListMembersRequest) (*v1.ListMembersResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListMembersRequest) *v1.ListMembersResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListMembersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListMembersRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type GophkeeperServiceServer_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListMembersRequest
+func (_e *GophkeeperServiceServer_Expecter) ListMembers(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListMembers_Call {
+	return &GophkeeperServiceServer_ListMembers_Call{Call: _e.mock.On("ListMembers", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListMembers_Call) Run(run func(_a0 context.Context, _a1 *v1.ListMembersRequest)) *GophkeeperServiceServer_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListMembersRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListMembers_Call) Return(_a0 *v1.ListMembersResponse, _a1 error) *GophkeeperServiceServer_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListMembers_Call) RunAndReturn(run func(context.Context, *v1.ListMembersRequest) (*v1.ListMembersResponse, error)) *GophkeeperServiceServer_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizations provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListOrganizations(_a0 context.Context, _a1 *v1.ListOrganizationsRequest) (*v1.ListOrganizationsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizations")
+	}
+
+	var r0 *v1.ListOrganizationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListOrganizationsRequest) (*v1.ListOrganizationsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListOrganizationsRequest) *v1.ListOrganizationsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListOrganizationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListOrganizationsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
+type GophkeeperServiceServer_ListOrganizations_Call struct {
+	*mock.Call
+}
+
+// ListOrganizations is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListOrganizationsRequest
+func (_e *GophkeeperServiceServer_Expecter) ListOrganizations(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListOrganizations_Call {
+	return &GophkeeperServiceServer_ListOrganizations_Call{Call: _e.mock.On("ListOrganizations", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListOrganizations_Call) Run(run func(_a0 context.Context, _a1 *v1.ListOrganizationsRequest)) *GophkeeperServiceServer_ListOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListOrganizationsRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListOrganizations_Call) Return(_a0 *v1.ListOrganizationsResponse, _a1 error) *GophkeeperServiceServer_ListOrganizations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListOrganizations_Call) RunAndReturn(run func(context.Context, *v1.ListOrganizationsRequest) (*v1.ListOrganizationsResponse, error)) *GophkeeperServiceServer_ListOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecretTypes provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListSecretTypes(_a0 context.Context, _a1 *v1.ListSecretTypesRequest) (*v1.ListSecretTypesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecretTypes")
+	}
+
+	var r0 *v1.ListSecretTypesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListSecretTypesRequest) (*v1.ListSecretTypesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListSecretTypesRequest) *v1.ListSecretTypesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListSecretTypesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListSecretTypesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListSecretTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretTypes'
+type GophkeeperServiceServer_ListSecretTypes_Call struct {
+	*mock.Call
+}
+
+// ListSecretTypes is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListSecretTypesRequest
+func (_e *GophkeeperServiceServer_Expecter) ListSecretTypes(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListSecretTypes_Call {
+	return &GophkeeperServiceServer_ListSecretTypes_Call{Call: _e.mock.On("ListSecretTypes", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListSecretTypes_Call) Run(run func(_a0 context.Context, _a1 *v1.ListSecretTypesRequest)) *GophkeeperServiceServer_ListSecretTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListSecretTypesRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListSecretTypes_Call) Return(_a0 *v1.ListSecretTypesResponse, _a1 error) *GophkeeperServiceServer_ListSecretTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListSecretTypes_Call) RunAndReturn(run func(context.Context, *v1.ListSecretTypesRequest) (*v1.ListSecretTypesResponse, error)) *GophkeeperServiceServer_ListSecretTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListServiceAccounts provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListServiceAccounts(_a0 context.Context, _a1 *v1.ListServiceAccountsRequest) (*v1.ListServiceAccountsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServiceAccounts")
+	}
+
+	var r0 *v1.ListServiceAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListServiceAccountsRequest) (*v1.ListServiceAccountsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListServiceAccountsRequest) *v1.ListServiceAccountsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListServiceAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListServiceAccountsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServiceAccounts'
+type GophkeeperServiceServer_ListServiceAccounts_Call struct {
+	*mock.Call
+}
+
+// ListServiceAccounts is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListServiceAccountsRequest
+func (_e *GophkeeperServiceServer_Expecter) ListServiceAccounts(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListServiceAccounts_Call {
+	return &GophkeeperServiceServer_ListServiceAccounts_Call{Call: _e.mock.On("ListServiceAccounts", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListServiceAccounts_Call) Run(run func(_a0 context.Context, _a1 *v1.ListServiceAccountsRequest)) *GophkeeperServiceServer_ListServiceAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListServiceAccountsRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListServiceAccounts_Call) Return(_a0 *v1.ListServiceAccountsResponse, _a1 error) *GophkeeperServiceServer_ListServiceAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListServiceAccounts_Call) RunAndReturn(run func(context.Context, *v1.ListServiceAccountsRequest) (*v1.ListServiceAccountsResponse, error)) *GophkeeperServiceServer_ListServiceAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListShares provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListShares(_a0 context.Context, _a1 *v1.ListSharesRequest) (*v1.ListSharesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListShares")
+	}
+
+	var r0 *v1.ListSharesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListSharesRequest) (*v1.ListSharesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListSharesRequest) *v1.ListSharesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListSharesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListSharesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListShares_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListShares'
+type GophkeeperServiceServer_ListShares_Call struct {
+	*mock.Call
+}
+
+// ListShares is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListSharesRequest
+func (_e *GophkeeperServiceServer_Expecter) ListShares(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListShares_Call {
+	return &GophkeeperServiceServer_ListShares_Call{Call: _e.mock.On("ListShares", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListShares_Call) Run(run func(_a0 context.Context, _a1 *v1.ListSharesRequest)) *GophkeeperServiceServer_ListShares_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListSharesRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListShares_Call) Return(_a0 *v1.ListSharesResponse, _a1 error) *GophkeeperServiceServer_ListShares_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListShares_Call) RunAndReturn(run func(context.Context, *v1.ListSharesRequest) (*v1.ListSharesResponse, error)) *GophkeeperServiceServer_ListShares_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTeams provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) ListTeams(_a0 context.Context, _a1 *v1.ListTeamsRequest) (*v1.ListTeamsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeams")
+	}
+
+	var r0 *v1.ListTeamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListTeamsRequest) (*v1.ListTeamsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListTeamsRequest) *v1.ListTeamsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListTeamsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListTeamsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_ListTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeams'
+type GophkeeperServiceServer_ListTeams_Call struct {
+	*mock.Call
+}
+
+// ListTeams is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.ListTeamsRequest
+func (_e *GophkeeperServiceServer_Expecter) ListTeams(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_ListTeams_Call {
+	return &GophkeeperServiceServer_ListTeams_Call{Call: _e.mock.On("ListTeams", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_ListTeams_Call) Run(run func(_a0 context.Context, _a1 *v1.ListTeamsRequest)) *GophkeeperServiceServer_ListTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.ListTeamsRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListTeams_Call) Return(_a0 *v1.ListTeamsResponse, _a1 error) *GophkeeperServiceServer_ListTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_ListTeams_Call) RunAndReturn(run func(context.Context, *v1.ListTeamsRequest) (*v1.ListTeamsResponse, error)) *GophkeeperServiceServer_ListTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) Login(_a0 context.Context, _a1 *v1.LoginRequest) (*v1.AuthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.LoginRequest) (*v1.AuthResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.LoginRequest) *v1.AuthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.LoginRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type GophkeeperServiceServer_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.LoginRequest
+func (_e *GophkeeperServiceServer_Expecter) Login(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Login_Call {
+	return &GophkeeperServiceServer_Login_Call{Call: _e.mock.On("Login", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_Login_Call) Run(run func(_a0 context.Context, _a1 *v1.LoginRequest)) *GophkeeperServiceServer_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.LoginRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Login_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Login_Call) RunAndReturn(run func(context.Context, *v1.LoginRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OIDCLogin provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) OIDCLogin(_a0 context.Context, _a1 *v1.OIDCLoginRequest) (*v1.AuthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OIDCLogin")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.OIDCLoginRequest) (*v1.AuthResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.OIDCLoginRequest) *v1.AuthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.OIDCLoginRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_OIDCLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OIDCLogin'
+type GophkeeperServiceServer_OIDCLogin_Call struct {
+	*mock.Call
+}
+
+// OIDCLogin is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.OIDCLoginRequest
+func (_e *GophkeeperServiceServer_Expecter) OIDCLogin(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_OIDCLogin_Call {
+	return &GophkeeperServiceServer_OIDCLogin_Call{Call: _e.mock.On("OIDCLogin", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_OIDCLogin_Call) Run(run func(_a0 context.Context, _a1 *v1.OIDCLoginRequest)) *GophkeeperServiceServer_OIDCLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.OIDCLoginRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_OIDCLogin_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_OIDCLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_OIDCLogin_Call) RunAndReturn(run func(context.Context, *v1.OIDCLoginRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_OIDCLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshToken provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) RefreshToken(_a0 context.Context, _a1 *v1.RefreshTokenRequest) (*v1.AuthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshToken")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RefreshTokenRequest) (*v1.AuthResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RefreshTokenRequest) *v1.AuthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RefreshTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
+type GophkeeperServiceServer_RefreshToken_Call struct {
+	*mock.Call
+}
+
+// RefreshToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.RefreshTokenRequest
+func (_e *GophkeeperServiceServer_Expecter) RefreshToken(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_RefreshToken_Call {
+	return &GophkeeperServiceServer_RefreshToken_Call{Call: _e.mock.On("RefreshToken", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_RefreshToken_Call) Run(run func(_a0 context.Context, _a1 *v1.RefreshTokenRequest)) *GophkeeperServiceServer_RefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.RefreshTokenRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RefreshToken_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_RefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RefreshToken_Call) RunAndReturn(run func(context.Context, *v1.RefreshTokenRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_RefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) Register(_a0 context.Context, _a1 *v1.RegisterRequest) (*v1.AuthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 *v1.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterRequest) (*v1.AuthResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterRequest) *v1.AuthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RegisterRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type GophkeeperServiceServer_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.RegisterRequest
+func (_e *GophkeeperServiceServer_Expecter) Register(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Register_Call {
+	return &GophkeeperServiceServer_Register_Call{Call: _e.mock.On("Register", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_Register_Call) Run(run func(_a0 context.Context, _a1 *v1.RegisterRequest)) *GophkeeperServiceServer_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.RegisterRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Register_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_Register_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Register_Call) RunAndReturn(run func(context.Context, *v1.RegisterRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterSecretType provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) RegisterSecretType(_a0 context.Context, _a1 *v1.RegisterSecretTypeRequest) (*v1.RegisterSecretTypeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterSecretType")
+	}
+
+	var r0 *v1.RegisterSecretTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterSecretTypeRequest) (*v1.RegisterSecretTypeResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterSecretTypeRequest) *v1.RegisterSecretTypeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RegisterSecretTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RegisterSecretTypeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_RegisterSecretType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterSecretType'
+type GophkeeperServiceServer_RegisterSecretType_Call struct {
+	*mock.Call
+}
+
+// RegisterSecretType is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.RegisterSecretTypeRequest
+func (_e *GophkeeperServiceServer_Expecter) RegisterSecretType(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_RegisterSecretType_Call {
+	return &GophkeeperServiceServer_RegisterSecretType_Call{Call: _e.mock.On("RegisterSecretType", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_RegisterSecretType_Call) Run(run func(_a0 context.Context, _a1 *v1.RegisterSecretTypeRequest)) *GophkeeperServiceServer_RegisterSecretType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.RegisterSecretTypeRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RegisterSecretType_Call) Return(_a0 *v1.RegisterSecretTypeResponse, _a1 error) *GophkeeperServiceServer_RegisterSecretType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RegisterSecretType_Call) RunAndReturn(run func(context.Context, *v1.RegisterSecretTypeRequest) (*v1.RegisterSecretTypeResponse, error)) *GophkeeperServiceServer_RegisterSecretType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromCollection provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) RemoveFromCollection(_a0 context.Context, _a1 *v1.RemoveFromCollectionRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromCollection")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveFromCollectionRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveFromCollectionRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RemoveFromCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_RemoveFromCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromCollection'
+type GophkeeperServiceServer_RemoveFromCollection_Call struct {
+	*mock.Call
+}
+
+// RemoveFromCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.RemoveFromCollectionRequest
+func (_e *GophkeeperServiceServer_Expecter) RemoveFromCollection(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_RemoveFromCollection_Call {
+	return &GophkeeperServiceServer_RemoveFromCollection_Call{Call: _e.mock.On("RemoveFromCollection", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_RemoveFromCollection_Call) Run(run func(_a0 context.Context, _a1 *v1.RemoveFromCollectionRequest)) *GophkeeperServiceServer_RemoveFromCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.RemoveFromCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RemoveFromCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_RemoveFromCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RemoveFromCollection_Call) RunAndReturn(run func(context.Context, *v1.RemoveFromCollectionRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_RemoveFromCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) RemoveMember(_a0 context.Context, _a1 *v1.RemoveMemberRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveMemberRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveMemberRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RemoveMemberRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type GophkeeperServiceServer_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.RemoveMemberRequest
+func (_e *GophkeeperServiceServer_Expecter) RemoveMember(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_RemoveMember_Call {
+	return &GophkeeperServiceServer_RemoveMember_Call{Call: _e.mock.On("RemoveMember", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_RemoveMember_Call) Run(run func(_a0 context.Context, _a1 *v1.RemoveMemberRequest)) *GophkeeperServiceServer_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.RemoveMemberRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RemoveMember_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_RemoveMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RemoveMember_Call) RunAndReturn(run func(context.Context, *v1.RemoveMemberRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMember provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) RemoveTeamMember(_a0 context.Context, _a1 *v1.RemoveTeamMemberRequest) (*v1.OrganizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMember")
+	}
+
+	var r0 *v1.OrganizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveTeamMemberRequest) (*v1.OrganizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RemoveTeamMemberRequest) *v1.OrganizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RemoveTeamMemberRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
+type GophkeeperServiceServer_RemoveTeamMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMember is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.RemoveTeamMemberRequest
+func (_e *GophkeeperServiceServer_Expecter) RemoveTeamMember(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_RemoveTeamMember_Call {
+	return &GophkeeperServiceServer_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_RemoveTeamMember_Call) Run(run func(_a0 context.Context, _a1 *v1.RemoveTeamMemberRequest)) *GophkeeperServiceServer_RemoveTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.RemoveTeamMemberRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RemoveTeamMember_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_RemoveTeamMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_RemoveTeamMember_Call) RunAndReturn(run func(context.Context, *v1.RemoveTeamMemberRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_RemoveTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) RevokeAPIKey(_a0 context.Context, _a1 *v1.RevokeAPIKeyRequest) (*v1.ServiceAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
 	}
 
-	var r0 *v1.CreateResponse
+	var r0 *v1.ServiceAccountResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateRequest) (*v1.CreateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RevokeAPIKeyRequest) (*v1.ServiceAccountResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.CreateRequest) *v1.CreateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RevokeAPIKeyRequest) *v1.ServiceAccountResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.CreateResponse)
+			r0 = ret.Get(0).(*v1.ServiceAccountResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.CreateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RevokeAPIKeyRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -54,57 +2272,57 @@ func (_m *GophkeeperServiceServer) Create(_a0 context.Context, _a1 *v1.CreateReq
 	return r0, r1
 }
 
-// GophkeeperServiceServer_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type GophkeeperServiceServer_Create_Call struct {
+// GophkeeperServiceServer_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type GophkeeperServiceServer_RevokeAPIKey_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// RevokeAPIKey is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *v1.CreateRequest
-func (_e *GophkeeperServiceServer_Expecter) Create(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Create_Call {
-	return &GophkeeperServiceServer_Create_Call{Call: _e.mock.On("Create", _a0, _a1)}
+//   - _a1 *v1.RevokeAPIKeyRequest
+func (_e *GophkeeperServiceServer_Expecter) RevokeAPIKey(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_RevokeAPIKey_Call {
+	return &GophkeeperServiceServer_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", _a0, _a1)}
 }
 
-func (_c *GophkeeperServiceServer_Create_Call) Run(run func(_a0 context.Context, _a1 *v1.CreateRequest)) *GophkeeperServiceServer_Create_Call {
+func (_c *GophkeeperServiceServer_RevokeAPIKey_Call) Run(run func(_a0 context.Context, _a1 *v1.RevokeAPIKeyRequest)) *GophkeeperServiceServer_RevokeAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v1.CreateRequest))
+		run(args[0].(context.Context), args[1].(*v1.RevokeAPIKeyRequest))
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Create_Call) Return(_a0 *v1.CreateResponse, _a1 error) *GophkeeperServiceServer_Create_Call {
+func (_c *GophkeeperServiceServer_RevokeAPIKey_Call) Return(_a0 *v1.ServiceAccountResponse, _a1 error) *GophkeeperServiceServer_RevokeAPIKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Create_Call) RunAndReturn(run func(context.Context, *v1.CreateRequest) (*v1.CreateResponse, error)) *GophkeeperServiceServer_Create_Call {
+func (_c *GophkeeperServiceServer_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *v1.RevokeAPIKeyRequest) (*v1.ServiceAccountResponse, error)) *GophkeeperServiceServer_RevokeAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: _a0, _a1
-func (_m *GophkeeperServiceServer) Delete(_a0 context.Context, _a1 *v1.DeleteRequest) (*v1.DeleteResponse, error) {
+// RotateSigningKey provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) RotateSigningKey(_a0 context.Context, _a1 *v1.RotateSigningKeyRequest) (*v1.RotateSigningKeyResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for RotateSigningKey")
 	}
 
-	var r0 *v1.DeleteResponse
+	var r0 *v1.RotateSigningKeyResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteRequest) (*v1.DeleteResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RotateSigningKeyRequest) (*v1.RotateSigningKeyResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.DeleteRequest) *v1.DeleteResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.RotateSigningKeyRequest) *v1.RotateSigningKeyResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.DeleteResponse)
+			r0 = ret.Get(0).(*v1.RotateSigningKeyResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.DeleteRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.RotateSigningKeyRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -113,104 +2331,116 @@ func (_m *GophkeeperServiceServer) Delete(_a0 context.Context, _a1 *v1.DeleteReq
 	return r0, r1
 }
 
-// GophkeeperServiceServer_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type GophkeeperServiceServer_Delete_Call struct {
+// GophkeeperServiceServer_RotateSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSigningKey'
+type GophkeeperServiceServer_RotateSigningKey_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// RotateSigningKey is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *v1.DeleteRequest
-func (_e *GophkeeperServiceServer_Expecter) Delete(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Delete_Call {
-	return &GophkeeperServiceServer_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1)}
+//   - _a1 *v1.RotateSigningKeyRequest
+func (_e *GophkeeperServiceServer_Expecter) RotateSigningKey(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_RotateSigningKey_Call {
+	return &GophkeeperServiceServer_RotateSigningKey_Call{Call: _e.mock.On("RotateSigningKey", _a0, _a1)}
 }
 
-func (_c *GophkeeperServiceServer_Delete_Call) Run(run func(_a0 context.Context, _a1 *v1.DeleteRequest)) *GophkeeperServiceServer_Delete_Call {
+func (_c *GophkeeperServiceServer_RotateSigningKey_Call) Run(run func(_a0 context.Context, _a1 *v1.RotateSigningKeyRequest)) *GophkeeperServiceServer_RotateSigningKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v1.DeleteRequest))
+		run(args[0].(context.Context), args[1].(*v1.RotateSigningKeyRequest))
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Delete_Call) Return(_a0 *v1.DeleteResponse, _a1 error) *GophkeeperServiceServer_Delete_Call {
+func (_c *GophkeeperServiceServer_RotateSigningKey_Call) Return(_a0 *v1.RotateSigningKeyResponse, _a1 error) *GophkeeperServiceServer_RotateSigningKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Delete_Call) RunAndReturn(run func(context.Context, *v1.DeleteRequest) (*v1.DeleteResponse, error)) *GophkeeperServiceServer_Delete_Call {
+func (_c *GophkeeperServiceServer_RotateSigningKey_Call) RunAndReturn(run func(context.Context, *v1.RotateSigningKeyRequest) (*v1.RotateSigningKeyResponse, error)) *GophkeeperServiceServer_RotateSigningKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Download provides a mock function with given fields: _a0, _a1
-func (_m *GophkeeperServiceServer) Download(_a0 *v1.DownloadRequest, _a1 grpc.ServerStreamingServer[v1.Chunk]) error {
+// SRPBegin provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) SRPBegin(_a0 context.Context, _a1 *v1.SRPBeginRequest) (*v1.SRPBeginResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Download")
+		panic("no return value specified for SRPBegin")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*v1.DownloadRequest, grpc.ServerStreamingServer[v1.Chunk]) error); ok {
+	var r0 *v1.SRPBeginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPBeginRequest) (*v1.SRPBeginResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPBeginRequest) *v1.SRPBeginResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.SRPBeginResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.SRPBeginRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// GophkeeperServiceServer_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
-type GophkeeperServiceServer_Download_Call struct {
+// GophkeeperServiceServer_SRPBegin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SRPBegin'
+type GophkeeperServiceServer_SRPBegin_Call struct {
 	*mock.Call
 }
 
-// Download is a helper method to define mock.On call
-//   - _a0 *v1.DownloadRequest
-//   - _a1 grpc.ServerStreamingServer[v1.Chunk]
-func (_e *GophkeeperServiceServer_Expecter) Download(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Download_Call {
-	return &GophkeeperServiceServer_Download_Call{Call: _e.mock.On("Download", _a0, _a1)}
+// SRPBegin is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.SRPBeginRequest
+func (_e *GophkeeperServiceServer_Expecter) SRPBegin(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_SRPBegin_Call {
+	return &GophkeeperServiceServer_SRPBegin_Call{Call: _e.mock.On("SRPBegin", _a0, _a1)}
 }
 
-func (_c *GophkeeperServiceServer_Download_Call) Run(run func(_a0 *v1.DownloadRequest, _a1 grpc.ServerStreamingServer[v1.Chunk])) *GophkeeperServiceServer_Download_Call {
+func (_c *GophkeeperServiceServer_SRPBegin_Call) Run(run func(_a0 context.Context, _a1 *v1.SRPBeginRequest)) *GophkeeperServiceServer_SRPBegin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*v1.DownloadRequest), args[1].(grpc.ServerStreamingServer[v1.Chunk]))
+		run(args[0].(context.Context), args[1].(*v1.SRPBeginRequest))
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Download_Call) Return(_a0 error) *GophkeeperServiceServer_Download_Call {
-	_c.Call.Return(_a0)
+func (_c *GophkeeperServiceServer_SRPBegin_Call) Return(_a0 *v1.SRPBeginResponse, _a1 error) *GophkeeperServiceServer_SRPBegin_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Download_Call) RunAndReturn(run func(*v1.DownloadRequest, grpc.ServerStreamingServer[v1.Chunk]) error) *GophkeeperServiceServer_Download_Call {
+func (_c *GophkeeperServiceServer_SRPBegin_Call) RunAndReturn(run func(context.Context, *v1.SRPBeginRequest) (*v1.SRPBeginResponse, error)) *GophkeeperServiceServer_SRPBegin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function with given fields: _a0, _a1
-func (_m *GophkeeperServiceServer) Get(_a0 context.Context, _a1 *v1.GetRequest) (*v1.GetResponse, error) {
+// SRPFinish provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) SRPFinish(_a0 context.Context, _a1 *v1.SRPFinishRequest) (*v1.SRPFinishResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for SRPFinish")
 	}
 
-	var r0 *v1.GetResponse
+	var r0 *v1.SRPFinishResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetRequest) (*v1.GetResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPFinishRequest) (*v1.SRPFinishResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.GetRequest) *v1.GetResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPFinishRequest) *v1.SRPFinishResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.GetResponse)
+			r0 = ret.Get(0).(*v1.SRPFinishResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.GetRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.SRPFinishRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -219,57 +2449,57 @@ func (_m *GophkeeperServiceServer) Get(_a0 context.Context, _a1 *v1.GetRequest)
 	return r0, r1
 }
 
-// GophkeeperServiceServer_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type GophkeeperServiceServer_Get_Call struct {
+// GophkeeperServiceServer_SRPFinish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SRPFinish'
+type GophkeeperServiceServer_SRPFinish_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
+// SRPFinish is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *v1.GetRequest
-func (_e *GophkeeperServiceServer_Expecter) Get(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Get_Call {
-	return &GophkeeperServiceServer_Get_Call{Call: _e.mock.On("Get", _a0, _a1)}
+//   - _a1 *v1.SRPFinishRequest
+func (_e *GophkeeperServiceServer_Expecter) SRPFinish(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_SRPFinish_Call {
+	return &GophkeeperServiceServer_SRPFinish_Call{Call: _e.mock.On("SRPFinish", _a0, _a1)}
 }
 
-func (_c *GophkeeperServiceServer_Get_Call) Run(run func(_a0 context.Context, _a1 *v1.GetRequest)) *GophkeeperServiceServer_Get_Call {
+func (_c *GophkeeperServiceServer_SRPFinish_Call) Run(run func(_a0 context.Context, _a1 *v1.SRPFinishRequest)) *GophkeeperServiceServer_SRPFinish_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v1.GetRequest))
+		run(args[0].(context.Context), args[1].(*v1.SRPFinishRequest))
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Get_Call) Return(_a0 *v1.GetResponse, _a1 error) *GophkeeperServiceServer_Get_Call {
+func (_c *GophkeeperServiceServer_SRPFinish_Call) Return(_a0 *v1.SRPFinishResponse, _a1 error) *GophkeeperServiceServer_SRPFinish_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Get_Call) RunAndReturn(run func(context.Context, *v1.GetRequest) (*v1.GetResponse, error)) *GophkeeperServiceServer_Get_Call {
+func (_c *GophkeeperServiceServer_SRPFinish_Call) RunAndReturn(run func(context.Context, *v1.SRPFinishRequest) (*v1.SRPFinishResponse, error)) *GophkeeperServiceServer_SRPFinish_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function with given fields: _a0, _a1
-func (_m *GophkeeperServiceServer) List(_a0 context.Context, _a1 *v1.ListRequest) (*v1.ListResponse, error) {
+// SRPRegister provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) SRPRegister(_a0 context.Context, _a1 *v1.SRPRegisterRequest) (*v1.AuthResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for SRPRegister")
 	}
 
-	var r0 *v1.ListResponse
+	var r0 *v1.AuthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListRequest) (*v1.ListResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPRegisterRequest) (*v1.AuthResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.ListRequest) *v1.ListResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SRPRegisterRequest) *v1.AuthResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.ListResponse)
+			r0 = ret.Get(0).(*v1.AuthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.ListRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.SRPRegisterRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -278,57 +2508,57 @@ func (_m *GophkeeperServiceServer) List(_a0 context.Context, _a1 *v1.ListRequest
 	return r0, r1
 }
 
-// GophkeeperServiceServer_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type GophkeeperServiceServer_List_Call struct {
+// GophkeeperServiceServer_SRPRegister_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SRPRegister'
+type GophkeeperServiceServer_SRPRegister_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// SRPRegister is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *v1.ListRequest
-func (_e *GophkeeperServiceServer_Expecter) List(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_List_Call {
-	return &GophkeeperServiceServer_List_Call{Call: _e.mock.On("List", _a0, _a1)}
+//   - _a1 *v1.SRPRegisterRequest
+func (_e *GophkeeperServiceServer_Expecter) SRPRegister(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_SRPRegister_Call {
+	return &GophkeeperServiceServer_SRPRegister_Call{Call: _e.mock.On("SRPRegister", _a0, _a1)}
 }
 
-func (_c *GophkeeperServiceServer_List_Call) Run(run func(_a0 context.Context, _a1 *v1.ListRequest)) *GophkeeperServiceServer_List_Call {
+func (_c *GophkeeperServiceServer_SRPRegister_Call) Run(run func(_a0 context.Context, _a1 *v1.SRPRegisterRequest)) *GophkeeperServiceServer_SRPRegister_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v1.ListRequest))
+		run(args[0].(context.Context), args[1].(*v1.SRPRegisterRequest))
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_List_Call) Return(_a0 *v1.ListResponse, _a1 error) *GophkeeperServiceServer_List_Call {
+func (_c *GophkeeperServiceServer_SRPRegister_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_SRPRegister_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_List_Call) RunAndReturn(run func(context.Context, *v1.ListRequest) (*v1.ListResponse, error)) *GophkeeperServiceServer_List_Call {
+func (_c *GophkeeperServiceServer_SRPRegister_Call) RunAndReturn(run func(context.Context, *v1.SRPRegisterRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_SRPRegister_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function with given fields: _a0, _a1
-func (_m *GophkeeperServiceServer) Login(_a0 context.Context, _a1 *v1.LoginRequest) (*v1.AuthResponse, error) {
+// SetMember provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) SetMember(_a0 context.Context, _a1 *v1.SetMemberRequest) (*v1.OrganizationResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for SetMember")
 	}
 
-	var r0 *v1.AuthResponse
+	var r0 *v1.OrganizationResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.LoginRequest) (*v1.AuthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SetMemberRequest) (*v1.OrganizationResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.LoginRequest) *v1.AuthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.SetMemberRequest) *v1.OrganizationResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.AuthResponse)
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.LoginRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.SetMemberRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -337,57 +2567,57 @@ func (_m *GophkeeperServiceServer) Login(_a0 context.Context, _a1 *v1.LoginReque
 	return r0, r1
 }
 
-// GophkeeperServiceServer_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type GophkeeperServiceServer_Login_Call struct {
+// GophkeeperServiceServer_SetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMember'
+type GophkeeperServiceServer_SetMember_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// SetMember is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *v1.LoginRequest
-func (_e *GophkeeperServiceServer_Expecter) Login(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Login_Call {
-	return &GophkeeperServiceServer_Login_Call{Call: _e.mock.On("Login", _a0, _a1)}
+//   - _a1 *v1.SetMemberRequest
+func (_e *GophkeeperServiceServer_Expecter) SetMember(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_SetMember_Call {
+	return &GophkeeperServiceServer_SetMember_Call{Call: _e.mock.On("SetMember", _a0, _a1)}
 }
 
-func (_c *GophkeeperServiceServer_Login_Call) Run(run func(_a0 context.Context, _a1 *v1.LoginRequest)) *GophkeeperServiceServer_Login_Call {
+func (_c *GophkeeperServiceServer_SetMember_Call) Run(run func(_a0 context.Context, _a1 *v1.SetMemberRequest)) *GophkeeperServiceServer_SetMember_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v1.LoginRequest))
+		run(args[0].(context.Context), args[1].(*v1.SetMemberRequest))
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Login_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_Login_Call {
+func (_c *GophkeeperServiceServer_SetMember_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_SetMember_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Login_Call) RunAndReturn(run func(context.Context, *v1.LoginRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_Login_Call {
+func (_c *GophkeeperServiceServer_SetMember_Call) RunAndReturn(run func(context.Context, *v1.SetMemberRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_SetMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RefreshToken provides a mock function with given fields: _a0, _a1
-func (_m *GophkeeperServiceServer) RefreshToken(_a0 context.Context, _a1 *v1.RefreshTokenRequest) (*v1.AuthResponse, error) {
+// Share provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) Share(_a0 context.Context, _a1 *v1.ShareRequest) (*v1.ShareResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RefreshToken")
+		panic("no return value specified for Share")
 	}
 
-	var r0 *v1.AuthResponse
+	var r0 *v1.ShareResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.RefreshTokenRequest) (*v1.AuthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ShareRequest) (*v1.ShareResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.RefreshTokenRequest) *v1.AuthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ShareRequest) *v1.ShareResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.AuthResponse)
+			r0 = ret.Get(0).(*v1.ShareResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.RefreshTokenRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ShareRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -396,57 +2626,57 @@ func (_m *GophkeeperServiceServer) RefreshToken(_a0 context.Context, _a1 *v1.Ref
 	return r0, r1
 }
 
-// GophkeeperServiceServer_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
-type GophkeeperServiceServer_RefreshToken_Call struct {
+// GophkeeperServiceServer_Share_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Share'
+type GophkeeperServiceServer_Share_Call struct {
 	*mock.Call
 }
 
-// RefreshToken is a helper method to define mock.On call
+// Share is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *v1.RefreshTokenRequest
-func (_e *GophkeeperServiceServer_Expecter) RefreshToken(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_RefreshToken_Call {
-	return &GophkeeperServiceServer_RefreshToken_Call{Call: _e.mock.On("RefreshToken", _a0, _a1)}
+//   - _a1 *v1.ShareRequest
+func (_e *GophkeeperServiceServer_Expecter) Share(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Share_Call {
+	return &GophkeeperServiceServer_Share_Call{Call: _e.mock.On("Share", _a0, _a1)}
 }
 
-func (_c *GophkeeperServiceServer_RefreshToken_Call) Run(run func(_a0 context.Context, _a1 *v1.RefreshTokenRequest)) *GophkeeperServiceServer_RefreshToken_Call {
+func (_c *GophkeeperServiceServer_Share_Call) Run(run func(_a0 context.Context, _a1 *v1.ShareRequest)) *GophkeeperServiceServer_Share_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v1.RefreshTokenRequest))
+		run(args[0].(context.Context), args[1].(*v1.ShareRequest))
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_RefreshToken_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_RefreshToken_Call {
+func (_c *GophkeeperServiceServer_Share_Call) Return(_a0 *v1.ShareResponse, _a1 error) *GophkeeperServiceServer_Share_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_RefreshToken_Call) RunAndReturn(run func(context.Context, *v1.RefreshTokenRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_RefreshToken_Call {
+func (_c *GophkeeperServiceServer_Share_Call) RunAndReturn(run func(context.Context, *v1.ShareRequest) (*v1.ShareResponse, error)) *GophkeeperServiceServer_Share_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields: _a0, _a1
-func (_m *GophkeeperServiceServer) Register(_a0 context.Context, _a1 *v1.RegisterRequest) (*v1.AuthResponse, error) {
+// UnassignCollection provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) UnassignCollection(_a0 context.Context, _a1 *v1.UnassignCollectionRequest) (*v1.OrganizationResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Register")
+		panic("no return value specified for UnassignCollection")
 	}
 
-	var r0 *v1.AuthResponse
+	var r0 *v1.OrganizationResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterRequest) (*v1.AuthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.UnassignCollectionRequest) (*v1.OrganizationResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1.RegisterRequest) *v1.AuthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.UnassignCollectionRequest) *v1.OrganizationResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.AuthResponse)
+			r0 = ret.Get(0).(*v1.OrganizationResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1.RegisterRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.UnassignCollectionRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -455,31 +2685,90 @@ func (_m *GophkeeperServiceServer) Register(_a0 context.Context, _a1 *v1.Registe
 	return r0, r1
 }
 
-// GophkeeperServiceServer_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
-type GophkeeperServiceServer_Register_Call struct {
+// GophkeeperServiceServer_UnassignCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignCollection'
+type GophkeeperServiceServer_UnassignCollection_Call struct {
 	*mock.Call
 }
 
-// Register is a helper method to define mock.On call
+// UnassignCollection is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *v1.RegisterRequest
-func (_e *GophkeeperServiceServer_Expecter) Register(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Register_Call {
-	return &GophkeeperServiceServer_Register_Call{Call: _e.mock.On("Register", _a0, _a1)}
+//   - _a1 *v1.UnassignCollectionRequest
+func (_e *GophkeeperServiceServer_Expecter) UnassignCollection(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_UnassignCollection_Call {
+	return &GophkeeperServiceServer_UnassignCollection_Call{Call: _e.mock.On("UnassignCollection", _a0, _a1)}
 }
 
-func (_c *GophkeeperServiceServer_Register_Call) Run(run func(_a0 context.Context, _a1 *v1.RegisterRequest)) *GophkeeperServiceServer_Register_Call {
+func (_c *GophkeeperServiceServer_UnassignCollection_Call) Run(run func(_a0 context.Context, _a1 *v1.UnassignCollectionRequest)) *GophkeeperServiceServer_UnassignCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v1.RegisterRequest))
+		run(args[0].(context.Context), args[1].(*v1.UnassignCollectionRequest))
 	})
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Register_Call) Return(_a0 *v1.AuthResponse, _a1 error) *GophkeeperServiceServer_Register_Call {
+func (_c *GophkeeperServiceServer_UnassignCollection_Call) Return(_a0 *v1.OrganizationResponse, _a1 error) *GophkeeperServiceServer_UnassignCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GophkeeperServiceServer_Register_Call) RunAndReturn(run func(context.Context, *v1.RegisterRequest) (*v1.AuthResponse, error)) *GophkeeperServiceServer_Register_Call {
+func (_c *GophkeeperServiceServer_UnassignCollection_Call) RunAndReturn(run func(context.Context, *v1.UnassignCollectionRequest) (*v1.OrganizationResponse, error)) *GophkeeperServiceServer_UnassignCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unshare provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) Unshare(_a0 context.Context, _a1 *v1.UnshareRequest) (*v1.UnshareResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unshare")
+	}
+
+	var r0 *v1.UnshareResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.UnshareRequest) (*v1.UnshareResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.UnshareRequest) *v1.UnshareResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.UnshareResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.UnshareRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_Unshare_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unshare'
+type GophkeeperServiceServer_Unshare_Call struct {
+	*mock.Call
+}
+
+// Unshare is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.UnshareRequest
+func (_e *GophkeeperServiceServer_Expecter) Unshare(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_Unshare_Call {
+	return &GophkeeperServiceServer_Unshare_Call{Call: _e.mock.On("Unshare", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_Unshare_Call) Run(run func(_a0 context.Context, _a1 *v1.UnshareRequest)) *GophkeeperServiceServer_Unshare_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.UnshareRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Unshare_Call) Return(_a0 *v1.UnshareResponse, _a1 error) *GophkeeperServiceServer_Unshare_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_Unshare_Call) RunAndReturn(run func(context.Context, *v1.UnshareRequest) (*v1.UnshareResponse, error)) *GophkeeperServiceServer_Unshare_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -530,6 +2819,65 @@ func (_c *GophkeeperServiceServer_Upload_Call) RunAndReturn(run func(grpc.Client
 	return _c
 }
 
+// VerifyAuditLog provides a mock function with given fields: _a0, _a1
+func (_m *GophkeeperServiceServer) VerifyAuditLog(_a0 context.Context, _a1 *v1.VerifyAuditLogRequest) (*v1.VerifyAuditLogResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyAuditLog")
+	}
+
+	var r0 *v1.VerifyAuditLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.VerifyAuditLogRequest) (*v1.VerifyAuditLogResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.VerifyAuditLogRequest) *v1.VerifyAuditLogResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.VerifyAuditLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.VerifyAuditLogRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GophkeeperServiceServer_VerifyAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyAuditLog'
+type GophkeeperServiceServer_VerifyAuditLog_Call struct {
+	*mock.Call
+}
+
+// VerifyAuditLog is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *v1.VerifyAuditLogRequest
+func (_e *GophkeeperServiceServer_Expecter) VerifyAuditLog(_a0 interface{}, _a1 interface{}) *GophkeeperServiceServer_VerifyAuditLog_Call {
+	return &GophkeeperServiceServer_VerifyAuditLog_Call{Call: _e.mock.On("VerifyAuditLog", _a0, _a1)}
+}
+
+func (_c *GophkeeperServiceServer_VerifyAuditLog_Call) Run(run func(_a0 context.Context, _a1 *v1.VerifyAuditLogRequest)) *GophkeeperServiceServer_VerifyAuditLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.VerifyAuditLogRequest))
+	})
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_VerifyAuditLog_Call) Return(_a0 *v1.VerifyAuditLogResponse, _a1 error) *GophkeeperServiceServer_VerifyAuditLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GophkeeperServiceServer_VerifyAuditLog_Call) RunAndReturn(run func(context.Context, *v1.VerifyAuditLogRequest) (*v1.VerifyAuditLogResponse, error)) *GophkeeperServiceServer_VerifyAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // mustEmbedUnimplementedGophkeeperServiceServer provides a mock function with given fields:
 func (_m *GophkeeperServiceServer) mustEmbedUnimplementedGophkeeperServiceServer() {
 	_m.Called()
@@ -0,0 +1,64 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package v1
+
+import mock "github.com/stretchr/testify/mock"
+
+// isExportItem_Item is an autogenerated mock type for the isExportItem_Item type
+type isExportItem_Item struct {
+	mock.Mock
+}
+
+type isExportItem_Item_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *isExportItem_Item) EXPECT() *isExportItem_Item_Expecter {
+	return &isExportItem_Item_Expecter{mock: &_m.Mock}
+}
+
+// isExportItem_Item provides a mock function with given fields:
+func (_m *isExportItem_Item) isExportItem_Item() {
+	_m.Called()
+}
+
+// isExportItem_Item_isExportItem_Item_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'isExportItem_Item'
+type isExportItem_Item_isExportItem_Item_Call struct {
+	*mock.Call
+}
+
+// isExportItem_Item is a helper method to define mock.On call
+func (_e *isExportItem_Item_Expecter) isExportItem_Item() *isExportItem_Item_isExportItem_Item_Call {
+	return &isExportItem_Item_isExportItem_Item_Call{Call: _e.mock.On("isExportItem_Item")}
+}
+
+func (_c *isExportItem_Item_isExportItem_Item_Call) Run(run func()) *isExportItem_Item_isExportItem_Item_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *isExportItem_Item_isExportItem_Item_Call) Return() *isExportItem_Item_isExportItem_Item_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *isExportItem_Item_isExportItem_Item_Call) RunAndReturn(run func()) *isExportItem_Item_isExportItem_Item_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newIsExportItem_Item creates a new instance of isExportItem_Item. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newIsExportItem_Item(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *isExportItem_Item {
+	mock := &isExportItem_Item{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
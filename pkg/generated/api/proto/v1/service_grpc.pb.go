@@ -19,15 +19,55 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GophkeeperService_Login_FullMethodName        = "/api.v1.GophkeeperService/Login"
-	GophkeeperService_Register_FullMethodName     = "/api.v1.GophkeeperService/Register"
-	GophkeeperService_RefreshToken_FullMethodName = "/api.v1.GophkeeperService/RefreshToken"
-	GophkeeperService_Create_FullMethodName       = "/api.v1.GophkeeperService/Create"
-	GophkeeperService_Get_FullMethodName          = "/api.v1.GophkeeperService/Get"
-	GophkeeperService_Delete_FullMethodName       = "/api.v1.GophkeeperService/Delete"
-	GophkeeperService_List_FullMethodName         = "/api.v1.GophkeeperService/List"
-	GophkeeperService_Upload_FullMethodName       = "/api.v1.GophkeeperService/Upload"
-	GophkeeperService_Download_FullMethodName     = "/api.v1.GophkeeperService/Download"
+	GophkeeperService_Login_FullMethodName                = "/api.v1.GophkeeperService/Login"
+	GophkeeperService_Register_FullMethodName             = "/api.v1.GophkeeperService/Register"
+	GophkeeperService_RefreshToken_FullMethodName         = "/api.v1.GophkeeperService/RefreshToken"
+	GophkeeperService_SRPRegister_FullMethodName          = "/api.v1.GophkeeperService/SRPRegister"
+	GophkeeperService_SRPBegin_FullMethodName             = "/api.v1.GophkeeperService/SRPBegin"
+	GophkeeperService_SRPFinish_FullMethodName            = "/api.v1.GophkeeperService/SRPFinish"
+	GophkeeperService_GetOIDCConfig_FullMethodName        = "/api.v1.GophkeeperService/GetOIDCConfig"
+	GophkeeperService_OIDCLogin_FullMethodName            = "/api.v1.GophkeeperService/OIDCLogin"
+	GophkeeperService_Create_FullMethodName               = "/api.v1.GophkeeperService/Create"
+	GophkeeperService_Get_FullMethodName                  = "/api.v1.GophkeeperService/Get"
+	GophkeeperService_Delete_FullMethodName               = "/api.v1.GophkeeperService/Delete"
+	GophkeeperService_List_FullMethodName                 = "/api.v1.GophkeeperService/List"
+	GophkeeperService_AttachTOTP_FullMethodName           = "/api.v1.GophkeeperService/AttachTOTP"
+	GophkeeperService_FindByURL_FullMethodName            = "/api.v1.GophkeeperService/FindByURL"
+	GophkeeperService_Upload_FullMethodName               = "/api.v1.GophkeeperService/Upload"
+	GophkeeperService_Download_FullMethodName             = "/api.v1.GophkeeperService/Download"
+	GophkeeperService_Export_FullMethodName               = "/api.v1.GophkeeperService/Export"
+	GophkeeperService_Share_FullMethodName                = "/api.v1.GophkeeperService/Share"
+	GophkeeperService_Unshare_FullMethodName              = "/api.v1.GophkeeperService/Unshare"
+	GophkeeperService_ListShares_FullMethodName           = "/api.v1.GophkeeperService/ListShares"
+	GophkeeperService_CreateOrganization_FullMethodName   = "/api.v1.GophkeeperService/CreateOrganization"
+	GophkeeperService_ListOrganizations_FullMethodName    = "/api.v1.GophkeeperService/ListOrganizations"
+	GophkeeperService_SetMember_FullMethodName            = "/api.v1.GophkeeperService/SetMember"
+	GophkeeperService_RemoveMember_FullMethodName         = "/api.v1.GophkeeperService/RemoveMember"
+	GophkeeperService_ListMembers_FullMethodName          = "/api.v1.GophkeeperService/ListMembers"
+	GophkeeperService_CreateTeam_FullMethodName           = "/api.v1.GophkeeperService/CreateTeam"
+	GophkeeperService_AddTeamMember_FullMethodName        = "/api.v1.GophkeeperService/AddTeamMember"
+	GophkeeperService_RemoveTeamMember_FullMethodName     = "/api.v1.GophkeeperService/RemoveTeamMember"
+	GophkeeperService_ListTeams_FullMethodName            = "/api.v1.GophkeeperService/ListTeams"
+	GophkeeperService_CreateCollection_FullMethodName     = "/api.v1.GophkeeperService/CreateCollection"
+	GophkeeperService_AddToCollection_FullMethodName      = "/api.v1.GophkeeperService/AddToCollection"
+	GophkeeperService_RemoveFromCollection_FullMethodName = "/api.v1.GophkeeperService/RemoveFromCollection"
+	GophkeeperService_AssignCollection_FullMethodName     = "/api.v1.GophkeeperService/AssignCollection"
+	GophkeeperService_UnassignCollection_FullMethodName   = "/api.v1.GophkeeperService/UnassignCollection"
+	GophkeeperService_ListCollections_FullMethodName      = "/api.v1.GophkeeperService/ListCollections"
+	GophkeeperService_ListAuditEvents_FullMethodName      = "/api.v1.GophkeeperService/ListAuditEvents"
+	GophkeeperService_VerifyAuditLog_FullMethodName       = "/api.v1.GophkeeperService/VerifyAuditLog"
+	GophkeeperService_CreateServiceAccount_FullMethodName = "/api.v1.GophkeeperService/CreateServiceAccount"
+	GophkeeperService_ListServiceAccounts_FullMethodName  = "/api.v1.GophkeeperService/ListServiceAccounts"
+	GophkeeperService_DeleteServiceAccount_FullMethodName = "/api.v1.GophkeeperService/DeleteServiceAccount"
+	GophkeeperService_CreateAPIKey_FullMethodName         = "/api.v1.GophkeeperService/CreateAPIKey"
+	GophkeeperService_ListAPIKeys_FullMethodName          = "/api.v1.GophkeeperService/ListAPIKeys"
+	GophkeeperService_RevokeAPIKey_FullMethodName         = "/api.v1.GophkeeperService/RevokeAPIKey"
+	GophkeeperService_ExchangeAPIKey_FullMethodName       = "/api.v1.GophkeeperService/ExchangeAPIKey"
+	GophkeeperService_GetJWKS_FullMethodName              = "/api.v1.GophkeeperService/GetJWKS"
+	GophkeeperService_RotateSigningKey_FullMethodName     = "/api.v1.GophkeeperService/RotateSigningKey"
+	GophkeeperService_RegisterSecretType_FullMethodName   = "/api.v1.GophkeeperService/RegisterSecretType"
+	GophkeeperService_GetSecretType_FullMethodName        = "/api.v1.GophkeeperService/GetSecretType"
+	GophkeeperService_ListSecretTypes_FullMethodName      = "/api.v1.GophkeeperService/ListSecretTypes"
 )
 
 // GophkeeperServiceClient is the client API for GophkeeperService service.
@@ -38,13 +78,59 @@ type GophkeeperServiceClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// zero-knowledge (SRP-6a) registration and login, the password never leaves the client
+	SRPRegister(ctx context.Context, in *SRPRegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	SRPBegin(ctx context.Context, in *SRPBeginRequest, opts ...grpc.CallOption) (*SRPBeginResponse, error)
+	SRPFinish(ctx context.Context, in *SRPFinishRequest, opts ...grpc.CallOption) (*SRPFinishResponse, error)
+	// single sign-on, the client signs in at the OpenID Connect issuer and exchanges the ID token
+	GetOIDCConfig(ctx context.Context, in *GetOIDCConfigRequest, opts ...grpc.CallOption) (*GetOIDCConfigResponse, error)
+	OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	// authenticated APIs
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	AttachTOTP(ctx context.Context, in *AttachTOTPRequest, opts ...grpc.CallOption) (*AttachTOTPResponse, error)
+	FindByURL(ctx context.Context, in *FindByURLRequest, opts ...grpc.CallOption) (*FindByURLResponse, error)
 	Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Chunk, UploadResponse], error)
 	Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Chunk], error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportItem], error)
+	Share(ctx context.Context, in *ShareRequest, opts ...grpc.CallOption) (*ShareResponse, error)
+	Unshare(ctx context.Context, in *UnshareRequest, opts ...grpc.CallOption) (*UnshareResponse, error)
+	ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	SetMember(ctx context.Context, in *SetMemberRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	AddToCollection(ctx context.Context, in *AddToCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	RemoveFromCollection(ctx context.Context, in *RemoveFromCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	AssignCollection(ctx context.Context, in *AssignCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	UnassignCollection(ctx context.Context, in *UnassignCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error)
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error)
+	// public, exchanges an API key for a short-lived access token
+	ExchangeAPIKey(ctx context.Context, in *ExchangeAPIKeyRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// public, the keys verifying the JWTs issued by the server
+	GetJWKS(ctx context.Context, in *GetJWKSRequest, opts ...grpc.CallOption) (*GetJWKSResponse, error)
+	// administrators only, the previous key stays valid for verification
+	RotateSigningKey(ctx context.Context, in *RotateSigningKeyRequest, opts ...grpc.CallOption) (*RotateSigningKeyResponse, error)
+	// user-defined secret types, registration is for administrators only
+	RegisterSecretType(ctx context.Context, in *RegisterSecretTypeRequest, opts ...grpc.CallOption) (*RegisterSecretTypeResponse, error)
+	GetSecretType(ctx context.Context, in *GetSecretTypeRequest, opts ...grpc.CallOption) (*GetSecretTypeResponse, error)
+	ListSecretTypes(ctx context.Context, in *ListSecretTypesRequest, opts ...grpc.CallOption) (*ListSecretTypesResponse, error)
 }
 
 type gophkeeperServiceClient struct {
@@ -85,6 +171,56 @@ func (c *gophkeeperServiceClient) RefreshToken(ctx context.Context, in *RefreshT
 	return out, nil
 }
 
+func (c *gophkeeperServiceClient) SRPRegister(ctx context.Context, in *SRPRegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_SRPRegister_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) SRPBegin(ctx context.Context, in *SRPBeginRequest, opts ...grpc.CallOption) (*SRPBeginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SRPBeginResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_SRPBegin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) SRPFinish(ctx context.Context, in *SRPFinishRequest, opts ...grpc.CallOption) (*SRPFinishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SRPFinishResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_SRPFinish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) GetOIDCConfig(ctx context.Context, in *GetOIDCConfigRequest, opts ...grpc.CallOption) (*GetOIDCConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOIDCConfigResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_GetOIDCConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_OIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gophkeeperServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateResponse)
@@ -125,6 +261,26 @@ func (c *gophkeeperServiceClient) List(ctx context.Context, in *ListRequest, opt
 	return out, nil
 }
 
+func (c *gophkeeperServiceClient) AttachTOTP(ctx context.Context, in *AttachTOTPRequest, opts ...grpc.CallOption) (*AttachTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachTOTPResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_AttachTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) FindByURL(ctx context.Context, in *FindByURLRequest, opts ...grpc.CallOption) (*FindByURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindByURLResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_FindByURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gophkeeperServiceClient) Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Chunk, UploadResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &GophkeeperService_ServiceDesc.Streams[0], GophkeeperService_Upload_FullMethodName, cOpts...)
@@ -157,222 +313,1440 @@ func (c *gophkeeperServiceClient) Download(ctx context.Context, in *DownloadRequ
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type GophkeeperService_DownloadClient = grpc.ServerStreamingClient[Chunk]
 
-// GophkeeperServiceServer is the server API for GophkeeperService service.
-// All implementations must embed UnimplementedGophkeeperServiceServer
-// for forward compatibility.
-type GophkeeperServiceServer interface {
-	// unauthenticated APIs
-	Login(context.Context, *LoginRequest) (*AuthResponse, error)
-	Register(context.Context, *RegisterRequest) (*AuthResponse, error)
-	RefreshToken(context.Context, *RefreshTokenRequest) (*AuthResponse, error)
-	// authenticated APIs
-	Create(context.Context, *CreateRequest) (*CreateResponse, error)
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	List(context.Context, *ListRequest) (*ListResponse, error)
-	Upload(grpc.ClientStreamingServer[Chunk, UploadResponse]) error
-	Download(*DownloadRequest, grpc.ServerStreamingServer[Chunk]) error
-	mustEmbedUnimplementedGophkeeperServiceServer()
+func (c *gophkeeperServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportItem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GophkeeperService_ServiceDesc.Streams[2], GophkeeperService_Export_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportItem]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// UnimplementedGophkeeperServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGophkeeperServiceServer struct{}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GophkeeperService_ExportClient = grpc.ServerStreamingClient[ExportItem]
 
-func (UnimplementedGophkeeperServiceServer) Login(context.Context, *LoginRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+func (c *gophkeeperServiceClient) Share(ctx context.Context, in *ShareRequest, opts ...grpc.CallOption) (*ShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_Share_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGophkeeperServiceServer) Register(context.Context, *RegisterRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+
+func (c *gophkeeperServiceClient) Unshare(ctx context.Context, in *UnshareRequest, opts ...grpc.CallOption) (*UnshareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_Unshare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGophkeeperServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+
+func (c *gophkeeperServiceClient) ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharesResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGophkeeperServiceServer) Create(context.Context, *CreateRequest) (*CreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+
+func (c *gophkeeperServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGophkeeperServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func (c *gophkeeperServiceClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGophkeeperServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func (c *gophkeeperServiceClient) SetMember(ctx context.Context, in *SetMemberRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_SetMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_AddTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_RemoveTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) AddToCollection(ctx context.Context, in *AddToCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_AddToCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) RemoveFromCollection(ctx context.Context, in *RemoveFromCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_RemoveFromCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) AssignCollection(ctx context.Context, in *AssignCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_AssignCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) UnassignCollection(ctx context.Context, in *UnassignCollectionRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_UnassignCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_DeleteServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) ExchangeAPIKey(ctx context.Context, in *ExchangeAPIKeyRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ExchangeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) GetJWKS(ctx context.Context, in *GetJWKSRequest, opts ...grpc.CallOption) (*GetJWKSResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJWKSResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_GetJWKS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) RotateSigningKey(ctx context.Context, in *RotateSigningKeyRequest, opts ...grpc.CallOption) (*RotateSigningKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateSigningKeyResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_RotateSigningKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) RegisterSecretType(ctx context.Context, in *RegisterSecretTypeRequest, opts ...grpc.CallOption) (*RegisterSecretTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterSecretTypeResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_RegisterSecretType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) GetSecretType(ctx context.Context, in *GetSecretTypeRequest, opts ...grpc.CallOption) (*GetSecretTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecretTypeResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_GetSecretType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophkeeperServiceClient) ListSecretTypes(ctx context.Context, in *ListSecretTypesRequest, opts ...grpc.CallOption) (*ListSecretTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecretTypesResponse)
+	err := c.cc.Invoke(ctx, GophkeeperService_ListSecretTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GophkeeperServiceServer is the server API for GophkeeperService service.
+// All implementations must embed UnimplementedGophkeeperServiceServer
+// for forward compatibility.
+type GophkeeperServiceServer interface {
+	// unauthenticated APIs
+	Login(context.Context, *LoginRequest) (*AuthResponse, error)
+	Register(context.Context, *RegisterRequest) (*AuthResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*AuthResponse, error)
+	// zero-knowledge (SRP-6a) registration and login, the password never leaves the client
+	SRPRegister(context.Context, *SRPRegisterRequest) (*AuthResponse, error)
+	SRPBegin(context.Context, *SRPBeginRequest) (*SRPBeginResponse, error)
+	SRPFinish(context.Context, *SRPFinishRequest) (*SRPFinishResponse, error)
+	// single sign-on, the client signs in at the OpenID Connect issuer and exchanges the ID token
+	GetOIDCConfig(context.Context, *GetOIDCConfigRequest) (*GetOIDCConfigResponse, error)
+	OIDCLogin(context.Context, *OIDCLoginRequest) (*AuthResponse, error)
+	// authenticated APIs
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	AttachTOTP(context.Context, *AttachTOTPRequest) (*AttachTOTPResponse, error)
+	FindByURL(context.Context, *FindByURLRequest) (*FindByURLResponse, error)
+	Upload(grpc.ClientStreamingServer[Chunk, UploadResponse]) error
+	Download(*DownloadRequest, grpc.ServerStreamingServer[Chunk]) error
+	Export(*ExportRequest, grpc.ServerStreamingServer[ExportItem]) error
+	Share(context.Context, *ShareRequest) (*ShareResponse, error)
+	Unshare(context.Context, *UnshareRequest) (*UnshareResponse, error)
+	ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error)
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*OrganizationResponse, error)
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	SetMember(context.Context, *SetMemberRequest) (*OrganizationResponse, error)
+	RemoveMember(context.Context, *RemoveMemberRequest) (*OrganizationResponse, error)
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*OrganizationResponse, error)
+	AddTeamMember(context.Context, *AddTeamMemberRequest) (*OrganizationResponse, error)
+	RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*OrganizationResponse, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*OrganizationResponse, error)
+	AddToCollection(context.Context, *AddToCollectionRequest) (*OrganizationResponse, error)
+	RemoveFromCollection(context.Context, *RemoveFromCollectionRequest) (*OrganizationResponse, error)
+	AssignCollection(context.Context, *AssignCollectionRequest) (*OrganizationResponse, error)
+	UnassignCollection(context.Context, *UnassignCollectionRequest) (*OrganizationResponse, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountResponse, error)
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*ServiceAccountResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*ServiceAccountResponse, error)
+	// public, exchanges an API key for a short-lived access token
+	ExchangeAPIKey(context.Context, *ExchangeAPIKeyRequest) (*AuthResponse, error)
+	// public, the keys verifying the JWTs issued by the server
+	GetJWKS(context.Context, *GetJWKSRequest) (*GetJWKSResponse, error)
+	// administrators only, the previous key stays valid for verification
+	RotateSigningKey(context.Context, *RotateSigningKeyRequest) (*RotateSigningKeyResponse, error)
+	// user-defined secret types, registration is for administrators only
+	RegisterSecretType(context.Context, *RegisterSecretTypeRequest) (*RegisterSecretTypeResponse, error)
+	GetSecretType(context.Context, *GetSecretTypeRequest) (*GetSecretTypeResponse, error)
+	ListSecretTypes(context.Context, *ListSecretTypesRequest) (*ListSecretTypesResponse, error)
+	mustEmbedUnimplementedGophkeeperServiceServer()
+}
+
+// UnimplementedGophkeeperServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGophkeeperServiceServer struct{}
+
+func (UnimplementedGophkeeperServiceServer) Login(context.Context, *LoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Register(context.Context, *RegisterRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) SRPRegister(context.Context, *SRPRegisterRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRPRegister not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) SRPBegin(context.Context, *SRPBeginRequest) (*SRPBeginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRPBegin not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) SRPFinish(context.Context, *SRPFinishRequest) (*SRPFinishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRPFinish not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) GetOIDCConfig(context.Context, *GetOIDCConfigRequest) (*GetOIDCConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCConfig not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) OIDCLogin(context.Context, *OIDCLoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCLogin not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Create(context.Context, *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) AttachTOTP(context.Context, *AttachTOTPRequest) (*AttachTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachTOTP not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) FindByURL(context.Context, *FindByURLRequest) (*FindByURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByURL not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Upload(grpc.ClientStreamingServer[Chunk, UploadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Download(*DownloadRequest, grpc.ServerStreamingServer[Chunk]) error {
+	return status.Errorf(codes.Unimplemented, "method Download not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Export(*ExportRequest, grpc.ServerStreamingServer[ExportItem]) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Share(context.Context, *ShareRequest) (*ShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Share not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) Unshare(context.Context, *UnshareRequest) (*UnshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unshare not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShares not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) SetMember(context.Context, *SetMemberRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMember not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) AddTeamMember(context.Context, *AddTeamMemberRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeamMember not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) AddToCollection(context.Context, *AddToCollectionRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToCollection not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) RemoveFromCollection(context.Context, *RemoveFromCollectionRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromCollection not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) AssignCollection(context.Context, *AssignCollectionRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignCollection not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) UnassignCollection(context.Context, *UnassignCollectionRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignCollection not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*ServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*ServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ExchangeAPIKey(context.Context, *ExchangeAPIKeyRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeAPIKey not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) GetJWKS(context.Context, *GetJWKSRequest) (*GetJWKSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) RotateSigningKey(context.Context, *RotateSigningKeyRequest) (*RotateSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSigningKey not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) RegisterSecretType(context.Context, *RegisterSecretTypeRequest) (*RegisterSecretTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSecretType not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) GetSecretType(context.Context, *GetSecretTypeRequest) (*GetSecretTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretType not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) ListSecretTypes(context.Context, *ListSecretTypesRequest) (*ListSecretTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecretTypes not implemented")
+}
+func (UnimplementedGophkeeperServiceServer) mustEmbedUnimplementedGophkeeperServiceServer() {}
+func (UnimplementedGophkeeperServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeGophkeeperServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GophkeeperServiceServer will
+// result in compilation errors.
+type UnsafeGophkeeperServiceServer interface {
+	mustEmbedUnimplementedGophkeeperServiceServer()
+}
+
+func RegisterGophkeeperServiceServer(s grpc.ServiceRegistrar, srv GophkeeperServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGophkeeperServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GophkeeperService_ServiceDesc, srv)
+}
+
+func _GophkeeperService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_SRPRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRPRegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).SRPRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_SRPRegister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).SRPRegister(ctx, req.(*SRPRegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_SRPBegin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRPBeginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).SRPBegin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_SRPBegin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).SRPBegin(ctx, req.(*SRPBeginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_SRPFinish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRPFinishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).SRPFinish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_SRPFinish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).SRPFinish(ctx, req.(*SRPFinishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_GetOIDCConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOIDCConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).GetOIDCConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_GetOIDCConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).GetOIDCConfig(ctx, req.(*GetOIDCConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_OIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).OIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_OIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).OIDCLogin(ctx, req.(*OIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_AttachTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).AttachTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_AttachTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).AttachTOTP(ctx, req.(*AttachTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_FindByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).FindByURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_FindByURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).FindByURL(ctx, req.(*FindByURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GophkeeperServiceServer).Upload(&grpc.GenericServerStream[Chunk, UploadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GophkeeperService_UploadServer = grpc.ClientStreamingServer[Chunk, UploadResponse]
+
+func _GophkeeperService_Download_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GophkeeperServiceServer).Download(m, &grpc.GenericServerStream[DownloadRequest, Chunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GophkeeperService_DownloadServer = grpc.ServerStreamingServer[Chunk]
+
+func _GophkeeperService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GophkeeperServiceServer).Export(m, &grpc.GenericServerStream[ExportRequest, ExportItem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GophkeeperService_ExportServer = grpc.ServerStreamingServer[ExportItem]
+
+func _GophkeeperService_Share_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).Share(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_Share_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).Share(ctx, req.(*ShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_Unshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).Unshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_Unshare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).Unshare(ctx, req.(*UnshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).ListShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_ListShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).ListShares(ctx, req.(*ListSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_SetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).SetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_SetMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).SetMember(ctx, req.(*SetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).ListMembers(ctx, req.(*ListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_AddTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).AddTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_AddTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).AddTeamMember(ctx, req.(*AddTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_RemoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).RemoveTeamMember(ctx, req.(*RemoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_AddToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).AddToCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_AddToCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).AddToCollection(ctx, req.(*AddToCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_RemoveFromCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).RemoveFromCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_RemoveFromCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).RemoveFromCollection(ctx, req.(*RemoveFromCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophkeeperService_AssignCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).AssignCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_AssignCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).AssignCollection(ctx, req.(*AssignCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophkeeperServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+
+func _GophkeeperService_UnassignCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).UnassignCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_UnassignCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).UnassignCollection(ctx, req.(*UnassignCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophkeeperServiceServer) Upload(grpc.ClientStreamingServer[Chunk, UploadResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+
+func _GophkeeperService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophkeeperServiceServer) Download(*DownloadRequest, grpc.ServerStreamingServer[Chunk]) error {
-	return status.Errorf(codes.Unimplemented, "method Download not implemented")
+
+func _GophkeeperService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophkeeperServiceServer) mustEmbedUnimplementedGophkeeperServiceServer() {}
-func (UnimplementedGophkeeperServiceServer) testEmbeddedByValue()                           {}
 
-// UnsafeGophkeeperServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GophkeeperServiceServer will
-// result in compilation errors.
-type UnsafeGophkeeperServiceServer interface {
-	mustEmbedUnimplementedGophkeeperServiceServer()
+func _GophkeeperService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGophkeeperServiceServer(s grpc.ServiceRegistrar, srv GophkeeperServiceServer) {
-	// If the following call pancis, it indicates UnimplementedGophkeeperServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _GophkeeperService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&GophkeeperService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GophkeeperService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _GophkeeperService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophkeeperServiceServer).Login(ctx, in)
+		return srv.(GophkeeperServiceServer).ListServiceAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophkeeperService_Login_FullMethodName,
+		FullMethod: GophkeeperService_ListServiceAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophkeeperServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(GophkeeperServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophkeeperService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _GophkeeperService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophkeeperServiceServer).Register(ctx, in)
+		return srv.(GophkeeperServiceServer).DeleteServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophkeeperService_Register_FullMethodName,
+		FullMethod: GophkeeperService_DeleteServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophkeeperServiceServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(GophkeeperServiceServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophkeeperService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _GophkeeperService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophkeeperServiceServer).RefreshToken(ctx, in)
+		return srv.(GophkeeperServiceServer).CreateAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophkeeperService_RefreshToken_FullMethodName,
+		FullMethod: GophkeeperService_CreateAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophkeeperServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(GophkeeperServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophkeeperService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRequest)
+func _GophkeeperService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophkeeperServiceServer).Create(ctx, in)
+		return srv.(GophkeeperServiceServer).ListAPIKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophkeeperService_Create_FullMethodName,
+		FullMethod: GophkeeperService_ListAPIKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophkeeperServiceServer).Create(ctx, req.(*CreateRequest))
+		return srv.(GophkeeperServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophkeeperService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
+func _GophkeeperService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophkeeperServiceServer).Get(ctx, in)
+		return srv.(GophkeeperServiceServer).RevokeAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophkeeperService_Get_FullMethodName,
+		FullMethod: GophkeeperService_RevokeAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophkeeperServiceServer).Get(ctx, req.(*GetRequest))
+		return srv.(GophkeeperServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophkeeperService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _GophkeeperService_ExchangeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophkeeperServiceServer).Delete(ctx, in)
+		return srv.(GophkeeperServiceServer).ExchangeAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophkeeperService_Delete_FullMethodName,
+		FullMethod: GophkeeperService_ExchangeAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophkeeperServiceServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(GophkeeperServiceServer).ExchangeAPIKey(ctx, req.(*ExchangeAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophkeeperService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
+func _GophkeeperService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJWKSRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophkeeperServiceServer).List(ctx, in)
+		return srv.(GophkeeperServiceServer).GetJWKS(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophkeeperService_List_FullMethodName,
+		FullMethod: GophkeeperService_GetJWKS_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophkeeperServiceServer).List(ctx, req.(*ListRequest))
+		return srv.(GophkeeperServiceServer).GetJWKS(ctx, req.(*GetJWKSRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophkeeperService_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GophkeeperServiceServer).Upload(&grpc.GenericServerStream[Chunk, UploadResponse]{ServerStream: stream})
+func _GophkeeperService_RotateSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).RotateSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_RotateSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).RotateSigningKey(ctx, req.(*RotateSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type GophkeeperService_UploadServer = grpc.ClientStreamingServer[Chunk, UploadResponse]
+func _GophkeeperService_RegisterSecretType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterSecretTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).RegisterSecretType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_RegisterSecretType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).RegisterSecretType(ctx, req.(*RegisterSecretTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func _GophkeeperService_Download_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DownloadRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GophkeeperService_GetSecretType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GophkeeperServiceServer).Download(m, &grpc.GenericServerStream[DownloadRequest, Chunk]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).GetSecretType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_GetSecretType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).GetSecretType(ctx, req.(*GetSecretTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type GophkeeperService_DownloadServer = grpc.ServerStreamingServer[Chunk]
+func _GophkeeperService_ListSecretTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophkeeperServiceServer).ListSecretTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophkeeperService_ListSecretTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophkeeperServiceServer).ListSecretTypes(ctx, req.(*ListSecretTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
 // GophkeeperService_ServiceDesc is the grpc.ServiceDesc for GophkeeperService service.
 // It's only intended for direct use with grpc.RegisterService,
@@ -393,6 +1767,26 @@ var GophkeeperService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshToken",
 			Handler:    _GophkeeperService_RefreshToken_Handler,
 		},
+		{
+			MethodName: "SRPRegister",
+			Handler:    _GophkeeperService_SRPRegister_Handler,
+		},
+		{
+			MethodName: "SRPBegin",
+			Handler:    _GophkeeperService_SRPBegin_Handler,
+		},
+		{
+			MethodName: "SRPFinish",
+			Handler:    _GophkeeperService_SRPFinish_Handler,
+		},
+		{
+			MethodName: "GetOIDCConfig",
+			Handler:    _GophkeeperService_GetOIDCConfig_Handler,
+		},
+		{
+			MethodName: "OIDCLogin",
+			Handler:    _GophkeeperService_OIDCLogin_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _GophkeeperService_Create_Handler,
@@ -409,6 +1803,142 @@ var GophkeeperService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _GophkeeperService_List_Handler,
 		},
+		{
+			MethodName: "AttachTOTP",
+			Handler:    _GophkeeperService_AttachTOTP_Handler,
+		},
+		{
+			MethodName: "FindByURL",
+			Handler:    _GophkeeperService_FindByURL_Handler,
+		},
+		{
+			MethodName: "Share",
+			Handler:    _GophkeeperService_Share_Handler,
+		},
+		{
+			MethodName: "Unshare",
+			Handler:    _GophkeeperService_Unshare_Handler,
+		},
+		{
+			MethodName: "ListShares",
+			Handler:    _GophkeeperService_ListShares_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _GophkeeperService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _GophkeeperService_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "SetMember",
+			Handler:    _GophkeeperService_SetMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _GophkeeperService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _GophkeeperService_ListMembers_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _GophkeeperService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "AddTeamMember",
+			Handler:    _GophkeeperService_AddTeamMember_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMember",
+			Handler:    _GophkeeperService_RemoveTeamMember_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _GophkeeperService_ListTeams_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _GophkeeperService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "AddToCollection",
+			Handler:    _GophkeeperService_AddToCollection_Handler,
+		},
+		{
+			MethodName: "RemoveFromCollection",
+			Handler:    _GophkeeperService_RemoveFromCollection_Handler,
+		},
+		{
+			MethodName: "AssignCollection",
+			Handler:    _GophkeeperService_AssignCollection_Handler,
+		},
+		{
+			MethodName: "UnassignCollection",
+			Handler:    _GophkeeperService_UnassignCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _GophkeeperService_ListCollections_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _GophkeeperService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _GophkeeperService_VerifyAuditLog_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _GophkeeperService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _GophkeeperService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _GophkeeperService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _GophkeeperService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _GophkeeperService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _GophkeeperService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "ExchangeAPIKey",
+			Handler:    _GophkeeperService_ExchangeAPIKey_Handler,
+		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _GophkeeperService_GetJWKS_Handler,
+		},
+		{
+			MethodName: "RotateSigningKey",
+			Handler:    _GophkeeperService_RotateSigningKey_Handler,
+		},
+		{
+			MethodName: "RegisterSecretType",
+			Handler:    _GophkeeperService_RegisterSecretType_Handler,
+		},
+		{
+			MethodName: "GetSecretType",
+			Handler:    _GophkeeperService_GetSecretType_Handler,
+		},
+		{
+			MethodName: "ListSecretTypes",
+			Handler:    _GophkeeperService_ListSecretTypes_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -421,6 +1951,11 @@ var GophkeeperService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GophkeeperService_Download_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Export",
+			Handler:       _GophkeeperService_Export_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/proto/v1/service.proto",
 }